@@ -0,0 +1,62 @@
+// Package actor identifies the caller of a request.
+//
+// The service has no authentication of its own; it expects the gateway in
+// front of it to authenticate the user and forward the identity in the
+// X-User-ID and X-User-Role headers.
+package actor
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type Role string
+
+const (
+	RoleAdmin   Role = "admin"
+	RoleTeacher Role = "teacher"
+	RoleStudent Role = "student"
+)
+
+const (
+	HeaderUserID   = "X-User-ID"
+	HeaderUserRole = "X-User-Role"
+)
+
+var ErrUnauthenticated = errors.New("missing or invalid user identity")
+
+type Actor struct {
+	ID   uint
+	Role Role
+}
+
+// FromContext reads the actor from the request headers.
+func FromContext(c *gin.Context) (Actor, error) {
+	role := Role(c.GetHeader(HeaderUserRole))
+	switch role {
+	case RoleAdmin, RoleTeacher, RoleStudent:
+	default:
+		return Actor{}, ErrUnauthenticated
+	}
+
+	id, err := strconv.ParseUint(c.GetHeader(HeaderUserID), 10, 32)
+	if err != nil {
+		return Actor{}, ErrUnauthenticated
+	}
+
+	return Actor{ID: uint(id), Role: role}, nil
+}
+
+func (a Actor) IsAdmin() bool {
+	return a.Role == RoleAdmin
+}
+
+func (a Actor) IsTeacher(id uint) bool {
+	return a.Role == RoleTeacher && a.ID == id
+}
+
+func (a Actor) IsStudent(id uint) bool {
+	return a.Role == RoleStudent && a.ID == id
+}
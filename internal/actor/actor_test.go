@@ -0,0 +1,43 @@
+package actor
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFromContext(t *testing.T) {
+	tests := []struct {
+		name    string
+		id      string
+		role    string
+		want    Actor
+		wantErr bool
+	}{
+		{"teacher", "7", "teacher", Actor{ID: 7, Role: RoleTeacher}, false},
+		{"admin", "1", "admin", Actor{ID: 1, Role: RoleAdmin}, false},
+		{"unknown role", "7", "janitor", Actor{}, true},
+		{"missing id", "", "student", Actor{}, true},
+		{"missing headers", "", "", Actor{}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, _ := gin.CreateTestContext(httptest.NewRecorder())
+			c.Request = httptest.NewRequest(http.MethodGet, "/", nil)
+			c.Request.Header.Set(HeaderUserID, tt.id)
+			c.Request.Header.Set(HeaderUserRole, tt.role)
+
+			a, err := FromContext(c)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrUnauthenticated)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, a)
+		})
+	}
+}
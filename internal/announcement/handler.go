@@ -0,0 +1,274 @@
+package announcement
+
+import (
+	"errors"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+	"net/http"
+	"strconv"
+	"student_go/internal/actor"
+	"student_go/internal/course"
+	"student_go/internal/dto/request"
+	"student_go/pkg/log"
+	"student_go/pkg/pagination"
+	"student_go/pkg/patch"
+)
+
+type Handler struct {
+	Service Service
+}
+
+func NewAnnouncementHandler() *Handler {
+	return &Handler{
+		Service: NewAnnouncementService(NewAnnouncementRepository(), course.NewCourseRepository()),
+	}
+}
+
+func (h *Handler) CreateAnnouncement(c *gin.Context) {
+	courseId, ok := parseID(c, "id", "invalid course ID")
+	if !ok {
+		return
+	}
+
+	a, err := actor.FromContext(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+
+	var req request.AnnouncementRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		log.Log.Warn("Invalid request in CreateAnnouncement", zap.Error(err))
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	log.Log.Info("CreateAnnouncement called", zap.Uint("course_id", courseId), zap.String("title", req.Title))
+
+	announcementResp, err := h.Service.CreateAnnouncement(courseId, a, req)
+	if err != nil {
+		writeError(c, err, "failed to save announcement")
+		return
+	}
+
+	c.JSON(http.StatusCreated, announcementResp)
+}
+
+func (h *Handler) UpdateAnnouncement(c *gin.Context) {
+	courseId, ok := parseID(c, "id", "invalid course ID")
+	if !ok {
+		return
+	}
+	id, ok := parseID(c, "announcementId", "invalid announcement ID")
+	if !ok {
+		return
+	}
+
+	a, err := actor.FromContext(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+
+	var req request.AnnouncementPatch
+	fields, err := patch.Bind(c.Request, &req)
+	if err != nil {
+		log.Log.Warn("Invalid request in UpdateAnnouncement", zap.Error(err))
+		if errors.Is(err, patch.ErrUnsupportedMediaType) {
+			c.JSON(http.StatusUnsupportedMediaType, gin.H{"error": err.Error()})
+		} else {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	log.Log.Info("UpdateAnnouncement called", zap.Uint("course_id", courseId), zap.Uint("id", id), zap.Strings("fields", fields.Names()))
+
+	announcementResp, err := h.Service.UpdateAnnouncement(courseId, id, a, req, fields)
+	if err != nil {
+		writeError(c, err, "failed to update announcement")
+		return
+	}
+
+	c.JSON(http.StatusOK, announcementResp)
+}
+
+func (h *Handler) PinAnnouncement(c *gin.Context) {
+	h.setPinned(c, true)
+}
+
+func (h *Handler) UnpinAnnouncement(c *gin.Context) {
+	h.setPinned(c, false)
+}
+
+func (h *Handler) setPinned(c *gin.Context, pinned bool) {
+	courseId, ok := parseID(c, "id", "invalid course ID")
+	if !ok {
+		return
+	}
+	id, ok := parseID(c, "announcementId", "invalid announcement ID")
+	if !ok {
+		return
+	}
+
+	a, err := actor.FromContext(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+
+	log.Log.Info("PinAnnouncement called",
+		zap.Uint("course_id", courseId),
+		zap.Uint("id", id),
+		zap.Bool("pinned", pinned),
+	)
+
+	announcementResp, err := h.Service.PinAnnouncement(courseId, id, a, pinned)
+	if err != nil {
+		writeError(c, err, "failed to pin announcement")
+		return
+	}
+
+	c.JSON(http.StatusOK, announcementResp)
+}
+
+func (h *Handler) FindAnnouncementById(c *gin.Context) {
+	courseId, ok := parseID(c, "id", "invalid course ID")
+	if !ok {
+		return
+	}
+	id, ok := parseID(c, "announcementId", "invalid announcement ID")
+	if !ok {
+		return
+	}
+
+	a, err := actor.FromContext(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+
+	log.Log.Info("FindAnnouncementById called", zap.Uint("course_id", courseId), zap.Uint("id", id))
+
+	announcementResp, err := h.Service.FindAnnouncementById(courseId, id, a)
+	if err != nil {
+		writeError(c, err, "something went wrong")
+		return
+	}
+
+	c.JSON(http.StatusOK, announcementResp)
+}
+
+func (h *Handler) FindAllAnnouncements(c *gin.Context) {
+	courseId, ok := parseID(c, "id", "invalid course ID")
+	if !ok {
+		return
+	}
+
+	a, err := actor.FromContext(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+
+	count, err := h.Service.Count(courseId, a)
+	if err != nil {
+		log.Log.Error("Failed to count announcements", zap.Error(err))
+		writeError(c, err, "failed to count announcements")
+		return
+	}
+
+	pages := pagination.NewFromRequest(c.Request, count)
+
+	log.Log.Info("FindAllAnnouncements called",
+		zap.Uint("course_id", courseId),
+		zap.Int("page", pages.Page),
+		zap.Int("per_page", pages.PerPage),
+		zap.Int("total_count", pages.TotalCount),
+	)
+
+	announcements, err := h.Service.FindAllAnnouncements(courseId, a, pages.Page, pages.PerPage)
+	if err != nil {
+		writeError(c, err, "failed to get announcements")
+		return
+	}
+
+	pages.Items = announcements
+	c.JSON(http.StatusOK, pages)
+}
+
+func (h *Handler) FindAnnouncementRevisions(c *gin.Context) {
+	courseId, ok := parseID(c, "id", "invalid course ID")
+	if !ok {
+		return
+	}
+	id, ok := parseID(c, "announcementId", "invalid announcement ID")
+	if !ok {
+		return
+	}
+
+	a, err := actor.FromContext(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+
+	log.Log.Info("FindAnnouncementRevisions called", zap.Uint("course_id", courseId), zap.Uint("id", id))
+
+	revisions, err := h.Service.FindAnnouncementRevisions(courseId, id, a)
+	if err != nil {
+		writeError(c, err, "failed to get announcement revisions")
+		return
+	}
+
+	c.JSON(http.StatusOK, revisions)
+}
+
+func (h *Handler) DeleteAnnouncementById(c *gin.Context) {
+	courseId, ok := parseID(c, "id", "invalid course ID")
+	if !ok {
+		return
+	}
+	id, ok := parseID(c, "announcementId", "invalid announcement ID")
+	if !ok {
+		return
+	}
+
+	a, err := actor.FromContext(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+
+	log.Log.Info("DeleteAnnouncementById called", zap.Uint("course_id", courseId), zap.Uint("id", id))
+
+	if err := h.Service.DeleteAnnouncementById(courseId, id, a); err != nil {
+		writeError(c, err, "failed to delete announcement")
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func parseID(c *gin.Context, name string, message string) (uint, bool) {
+	param := c.Param(name)
+	parsed, err := strconv.ParseUint(param, 10, 32)
+	if err != nil {
+		log.Log.Warn("Invalid path parameter", zap.String(name, param), zap.Error(err))
+		c.JSON(http.StatusBadRequest, gin.H{"error": message})
+		return 0, false
+	}
+
+	return uint(parsed), true
+}
+
+func writeError(c *gin.Context, err error, fallback string) {
+	switch {
+	case errors.Is(err, ErrCourseNotFound), errors.Is(err, ErrAnnouncementNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	case errors.Is(err, ErrForbidden):
+		c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": fallback})
+	}
+}
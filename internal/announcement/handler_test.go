@@ -0,0 +1,118 @@
+package announcement
+
+import (
+	"bytes"
+	"encoding/json"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"net/http"
+	"net/http/httptest"
+	"student_go/internal/actor"
+	"student_go/internal/dto/request"
+	"student_go/internal/dto/response"
+	"student_go/internal/mocks"
+	"testing"
+)
+
+func setupHandlerTest() (*gin.Engine, *mocks.AnnouncementServiceMock, *Handler) {
+	gin.SetMode(gin.TestMode)
+	mockService := new(mocks.AnnouncementServiceMock)
+	handler := &Handler{Service: mockService}
+	r := gin.Default()
+	return r, mockService, handler
+}
+
+func setActor(req *http.Request, id string, role string) {
+	req.Header.Set(actor.HeaderUserID, id)
+	req.Header.Set(actor.HeaderUserRole, role)
+}
+
+func TestCreateAnnouncementHandler(t *testing.T) {
+	r, mockService, handler := setupHandlerTest()
+	input := request.AnnouncementRequest{Title: "Exam", Body: "Friday"}
+	expected := &response.AnnouncementResponse{ID: 1, CourseID: 1, Title: "Exam"}
+	mockService.On("CreateAnnouncement", uint(1), courseTeacher, input).Return(expected, nil)
+
+	r.POST("/courses/:id/announcements", handler.CreateAnnouncement)
+	body, _ := json.Marshal(input)
+	req := httptest.NewRequest(http.MethodPost, "/courses/1/announcements", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	setActor(req, "10", "teacher")
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusCreated, resp.Code)
+	mockService.AssertExpectations(t)
+}
+
+func TestCreateAnnouncementHandler_Unauthenticated(t *testing.T) {
+	r, mockService, handler := setupHandlerTest()
+
+	r.POST("/courses/:id/announcements", handler.CreateAnnouncement)
+	req := httptest.NewRequest(http.MethodPost, "/courses/1/announcements", bytes.NewBufferString(`{}`))
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusUnauthorized, resp.Code)
+	mockService.AssertNotCalled(t, "CreateAnnouncement", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestFindAllAnnouncementsHandler(t *testing.T) {
+	r, mockService, handler := setupHandlerTest()
+	announcements := []*response.AnnouncementResponse{{ID: 1, Title: "Welcome"}}
+	mockService.On("Count", uint(1), enrolledStudent).Return(1, nil)
+	mockService.On("FindAllAnnouncements", uint(1), enrolledStudent, 1, 10).Return(announcements, nil)
+
+	r.GET("/courses/:id/announcements", handler.FindAllAnnouncements)
+	req := httptest.NewRequest(http.MethodGet, "/courses/1/announcements?page=1&per_page=10", nil)
+	setActor(req, "20", "student")
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	mockService.AssertExpectations(t)
+}
+
+func TestFindAnnouncementByIdHandler_Forbidden(t *testing.T) {
+	r, mockService, handler := setupHandlerTest()
+	mockService.On("FindAnnouncementById", uint(1), uint(2), enrolledStudent).Return(nil, ErrForbidden)
+
+	r.GET("/courses/:id/announcements/:announcementId", handler.FindAnnouncementById)
+	req := httptest.NewRequest(http.MethodGet, "/courses/1/announcements/2", nil)
+	setActor(req, "20", "student")
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusForbidden, resp.Code)
+	mockService.AssertExpectations(t)
+}
+
+func TestPinAnnouncementHandler(t *testing.T) {
+	r, mockService, handler := setupHandlerTest()
+	expected := &response.AnnouncementResponse{ID: 2, Pinned: true}
+	mockService.On("PinAnnouncement", uint(1), uint(2), courseTeacher, true).Return(expected, nil)
+
+	r.POST("/courses/:id/announcements/:announcementId/pin", handler.PinAnnouncement)
+	req := httptest.NewRequest(http.MethodPost, "/courses/1/announcements/2/pin", nil)
+	setActor(req, "10", "teacher")
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	mockService.AssertExpectations(t)
+}
+
+func TestDeleteAnnouncementHandler_NotFound(t *testing.T) {
+	r, mockService, handler := setupHandlerTest()
+	mockService.On("DeleteAnnouncementById", uint(1), uint(5), courseTeacher).Return(ErrAnnouncementNotFound)
+
+	r.DELETE("/courses/:id/announcements/:announcementId", handler.DeleteAnnouncementById)
+	req := httptest.NewRequest(http.MethodDelete, "/courses/1/announcements/5", nil)
+	setActor(req, "10", "teacher")
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusNotFound, resp.Code)
+	mockService.AssertExpectations(t)
+}
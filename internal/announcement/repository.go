@@ -0,0 +1,120 @@
+package announcement
+
+import (
+	"gorm.io/gorm"
+	"student_go/internal/entity"
+	"student_go/pkg/dbcontext"
+)
+
+type Repository interface {
+	Save(announcement *entity.Announcement) (*entity.Announcement, error)
+	Update(announcement *entity.Announcement, revision *entity.AnnouncementRevision) (*entity.Announcement, error)
+	SetPinned(id uint, pinned bool) error
+	FindById(courseId uint, id uint) (*entity.Announcement, error)
+	FindAll(courseId uint, page, limit int) ([]entity.Announcement, error)
+	FindRevisions(id uint) ([]entity.AnnouncementRevision, error)
+	DeleteById(id uint) error
+	Count(courseId uint) (int, error)
+}
+
+type repository struct{}
+
+func NewAnnouncementRepository() Repository {
+	return &repository{}
+}
+
+func (r *repository) Save(announcement *entity.Announcement) (*entity.Announcement, error) {
+	err := dbcontext.DB.Create(announcement).Error
+	return announcement, err
+}
+
+// Update stores the previous content as a revision and applies the new one
+// in a single transaction.
+func (r *repository) Update(announcement *entity.Announcement, revision *entity.AnnouncementRevision) (*entity.Announcement, error) {
+	err := dbcontext.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(revision).Error; err != nil {
+			return err
+		}
+
+		return tx.Model(&entity.Announcement{}).
+			Where("id = ?", announcement.ID).
+			Updates(map[string]interface{}{
+				"title":     announcement.Title,
+				"body":      announcement.Body,
+				"edited_at": announcement.EditedAt,
+			}).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return r.FindById(announcement.CourseID, announcement.ID)
+}
+
+func (r *repository) SetPinned(id uint, pinned bool) error {
+	return dbcontext.DB.Model(&entity.Announcement{}).
+		Where("id = ?", id).
+		Update("pinned", pinned).Error
+}
+
+func (r *repository) FindById(courseId uint, id uint) (*entity.Announcement, error) {
+	var announcement entity.Announcement
+	result := dbcontext.DB.
+		Where("course_id = ?", courseId).
+		First(&announcement, id)
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return &announcement, nil
+}
+
+func (r *repository) FindAll(courseId uint, page, limit int) ([]entity.Announcement, error) {
+	var announcements []entity.Announcement
+
+	offset := (page - 1) * limit
+
+	result := dbcontext.DB.
+		Where("course_id = ?", courseId).
+		Order("pinned DESC").
+		Order("created_at DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&announcements)
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return announcements, nil
+}
+
+func (r *repository) FindRevisions(id uint) ([]entity.AnnouncementRevision, error) {
+	var revisions []entity.AnnouncementRevision
+
+	result := dbcontext.DB.
+		Where("announcement_id = ?", id).
+		Order("created_at DESC").
+		Find(&revisions)
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return revisions, nil
+}
+
+func (r *repository) DeleteById(id uint) error {
+	result := dbcontext.DB.Delete(&entity.Announcement{}, id)
+
+	return result.Error
+}
+
+func (r *repository) Count(courseId uint) (int, error) {
+	var count int64
+	err := dbcontext.DB.Model(&entity.Announcement{}).
+		Where("course_id = ?", courseId).
+		Count(&count).Error
+	return int(count), err
+}
@@ -0,0 +1,100 @@
+package announcement
+
+import (
+	"database/sql"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"regexp"
+	"testing"
+	"time"
+
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+
+	"student_go/internal/entity"
+	"student_go/pkg/dbcontext"
+)
+
+func setupTestDB(t *testing.T) (*sql.DB, sqlmock.Sqlmock, *gorm.DB) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+
+	dialector := postgres.New(postgres.Config{
+		Conn:                 db,
+		PreferSimpleProtocol: true,
+	})
+
+	gormDB, err := gorm.Open(dialector, &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	assert.NoError(t, err)
+
+	dbcontext.DB = gormDB
+	return db, mock, gormDB
+}
+
+func TestAnnouncementFindAll(t *testing.T) {
+	db, mock, _ := setupTestDB(t)
+	defer db.Close()
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "announcements" WHERE course_id = $1 ORDER BY pinned DESC,created_at DESC LIMIT $2`)).
+		WithArgs(1, 10).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "course_id", "title", "pinned"}).
+			AddRow(2, 1, "Pinned", true).
+			AddRow(1, 1, "Welcome", false))
+
+	repo := NewAnnouncementRepository()
+	announcements, err := repo.FindAll(1, 1, 10)
+
+	require.NoError(t, err)
+	require.Len(t, announcements, 2)
+	assert.True(t, announcements[0].Pinned)
+	assert.Equal(t, "Welcome", announcements[1].Title)
+}
+
+func TestAnnouncementUpdate(t *testing.T) {
+	db, mock, _ := setupTestDB(t)
+	defer db.Close()
+
+	editedAt := time.Now()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "announcement_revisions"`)).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE "announcements" SET "body"=$1,"edited_at"=$2,"title"=$3,"updated_at"=$4 WHERE id = $5`)).
+		WithArgs("New body", editedAt, "New", sqlmock.AnyArg(), 3).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	mock.ExpectQuery(`SELECT \* FROM "announcements" WHERE course_id = \$1 AND "announcements"\."id" = \$2 ORDER BY "announcements"\."id" LIMIT .*`).
+		WithArgs(1, 3, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "course_id", "title", "body"}).
+			AddRow(3, 1, "New", "New body"))
+
+	repo := NewAnnouncementRepository()
+	updated, err := repo.Update(
+		&entity.Announcement{ID: 3, CourseID: 1, Title: "New", Body: "New body", EditedAt: &editedAt},
+		&entity.AnnouncementRevision{AnnouncementID: 3, Title: "Old", Body: "Old body"},
+	)
+
+	require.NoError(t, err)
+	assert.Equal(t, "New", updated.Title)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestAnnouncementCount(t *testing.T) {
+	db, mock, _ := setupTestDB(t)
+	defer db.Close()
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "announcements" WHERE course_id = $1`)).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(4))
+
+	repo := NewAnnouncementRepository()
+	count, err := repo.Count(1)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 4, count)
+}
@@ -0,0 +1,266 @@
+package announcement
+
+import (
+	"errors"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"student_go/internal/actor"
+	"student_go/internal/course"
+	"student_go/internal/dto/request"
+	"student_go/internal/dto/response"
+	"student_go/internal/entity"
+	"student_go/pkg/log"
+	"student_go/pkg/patch"
+	"time"
+)
+
+var (
+	ErrCourseNotFound       = errors.New("course not found")
+	ErrAnnouncementNotFound = errors.New("announcement not found")
+	ErrForbidden            = errors.New("forbidden")
+)
+
+type Service interface {
+	CreateAnnouncement(courseId uint, a actor.Actor, input request.AnnouncementRequest) (*response.AnnouncementResponse, error)
+	UpdateAnnouncement(courseId uint, id uint, a actor.Actor, input request.AnnouncementPatch, fields patch.Fields) (*response.AnnouncementResponse, error)
+	PinAnnouncement(courseId uint, id uint, a actor.Actor, pinned bool) (*response.AnnouncementResponse, error)
+	FindAnnouncementById(courseId uint, id uint, a actor.Actor) (*response.AnnouncementResponse, error)
+	FindAllAnnouncements(courseId uint, a actor.Actor, page, limit int) ([]*response.AnnouncementResponse, error)
+	FindAnnouncementRevisions(courseId uint, id uint, a actor.Actor) ([]*response.AnnouncementRevisionResponse, error)
+	DeleteAnnouncementById(courseId uint, id uint, a actor.Actor) error
+	Count(courseId uint, a actor.Actor) (int, error)
+}
+
+type service struct {
+	announcementRepository Repository
+	courseRepository       course.Repository
+}
+
+func NewAnnouncementService(
+	announcementRepository Repository,
+	courseRepository course.Repository,
+) Service {
+	return &service{
+		announcementRepository: announcementRepository,
+		courseRepository:       courseRepository,
+	}
+}
+
+func (s *service) CreateAnnouncement(courseId uint, a actor.Actor, input request.AnnouncementRequest) (*response.AnnouncementResponse, error) {
+	log.Log.Info("CreateAnnouncement (service) called", zap.Uint("course_id", courseId), zap.String("title", input.Title))
+
+	if err := s.authorize(courseId, a, course.AccessStaff); err != nil {
+		return nil, err
+	}
+
+	announcement := entity.Announcement{
+		CourseID:   courseId,
+		AuthorRole: string(a.Role),
+		AuthorID:   a.ID,
+		Title:      input.Title,
+		Body:       input.Body,
+	}
+	saved, err := s.announcementRepository.Save(&announcement)
+	if err != nil {
+		return nil, err
+	}
+
+	return toAnnouncementResponse(saved), nil
+}
+
+func (s *service) UpdateAnnouncement(courseId uint, id uint, a actor.Actor, input request.AnnouncementPatch, fields patch.Fields) (*response.AnnouncementResponse, error) {
+	log.Log.Info("UpdateAnnouncement (service) called", zap.Uint("course_id", courseId), zap.Uint("id", id))
+
+	if err := s.authorize(courseId, a, course.AccessStaff); err != nil {
+		return nil, err
+	}
+
+	current, err := s.findAnnouncement(courseId, id)
+	if err != nil {
+		return nil, err
+	}
+	if len(fields) == 0 {
+		return toAnnouncementResponse(current), nil
+	}
+
+	revision := entity.AnnouncementRevision{
+		AnnouncementID: current.ID,
+		Title:          current.Title,
+		Body:           current.Body,
+		EditorRole:     string(a.Role),
+		EditorID:       a.ID,
+	}
+
+	now := time.Now()
+	if fields.Has("title") {
+		current.Title = patch.Value(input.Title)
+	}
+	if fields.Has("body") {
+		current.Body = patch.Value(input.Body)
+	}
+	current.EditedAt = &now
+
+	updated, err := s.announcementRepository.Update(current, &revision)
+	if err != nil {
+		return nil, err
+	}
+
+	return toAnnouncementResponse(updated), nil
+}
+
+func (s *service) PinAnnouncement(courseId uint, id uint, a actor.Actor, pinned bool) (*response.AnnouncementResponse, error) {
+	log.Log.Info("PinAnnouncement (service) called",
+		zap.Uint("course_id", courseId),
+		zap.Uint("id", id),
+		zap.Bool("pinned", pinned),
+	)
+
+	if err := s.authorize(courseId, a, course.AccessStaff); err != nil {
+		return nil, err
+	}
+
+	current, err := s.findAnnouncement(courseId, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.announcementRepository.SetPinned(current.ID, pinned); err != nil {
+		return nil, err
+	}
+	current.Pinned = pinned
+
+	return toAnnouncementResponse(current), nil
+}
+
+func (s *service) FindAnnouncementById(courseId uint, id uint, a actor.Actor) (*response.AnnouncementResponse, error) {
+	log.Log.Info("FindAnnouncementById (service) called", zap.Uint("course_id", courseId), zap.Uint("id", id))
+
+	if err := s.authorize(courseId, a, course.AccessMember); err != nil {
+		return nil, err
+	}
+
+	announcement, err := s.findAnnouncement(courseId, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return toAnnouncementResponse(announcement), nil
+}
+
+func (s *service) FindAllAnnouncements(courseId uint, a actor.Actor, page, limit int) ([]*response.AnnouncementResponse, error) {
+	log.Log.Info("FindAllAnnouncements (service) called",
+		zap.Uint("course_id", courseId),
+		zap.Int("page", page),
+		zap.Int("limit", limit),
+	)
+
+	if err := s.authorize(courseId, a, course.AccessMember); err != nil {
+		return nil, err
+	}
+
+	announcements, err := s.announcementRepository.FindAll(courseId, page, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	announcementResponses := make([]*response.AnnouncementResponse, 0, len(announcements))
+	for i := range announcements {
+		announcementResponses = append(announcementResponses, toAnnouncementResponse(&announcements[i]))
+	}
+
+	return announcementResponses, nil
+}
+
+func (s *service) FindAnnouncementRevisions(courseId uint, id uint, a actor.Actor) ([]*response.AnnouncementRevisionResponse, error) {
+	log.Log.Info("FindAnnouncementRevisions (service) called", zap.Uint("course_id", courseId), zap.Uint("id", id))
+
+	if err := s.authorize(courseId, a, course.AccessMember); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.findAnnouncement(courseId, id); err != nil {
+		return nil, err
+	}
+
+	revisions, err := s.announcementRepository.FindRevisions(id)
+	if err != nil {
+		return nil, err
+	}
+
+	revisionResponses := make([]*response.AnnouncementRevisionResponse, 0, len(revisions))
+	for _, revision := range revisions {
+		revisionResponses = append(revisionResponses, &response.AnnouncementRevisionResponse{
+			ID:         revision.ID,
+			Title:      revision.Title,
+			Body:       revision.Body,
+			EditorRole: revision.EditorRole,
+			EditorID:   revision.EditorID,
+			CreatedAt:  revision.CreatedAt,
+		})
+	}
+
+	return revisionResponses, nil
+}
+
+func (s *service) DeleteAnnouncementById(courseId uint, id uint, a actor.Actor) error {
+	log.Log.Info("DeleteAnnouncementById (service) called", zap.Uint("course_id", courseId), zap.Uint("id", id))
+
+	if err := s.authorize(courseId, a, course.AccessStaff); err != nil {
+		return err
+	}
+
+	if _, err := s.findAnnouncement(courseId, id); err != nil {
+		return err
+	}
+
+	return s.announcementRepository.DeleteById(id)
+}
+
+func (s *service) Count(courseId uint, a actor.Actor) (int, error) {
+	if err := s.authorize(courseId, a, course.AccessMember); err != nil {
+		return 0, err
+	}
+
+	return s.announcementRepository.Count(courseId)
+}
+
+func (s *service) authorize(courseId uint, a actor.Actor, required course.Access) error {
+	exists, err := s.courseRepository.ExistsById(courseId)
+	if err != nil || !exists {
+		return ErrCourseNotFound
+	}
+
+	access, err := course.ResolveAccess(s.courseRepository, courseId, a)
+	if err != nil {
+		return err
+	}
+	if access < required {
+		return ErrForbidden
+	}
+
+	return nil
+}
+
+func (s *service) findAnnouncement(courseId uint, id uint) (*entity.Announcement, error) {
+	announcement, err := s.announcementRepository.FindById(courseId, id)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrAnnouncementNotFound
+	}
+
+	return announcement, err
+}
+
+func toAnnouncementResponse(announcement *entity.Announcement) *response.AnnouncementResponse {
+	return &response.AnnouncementResponse{
+		ID:         announcement.ID,
+		CourseID:   announcement.CourseID,
+		AuthorRole: announcement.AuthorRole,
+		AuthorID:   announcement.AuthorID,
+		Title:      announcement.Title,
+		Body:       announcement.Body,
+		Pinned:     announcement.Pinned,
+		EditedAt:   announcement.EditedAt,
+		CreatedAt:  announcement.CreatedAt,
+		UpdatedAt:  announcement.UpdatedAt,
+	}
+}
@@ -0,0 +1,166 @@
+package announcement
+
+import (
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"student_go/internal/actor"
+	"student_go/internal/dto/request"
+	"student_go/internal/entity"
+	mocks2 "student_go/internal/mocks"
+	"student_go/pkg/log"
+	"student_go/pkg/patch"
+	"testing"
+)
+
+func init() {
+	logger, _ := zap.NewDevelopment()
+	log.Log = logger
+}
+
+var (
+	courseTeacher   = actor.Actor{ID: 10, Role: actor.RoleTeacher}
+	enrolledStudent = actor.Actor{ID: 20, Role: actor.RoleStudent}
+)
+
+func newTestAnnouncementService() (Service, *mocks2.AnnouncementRepository, *mocks2.CourseRepository) {
+	mockAnnouncementRepo := new(mocks2.AnnouncementRepository)
+	mockCourseRepo := new(mocks2.CourseRepository)
+
+	mockCourseRepo.On("ExistsById", uint(1)).Return(true, nil).Maybe()
+	mockCourseRepo.On("HasTeacher", uint(1), uint(10)).Return(true, nil).Maybe()
+	mockCourseRepo.On("HasStudent", uint(1), uint(20)).Return(true, nil).Maybe()
+
+	svc := NewAnnouncementService(mockAnnouncementRepo, mockCourseRepo)
+	return svc, mockAnnouncementRepo, mockCourseRepo
+}
+
+func TestCreateAnnouncement(t *testing.T) {
+	svc, mockAnnouncementRepo, _ := newTestAnnouncementService()
+
+	input := request.AnnouncementRequest{Title: "Exam", Body: "Exam moved to Friday"}
+	saved := &entity.Announcement{ID: 1, CourseID: 1, AuthorRole: "teacher", AuthorID: 10, Title: "Exam", Body: "Exam moved to Friday"}
+
+	mockAnnouncementRepo.On("Save", mock.MatchedBy(func(a *entity.Announcement) bool {
+		return a.CourseID == 1 && a.AuthorID == 10 && a.AuthorRole == "teacher"
+	})).Return(saved, nil)
+
+	result, err := svc.CreateAnnouncement(1, courseTeacher, input)
+
+	assert.NoError(t, err)
+	assert.Equal(t, uint(1), result.ID)
+	assert.Equal(t, "Exam", result.Title)
+
+	mockAnnouncementRepo.AssertExpectations(t)
+}
+
+func TestCreateAnnouncement_StudentForbidden(t *testing.T) {
+	svc, mockAnnouncementRepo, _ := newTestAnnouncementService()
+
+	result, err := svc.CreateAnnouncement(1, enrolledStudent, request.AnnouncementRequest{Title: "X", Body: "Y"})
+
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, ErrForbidden)
+	mockAnnouncementRepo.AssertNotCalled(t, "Save", mock.Anything)
+}
+
+func TestCreateAnnouncement_CourseNotFound(t *testing.T) {
+	svc, _, mockCourseRepo := newTestAnnouncementService()
+
+	mockCourseRepo.On("ExistsById", uint(2)).Return(false, nil)
+
+	result, err := svc.CreateAnnouncement(2, courseTeacher, request.AnnouncementRequest{Title: "X", Body: "Y"})
+
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, ErrCourseNotFound)
+}
+
+func TestFindAllAnnouncements_NotEnrolled(t *testing.T) {
+	svc, _, mockCourseRepo := newTestAnnouncementService()
+
+	outsider := actor.Actor{ID: 21, Role: actor.RoleStudent}
+	mockCourseRepo.On("HasStudent", uint(1), uint(21)).Return(false, nil)
+
+	result, err := svc.FindAllAnnouncements(1, outsider, 1, 10)
+
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, ErrForbidden)
+}
+
+func TestFindAllAnnouncements(t *testing.T) {
+	svc, mockAnnouncementRepo, _ := newTestAnnouncementService()
+
+	mockAnnouncementRepo.On("FindAll", uint(1), 1, 10).Return([]entity.Announcement{
+		{ID: 2, CourseID: 1, Title: "Pinned", Pinned: true},
+		{ID: 1, CourseID: 1, Title: "Welcome"},
+	}, nil)
+
+	result, err := svc.FindAllAnnouncements(1, enrolledStudent, 1, 10)
+
+	assert.NoError(t, err)
+	assert.Len(t, result, 2)
+	assert.True(t, result[0].Pinned)
+
+	mockAnnouncementRepo.AssertExpectations(t)
+}
+
+func TestUpdateAnnouncement_KeepsRevision(t *testing.T) {
+	svc, mockAnnouncementRepo, _ := newTestAnnouncementService()
+
+	current := &entity.Announcement{ID: 3, CourseID: 1, Title: "Old", Body: "Old body"}
+	mockAnnouncementRepo.On("FindById", uint(1), uint(3)).Return(current, nil)
+	mockAnnouncementRepo.On("Update",
+		mock.MatchedBy(func(a *entity.Announcement) bool {
+			return a.Title == "New" && a.Body == "Old body" && a.EditedAt != nil
+		}),
+		mock.MatchedBy(func(r *entity.AnnouncementRevision) bool {
+			return r.AnnouncementID == 3 && r.Title == "Old" && r.Body == "Old body" && r.EditorID == 10
+		}),
+	).Return(&entity.Announcement{ID: 3, CourseID: 1, Title: "New", Body: "New body"}, nil)
+
+	title := "New"
+	result, err := svc.UpdateAnnouncement(1, 3, courseTeacher, request.AnnouncementPatch{Title: &title}, patch.Fields{"title": true})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "New", result.Title)
+	mockAnnouncementRepo.AssertExpectations(t)
+}
+
+func TestUpdateAnnouncement_EmptyPatch(t *testing.T) {
+	svc, mockAnnouncementRepo, _ := newTestAnnouncementService()
+
+	current := &entity.Announcement{ID: 3, CourseID: 1, Title: "Old", Body: "Old body"}
+	mockAnnouncementRepo.On("FindById", uint(1), uint(3)).Return(current, nil)
+
+	result, err := svc.UpdateAnnouncement(1, 3, courseTeacher, request.AnnouncementPatch{}, patch.Fields{})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Old", result.Title)
+	assert.Nil(t, result.EditedAt)
+	mockAnnouncementRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+}
+
+func TestPinAnnouncement_NotFound(t *testing.T) {
+	svc, mockAnnouncementRepo, _ := newTestAnnouncementService()
+
+	mockAnnouncementRepo.On("FindById", uint(1), uint(9)).Return(nil, gorm.ErrRecordNotFound)
+
+	result, err := svc.PinAnnouncement(1, 9, courseTeacher, true)
+
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, ErrAnnouncementNotFound)
+}
+
+func TestDeleteAnnouncementById_Error(t *testing.T) {
+	svc, mockAnnouncementRepo, _ := newTestAnnouncementService()
+
+	mockAnnouncementRepo.On("FindById", uint(1), uint(4)).Return(&entity.Announcement{ID: 4, CourseID: 1}, nil)
+	mockAnnouncementRepo.On("DeleteById", uint(4)).Return(errors.New("delete error"))
+
+	err := svc.DeleteAnnouncementById(1, 4, courseTeacher)
+
+	assert.EqualError(t, err, "delete error")
+	mockAnnouncementRepo.AssertExpectations(t)
+}
@@ -0,0 +1,163 @@
+package app
+
+import (
+	"github.com/gin-gonic/gin"
+	"student_go/internal/announcement"
+	"student_go/internal/attachment"
+	"student_go/internal/audit"
+	"student_go/internal/calendar"
+	"student_go/internal/cohort"
+	"student_go/internal/contact"
+	"student_go/internal/course"
+	"student_go/internal/department"
+	"student_go/internal/discussion"
+	"student_go/internal/hold"
+	"student_go/internal/leave"
+	"student_go/internal/officehour"
+	"student_go/internal/qualification"
+	"student_go/internal/search"
+	"student_go/internal/student"
+	"student_go/internal/teacher"
+	"student_go/internal/workload"
+)
+
+// Handlers are the HTTP handlers of every resource.
+type Handlers struct {
+	Student       *student.StudentHandler
+	Teacher       *teacher.TeacherHandler
+	Course        *course.Handler
+	Department    *department.DepartmentHandler
+	Announcement  *announcement.Handler
+	Discussion    *discussion.Handler
+	OfficeHour    *officehour.Handler
+	Contact       *contact.Handler
+	Attachment    *attachment.Handler
+	Cohort        *cohort.Handler
+	Qualification *qualification.Handler
+	Workload      *workload.Handler
+	Leave         *leave.Handler
+	Calendar      *calendar.Handler
+	Hold          *hold.Handler
+	Search        *search.Handler
+	Audit         *audit.Handler
+}
+
+// RegisterRoutes adds the API routes to r. gin panics here when two routes
+// name the same path segment differently, e.g. :id and :courseId.
+func RegisterRoutes(r gin.IRoutes, h Handlers) {
+	r.POST("/api/v1/students", h.Student.CreateStudent)
+	r.PATCH("/api/v1/students/:id", h.Student.UpdateStudent)
+	r.GET("/api/v1/students/:id", h.Student.FindStudentById)
+	r.GET("/api/v1/students/by-number/:number", h.Student.FindStudentByNumber)
+	r.GET("/api/v1/students", h.Student.FindAllStudents)
+	r.GET("/api/v1/students/:id/courses", h.Student.FindAllCoursesByStudentId)
+	r.DELETE("/api/v1/students/:id", h.Student.DeleteStudentById)
+	r.POST("/api/v1/students/:id/restore", h.Student.RestoreStudent)
+	r.GET("/api/v1/students/:id/duplicates", h.Student.FindDuplicates)
+	r.POST("/api/v1/students/:id/merge", h.Student.MergeStudent)
+	r.POST("/api/v1/students/:id/courses/:courseId", h.Student.StudentAddCourse)
+	r.POST("/api/v1/students/:id/contacts", h.Contact.CreateContact)
+	r.GET("/api/v1/students/:id/contacts", h.Contact.FindAllContacts)
+	r.GET("/api/v1/students/:id/contacts/:contactId", h.Contact.FindContactById)
+	r.PATCH("/api/v1/students/:id/contacts/:contactId", h.Contact.UpdateContact)
+	r.DELETE("/api/v1/students/:id/contacts/:contactId", h.Contact.DeleteContactById)
+	r.GET("/api/v1/students/:id/appointments", h.OfficeHour.FindStudentAppointments)
+	r.GET("/api/v1/students/:id/appointments.ics", h.OfficeHour.StudentCalendar)
+	r.POST("/api/v1/students/:id/holds", h.Hold.PlaceHold)
+	r.GET("/api/v1/students/:id/holds", h.Hold.FindAllHolds)
+	r.POST("/api/v1/students/:id/holds/:holdId/release", h.Hold.ReleaseHold)
+	r.GET("/api/v1/students/:id/holds/:holdId/history", h.Hold.FindHoldHistory)
+
+	r.POST("/api/v1/courses", h.Course.CreateCourse)
+	r.PATCH("/api/v1/courses/:id", h.Course.UpdateCourse)
+	r.GET("/api/v1/courses/:id", h.Course.FindCourseById)
+	r.GET("/api/v1/courses/:id/students", h.Course.FindCourseStudents)
+	r.GET("/api/v1/courses", h.Course.FindAllCourses)
+	r.DELETE("/api/v1/courses/:id", h.Course.DeleteCourseById)
+	r.POST("/api/v1/courses/:id/restore", h.Course.RestoreCourse)
+	r.POST("/api/v1/courses/:id/teacher/:teacherId", h.Course.SetTeacherToCourse)
+	r.GET("/api/v1/courses/:id/qualified-teachers", h.Course.FindQualifiedTeachers)
+	r.GET("/api/v1/courses/:id/teacher", h.Leave.FindCourseTeacher)
+
+	r.POST("/api/v1/courses/:id/announcements", h.Announcement.CreateAnnouncement)
+	r.GET("/api/v1/courses/:id/announcements", h.Announcement.FindAllAnnouncements)
+	r.GET("/api/v1/courses/:id/announcements/:announcementId", h.Announcement.FindAnnouncementById)
+	r.PATCH("/api/v1/courses/:id/announcements/:announcementId", h.Announcement.UpdateAnnouncement)
+	r.DELETE("/api/v1/courses/:id/announcements/:announcementId", h.Announcement.DeleteAnnouncementById)
+	r.GET("/api/v1/courses/:id/announcements/:announcementId/revisions", h.Announcement.FindAnnouncementRevisions)
+	r.POST("/api/v1/courses/:id/announcements/:announcementId/pin", h.Announcement.PinAnnouncement)
+	r.DELETE("/api/v1/courses/:id/announcements/:announcementId/pin", h.Announcement.UnpinAnnouncement)
+
+	r.POST("/api/v1/courses/:id/threads", h.Discussion.CreateThread)
+	r.GET("/api/v1/courses/:id/threads", h.Discussion.FindAllThreads)
+	r.GET("/api/v1/courses/:id/threads/:threadId", h.Discussion.FindThreadById)
+	r.POST("/api/v1/courses/:id/threads/:threadId/pin", h.Discussion.PinThread)
+	r.DELETE("/api/v1/courses/:id/threads/:threadId/pin", h.Discussion.UnpinThread)
+	r.POST("/api/v1/courses/:id/threads/:threadId/posts", h.Discussion.CreatePost)
+	r.GET("/api/v1/courses/:id/threads/:threadId/posts", h.Discussion.FindAllPosts)
+	r.PATCH("/api/v1/courses/:id/threads/:threadId/posts/:postId", h.Discussion.UpdatePost)
+	r.GET("/api/v1/courses/:id/threads/:threadId/posts/:postId/revisions", h.Discussion.FindPostRevisions)
+
+	r.POST("/api/v1/teachers", h.Teacher.CreateTeacher)
+	r.PATCH("/api/v1/teachers/:id", h.Teacher.UpdateTeacher)
+	r.GET("/api/v1/teachers/:id", h.Teacher.FindTeacherById)
+	r.GET("/api/v1/teachers", h.Teacher.FindAllTeachers)
+	r.DELETE("/api/v1/teachers/:id", h.Teacher.DeleteTeacherById)
+	r.POST("/api/v1/teachers/:id/restore", h.Teacher.RestoreTeacher)
+
+	r.POST("/api/v1/teachers/:id/qualifications", h.Qualification.CreateQualification)
+	r.GET("/api/v1/teachers/:id/qualifications", h.Qualification.FindAllQualifications)
+	r.DELETE("/api/v1/teachers/:id/qualifications/:qualificationId", h.Qualification.DeleteQualificationById)
+
+	r.GET("/api/v1/teachers/:id/workload", h.Workload.FindTeacherWorkload)
+
+	r.POST("/api/v1/teachers/:id/leave-requests", h.Leave.CreateLeaveRequest)
+	r.GET("/api/v1/teachers/:id/leave-requests", h.Leave.FindAllLeaveRequests)
+	r.GET("/api/v1/leave-requests/:id", h.Leave.FindLeaveRequestById)
+	r.POST("/api/v1/leave-requests/:id/approve", h.Leave.ApproveLeaveRequest)
+	r.POST("/api/v1/leave-requests/:id/reject", h.Leave.RejectLeaveRequest)
+	r.POST("/api/v1/leave-requests/:id/substitutes", h.Leave.AssignSubstitute)
+
+	r.POST("/api/v1/teachers/:id/office-hours", h.OfficeHour.CreateOfficeHour)
+	r.GET("/api/v1/teachers/:id/office-hours", h.OfficeHour.FindOfficeHours)
+	r.DELETE("/api/v1/teachers/:id/office-hours/:officeHourId", h.OfficeHour.DeleteOfficeHourById)
+	r.GET("/api/v1/teachers/:id/slots", h.OfficeHour.FindSlots)
+	r.POST("/api/v1/teachers/:id/appointments", h.OfficeHour.BookAppointment)
+	r.GET("/api/v1/teachers/:id/appointments", h.OfficeHour.FindTeacherAppointments)
+	r.GET("/api/v1/teachers/:id/appointments.ics", h.OfficeHour.TeacherCalendar)
+	r.POST("/api/v1/appointments/:id/cancel", h.OfficeHour.CancelAppointment)
+
+	r.POST("/api/v1/departments", h.Department.CreateDepartment)
+	r.PATCH("/api/v1/departments/:id", h.Department.UpdateDepartment)
+	r.GET("/api/v1/departments/:id", h.Department.FindDepartmentById)
+	r.GET("/api/v1/departments", h.Department.FindAllDepartments)
+	r.DELETE("/api/v1/departments/:id", h.Department.DeleteDepartmentById)
+	r.POST("/api/v1/departments/:id/restore", h.Department.RestoreDepartment)
+	r.POST("/api/v1/departments/:id/teacher/:teacherId", h.Department.DepartmentSetTeacher)
+	r.GET("/api/v1/departments/:id/workload", h.Workload.FindDepartmentWorkload)
+
+	r.POST("/api/v1/cohorts", h.Cohort.CreateCohort)
+	r.PATCH("/api/v1/cohorts/:id", h.Cohort.UpdateCohort)
+	r.GET("/api/v1/cohorts/:id", h.Cohort.FindCohortById)
+	r.GET("/api/v1/cohorts", h.Cohort.FindAllCohorts)
+	r.DELETE("/api/v1/cohorts/:id", h.Cohort.DeleteCohortById)
+	r.POST("/api/v1/cohorts/:id/students", h.Cohort.AddStudents)
+	r.DELETE("/api/v1/cohorts/:id/students/:studentId", h.Cohort.RemoveStudent)
+	r.POST("/api/v1/cohorts/:id/courses/:courseId", h.Cohort.EnrollInCourse)
+
+	r.POST("/api/v1/attachments", h.Attachment.UploadAttachment)
+	r.GET("/api/v1/attachments", h.Attachment.FindAllAttachments)
+	r.GET("/api/v1/attachments/:id", h.Attachment.FindAttachmentById)
+	r.GET("/api/v1/attachments/:id/content", h.Attachment.DownloadAttachment)
+	r.DELETE("/api/v1/attachments/:id", h.Attachment.DeleteAttachmentById)
+
+	r.POST("/api/v1/calendar", h.Calendar.CreateEvent)
+	r.GET("/api/v1/calendar", h.Calendar.FindAllEvents)
+	r.GET("/api/v1/calendar/:id", h.Calendar.FindEventById)
+	r.PATCH("/api/v1/calendar/:id", h.Calendar.UpdateEvent)
+	r.DELETE("/api/v1/calendar/:id", h.Calendar.DeleteEventById)
+
+	r.GET("/api/v1/search", h.Search.Search)
+
+	r.GET("/api/v1/audit", h.Audit.FindAllEntries)
+}
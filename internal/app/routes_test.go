@@ -0,0 +1,24 @@
+package app
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestRegisterRoutes(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+
+	// The handlers are never called, so nil ones will do.
+	require.NotPanics(t, func() { RegisterRoutes(r, Handlers{}) })
+
+	routes := make(map[string]bool)
+	for _, route := range r.Routes() {
+		routes[route.Method+" "+route.Path] = true
+	}
+	assert.True(t, routes["POST /api/v1/students/:id/courses/:courseId"])
+	assert.True(t, routes["POST /api/v1/courses/:id/teacher/:teacherId"])
+	assert.True(t, routes["POST /api/v1/departments/:id/teacher/:teacherId"])
+}
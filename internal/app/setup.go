@@ -1,12 +1,28 @@
 package app
 
 import (
+	"context"
 	"github.com/gin-gonic/gin"
+	"student_go/internal/announcement"
+	"student_go/internal/attachment"
+	"student_go/internal/audit"
+	"student_go/internal/calendar"
+	"student_go/internal/cohort"
 	"student_go/internal/config"
+	"student_go/internal/contact"
 	"student_go/internal/course"
 	"student_go/internal/department"
+	"student_go/internal/discussion"
+	"student_go/internal/hold"
+	"student_go/internal/leave"
+	"student_go/internal/notification"
+	"student_go/internal/officehour"
+	"student_go/internal/purge"
+	"student_go/internal/qualification"
+	"student_go/internal/search"
 	"student_go/internal/student"
 	"student_go/internal/teacher"
+	"student_go/internal/workload"
 	"student_go/pkg/dbcontext"
 	"student_go/pkg/migration"
 )
@@ -22,40 +38,46 @@ func SetupApp() (*gin.Engine, error) {
 
 	migration.ApplyMigrations()
 
+	templates, err := notification.LoadTemplates(config.Config.Notification.DefaultLocale)
+	if err != nil {
+		return nil, err
+	}
+	sender, err := notification.NewSender()
+	if err != nil {
+		return nil, err
+	}
+	notificationRepository := notification.NewNotificationRepository()
+	notifier := notification.NewNotifier(notificationRepository, templates)
+	go notification.NewDispatcher(notificationRepository, sender).Run(context.Background())
+	go purge.NewPurger(purge.NewPurgeRepository()).Run(context.Background())
+
+	store, err := attachment.NewStorage()
+	if err != nil {
+		return nil, err
+	}
+
 	r := gin.Default()
+	r.Use(audit.RequestID())
 
-	studentHandler := student.NewStudentHandler()
-	teacherHandler := teacher.NewTeacherHandler()
-	courseHandler := course.NewCourseHandler()
-	departmentHandler := department.NewDepartmentHandler()
-
-	r.POST("/api/v1/students", studentHandler.CreateStudent)
-	r.PATCH("/api/v1/students/:id", studentHandler.UpdateStudent)
-	r.GET("/api/v1/students/:id", studentHandler.FindStudentById)
-	r.GET("/api/v1/students", studentHandler.FindAllStudents)
-	r.GET("/api/v1/students/:id/courses", studentHandler.FindAllCoursesByStudentId)
-	r.DELETE("/api/v1/students/:id", studentHandler.DeleteStudentById)
-	r.POST("/api/v1/students/:studentId/courses/:courseId", studentHandler.StudentAddCourse)
-
-	r.POST("/api/v1/courses", courseHandler.CreateCourse)
-	r.PATCH("/api/v1/courses/:id", courseHandler.UpdateCourse)
-	r.GET("/api/v1/courses/:id", courseHandler.FindCourseById)
-	r.GET("/api/v1/courses", courseHandler.FindAllCourses)
-	r.DELETE("/api/v1/courses/:id", courseHandler.DeleteCourseById)
-	r.POST("/api/v1/courses/:courseId/teacher/:teacherId", courseHandler.SetTeacherToCourse)
-
-	r.POST("/api/v1/teachers", teacherHandler.CreateTeacher)
-	r.PATCH("/api/v1/teachers/:id", teacherHandler.UpdateTeacher)
-	r.GET("/api/v1/teachers/:id", teacherHandler.FindTeacherById)
-	r.GET("/api/v1/teachers", teacherHandler.FindAllTeachers)
-	r.DELETE("/api/v1/teachers/:id", teacherHandler.DeleteTeacherById)
-
-	r.POST("/api/v1/departments", departmentHandler.CreateDepartment)
-	r.PATCH("/api/v1/departments/:id", departmentHandler.UpdateDepartment)
-	r.GET("/api/v1/departments/:id", departmentHandler.FindDepartmentById)
-	r.GET("/api/v1/departments", departmentHandler.FindAllDepartments)
-	r.DELETE("/api/v1/departments/:id", departmentHandler.DeleteDepartmentById)
-	r.POST("/api/v1/departments/:departmentId/teacher/:teacherId", departmentHandler.DepartmentSetTeacher)
+	RegisterRoutes(r, Handlers{
+		Student:       student.NewStudentHandler(notifier),
+		Teacher:       teacher.NewTeacherHandler(),
+		Course:        course.NewCourseHandler(notifier),
+		Department:    department.NewDepartmentHandler(),
+		Announcement:  announcement.NewAnnouncementHandler(),
+		Discussion:    discussion.NewDiscussionHandler(),
+		OfficeHour:    officehour.NewOfficeHourHandler(),
+		Contact:       contact.NewContactHandler(),
+		Attachment:    attachment.NewAttachmentHandler(store),
+		Cohort:        cohort.NewCohortHandler(notifier),
+		Qualification: qualification.NewQualificationHandler(),
+		Workload:      workload.NewWorkloadHandler(),
+		Leave:         leave.NewLeaveHandler(),
+		Calendar:      calendar.NewCalendarHandler(),
+		Hold:          hold.NewHoldHandler(),
+		Search:        search.NewSearchHandler(),
+		Audit:         audit.NewAuditHandler(),
+	})
 
 	return r, nil
 }
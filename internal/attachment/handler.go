@@ -0,0 +1,241 @@
+package attachment
+
+import (
+	"errors"
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+	"mime"
+	"net/http"
+	"strconv"
+	"student_go/internal/actor"
+	"student_go/internal/config"
+	"student_go/internal/dto/request"
+	"student_go/pkg/log"
+	"student_go/pkg/pagination"
+	"student_go/pkg/storage"
+)
+
+// multipartOverhead is the room left for form fields and part headers on
+// top of the file size limit.
+const multipartOverhead = 1 << 20
+
+type Handler struct {
+	Service Service
+	// MaxUploadSize limits the upload body; zero means no limit.
+	MaxUploadSize int64
+}
+
+func NewAttachmentHandler(store storage.Storage) *Handler {
+	conf := config.Config.Storage
+
+	return &Handler{
+		Service:       NewAttachmentService(NewAttachmentRepository(), store, conf.MaxUploadSize, conf.AllowedTypes),
+		MaxUploadSize: conf.MaxUploadSize,
+	}
+}
+
+func (h *Handler) UploadAttachment(c *gin.Context) {
+	a, err := actor.FromContext(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+
+	if h.MaxUploadSize > 0 {
+		c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, h.MaxUploadSize+multipartOverhead)
+	}
+
+	var req request.AttachmentRequest
+	if err := c.ShouldBind(&req); err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			c.JSON(http.StatusRequestEntityTooLarge, gin.H{"error": ErrTooLarge.Error()})
+			return
+		}
+		log.Log.Warn("Invalid request in UploadAttachment", zap.Error(err))
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		log.Log.Warn("Missing file in UploadAttachment", zap.Error(err))
+		c.JSON(http.StatusBadRequest, gin.H{"error": "file is required"})
+		return
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		log.Log.Error("Failed to open uploaded file", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to read file"})
+		return
+	}
+	defer file.Close()
+
+	log.Log.Info("UploadAttachment called", zap.String("filename", fileHeader.Filename), zap.Int64("size", fileHeader.Size))
+
+	attachmentResp, err := h.Service.Upload(c.Request.Context(), a, req.Kind, fileHeader.Filename, fileHeader.Size, file)
+	if err != nil {
+		writeError(c, err, "failed to save attachment")
+		return
+	}
+
+	c.JSON(http.StatusCreated, attachmentResp)
+}
+
+func (h *Handler) FindAttachmentById(c *gin.Context) {
+	id, ok := parseID(c, "id", "invalid attachment ID")
+	if !ok {
+		return
+	}
+
+	a, err := actor.FromContext(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+
+	log.Log.Info("FindAttachmentById called", zap.Uint("id", id))
+
+	attachmentResp, err := h.Service.FindAttachmentById(id, a)
+	if err != nil {
+		writeError(c, err, "something went wrong")
+		return
+	}
+
+	c.JSON(http.StatusOK, attachmentResp)
+}
+
+func (h *Handler) FindAllAttachments(c *gin.Context) {
+	a, err := actor.FromContext(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+
+	count, err := h.Service.Count(a)
+	if err != nil {
+		log.Log.Error("Failed to count attachments", zap.Error(err))
+		writeError(c, err, "failed to count attachments")
+		return
+	}
+
+	pages := pagination.NewFromRequest(c.Request, count)
+
+	log.Log.Info("FindAllAttachments called",
+		zap.Int("page", pages.Page),
+		zap.Int("per_page", pages.PerPage),
+		zap.Int("total_count", pages.TotalCount),
+	)
+
+	attachments, err := h.Service.FindAllAttachments(a, pages.Page, pages.PerPage)
+	if err != nil {
+		writeError(c, err, "failed to get attachments")
+		return
+	}
+
+	pages.Items = attachments
+	c.JSON(http.StatusOK, pages)
+}
+
+// DownloadAttachment streams the attachment content. A single byte range
+// is honoured with 206 Partial Content so that large files can be resumed.
+func (h *Handler) DownloadAttachment(c *gin.Context) {
+	id, ok := parseID(c, "id", "invalid attachment ID")
+	if !ok {
+		return
+	}
+
+	a, err := actor.FromContext(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+
+	log.Log.Info("DownloadAttachment called", zap.Uint("id", id), zap.String("range", c.GetHeader("Range")))
+
+	attachment, err := h.Service.FindAttachmentById(id, a)
+	if err != nil {
+		writeError(c, err, "something went wrong")
+		return
+	}
+
+	offset, length, partial, err := parseRange(c.GetHeader("Range"), attachment.Size)
+	if err != nil {
+		c.Header("Content-Range", fmt.Sprintf("bytes */%d", attachment.Size))
+		c.JSON(http.StatusRequestedRangeNotSatisfiable, gin.H{"error": err.Error()})
+		return
+	}
+
+	content, err := h.Service.OpenContent(c.Request.Context(), id, a, offset, length)
+	if err != nil {
+		writeError(c, err, "failed to read attachment")
+		return
+	}
+	defer content.Close()
+
+	headers := map[string]string{
+		"Accept-Ranges":          "bytes",
+		"ETag":                   `"` + attachment.SHA256 + `"`,
+		"Content-Disposition":    mime.FormatMediaType("attachment", map[string]string{"filename": attachment.Filename}),
+		"X-Content-Type-Options": "nosniff",
+	}
+
+	status := http.StatusOK
+	if partial {
+		status = http.StatusPartialContent
+		headers["Content-Range"] = fmt.Sprintf("bytes %d-%d/%d", offset, offset+length-1, attachment.Size)
+	}
+
+	c.DataFromReader(status, length, attachment.ContentType, content, headers)
+}
+
+func (h *Handler) DeleteAttachmentById(c *gin.Context) {
+	id, ok := parseID(c, "id", "invalid attachment ID")
+	if !ok {
+		return
+	}
+
+	a, err := actor.FromContext(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+
+	log.Log.Info("DeleteAttachmentById called", zap.Uint("id", id))
+
+	if err := h.Service.DeleteAttachmentById(id, a); err != nil {
+		writeError(c, err, "failed to delete attachment")
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func parseID(c *gin.Context, name string, message string) (uint, bool) {
+	param := c.Param(name)
+	parsed, err := strconv.ParseUint(param, 10, 32)
+	if err != nil {
+		log.Log.Warn("Invalid path parameter", zap.String(name, param), zap.Error(err))
+		c.JSON(http.StatusBadRequest, gin.H{"error": message})
+		return 0, false
+	}
+
+	return uint(parsed), true
+}
+
+func writeError(c *gin.Context, err error, fallback string) {
+	switch {
+	case errors.Is(err, ErrAttachmentNotFound), errors.Is(err, storage.ErrNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	case errors.Is(err, ErrForbidden):
+		c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+	case errors.Is(err, ErrTooLarge):
+		c.JSON(http.StatusRequestEntityTooLarge, gin.H{"error": err.Error()})
+	case errors.Is(err, ErrUnsupportedType):
+		c.JSON(http.StatusUnsupportedMediaType, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": fallback})
+	}
+}
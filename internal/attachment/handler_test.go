@@ -0,0 +1,185 @@
+package attachment
+
+import (
+	"bytes"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"student_go/internal/actor"
+	"student_go/internal/dto/response"
+	"student_go/internal/mocks"
+	"testing"
+)
+
+func setupHandlerTest() (*gin.Engine, *mocks.AttachmentServiceMock, *Handler) {
+	gin.SetMode(gin.TestMode)
+	mockService := new(mocks.AttachmentServiceMock)
+	handler := &Handler{Service: mockService}
+	r := gin.Default()
+	return r, mockService, handler
+}
+
+func setActor(req *http.Request, id string, role string) {
+	req.Header.Set(actor.HeaderUserID, id)
+	req.Header.Set(actor.HeaderUserRole, role)
+}
+
+func multipartBody(t *testing.T, kind string, content []byte) (*bytes.Buffer, string) {
+	var body bytes.Buffer
+	w := multipart.NewWriter(&body)
+	if kind != "" {
+		assert.NoError(t, w.WriteField("kind", kind))
+	}
+	part, err := w.CreateFormFile("file", "diploma.pdf")
+	assert.NoError(t, err)
+	_, _ = part.Write(content)
+	assert.NoError(t, w.Close())
+	return &body, w.FormDataContentType()
+}
+
+var downloadable = &response.AttachmentResponse{
+	ID:          7,
+	Filename:    "отчёт.txt",
+	ContentType: "text/plain; charset=utf-8",
+	Size:        10,
+	SHA256:      "84d8",
+}
+
+func TestUploadAttachmentHandler(t *testing.T) {
+	r, mockService, handler := setupHandlerTest()
+	mockService.On("Upload", mock.Anything, owner, "diploma", "diploma.pdf", int64(len(pdfData)), mock.MatchedBy(func(r io.Reader) bool {
+		data, _ := io.ReadAll(r)
+		return bytes.Equal(data, pdfData)
+	})).Return(&response.AttachmentResponse{ID: 7}, nil)
+
+	r.POST("/attachments", handler.UploadAttachment)
+	body, contentType := multipartBody(t, "diploma", pdfData)
+	req := httptest.NewRequest(http.MethodPost, "/attachments", body)
+	req.Header.Set("Content-Type", contentType)
+	setActor(req, "5", "student")
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusCreated, resp.Code)
+	mockService.AssertExpectations(t)
+}
+
+func TestUploadAttachmentHandler_InvalidKind(t *testing.T) {
+	r, mockService, handler := setupHandlerTest()
+
+	r.POST("/attachments", handler.UploadAttachment)
+	body, contentType := multipartBody(t, "selfie", pdfData)
+	req := httptest.NewRequest(http.MethodPost, "/attachments", body)
+	req.Header.Set("Content-Type", contentType)
+	setActor(req, "5", "student")
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	mockService.AssertNotCalled(t, "Upload", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestUploadAttachmentHandler_BodyTooLarge(t *testing.T) {
+	r, mockService, handler := setupHandlerTest()
+	handler.MaxUploadSize = 1
+
+	r.POST("/attachments", handler.UploadAttachment)
+	body, contentType := multipartBody(t, "diploma", bytes.Repeat([]byte("a"), multipartOverhead+10))
+	req := httptest.NewRequest(http.MethodPost, "/attachments", body)
+	req.Header.Set("Content-Type", contentType)
+	setActor(req, "5", "student")
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusRequestEntityTooLarge, resp.Code)
+	mockService.AssertNotCalled(t, "Upload", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestUploadAttachmentHandler_UnsupportedType(t *testing.T) {
+	r, mockService, handler := setupHandlerTest()
+	mockService.On("Upload", mock.Anything, owner, "other", "diploma.pdf", mock.Anything, mock.Anything).Return(nil, ErrUnsupportedType)
+
+	r.POST("/attachments", handler.UploadAttachment)
+	body, contentType := multipartBody(t, "other", []byte("<html></html>"))
+	req := httptest.NewRequest(http.MethodPost, "/attachments", body)
+	req.Header.Set("Content-Type", contentType)
+	setActor(req, "5", "student")
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusUnsupportedMediaType, resp.Code)
+}
+
+func TestDownloadAttachmentHandler(t *testing.T) {
+	r, mockService, handler := setupHandlerTest()
+	mockService.On("FindAttachmentById", uint(7), owner).Return(downloadable, nil)
+	mockService.On("OpenContent", mock.Anything, uint(7), owner, int64(0), int64(10)).
+		Return(io.NopCloser(strings.NewReader("0123456789")), nil)
+
+	r.GET("/attachments/:id/content", handler.DownloadAttachment)
+	req := httptest.NewRequest(http.MethodGet, "/attachments/7/content", nil)
+	setActor(req, "5", "student")
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "0123456789", resp.Body.String())
+	assert.Equal(t, "bytes", resp.Header().Get("Accept-Ranges"))
+	assert.Equal(t, `"84d8"`, resp.Header().Get("ETag"))
+	assert.Equal(t, "text/plain; charset=utf-8", resp.Header().Get("Content-Type"))
+	assert.Equal(t, "attachment; filename*=utf-8''%D0%BE%D1%82%D1%87%D1%91%D1%82.txt", resp.Header().Get("Content-Disposition"))
+}
+
+func TestDownloadAttachmentHandler_Range(t *testing.T) {
+	r, mockService, handler := setupHandlerTest()
+	mockService.On("FindAttachmentById", uint(7), owner).Return(downloadable, nil)
+	mockService.On("OpenContent", mock.Anything, uint(7), owner, int64(7), int64(3)).
+		Return(io.NopCloser(strings.NewReader("789")), nil)
+
+	r.GET("/attachments/:id/content", handler.DownloadAttachment)
+	req := httptest.NewRequest(http.MethodGet, "/attachments/7/content", nil)
+	req.Header.Set("Range", "bytes=-3")
+	setActor(req, "5", "student")
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusPartialContent, resp.Code)
+	assert.Equal(t, "789", resp.Body.String())
+	assert.Equal(t, "bytes 7-9/10", resp.Header().Get("Content-Range"))
+	assert.Equal(t, "3", resp.Header().Get("Content-Length"))
+}
+
+func TestDownloadAttachmentHandler_RangeNotSatisfiable(t *testing.T) {
+	r, mockService, handler := setupHandlerTest()
+	mockService.On("FindAttachmentById", uint(7), owner).Return(downloadable, nil)
+
+	r.GET("/attachments/:id/content", handler.DownloadAttachment)
+	req := httptest.NewRequest(http.MethodGet, "/attachments/7/content", nil)
+	req.Header.Set("Range", "bytes=10-")
+	setActor(req, "5", "student")
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusRequestedRangeNotSatisfiable, resp.Code)
+	assert.Equal(t, "bytes */10", resp.Header().Get("Content-Range"))
+	mockService.AssertNotCalled(t, "OpenContent", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestDownloadAttachmentHandler_Forbidden(t *testing.T) {
+	r, mockService, handler := setupHandlerTest()
+	other := actor.Actor{ID: 6, Role: actor.RoleStudent}
+	mockService.On("FindAttachmentById", uint(7), other).Return(nil, ErrForbidden)
+
+	r.GET("/attachments/:id/content", handler.DownloadAttachment)
+	req := httptest.NewRequest(http.MethodGet, "/attachments/7/content", nil)
+	setActor(req, "6", "student")
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusForbidden, resp.Code)
+}
@@ -0,0 +1,41 @@
+package attachment
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestParseRange(t *testing.T) {
+	tests := []struct {
+		header  string
+		offset  int64
+		length  int64
+		partial bool
+		err     bool
+	}{
+		{header: "", offset: 0, length: 100},
+		{header: "bytes=0-9", offset: 0, length: 10, partial: true},
+		{header: "bytes=90-", offset: 90, length: 10, partial: true},
+		{header: "bytes=90-500", offset: 90, length: 10, partial: true},
+		{header: "bytes=-20", offset: 80, length: 20, partial: true},
+		{header: "bytes=-500", offset: 0, length: 100, partial: true},
+		{header: "bytes=0-1,5-6", offset: 0, length: 100},
+		{header: "items=0-1", offset: 0, length: 100},
+		{header: "bytes=100-", err: true},
+		{header: "bytes=9-3", err: true},
+		{header: "bytes=-0", err: true},
+		{header: "bytes=abc", err: true},
+	}
+
+	for _, tt := range tests {
+		offset, length, partial, err := parseRange(tt.header, 100)
+		if tt.err {
+			assert.ErrorIs(t, err, errRangeNotSatisfiable, tt.header)
+			continue
+		}
+		assert.NoError(t, err, tt.header)
+		assert.Equal(t, tt.offset, offset, tt.header)
+		assert.Equal(t, tt.length, length, tt.header)
+		assert.Equal(t, tt.partial, partial, tt.header)
+	}
+}
@@ -0,0 +1,69 @@
+package attachment
+
+import (
+	"student_go/internal/entity"
+	"student_go/pkg/dbcontext"
+)
+
+type Repository interface {
+	Save(attachment *entity.Attachment) (*entity.Attachment, error)
+	FindById(id uint) (*entity.Attachment, error)
+	FindAll(ownerRole string, ownerId uint, page, limit int) ([]entity.Attachment, error)
+	DeleteById(id uint) error
+	Count(ownerRole string, ownerId uint) (int, error)
+}
+
+type repository struct{}
+
+func NewAttachmentRepository() Repository {
+	return &repository{}
+}
+
+func (r *repository) Save(attachment *entity.Attachment) (*entity.Attachment, error) {
+	err := dbcontext.DB.Create(attachment).Error
+	return attachment, err
+}
+
+func (r *repository) FindById(id uint) (*entity.Attachment, error) {
+	var attachment entity.Attachment
+	result := dbcontext.DB.First(&attachment, id)
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return &attachment, nil
+}
+
+func (r *repository) FindAll(ownerRole string, ownerId uint, page, limit int) ([]entity.Attachment, error) {
+	var attachments []entity.Attachment
+
+	offset := (page - 1) * limit
+
+	result := dbcontext.DB.
+		Where("owner_role = ? AND owner_id = ?", ownerRole, ownerId).
+		Order("created_at DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&attachments)
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return attachments, nil
+}
+
+func (r *repository) DeleteById(id uint) error {
+	result := dbcontext.DB.Delete(&entity.Attachment{}, id)
+
+	return result.Error
+}
+
+func (r *repository) Count(ownerRole string, ownerId uint) (int, error) {
+	var count int64
+	err := dbcontext.DB.Model(&entity.Attachment{}).
+		Where("owner_role = ? AND owner_id = ?", ownerRole, ownerId).
+		Count(&count).Error
+	return int(count), err
+}
@@ -0,0 +1,69 @@
+package attachment
+
+import (
+	"database/sql"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"regexp"
+	"testing"
+
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+
+	"student_go/pkg/dbcontext"
+)
+
+func setupTestDB(t *testing.T) (*sql.DB, sqlmock.Sqlmock, *gorm.DB) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+
+	dialector := postgres.New(postgres.Config{
+		Conn:                 db,
+		PreferSimpleProtocol: true,
+	})
+
+	gormDB, err := gorm.Open(dialector, &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	assert.NoError(t, err)
+
+	dbcontext.DB = gormDB
+	return db, mock, gormDB
+}
+
+func TestAttachmentFindAll(t *testing.T) {
+	db, mock, _ := setupTestDB(t)
+	defer db.Close()
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "attachments" WHERE owner_role = $1 AND owner_id = $2 ORDER BY created_at DESC LIMIT $3 OFFSET $4`)).
+		WithArgs("student", 5, 10, 10).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "owner_role", "owner_id", "filename", "sha256"}).
+			AddRow(3, "student", 5, "diploma.pdf", "ab12"))
+
+	repo := NewAttachmentRepository()
+	attachments, err := repo.FindAll("student", 5, 2, 10)
+
+	require.NoError(t, err)
+	require.Len(t, attachments, 1)
+	assert.Equal(t, "diploma.pdf", attachments[0].Filename)
+	assert.Equal(t, "ab12", attachments[0].SHA256)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestAttachmentCount(t *testing.T) {
+	db, mock, _ := setupTestDB(t)
+	defer db.Close()
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "attachments" WHERE owner_role = $1 AND owner_id = $2`)).
+		WithArgs("teacher", 3).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(4))
+
+	repo := NewAttachmentRepository()
+	count, err := repo.Count("teacher", 3)
+
+	require.NoError(t, err)
+	assert.Equal(t, 4, count)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,234 @@
+package attachment
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"io"
+	"net/http"
+	"slices"
+	"student_go/internal/actor"
+	"student_go/internal/dto/response"
+	"student_go/internal/entity"
+	"student_go/pkg/log"
+	"student_go/pkg/storage"
+	"time"
+)
+
+// sniffLength is how much of an upload http.DetectContentType looks at.
+const sniffLength = 512
+
+var (
+	ErrAttachmentNotFound = errors.New("attachment not found")
+	ErrForbidden          = errors.New("forbidden")
+	ErrTooLarge           = errors.New("attachment is too large")
+	ErrUnsupportedType    = errors.New("attachment content type is not allowed")
+)
+
+type Service interface {
+	Upload(ctx context.Context, a actor.Actor, kind, filename string, size int64, content io.Reader) (*response.AttachmentResponse, error)
+	FindAttachmentById(id uint, a actor.Actor) (*response.AttachmentResponse, error)
+	FindAllAttachments(a actor.Actor, page, limit int) ([]*response.AttachmentResponse, error)
+	OpenContent(ctx context.Context, id uint, a actor.Actor, offset, length int64) (io.ReadCloser, error)
+	DeleteAttachmentById(id uint, a actor.Actor) error
+	Count(a actor.Actor) (int, error)
+}
+
+type service struct {
+	attachmentRepository Repository
+	storage              storage.Storage
+	maxSize              int64
+	allowedTypes         []string
+	newKey               func() string
+}
+
+// NewAttachmentService creates the service. A maxSize of zero disables the
+// size limit and an empty allowedTypes accepts every content type.
+func NewAttachmentService(
+	attachmentRepository Repository,
+	storage storage.Storage,
+	maxSize int64,
+	allowedTypes []string,
+) Service {
+	return &service{
+		attachmentRepository: attachmentRepository,
+		storage:              storage,
+		maxSize:              maxSize,
+		allowedTypes:         allowedTypes,
+		newKey:               newStorageKey,
+	}
+}
+
+// Upload sniffs the content type from the first bytes, streams the content
+// to storage while hashing it and records the attachment. size must be the
+// exact length of content. The stored object is removed again if the
+// record cannot be saved.
+func (s *service) Upload(ctx context.Context, a actor.Actor, kind, filename string, size int64, content io.Reader) (*response.AttachmentResponse, error) {
+	log.Log.Info("Upload (service) called",
+		zap.String("owner_role", string(a.Role)),
+		zap.Uint("owner_id", a.ID),
+		zap.String("filename", filename),
+		zap.Int64("size", size),
+	)
+
+	if s.maxSize > 0 && size > s.maxSize {
+		return nil, fmt.Errorf("%w: %d bytes, limit is %d", ErrTooLarge, size, s.maxSize)
+	}
+
+	head := make([]byte, sniffLength)
+	n, err := io.ReadFull(content, head)
+	if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return nil, err
+	}
+	head = head[:n]
+
+	contentType := http.DetectContentType(head)
+	if len(s.allowedTypes) > 0 && !slices.Contains(s.allowedTypes, contentType) {
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedType, contentType)
+	}
+
+	hash := sha256.New()
+	body := io.TeeReader(io.MultiReader(bytes.NewReader(head), content), hash)
+
+	key := s.newKey()
+	if err := s.storage.Put(ctx, key, body, size, contentType); err != nil {
+		return nil, err
+	}
+
+	attachment := entity.Attachment{
+		OwnerRole:   string(a.Role),
+		OwnerID:     a.ID,
+		Kind:        kind,
+		Filename:    filename,
+		ContentType: contentType,
+		Size:        size,
+		SHA256:      hex.EncodeToString(hash.Sum(nil)),
+		StorageKey:  key,
+	}
+	saved, err := s.attachmentRepository.Save(&attachment)
+	if err != nil {
+		if deleteErr := s.storage.Delete(ctx, key); deleteErr != nil {
+			log.Log.Error("Failed to remove orphaned attachment content", zap.String("key", key), zap.Error(deleteErr))
+		}
+		return nil, err
+	}
+
+	return toAttachmentResponse(saved), nil
+}
+
+func (s *service) FindAttachmentById(id uint, a actor.Actor) (*response.AttachmentResponse, error) {
+	log.Log.Info("FindAttachmentById (service) called", zap.Uint("id", id))
+
+	attachment, err := s.findAttachment(id, a)
+	if err != nil {
+		return nil, err
+	}
+
+	return toAttachmentResponse(attachment), nil
+}
+
+func (s *service) FindAllAttachments(a actor.Actor, page, limit int) ([]*response.AttachmentResponse, error) {
+	log.Log.Info("FindAllAttachments (service) called",
+		zap.String("owner_role", string(a.Role)),
+		zap.Uint("owner_id", a.ID),
+		zap.Int("page", page),
+		zap.Int("limit", limit),
+	)
+
+	attachments, err := s.attachmentRepository.FindAll(string(a.Role), a.ID, page, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	attachmentResponses := make([]*response.AttachmentResponse, 0, len(attachments))
+	for i := range attachments {
+		attachmentResponses = append(attachmentResponses, toAttachmentResponse(&attachments[i]))
+	}
+
+	return attachmentResponses, nil
+}
+
+func (s *service) OpenContent(ctx context.Context, id uint, a actor.Actor, offset, length int64) (io.ReadCloser, error) {
+	log.Log.Info("OpenContent (service) called", zap.Uint("id", id), zap.Int64("offset", offset), zap.Int64("length", length))
+
+	attachment, err := s.findAttachment(id, a)
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := s.storage.Open(ctx, attachment.StorageKey, offset, length)
+	if errors.Is(err, storage.ErrNotFound) {
+		log.Log.Error("Attachment content is missing", zap.Uint("id", id), zap.String("key", attachment.StorageKey))
+	}
+
+	return content, err
+}
+
+func (s *service) DeleteAttachmentById(id uint, a actor.Actor) error {
+	log.Log.Info("DeleteAttachmentById (service) called", zap.Uint("id", id))
+
+	attachment, err := s.findAttachment(id, a)
+	if err != nil {
+		return err
+	}
+
+	if err := s.attachmentRepository.DeleteById(id); err != nil {
+		return err
+	}
+
+	// The record is gone, so a leftover object is only wasted space.
+	if err := s.storage.Delete(context.Background(), attachment.StorageKey); err != nil {
+		log.Log.Error("Failed to delete attachment content", zap.Uint("id", id), zap.Error(err))
+	}
+
+	return nil
+}
+
+func (s *service) Count(a actor.Actor) (int, error) {
+	return s.attachmentRepository.Count(string(a.Role), a.ID)
+}
+
+// findAttachment loads an attachment visible to a: its owner or an admin.
+func (s *service) findAttachment(id uint, a actor.Actor) (*entity.Attachment, error) {
+	attachment, err := s.attachmentRepository.FindById(id)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrAttachmentNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	isOwner := attachment.OwnerRole == string(a.Role) && attachment.OwnerID == a.ID
+	if !isOwner && !a.IsAdmin() {
+		return nil, ErrForbidden
+	}
+
+	return attachment, nil
+}
+
+func newStorageKey() string {
+	random := make([]byte, 16)
+	_, _ = rand.Read(random)
+
+	return time.Now().UTC().Format("2006/01/") + hex.EncodeToString(random)
+}
+
+func toAttachmentResponse(attachment *entity.Attachment) *response.AttachmentResponse {
+	return &response.AttachmentResponse{
+		ID:          attachment.ID,
+		OwnerRole:   attachment.OwnerRole,
+		OwnerID:     attachment.OwnerID,
+		Kind:        attachment.Kind,
+		Filename:    attachment.Filename,
+		ContentType: attachment.ContentType,
+		Size:        attachment.Size,
+		SHA256:      attachment.SHA256,
+		CreatedAt:   attachment.CreatedAt,
+	}
+}
@@ -0,0 +1,157 @@
+package attachment
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"io"
+	"student_go/internal/actor"
+	"student_go/internal/entity"
+	mocks2 "student_go/internal/mocks"
+	"student_go/pkg/log"
+	"student_go/pkg/storage"
+	"testing"
+)
+
+func init() {
+	logger, _ := zap.NewDevelopment()
+	log.Log = logger
+}
+
+var (
+	admin   = actor.Actor{ID: 1, Role: actor.RoleAdmin}
+	owner   = actor.Actor{ID: 5, Role: actor.RoleStudent}
+	pdfData = []byte("%PDF-1.7\n1 0 obj << /Type /Catalog >> endobj\n%%EOF\n")
+	stored  = &entity.Attachment{ID: 7, OwnerRole: "student", OwnerID: 5, Filename: "scan.pdf", ContentType: "application/pdf", Size: 10, StorageKey: "2026/10/abc"}
+)
+
+func newTestAttachmentService(t *testing.T) (*service, *mocks2.AttachmentRepository, storage.Storage) {
+	mockRepo := new(mocks2.AttachmentRepository)
+	store := storage.NewLocal(t.TempDir())
+
+	svc := NewAttachmentService(mockRepo, store, 1024, []string{"application/pdf", "image/png"}).(*service)
+	svc.newKey = func() string { return "2026/10/abc" }
+	return svc, mockRepo, store
+}
+
+func TestUpload(t *testing.T) {
+	svc, mockRepo, store := newTestAttachmentService(t)
+	sum := sha256.Sum256(pdfData)
+
+	mockRepo.On("Save", mock.MatchedBy(func(a *entity.Attachment) bool {
+		return a.OwnerRole == "student" && a.OwnerID == 5 && a.Kind == "diploma" &&
+			a.ContentType == "application/pdf" && a.SHA256 == hex.EncodeToString(sum[:]) &&
+			a.Size == int64(len(pdfData)) && a.StorageKey == "2026/10/abc"
+	})).Return(&entity.Attachment{ID: 7, Filename: "diploma.pdf"}, nil)
+
+	result, err := svc.Upload(context.Background(), owner, "diploma", "diploma.pdf", int64(len(pdfData)), bytes.NewReader(pdfData))
+
+	require.NoError(t, err)
+	assert.Equal(t, uint(7), result.ID)
+	mockRepo.AssertExpectations(t)
+
+	content, err := store.Open(context.Background(), "2026/10/abc", 0, -1)
+	require.NoError(t, err)
+	defer content.Close()
+	data, _ := io.ReadAll(content)
+	assert.Equal(t, pdfData, data)
+}
+
+func TestUpload_TooLarge(t *testing.T) {
+	svc, mockRepo, _ := newTestAttachmentService(t)
+
+	result, err := svc.Upload(context.Background(), owner, "other", "big.pdf", 2048, bytes.NewReader(nil))
+
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, ErrTooLarge)
+	mockRepo.AssertNotCalled(t, "Save", mock.Anything)
+}
+
+func TestUpload_UnsupportedType(t *testing.T) {
+	svc, mockRepo, _ := newTestAttachmentService(t)
+	html := []byte("<html><script>alert(1)</script></html>")
+
+	result, err := svc.Upload(context.Background(), owner, "other", "scan.pdf", int64(len(html)), bytes.NewReader(html))
+
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, ErrUnsupportedType)
+	mockRepo.AssertNotCalled(t, "Save", mock.Anything)
+}
+
+func TestUpload_SaveFailsRemovesContent(t *testing.T) {
+	svc, mockRepo, store := newTestAttachmentService(t)
+	mockRepo.On("Save", mock.Anything).Return(nil, errors.New("db down"))
+
+	_, err := svc.Upload(context.Background(), owner, "other", "scan.pdf", int64(len(pdfData)), bytes.NewReader(pdfData))
+
+	assert.Error(t, err)
+	_, err = store.Open(context.Background(), "2026/10/abc", 0, -1)
+	assert.ErrorIs(t, err, storage.ErrNotFound)
+}
+
+func TestFindAttachmentById(t *testing.T) {
+	svc, mockRepo, _ := newTestAttachmentService(t)
+	mockRepo.On("FindById", uint(7)).Return(stored, nil)
+
+	result, err := svc.FindAttachmentById(7, owner)
+	require.NoError(t, err)
+	assert.Equal(t, "scan.pdf", result.Filename)
+
+	result, err = svc.FindAttachmentById(7, admin)
+	require.NoError(t, err)
+	assert.Equal(t, uint(7), result.ID)
+}
+
+func TestFindAttachmentById_OtherOwnerForbidden(t *testing.T) {
+	svc, mockRepo, _ := newTestAttachmentService(t)
+	mockRepo.On("FindById", uint(7)).Return(stored, nil)
+
+	// Same ID, different role: a teacher with ID 5 is not the owner.
+	result, err := svc.FindAttachmentById(7, actor.Actor{ID: 5, Role: actor.RoleTeacher})
+
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, ErrForbidden)
+}
+
+func TestFindAttachmentById_NotFound(t *testing.T) {
+	svc, mockRepo, _ := newTestAttachmentService(t)
+	mockRepo.On("FindById", uint(7)).Return(nil, gorm.ErrRecordNotFound)
+
+	result, err := svc.FindAttachmentById(7, owner)
+
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, ErrAttachmentNotFound)
+}
+
+func TestOpenContent(t *testing.T) {
+	svc, mockRepo, store := newTestAttachmentService(t)
+	mockRepo.On("FindById", uint(7)).Return(stored, nil)
+	require.NoError(t, store.Put(context.Background(), "2026/10/abc", bytes.NewReader([]byte("0123456789")), 10, "text/plain"))
+
+	content, err := svc.OpenContent(context.Background(), 7, owner, 2, 3)
+	require.NoError(t, err)
+	defer content.Close()
+
+	data, _ := io.ReadAll(content)
+	assert.Equal(t, "234", string(data))
+}
+
+func TestDeleteAttachmentById(t *testing.T) {
+	svc, mockRepo, store := newTestAttachmentService(t)
+	mockRepo.On("FindById", uint(7)).Return(stored, nil)
+	mockRepo.On("DeleteById", uint(7)).Return(nil)
+	require.NoError(t, store.Put(context.Background(), "2026/10/abc", bytes.NewReader([]byte("0123456789")), 10, "text/plain"))
+
+	err := svc.DeleteAttachmentById(7, owner)
+
+	require.NoError(t, err)
+	_, err = store.Open(context.Background(), "2026/10/abc", 0, -1)
+	assert.ErrorIs(t, err, storage.ErrNotFound)
+}
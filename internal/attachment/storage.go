@@ -0,0 +1,25 @@
+package attachment
+
+import (
+	"fmt"
+	"student_go/internal/config"
+	"student_go/pkg/storage"
+)
+
+// NewStorage builds the storage selected in the storage config.
+func NewStorage() (storage.Storage, error) {
+	conf := config.Config.Storage
+
+	switch conf.Driver {
+	case "s3":
+		return storage.NewS3(conf.S3.Endpoint, conf.S3.Region, conf.S3.Bucket, conf.S3.AccessKey, conf.S3.SecretKey), nil
+	case "local", "":
+		dir := conf.LocalDir
+		if dir == "" {
+			dir = "tmp/attachments"
+		}
+		return storage.NewLocal(dir), nil
+	}
+
+	return nil, fmt.Errorf("unknown storage driver %q", conf.Driver)
+}
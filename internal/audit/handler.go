@@ -0,0 +1,83 @@
+package audit
+
+import (
+	"errors"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+	"net/http"
+	"strconv"
+	"student_go/internal/actor"
+	"student_go/pkg/log"
+	"student_go/pkg/pagination"
+)
+
+type Handler struct {
+	Service Service
+}
+
+func NewAuditHandler() *Handler {
+	return &Handler{
+		Service: NewAuditService(NewAuditRepository()),
+	}
+}
+
+// FindAllEntries answers GET /audit?entity=student&id=5 with the changes
+// to the entity, one page at a time. Only admins can read the audit log.
+func (h *Handler) FindAllEntries(c *gin.Context) {
+	a, err := actor.FromContext(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+	if !a.IsAdmin() {
+		c.JSON(http.StatusForbidden, gin.H{"error": "only admins can read the audit log"})
+		return
+	}
+
+	entityName := c.Query("entity")
+
+	var entityId uint
+	if param := c.Query("id"); param != "" {
+		parsedID, err := strconv.ParseUint(param, 10, 32)
+		if err != nil {
+			log.Log.Warn("Invalid ID in FindAllEntries", zap.String("id", param), zap.Error(err))
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid entity ID"})
+			return
+		}
+		entityId = uint(parsedID)
+	}
+
+	count, err := h.Service.Count(entityName, entityId)
+	if err != nil {
+		writeError(c, err, "failed to count audit entries")
+		return
+	}
+
+	pages := pagination.NewFromRequest(c.Request, count)
+
+	log.Log.Info("FindAllEntries called",
+		zap.String("entity", entityName),
+		zap.Uint("entity_id", entityId),
+		zap.Int("page", pages.Page),
+		zap.Int("per_page", pages.PerPage),
+	)
+
+	entries, err := h.Service.FindAllEntries(entityName, entityId, pages.Page, pages.PerPage)
+	if err != nil {
+		writeError(c, err, "failed to get audit entries")
+		return
+	}
+
+	pages.Items = entries
+	c.JSON(http.StatusOK, pages)
+}
+
+func writeError(c *gin.Context, err error, fallback string) {
+	switch {
+	case errors.Is(err, ErrInvalidEntity):
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	default:
+		log.Log.Error(fallback, zap.Error(err))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": fallback})
+	}
+}
@@ -0,0 +1,111 @@
+package audit_test
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"student_go/internal/actor"
+	"student_go/internal/audit"
+	"student_go/internal/dto/response"
+	"student_go/internal/mocks"
+	"testing"
+)
+
+func setupHandlerTest() (*gin.Engine, *mocks.AuditServiceMock, *audit.Handler) {
+	gin.SetMode(gin.TestMode)
+	mockService := new(mocks.AuditServiceMock)
+	handler := &audit.Handler{Service: mockService}
+	r := gin.Default()
+	return r, mockService, handler
+}
+
+func setActor(req *http.Request, id string, role string) {
+	req.Header.Set(actor.HeaderUserID, id)
+	req.Header.Set(actor.HeaderUserRole, role)
+}
+
+func TestFindAllEntriesHandler(t *testing.T) {
+	r, mockService, handler := setupHandlerTest()
+	mockService.On("Count", "student", uint(5)).Return(1, nil)
+	mockService.On("FindAllEntries", "student", uint(5), 1, 100).
+		Return([]*response.AuditEntryResponse{{ID: 12}}, nil)
+
+	r.GET("/audit", handler.FindAllEntries)
+	req := httptest.NewRequest(http.MethodGet, "/audit?entity=student&id=5", nil)
+	setActor(req, "1", "admin")
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	mockService.AssertExpectations(t)
+}
+
+func TestFindAllEntriesHandler_Access(t *testing.T) {
+	tests := []struct {
+		name     string
+		role     string
+		expected int
+	}{
+		{name: "anonymous", expected: http.StatusUnauthorized},
+		{name: "teacher", role: "teacher", expected: http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, mockService, handler := setupHandlerTest()
+
+			r.GET("/audit", handler.FindAllEntries)
+			req := httptest.NewRequest(http.MethodGet, "/audit?entity=student", nil)
+			if tt.role != "" {
+				setActor(req, "2", tt.role)
+			}
+			resp := httptest.NewRecorder()
+			r.ServeHTTP(resp, req)
+
+			assert.Equal(t, tt.expected, resp.Code)
+			mockService.AssertNotCalled(t, "Count")
+		})
+	}
+}
+
+func TestFindAllEntriesHandler_InvalidEntity(t *testing.T) {
+	r, mockService, handler := setupHandlerTest()
+	mockService.On("Count", "hold", uint(0)).Return(0, audit.ErrInvalidEntity)
+
+	r.GET("/audit", handler.FindAllEntries)
+	req := httptest.NewRequest(http.MethodGet, "/audit?entity=hold", nil)
+	setActor(req, "1", "admin")
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+}
+
+func TestRequestID(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.Use(audit.RequestID())
+
+	var seen audit.Meta
+	r.GET("/ping", func(c *gin.Context) {
+		seen = audit.FromContext(c)
+		c.Status(http.StatusNoContent)
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/ping", nil)
+	req.Header.Set(audit.HeaderRequestID, "req-1")
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, "req-1", resp.Header().Get(audit.HeaderRequestID))
+	assert.Equal(t, "req-1", seen.RequestID)
+	assert.Nil(t, seen.ActorID)
+
+	req = httptest.NewRequest(http.MethodGet, "/ping", nil)
+	resp = httptest.NewRecorder()
+	r.ServeHTTP(resp, req)
+
+	assert.Len(t, resp.Header().Get(audit.HeaderRequestID), 32)
+	assert.Equal(t, resp.Header().Get(audit.HeaderRequestID), seen.RequestID)
+}
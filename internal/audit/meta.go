@@ -0,0 +1,54 @@
+package audit
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"github.com/gin-gonic/gin"
+	"student_go/internal/actor"
+)
+
+// HeaderRequestID carries the request ID that ties audit entries to the
+// request, and to the gateway's logs when it sets one.
+const HeaderRequestID = "X-Request-ID"
+
+// maxRequestIDLength bounds the request IDs accepted from clients.
+const maxRequestIDLength = 128
+
+// Meta says who made a change and in which request.
+type Meta struct {
+	ActorID   *uint
+	ActorRole string
+	RequestID string
+}
+
+// RequestID makes sure every request has an ID: it keeps the one sent in
+// X-Request-ID, or makes one up, and echoes it in the response.
+func RequestID() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id := c.GetHeader(HeaderRequestID)
+		if id == "" || len(id) > maxRequestIDLength {
+			id = newRequestID()
+			c.Request.Header.Set(HeaderRequestID, id)
+		}
+		c.Header(HeaderRequestID, id)
+		c.Next()
+	}
+}
+
+func newRequestID() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// FromContext returns the Meta of the request. Changes made without a
+// valid identity are recorded without an actor.
+func FromContext(c *gin.Context) Meta {
+	meta := Meta{RequestID: c.GetHeader(HeaderRequestID)}
+	if a, err := actor.FromContext(c); err == nil {
+		meta.ActorID = &a.ID
+		meta.ActorRole = string(a.Role)
+	}
+
+	return meta
+}
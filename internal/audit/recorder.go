@@ -0,0 +1,190 @@
+package audit
+
+import (
+	"encoding/json"
+	"errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"reflect"
+	"student_go/internal/entity"
+)
+
+// Create inserts row and records it in the same transaction.
+func Create(db *gorm.DB, meta Meta, row interface{}) error {
+	return db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(row).Error; err != nil {
+			return err
+		}
+
+		name, id, after, err := describe(tx, row)
+		if err != nil {
+			return err
+		}
+
+		return Record(tx, meta, name, id, entity.AuditCreate, nil, after)
+	})
+}
+
+// Track runs change in a transaction and records what it did to the row
+// of model's table with the given ID, deleted or not. The row is locked
+// until the transaction ends. Nothing is recorded when change leaves the
+// row as it was.
+func Track(db *gorm.DB, meta Meta, model interface{}, id uint, action string, change func(tx *gorm.DB) error) error {
+	return db.Transaction(func(tx *gorm.DB) error {
+		before, err := Snapshot(tx.Clauses(clause.Locking{Strength: "UPDATE"}), model, id)
+		if err != nil {
+			return err
+		}
+
+		if err := change(tx); err != nil {
+			return err
+		}
+
+		after, err := Snapshot(tx, model, id)
+		if err != nil {
+			return err
+		}
+
+		name, _, _, err := describe(tx, model)
+		if err != nil {
+			return err
+		}
+
+		return Record(tx, meta, name, id, action, before, after)
+	})
+}
+
+// Snapshot reads the columns of the row of model's table with the given
+// ID, deleted or not. It returns nil when there is no such row.
+func Snapshot(tx *gorm.DB, model interface{}, id uint) (map[string]interface{}, error) {
+	row := reflect.New(reflect.TypeOf(model).Elem()).Interface()
+	err := tx.Unscoped().Take(row, id).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	_, _, columns, err := describe(tx, row)
+	return columns, err
+}
+
+// Record appends an entry to the audit log in tx. before and after map
+// column names to values; only the ones that differ are kept, and nothing
+// is written when none do.
+func Record(tx *gorm.DB, meta Meta, entityName string, id uint, action string, before, after map[string]interface{}) error {
+	beforeJSON, afterJSON, err := diff(before, after)
+	if err != nil || (beforeJSON == nil && afterJSON == nil) {
+		return err
+	}
+
+	return tx.Create(&entity.AuditEntry{
+		Entity:    entityName,
+		EntityID:  id,
+		Action:    action,
+		ActorID:   meta.ActorID,
+		ActorRole: meta.ActorRole,
+		RequestID: meta.RequestID,
+		Before:    beforeJSON,
+		After:     afterJSON,
+	}).Error
+}
+
+// describe returns the entity name, primary key and column values of row,
+// a pointer to a model.
+func describe(tx *gorm.DB, row interface{}) (string, uint, map[string]interface{}, error) {
+	stmt := &gorm.Statement{DB: tx}
+	if err := stmt.Parse(row); err != nil {
+		return "", 0, nil, err
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(row))
+	columns := make(map[string]interface{}, len(stmt.Schema.DBNames))
+	for _, name := range stmt.Schema.DBNames {
+		field := stmt.Schema.FieldsByDBName[name]
+		// Read-only fields, like computed counts, are not stored.
+		if !field.Creatable && !field.Updatable {
+			continue
+		}
+		columns[name], _ = field.ValueOf(tx.Statement.Context, value)
+	}
+
+	var id uint
+	if field := stmt.Schema.PrioritizedPrimaryField; field != nil {
+		if primaryKey, ok := columns[field.DBName].(uint); ok {
+			id = primaryKey
+		}
+	}
+
+	return tx.NamingStrategy.ColumnName("", stmt.Schema.Name), id, columns, nil
+}
+
+// diff returns the values of before and after that differ, as JSON
+// objects. A side that is nil, like before for a created row, stays nil.
+func diff(before, after map[string]interface{}) (*string, *string, error) {
+	changedBefore := map[string]json.RawMessage{}
+	changedAfter := map[string]json.RawMessage{}
+
+	for _, name := range columnNames(before, after) {
+		beforeValue, err := json.Marshal(before[name])
+		if err != nil {
+			return nil, nil, err
+		}
+		afterValue, err := json.Marshal(after[name])
+		if err != nil {
+			return nil, nil, err
+		}
+		if before != nil && after != nil && string(beforeValue) == string(afterValue) {
+			continue
+		}
+
+		if _, ok := before[name]; ok {
+			changedBefore[name] = beforeValue
+		}
+		if _, ok := after[name]; ok {
+			changedAfter[name] = afterValue
+		}
+	}
+
+	beforeJSON, err := encode(before, changedBefore)
+	if err != nil {
+		return nil, nil, err
+	}
+	afterJSON, err := encode(after, changedAfter)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return beforeJSON, afterJSON, nil
+}
+
+func columnNames(before, after map[string]interface{}) []string {
+	var names []string
+	for name := range before {
+		names = append(names, name)
+	}
+	for name := range after {
+		if _, ok := before[name]; !ok {
+			names = append(names, name)
+		}
+	}
+
+	return names
+}
+
+// encode marshals the changed values of one side, or returns nil when
+// the side is missing or nothing in it changed.
+func encode(side map[string]interface{}, changed map[string]json.RawMessage) (*string, error) {
+	if side == nil || len(changed) == 0 {
+		return nil, nil
+	}
+
+	encoded, err := json.Marshal(changed)
+	if err != nil {
+		return nil, err
+	}
+
+	s := string(encoded)
+	return &s, nil
+}
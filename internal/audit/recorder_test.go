@@ -0,0 +1,80 @@
+package audit
+
+import (
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+	"regexp"
+	"student_go/internal/entity"
+	"testing"
+)
+
+func TestDiff_KeepsChangedColumns(t *testing.T) {
+	before, after, err := diff(
+		map[string]interface{}{"id": 1, "name": "John", "email": "john@example.com"},
+		map[string]interface{}{"id": 1, "name": "Jane", "email": "john@example.com"},
+	)
+
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"name":"John"}`, *before)
+	assert.JSONEq(t, `{"name":"Jane"}`, *after)
+}
+
+func TestDiff_Unchanged(t *testing.T) {
+	before, after, err := diff(
+		map[string]interface{}{"id": 1, "name": "John"},
+		map[string]interface{}{"id": 1, "name": "John"},
+	)
+
+	require.NoError(t, err)
+	assert.Nil(t, before)
+	assert.Nil(t, after)
+}
+
+func TestDiff_Created(t *testing.T) {
+	before, after, err := diff(nil, map[string]interface{}{"id": 1, "name": "John"})
+
+	require.NoError(t, err)
+	assert.Nil(t, before)
+	assert.JSONEq(t, `{"id":1,"name":"John"}`, *after)
+}
+
+func TestRecord(t *testing.T) {
+	db, mock, gormDB := setupTestDB(t)
+	defer db.Close()
+
+	actorId := uint(3)
+	meta := Meta{ActorID: &actorId, ActorRole: "admin", RequestID: "req-1"}
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "audit_entries" ("entity","entity_id","action","actor_id","actor_role","request_id","before","after","created_at") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9) RETURNING "id"`)).
+		WithArgs("student", 5, "enroll", 3, "admin", "req-1", nil, `{"course_id":9}`, sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectCommit()
+
+	err := gormDB.Transaction(func(tx *gorm.DB) error {
+		return Record(tx, meta, entity.AuditStudent, 5, entity.AuditEnroll, nil, map[string]interface{}{"course_id": 9})
+	})
+
+	require.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestTrack_ChangeFails(t *testing.T) {
+	db, mock, gormDB := setupTestDB(t)
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "departments" WHERE "departments"."id" = $1 LIMIT $2 FOR UPDATE`)).
+		WithArgs(2, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(2, "Physics"))
+	mock.ExpectRollback()
+
+	err := Track(gormDB, Meta{}, &entity.Department{}, 2, entity.AuditUpdate, func(tx *gorm.DB) error {
+		return gorm.ErrRecordNotFound
+	})
+
+	assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,48 @@
+package audit
+
+import (
+	"gorm.io/gorm"
+	"student_go/internal/entity"
+	"student_go/pkg/dbcontext"
+)
+
+type Repository interface {
+	// FindAll and Count return the entries for one entity type, narrowed
+	// to one entity when entityId is not zero. FindAll lists the newest
+	// first.
+	FindAll(entityName string, entityId uint, page, limit int) ([]entity.AuditEntry, error)
+	Count(entityName string, entityId uint) (int, error)
+}
+
+type repository struct{}
+
+func NewAuditRepository() Repository {
+	return &repository{}
+}
+
+func (r *repository) FindAll(entityName string, entityId uint, page, limit int) ([]entity.AuditEntry, error) {
+	var entries []entity.AuditEntry
+	err := forEntity(dbcontext.DB, entityName, entityId).
+		Order("id DESC").
+		Offset((page - 1) * limit).
+		Limit(limit).
+		Find(&entries).
+		Error
+
+	return entries, err
+}
+
+func (r *repository) Count(entityName string, entityId uint) (int, error) {
+	var count int64
+	err := forEntity(dbcontext.DB.Model(&entity.AuditEntry{}), entityName, entityId).Count(&count).Error
+	return int(count), err
+}
+
+func forEntity(db *gorm.DB, entityName string, entityId uint) *gorm.DB {
+	db = db.Where("entity = ?", entityName)
+	if entityId == 0 {
+		return db
+	}
+
+	return db.Where("entity_id = ?", entityId)
+}
@@ -0,0 +1,69 @@
+package audit
+
+import (
+	"database/sql"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"regexp"
+	"testing"
+
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+
+	"student_go/pkg/dbcontext"
+)
+
+func setupTestDB(t *testing.T) (*sql.DB, sqlmock.Sqlmock, *gorm.DB) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+
+	dialector := postgres.New(postgres.Config{
+		Conn:                 db,
+		PreferSimpleProtocol: true,
+	})
+
+	gormDB, err := gorm.Open(dialector, &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	assert.NoError(t, err)
+
+	dbcontext.DB = gormDB
+	return db, mock, gormDB
+}
+
+func TestAuditFindAll(t *testing.T) {
+	db, mock, _ := setupTestDB(t)
+	defer db.Close()
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "audit_entries" WHERE entity = $1 AND entity_id = $2 ORDER BY id DESC LIMIT $3 OFFSET $4`)).
+		WithArgs("student", 5, 10, 10).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "entity", "entity_id", "action", "after"}).
+			AddRow(12, "student", 5, "update", `{"name":"Jane"}`))
+
+	repo := NewAuditRepository()
+	entries, err := repo.FindAll("student", 5, 2, 10)
+
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, `{"name":"Jane"}`, *entries[0].After)
+	assert.Nil(t, entries[0].Before)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestAuditCount_AllOfEntity(t *testing.T) {
+	db, mock, _ := setupTestDB(t)
+	defer db.Close()
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "audit_entries" WHERE entity = $1`)).
+		WithArgs("course").
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(4))
+
+	repo := NewAuditRepository()
+	count, err := repo.Count("course", 0)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 4, count)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
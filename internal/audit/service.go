@@ -0,0 +1,95 @@
+package audit
+
+import (
+	"encoding/json"
+	"errors"
+	"go.uber.org/zap"
+	"student_go/internal/dto/response"
+	"student_go/internal/entity"
+	"student_go/pkg/log"
+)
+
+var ErrInvalidEntity = errors.New("entity must be one of student, teacher, course or department")
+
+// entities are the entity types the audit log covers.
+var entities = map[string]bool{
+	entity.AuditStudent:    true,
+	entity.AuditTeacher:    true,
+	entity.AuditCourse:     true,
+	entity.AuditDepartment: true,
+}
+
+type Service interface {
+	// FindAllEntries lists the changes to one entity type, or to one
+	// entity of it when entityId is not zero, the newest first.
+	FindAllEntries(entityName string, entityId uint, page, limit int) ([]*response.AuditEntryResponse, error)
+	Count(entityName string, entityId uint) (int, error)
+}
+
+type service struct {
+	auditRepository Repository
+}
+
+func NewAuditService(auditRepository Repository) Service {
+	return &service{
+		auditRepository: auditRepository,
+	}
+}
+
+func (s *service) FindAllEntries(entityName string, entityId uint, page, limit int) ([]*response.AuditEntryResponse, error) {
+	log.Log.Info("FindAllEntries (service) called",
+		zap.String("entity", entityName),
+		zap.Uint("entity_id", entityId),
+		zap.Int("page", page),
+		zap.Int("limit", limit),
+	)
+
+	if !entities[entityName] {
+		return nil, ErrInvalidEntity
+	}
+
+	entries, err := s.auditRepository.FindAll(entityName, entityId, page, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	entryResponses := make([]*response.AuditEntryResponse, 0, len(entries))
+	for i := range entries {
+		entryResponses = append(entryResponses, toAuditEntryResponse(&entries[i]))
+	}
+
+	return entryResponses, nil
+}
+
+func (s *service) Count(entityName string, entityId uint) (int, error) {
+	if !entities[entityName] {
+		return 0, ErrInvalidEntity
+	}
+
+	return s.auditRepository.Count(entityName, entityId)
+}
+
+func toAuditEntryResponse(entry *entity.AuditEntry) *response.AuditEntryResponse {
+	return &response.AuditEntryResponse{
+		ID:        entry.ID,
+		Entity:    entry.Entity,
+		EntityID:  entry.EntityID,
+		Action:    entry.Action,
+		ActorID:   entry.ActorID,
+		ActorRole: entry.ActorRole,
+		RequestID: entry.RequestID,
+		Before:    rawJSON(entry.Before),
+		After:     rawJSON(entry.After),
+		CreatedAt: entry.CreatedAt,
+	}
+}
+
+// rawJSON passes a stored JSON object through as it is, and a missing one
+// as null.
+func rawJSON(value *string) json.RawMessage {
+	if value == nil {
+		return json.RawMessage("null")
+	}
+
+	return json.RawMessage(*value)
+}
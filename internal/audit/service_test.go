@@ -0,0 +1,55 @@
+package audit_test
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"student_go/internal/audit"
+	"student_go/internal/entity"
+	mocks2 "student_go/internal/mocks"
+	"student_go/pkg/log"
+	"testing"
+)
+
+func init() {
+	logger, _ := zap.NewDevelopment()
+	log.Log = logger
+}
+
+func TestFindAllEntries(t *testing.T) {
+	mockAuditRepo := new(mocks2.AuditRepository)
+	svc := audit.NewAuditService(mockAuditRepo)
+
+	after := `{"name":"Jane"}`
+	mockAuditRepo.On("FindAll", "student", uint(5), 1, 20).
+		Return([]entity.AuditEntry{{ID: 12, Entity: "student", EntityID: 5, Action: "update", After: &after}}, nil)
+
+	entries, err := svc.FindAllEntries("student", 5, 1, 20)
+
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, uint(12), entries[0].ID)
+	assert.JSONEq(t, after, string(entries[0].After))
+	assert.Equal(t, "null", string(entries[0].Before))
+	mockAuditRepo.AssertExpectations(t)
+}
+
+func TestFindAllEntries_InvalidEntity(t *testing.T) {
+	mockAuditRepo := new(mocks2.AuditRepository)
+	svc := audit.NewAuditService(mockAuditRepo)
+
+	_, err := svc.FindAllEntries("hold", 0, 1, 20)
+
+	assert.ErrorIs(t, err, audit.ErrInvalidEntity)
+	mockAuditRepo.AssertNotCalled(t, "FindAll")
+}
+
+func TestAuditServiceCount_InvalidEntity(t *testing.T) {
+	mockAuditRepo := new(mocks2.AuditRepository)
+	svc := audit.NewAuditService(mockAuditRepo)
+
+	_, err := svc.Count("", 0)
+
+	assert.ErrorIs(t, err, audit.ErrInvalidEntity)
+	mockAuditRepo.AssertNotCalled(t, "Count")
+}
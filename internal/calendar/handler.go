@@ -0,0 +1,204 @@
+package calendar
+
+import (
+	"errors"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+	"net/http"
+	"strconv"
+	"strings"
+	"student_go/internal/actor"
+	"student_go/internal/dto/request"
+	"student_go/pkg/ical"
+	"student_go/pkg/log"
+	"student_go/pkg/patch"
+	"time"
+)
+
+type Handler struct {
+	Service Service
+}
+
+func NewCalendarHandler() *Handler {
+	return &Handler{
+		Service: NewCalendarService(NewCalendarRepository()),
+	}
+}
+
+func (h *Handler) CreateEvent(c *gin.Context) {
+	a, err := actor.FromContext(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+
+	var req request.CalendarEventRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		log.Log.Warn("Invalid request in CreateEvent", zap.Error(err))
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	log.Log.Info("CreateEvent called", zap.String("kind", req.Kind), zap.String("name", req.Name))
+
+	eventResp, err := h.Service.CreateEvent(a, req)
+	if err != nil {
+		writeError(c, err, "failed to save calendar event")
+		return
+	}
+
+	c.JSON(http.StatusCreated, eventResp)
+}
+
+func (h *Handler) UpdateEvent(c *gin.Context) {
+	id, ok := parseID(c, "id", "invalid calendar event ID")
+	if !ok {
+		return
+	}
+
+	a, err := actor.FromContext(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+
+	var req request.CalendarEventPatch
+	fields, err := patch.Bind(c.Request, &req)
+	if err != nil {
+		log.Log.Warn("Invalid request in UpdateEvent", zap.Error(err))
+		if errors.Is(err, patch.ErrUnsupportedMediaType) {
+			c.JSON(http.StatusUnsupportedMediaType, gin.H{"error": err.Error()})
+		} else {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	log.Log.Info("UpdateEvent called", zap.Uint("id", id), zap.Strings("fields", fields.Names()))
+
+	eventResp, err := h.Service.UpdateEvent(id, a, req, fields)
+	if err != nil {
+		writeError(c, err, "failed to update calendar event")
+		return
+	}
+
+	c.JSON(http.StatusOK, eventResp)
+}
+
+func (h *Handler) FindEventById(c *gin.Context) {
+	id, ok := parseID(c, "id", "invalid calendar event ID")
+	if !ok {
+		return
+	}
+
+	log.Log.Info("FindEventById called", zap.Uint("id", id))
+
+	eventResp, err := h.Service.FindEventById(id)
+	if err != nil {
+		writeError(c, err, "failed to get calendar event")
+		return
+	}
+
+	c.JSON(http.StatusOK, eventResp)
+}
+
+func (h *Handler) DeleteEventById(c *gin.Context) {
+	id, ok := parseID(c, "id", "invalid calendar event ID")
+	if !ok {
+		return
+	}
+
+	a, err := actor.FromContext(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+
+	log.Log.Info("DeleteEventById called", zap.Uint("id", id))
+
+	if err := h.Service.DeleteEventById(id, a); err != nil {
+		writeError(c, err, "failed to delete calendar event")
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// FindAllEvents lists the calendar, optionally narrowed to a term and to
+// the "from" and "to" days (YYYY-MM-DD). Clients that ask for text/calendar
+// or pass format=ics get an iCalendar feed of the term instead.
+func (h *Handler) FindAllEvents(c *gin.Context) {
+	term := c.Query("term")
+
+	if c.Query("format") == "ics" || strings.Contains(c.GetHeader("Accept"), "text/calendar") {
+		log.Log.Info("Calendar feed called", zap.String("term", term))
+
+		feed, err := h.Service.Feed(term)
+		if err != nil {
+			writeError(c, err, "failed to build calendar")
+			return
+		}
+
+		c.Data(http.StatusOK, ical.ContentType, feed)
+		return
+	}
+
+	from, ok := parseDate(c, "from")
+	if !ok {
+		return
+	}
+	to, ok := parseDate(c, "to")
+	if !ok {
+		return
+	}
+
+	log.Log.Info("FindAllEvents called", zap.String("term", term))
+
+	events, err := h.Service.FindAllEvents(term, from, to)
+	if err != nil {
+		writeError(c, err, "failed to get calendar")
+		return
+	}
+
+	c.JSON(http.StatusOK, events)
+}
+
+func parseDate(c *gin.Context, name string) (time.Time, bool) {
+	value := c.Query(name)
+	if value == "" {
+		return time.Time{}, true
+	}
+
+	parsed, err := time.Parse(dateLayout, value)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid " + name + ", expected YYYY-MM-DD"})
+		return time.Time{}, false
+	}
+
+	return parsed, true
+}
+
+func parseID(c *gin.Context, name string, message string) (uint, bool) {
+	param := c.Param(name)
+	parsed, err := strconv.ParseUint(param, 10, 32)
+	if err != nil {
+		log.Log.Warn("Invalid path parameter", zap.String(name, param), zap.Error(err))
+		c.JSON(http.StatusBadRequest, gin.H{"error": message})
+		return 0, false
+	}
+
+	return uint(parsed), true
+}
+
+func writeError(c *gin.Context, err error, fallback string) {
+	switch {
+	case errors.Is(err, ErrEventNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	case errors.Is(err, ErrForbidden):
+		c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+	case errors.Is(err, ErrInvalidRange):
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": fallback})
+	}
+}
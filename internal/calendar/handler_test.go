@@ -0,0 +1,101 @@
+package calendar
+
+import (
+	"bytes"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"net/http"
+	"net/http/httptest"
+	"student_go/internal/actor"
+	"student_go/internal/dto/request"
+	"student_go/internal/dto/response"
+	"student_go/internal/mocks"
+	"student_go/pkg/ical"
+	"testing"
+	"time"
+)
+
+func setupHandlerTest() (*gin.Engine, *mocks.CalendarServiceMock, *Handler) {
+	gin.SetMode(gin.TestMode)
+	mockService := new(mocks.CalendarServiceMock)
+	handler := &Handler{Service: mockService}
+	r := gin.Default()
+	return r, mockService, handler
+}
+
+func TestCreateEventHandler(t *testing.T) {
+	r, mockService, handler := setupHandlerTest()
+	input := request.CalendarEventRequest{Kind: "holiday", Name: "Winter break", StartDate: "2026-12-21", EndDate: "2027-01-06"}
+	mockService.On("CreateEvent", admin, input).Return(&response.CalendarEventResponse{ID: 4}, nil)
+
+	r.POST("/calendar", handler.CreateEvent)
+	req := httptest.NewRequest(http.MethodPost, "/calendar",
+		bytes.NewBufferString(`{"kind":"holiday","name":"Winter break","startDate":"2026-12-21","endDate":"2027-01-06"}`))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(actor.HeaderUserID, "1")
+	req.Header.Set(actor.HeaderUserRole, "admin")
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusCreated, resp.Code)
+	mockService.AssertExpectations(t)
+}
+
+func TestCreateEventHandler_InvalidKind(t *testing.T) {
+	r, mockService, handler := setupHandlerTest()
+
+	r.POST("/calendar", handler.CreateEvent)
+	req := httptest.NewRequest(http.MethodPost, "/calendar",
+		bytes.NewBufferString(`{"kind":"party","name":"Party","startDate":"2026-12-21","endDate":"2026-12-21"}`))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(actor.HeaderUserID, "1")
+	req.Header.Set(actor.HeaderUserRole, "admin")
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	mockService.AssertNotCalled(t, "CreateEvent", mock.Anything, mock.Anything)
+}
+
+func TestFindAllEventsHandler(t *testing.T) {
+	r, mockService, handler := setupHandlerTest()
+	from := time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC)
+	mockService.On("FindAllEvents", "2026-fall", from, time.Time{}).
+		Return([]*response.CalendarEventResponse{{ID: 4, Name: "Reading week"}}, nil)
+
+	r.GET("/calendar", handler.FindAllEvents)
+	req := httptest.NewRequest(http.MethodGet, "/calendar?term=2026-fall&from=2026-09-01", nil)
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Contains(t, resp.Body.String(), "Reading week")
+}
+
+func TestFindAllEventsHandler_Feed(t *testing.T) {
+	r, mockService, handler := setupHandlerTest()
+	mockService.On("Feed", "").Return([]byte("BEGIN:VCALENDAR\r\nEND:VCALENDAR\r\n"), nil)
+
+	r.GET("/calendar", handler.FindAllEvents)
+	req := httptest.NewRequest(http.MethodGet, "/calendar", nil)
+	req.Header.Set("Accept", "text/calendar")
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, ical.ContentType, resp.Header().Get("Content-Type"))
+	mockService.AssertNotCalled(t, "FindAllEvents", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestFindAllEventsHandler_InvalidDate(t *testing.T) {
+	r, mockService, handler := setupHandlerTest()
+
+	r.GET("/calendar", handler.FindAllEvents)
+	req := httptest.NewRequest(http.MethodGet, "/calendar?to=next-week", nil)
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	mockService.AssertNotCalled(t, "FindAllEvents", mock.Anything, mock.Anything, mock.Anything)
+}
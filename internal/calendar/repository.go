@@ -0,0 +1,99 @@
+package calendar
+
+import (
+	"student_go/internal/entity"
+	"student_go/pkg/dbcontext"
+	"time"
+)
+
+type Repository interface {
+	Save(event *entity.CalendarEvent) (*entity.CalendarEvent, error)
+	Update(event *entity.CalendarEvent) (bool, error)
+	FindById(id uint) (*entity.CalendarEvent, error)
+	// FindAll lists the events of a term, or of every term when it is
+	// empty, that overlap the days from to to. Zero times leave the range
+	// open.
+	FindAll(term string, from, to time.Time) ([]entity.CalendarEvent, error)
+	DeleteById(id uint) (bool, error)
+	// FindBlackouts lists blackout events that overlap the days from to to.
+	FindBlackouts(from, to time.Time) ([]entity.CalendarEvent, error)
+}
+
+type repository struct{}
+
+func NewCalendarRepository() Repository {
+	return &repository{}
+}
+
+func (r *repository) Save(event *entity.CalendarEvent) (*entity.CalendarEvent, error) {
+	err := dbcontext.DB.Create(event).Error
+	return event, err
+}
+
+func (r *repository) Update(event *entity.CalendarEvent) (bool, error) {
+	result := dbcontext.DB.Model(&entity.CalendarEvent{}).
+		Where("id = ?", event.ID).
+		Updates(map[string]interface{}{
+			"term":       event.Term,
+			"kind":       event.Kind,
+			"name":       event.Name,
+			"start_date": event.StartDate,
+			"end_date":   event.EndDate,
+			"blackout":   event.Blackout,
+		})
+
+	return result.RowsAffected > 0, result.Error
+}
+
+func (r *repository) FindById(id uint) (*entity.CalendarEvent, error) {
+	var event entity.CalendarEvent
+	result := dbcontext.DB.First(&event, id)
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return &event, nil
+}
+
+func (r *repository) FindAll(term string, from, to time.Time) ([]entity.CalendarEvent, error) {
+	var events []entity.CalendarEvent
+
+	query := dbcontext.DB.Order("start_date").Order("id")
+	if term != "" {
+		query = query.Where("term = ?", term)
+	}
+	if !from.IsZero() {
+		query = query.Where("end_date >= ?", from.Format(dateLayout))
+	}
+	if !to.IsZero() {
+		query = query.Where("start_date <= ?", to.Format(dateLayout))
+	}
+
+	if err := query.Find(&events).Error; err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
+
+func (r *repository) DeleteById(id uint) (bool, error) {
+	result := dbcontext.DB.Delete(&entity.CalendarEvent{}, id)
+
+	return result.RowsAffected > 0, result.Error
+}
+
+func (r *repository) FindBlackouts(from, to time.Time) ([]entity.CalendarEvent, error) {
+	var events []entity.CalendarEvent
+
+	result := dbcontext.DB.
+		Where("blackout AND start_date <= ? AND end_date >= ?", to.Format(dateLayout), from.Format(dateLayout)).
+		Order("start_date").
+		Find(&events)
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return events, nil
+}
@@ -0,0 +1,84 @@
+package calendar
+
+import (
+	"database/sql"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"regexp"
+	"testing"
+	"time"
+
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+
+	"student_go/pkg/dbcontext"
+)
+
+func setupTestDB(t *testing.T) (*sql.DB, sqlmock.Sqlmock, *gorm.DB) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+
+	dialector := postgres.New(postgres.Config{
+		Conn:                 db,
+		PreferSimpleProtocol: true,
+	})
+
+	gormDB, err := gorm.Open(dialector, &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	assert.NoError(t, err)
+
+	dbcontext.DB = gormDB
+	return db, mock, gormDB
+}
+
+func TestCalendarFindAll(t *testing.T) {
+	db, mock, _ := setupTestDB(t)
+	defer db.Close()
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "calendar_events" WHERE term = $1 AND end_date >= $2 ORDER BY start_date,id`)).
+		WithArgs("2026-fall", "2026-09-01").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "term", "kind", "name"}).
+			AddRow(1, "2026-fall", "reading_week", "Reading week"))
+
+	repo := NewCalendarRepository()
+	events, err := repo.FindAll("2026-fall", time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC), time.Time{})
+
+	require.NoError(t, err)
+	assert.Len(t, events, 1)
+	assert.Equal(t, "reading_week", events[0].Kind)
+}
+
+func TestCalendarFindBlackouts(t *testing.T) {
+	db, mock, _ := setupTestDB(t)
+	defer db.Close()
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "calendar_events" WHERE blackout AND start_date <= $1 AND end_date >= $2 ORDER BY start_date`)).
+		WithArgs("2026-12-31", "2026-12-01").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "blackout"}).AddRow(2, "Winter break", true))
+
+	repo := NewCalendarRepository()
+	events, err := repo.FindBlackouts(time.Date(2026, 12, 1, 0, 0, 0, 0, time.UTC), time.Date(2026, 12, 31, 0, 0, 0, 0, time.UTC))
+
+	require.NoError(t, err)
+	assert.Equal(t, "Winter break", events[0].Name)
+}
+
+func TestCalendarDeleteById_NotFound(t *testing.T) {
+	db, mock, _ := setupTestDB(t)
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "calendar_events" WHERE "calendar_events"."id" = $1`)).
+		WithArgs(9).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+
+	repo := NewCalendarRepository()
+	deleted, err := repo.DeleteById(9)
+
+	require.NoError(t, err)
+	assert.False(t, deleted)
+}
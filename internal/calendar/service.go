@@ -0,0 +1,268 @@
+package calendar
+
+import (
+	"errors"
+	"fmt"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"student_go/internal/actor"
+	"student_go/internal/dto/request"
+	"student_go/internal/dto/response"
+	"student_go/internal/entity"
+	"student_go/pkg/ical"
+	"student_go/pkg/log"
+	"student_go/pkg/patch"
+	"time"
+)
+
+const dateLayout = "2006-01-02"
+
+var (
+	ErrEventNotFound = errors.New("calendar event not found")
+	ErrForbidden     = errors.New("forbidden")
+	ErrInvalidRange  = errors.New("end date must not be before start date")
+)
+
+var kindNames = map[string]string{
+	entity.CalendarHoliday:     "Holiday",
+	entity.CalendarExamPeriod:  "Exam period",
+	entity.CalendarReadingWeek: "Reading week",
+}
+
+type Service interface {
+	CreateEvent(a actor.Actor, input request.CalendarEventRequest) (*response.CalendarEventResponse, error)
+	UpdateEvent(id uint, a actor.Actor, input request.CalendarEventPatch, fields patch.Fields) (*response.CalendarEventResponse, error)
+	FindEventById(id uint) (*response.CalendarEventResponse, error)
+	DeleteEventById(id uint, a actor.Actor) error
+	FindAllEvents(term string, from, to time.Time) ([]*response.CalendarEventResponse, error)
+	// Feed renders the events of a term, or of every term, as iCalendar.
+	Feed(term string) ([]byte, error)
+}
+
+type service struct {
+	calendarRepository Repository
+	now                func() time.Time
+}
+
+func NewCalendarService(calendarRepository Repository) Service {
+	return &service{
+		calendarRepository: calendarRepository,
+		now:                time.Now,
+	}
+}
+
+// CreateEvent adds a calendar entry. Only admins maintain the calendar.
+func (s *service) CreateEvent(a actor.Actor, input request.CalendarEventRequest) (*response.CalendarEventResponse, error) {
+	log.Log.Info("CreateEvent (service) called", zap.String("kind", input.Kind), zap.String("name", input.Name))
+
+	if !a.IsAdmin() {
+		return nil, ErrForbidden
+	}
+
+	event, err := parseEvent(input)
+	if err != nil {
+		return nil, err
+	}
+
+	saved, err := s.calendarRepository.Save(event)
+	if err != nil {
+		return nil, err
+	}
+
+	return toEventResponse(saved), nil
+}
+
+func (s *service) UpdateEvent(id uint, a actor.Actor, input request.CalendarEventPatch, fields patch.Fields) (*response.CalendarEventResponse, error) {
+	log.Log.Info("UpdateEvent (service) called", zap.Uint("id", id))
+
+	if !a.IsAdmin() {
+		return nil, ErrForbidden
+	}
+
+	current, err := s.FindEventById(id)
+	if err != nil {
+		return nil, err
+	}
+	if len(fields) == 0 {
+		return current, nil
+	}
+
+	// The patch is applied to the stored event, which is then checked like
+	// a new one.
+	merged := request.CalendarEventRequest{
+		Term:      current.Term,
+		Kind:      current.Kind,
+		Name:      current.Name,
+		StartDate: current.StartDate,
+		EndDate:   current.EndDate,
+		Blackout:  &current.Blackout,
+	}
+	if fields.Has("term") {
+		merged.Term = patch.Value(input.Term)
+	}
+	if fields.Has("kind") {
+		merged.Kind = patch.Value(input.Kind)
+	}
+	if fields.Has("name") {
+		merged.Name = patch.Value(input.Name)
+	}
+	if fields.Has("startDate") {
+		merged.StartDate = patch.Value(input.StartDate)
+	}
+	if fields.Has("endDate") {
+		merged.EndDate = patch.Value(input.EndDate)
+	}
+	if fields.Has("blackout") {
+		merged.Blackout = input.Blackout
+	}
+
+	event, err := parseEvent(merged)
+	if err != nil {
+		return nil, err
+	}
+	event.ID = id
+
+	updated, err := s.calendarRepository.Update(event)
+	if err != nil {
+		return nil, err
+	}
+	if !updated {
+		return nil, ErrEventNotFound
+	}
+
+	return toEventResponse(event), nil
+}
+
+func (s *service) FindEventById(id uint) (*response.CalendarEventResponse, error) {
+	log.Log.Info("FindEventById (service) called", zap.Uint("id", id))
+
+	event, err := s.calendarRepository.FindById(id)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrEventNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return toEventResponse(event), nil
+}
+
+func (s *service) DeleteEventById(id uint, a actor.Actor) error {
+	log.Log.Info("DeleteEventById (service) called", zap.Uint("id", id))
+
+	if !a.IsAdmin() {
+		return ErrForbidden
+	}
+
+	deleted, err := s.calendarRepository.DeleteById(id)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return ErrEventNotFound
+	}
+
+	return nil
+}
+
+func (s *service) FindAllEvents(term string, from, to time.Time) ([]*response.CalendarEventResponse, error) {
+	log.Log.Info("FindAllEvents (service) called", zap.String("term", term))
+
+	events, err := s.calendarRepository.FindAll(term, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	eventResponses := make([]*response.CalendarEventResponse, 0, len(events))
+	for i := range events {
+		eventResponses = append(eventResponses, toEventResponse(&events[i]))
+	}
+
+	return eventResponses, nil
+}
+
+func (s *service) Feed(term string) ([]byte, error) {
+	log.Log.Info("Feed (service) called", zap.String("term", term))
+
+	events, err := s.calendarRepository.FindAll(term, time.Time{}, time.Time{})
+	if err != nil {
+		return nil, err
+	}
+
+	icalEvents := make([]ical.Event, 0, len(events))
+	for _, event := range events {
+		description := kindNames[event.Kind]
+		if event.Term != "" {
+			description += ", " + event.Term
+		}
+		icalEvents = append(icalEvents, ical.Event{
+			UID:         fmt.Sprintf("calendar-%d@student_go", event.ID),
+			Start:       event.StartDate,
+			End:         event.EndDate.AddDate(0, 0, 1),
+			AllDay:      true,
+			Summary:     event.Name,
+			Description: description,
+		})
+	}
+
+	name := "Academic calendar"
+	if term != "" {
+		name += " " + term
+	}
+
+	return ical.Calendar{Name: name, Events: icalEvents}.Encode(s.now()), nil
+}
+
+// IsBlackout reports whether day falls on one of the blackout events.
+// Callers load the events once with Repository.FindBlackouts and check
+// each day they schedule.
+func IsBlackout(events []entity.CalendarEvent, day time.Time) (*entity.CalendarEvent, bool) {
+	date := day.Format(dateLayout)
+	for i := range events {
+		if events[i].Blackout && events[i].StartDate.Format(dateLayout) <= date && date <= events[i].EndDate.Format(dateLayout) {
+			return &events[i], true
+		}
+	}
+
+	return nil, false
+}
+
+func parseEvent(input request.CalendarEventRequest) (*entity.CalendarEvent, error) {
+	startDate, err := time.Parse(dateLayout, input.StartDate)
+	if err != nil {
+		return nil, err
+	}
+	endDate, err := time.Parse(dateLayout, input.EndDate)
+	if err != nil {
+		return nil, err
+	}
+	if endDate.Before(startDate) {
+		return nil, ErrInvalidRange
+	}
+
+	blackout := input.Kind == entity.CalendarHoliday
+	if input.Blackout != nil {
+		blackout = *input.Blackout
+	}
+
+	return &entity.CalendarEvent{
+		Term:      input.Term,
+		Kind:      input.Kind,
+		Name:      input.Name,
+		StartDate: startDate,
+		EndDate:   endDate,
+		Blackout:  blackout,
+	}, nil
+}
+
+func toEventResponse(event *entity.CalendarEvent) *response.CalendarEventResponse {
+	return &response.CalendarEventResponse{
+		ID:        event.ID,
+		Term:      event.Term,
+		Kind:      event.Kind,
+		Name:      event.Name,
+		StartDate: event.StartDate.Format(dateLayout),
+		EndDate:   event.EndDate.Format(dateLayout),
+		Blackout:  event.Blackout,
+	}
+}
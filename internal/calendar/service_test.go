@@ -0,0 +1,190 @@
+package calendar
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"strings"
+	"student_go/internal/actor"
+	"student_go/internal/dto/request"
+	"student_go/internal/entity"
+	mocks2 "student_go/internal/mocks"
+	"student_go/pkg/log"
+	"student_go/pkg/patch"
+	"testing"
+	"time"
+)
+
+func init() {
+	logger, _ := zap.NewDevelopment()
+	log.Log = logger
+}
+
+var (
+	admin        = actor.Actor{ID: 1, Role: actor.RoleAdmin}
+	teacherActor = actor.Actor{ID: 3, Role: actor.RoleTeacher}
+)
+
+func newTestCalendarService() (*service, *mocks2.CalendarRepository) {
+	mockCalendarRepo := new(mocks2.CalendarRepository)
+
+	svc := NewCalendarService(mockCalendarRepo).(*service)
+	svc.now = func() time.Time { return time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC) }
+	return svc, mockCalendarRepo
+}
+
+func TestCreateEvent_HolidayIsBlackout(t *testing.T) {
+	svc, mockCalendarRepo := newTestCalendarService()
+
+	mockCalendarRepo.On("Save", mock.MatchedBy(func(e *entity.CalendarEvent) bool {
+		return e.Kind == entity.CalendarHoliday && e.Blackout && e.EndDate.Format(dateLayout) == "2027-01-06"
+	})).Return(func(e *entity.CalendarEvent) *entity.CalendarEvent {
+		e.ID = 4
+		return e
+	}, nil)
+
+	result, err := svc.CreateEvent(admin, request.CalendarEventRequest{
+		Term:      "2026-fall",
+		Kind:      entity.CalendarHoliday,
+		Name:      "Winter break",
+		StartDate: "2026-12-21",
+		EndDate:   "2027-01-06",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, uint(4), result.ID)
+	assert.True(t, result.Blackout)
+}
+
+func TestCreateEvent_ExplicitBlackout(t *testing.T) {
+	svc, mockCalendarRepo := newTestCalendarService()
+	blackout := true
+
+	mockCalendarRepo.On("Save", mock.MatchedBy(func(e *entity.CalendarEvent) bool {
+		return e.Kind == entity.CalendarExamPeriod && e.Blackout
+	})).Return(func(e *entity.CalendarEvent) *entity.CalendarEvent { return e }, nil)
+
+	result, err := svc.CreateEvent(admin, request.CalendarEventRequest{
+		Kind:      entity.CalendarExamPeriod,
+		Name:      "Finals",
+		StartDate: "2026-12-07",
+		EndDate:   "2026-12-18",
+		Blackout:  &blackout,
+	})
+
+	require.NoError(t, err)
+	assert.True(t, result.Blackout)
+}
+
+func TestCreateEvent_Forbidden(t *testing.T) {
+	svc, mockCalendarRepo := newTestCalendarService()
+
+	result, err := svc.CreateEvent(teacherActor, request.CalendarEventRequest{})
+
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, ErrForbidden)
+	mockCalendarRepo.AssertNotCalled(t, "Save", mock.Anything)
+}
+
+func TestUpdateEvent_InvalidRange(t *testing.T) {
+	svc, mockCalendarRepo := newTestCalendarService()
+
+	mockCalendarRepo.On("FindById", uint(4)).Return(readingWeek(), nil)
+
+	endDate := "2026-10-30"
+	result, err := svc.UpdateEvent(4, admin, request.CalendarEventPatch{EndDate: &endDate}, patch.Fields{"endDate": true})
+
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, ErrInvalidRange)
+	mockCalendarRepo.AssertNotCalled(t, "Update", mock.Anything)
+}
+
+func TestUpdateEvent_KeepsUnsetFields(t *testing.T) {
+	svc, mockCalendarRepo := newTestCalendarService()
+
+	mockCalendarRepo.On("FindById", uint(4)).Return(readingWeek(), nil)
+	mockCalendarRepo.On("Update", mock.MatchedBy(func(e *entity.CalendarEvent) bool {
+		return e.ID == 4 && e.Name == "Study week" && e.Term == "2026-fall" &&
+			e.StartDate.Equal(time.Date(2026, 11, 2, 0, 0, 0, 0, time.UTC)) && e.Blackout
+	})).Return(true, nil)
+
+	name := "Study week"
+	result, err := svc.UpdateEvent(4, admin, request.CalendarEventPatch{Name: &name}, patch.Fields{"name": true})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Study week", result.Name)
+	assert.Equal(t, "2026-11-06", result.EndDate)
+	mockCalendarRepo.AssertExpectations(t)
+}
+
+func readingWeek() *entity.CalendarEvent {
+	return &entity.CalendarEvent{
+		ID:        4,
+		Term:      "2026-fall",
+		Kind:      entity.CalendarReadingWeek,
+		Name:      "Reading week",
+		StartDate: time.Date(2026, 11, 2, 0, 0, 0, 0, time.UTC),
+		EndDate:   time.Date(2026, 11, 6, 0, 0, 0, 0, time.UTC),
+		Blackout:  true,
+	}
+}
+
+func TestFindEventById_NotFound(t *testing.T) {
+	svc, mockCalendarRepo := newTestCalendarService()
+
+	mockCalendarRepo.On("FindById", uint(4)).Return(nil, gorm.ErrRecordNotFound)
+
+	result, err := svc.FindEventById(4)
+
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, ErrEventNotFound)
+}
+
+func TestDeleteEventById_NotFound(t *testing.T) {
+	svc, mockCalendarRepo := newTestCalendarService()
+
+	mockCalendarRepo.On("DeleteById", uint(4)).Return(false, nil)
+
+	assert.ErrorIs(t, svc.DeleteEventById(4, admin), ErrEventNotFound)
+}
+
+func TestFeed(t *testing.T) {
+	svc, mockCalendarRepo := newTestCalendarService()
+
+	mockCalendarRepo.On("FindAll", "2026-fall", time.Time{}, time.Time{}).Return([]entity.CalendarEvent{{
+		ID:        4,
+		Term:      "2026-fall",
+		Kind:      entity.CalendarReadingWeek,
+		Name:      "Reading week",
+		StartDate: time.Date(2026, 11, 2, 0, 0, 0, 0, time.UTC),
+		EndDate:   time.Date(2026, 11, 6, 0, 0, 0, 0, time.UTC),
+	}}, nil)
+
+	feed, err := svc.Feed("2026-fall")
+
+	require.NoError(t, err)
+	body := string(feed)
+	assert.Contains(t, body, "X-WR-CALNAME:Academic calendar 2026-fall\r\n")
+	assert.Contains(t, body, "UID:calendar-4@student_go\r\n")
+	assert.Contains(t, body, "DTSTART;VALUE=DATE:20261102\r\n")
+	// DTEND of an all-day event is exclusive.
+	assert.Contains(t, body, "DTEND;VALUE=DATE:20261107\r\n")
+	assert.Contains(t, body, `DESCRIPTION:Reading week\, 2026-fall`)
+	assert.Equal(t, 1, strings.Count(body, "BEGIN:VEVENT"))
+}
+
+func TestIsBlackout(t *testing.T) {
+	events := []entity.CalendarEvent{
+		{Name: "Reading week", StartDate: time.Date(2026, 11, 2, 0, 0, 0, 0, time.UTC), EndDate: time.Date(2026, 11, 6, 0, 0, 0, 0, time.UTC)},
+		{Name: "Holiday", StartDate: time.Date(2026, 11, 11, 0, 0, 0, 0, time.UTC), EndDate: time.Date(2026, 11, 11, 0, 0, 0, 0, time.UTC), Blackout: true},
+	}
+
+	_, ok := IsBlackout(events, time.Date(2026, 11, 3, 10, 0, 0, 0, time.UTC))
+	assert.False(t, ok)
+
+	event, ok := IsBlackout(events, time.Date(2026, 11, 11, 23, 0, 0, 0, time.UTC))
+	assert.True(t, ok)
+	assert.Equal(t, "Holiday", event.Name)
+}
@@ -0,0 +1,230 @@
+package cohort
+
+import (
+	"errors"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+	"net/http"
+	"strconv"
+	"student_go/internal/audit"
+	"student_go/internal/course"
+	"student_go/internal/department"
+	"student_go/internal/dto/request"
+	"student_go/internal/hold"
+	"student_go/internal/notification"
+	"student_go/internal/student"
+	"student_go/pkg/log"
+	"student_go/pkg/pagination"
+	"student_go/pkg/patch"
+)
+
+type Handler struct {
+	Service Service
+}
+
+func NewCohortHandler(notifier notification.Notifier) *Handler {
+	courseRepository := course.NewCourseRepository()
+	studentService := student.NewStudentService(student.NewStudentRepository(), courseRepository, hold.NewHoldRepository(),
+		department.NewDepartmentRepository(), notifier)
+
+	return &Handler{
+		Service: NewCohortService(NewCohortRepository(), courseRepository, studentService),
+	}
+}
+
+func (h *Handler) CreateCohort(c *gin.Context) {
+	var req request.CohortRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		log.Log.Warn("Invalid request in CreateCohort", zap.Error(err))
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	log.Log.Info("CreateCohort called", zap.String("name", req.Name))
+
+	cohortResp, err := h.Service.CreateCohort(req)
+	if err != nil {
+		writeError(c, err, "failed to save cohort")
+		return
+	}
+
+	c.JSON(http.StatusCreated, cohortResp)
+}
+
+func (h *Handler) UpdateCohort(c *gin.Context) {
+	id, ok := parseID(c, "id", "invalid cohort ID")
+	if !ok {
+		return
+	}
+
+	var req request.CohortPatch
+	fields, err := patch.Bind(c.Request, &req)
+	if err != nil {
+		log.Log.Warn("Invalid request in UpdateCohort", zap.Error(err))
+		if errors.Is(err, patch.ErrUnsupportedMediaType) {
+			c.JSON(http.StatusUnsupportedMediaType, gin.H{"error": err.Error()})
+		} else {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	log.Log.Info("UpdateCohort called", zap.Uint("id", id), zap.Strings("fields", fields.Names()))
+
+	cohortResp, err := h.Service.UpdateCohort(id, req, fields)
+	if err != nil {
+		writeError(c, err, "failed to update cohort")
+		return
+	}
+
+	c.JSON(http.StatusOK, cohortResp)
+}
+
+func (h *Handler) FindCohortById(c *gin.Context) {
+	id, ok := parseID(c, "id", "invalid cohort ID")
+	if !ok {
+		return
+	}
+
+	log.Log.Info("FindCohortById called", zap.Uint("id", id))
+
+	cohortResp, err := h.Service.FindCohortById(id)
+	if err != nil {
+		writeError(c, err, "something went wrong")
+		return
+	}
+
+	c.JSON(http.StatusOK, cohortResp)
+}
+
+func (h *Handler) FindAllCohorts(c *gin.Context) {
+	count, err := h.Service.Count()
+	if err != nil {
+		log.Log.Error("Failed to count cohorts", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to count cohorts"})
+		return
+	}
+
+	pages := pagination.NewFromRequest(c.Request, count)
+
+	log.Log.Info("FindAllCohorts called",
+		zap.Int("page", pages.Page),
+		zap.Int("per_page", pages.PerPage),
+		zap.Int("total_count", pages.TotalCount),
+	)
+
+	cohorts, err := h.Service.FindAllCohorts(pages.Page, pages.PerPage)
+	if err != nil {
+		writeError(c, err, "failed to get cohorts")
+		return
+	}
+
+	pages.Items = cohorts
+	c.JSON(http.StatusOK, pages)
+}
+
+func (h *Handler) DeleteCohortById(c *gin.Context) {
+	id, ok := parseID(c, "id", "invalid cohort ID")
+	if !ok {
+		return
+	}
+
+	log.Log.Info("DeleteCohortById called", zap.Uint("id", id))
+
+	if err := h.Service.DeleteCohortById(id); err != nil {
+		writeError(c, err, "failed to delete cohort")
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (h *Handler) AddStudents(c *gin.Context) {
+	id, ok := parseID(c, "id", "invalid cohort ID")
+	if !ok {
+		return
+	}
+
+	var req request.CohortMembersRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		log.Log.Warn("Invalid request in AddStudents", zap.Error(err))
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	log.Log.Info("AddStudents called", zap.Uint("id", id), zap.Int("students", len(req.StudentIDs)))
+
+	if err := h.Service.AddStudents(id, req); err != nil {
+		writeError(c, err, "failed to add students to cohort")
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (h *Handler) RemoveStudent(c *gin.Context) {
+	id, ok := parseID(c, "id", "invalid cohort ID")
+	if !ok {
+		return
+	}
+	studentId, ok := parseID(c, "studentId", "invalid student ID")
+	if !ok {
+		return
+	}
+
+	log.Log.Info("RemoveStudent called", zap.Uint("id", id), zap.Uint("student_id", studentId))
+
+	if err := h.Service.RemoveStudent(id, studentId); err != nil {
+		writeError(c, err, "failed to remove student from cohort")
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// EnrollInCourse enrolls the whole cohort and responds with a per-student
+// report, also when some of the members could not be enrolled.
+func (h *Handler) EnrollInCourse(c *gin.Context) {
+	id, ok := parseID(c, "id", "invalid cohort ID")
+	if !ok {
+		return
+	}
+	courseId, ok := parseID(c, "courseId", "invalid course ID")
+	if !ok {
+		return
+	}
+
+	log.Log.Info("EnrollInCourse called", zap.Uint("id", id), zap.Uint("course_id", courseId))
+
+	report, err := h.Service.EnrollInCourse(id, courseId, audit.FromContext(c))
+	if err != nil {
+		writeError(c, err, "failed to enroll cohort")
+		return
+	}
+
+	c.JSON(http.StatusOK, report)
+}
+
+func parseID(c *gin.Context, name string, message string) (uint, bool) {
+	param := c.Param(name)
+	parsed, err := strconv.ParseUint(param, 10, 32)
+	if err != nil {
+		log.Log.Warn("Invalid path parameter", zap.String(name, param), zap.Error(err))
+		c.JSON(http.StatusBadRequest, gin.H{"error": message})
+		return 0, false
+	}
+
+	return uint(parsed), true
+}
+
+func writeError(c *gin.Context, err error, fallback string) {
+	switch {
+	case errors.Is(err, ErrCohortNotFound), errors.Is(err, ErrCourseNotFound),
+		errors.Is(err, ErrStudentNotFound), errors.Is(err, ErrNotMember):
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	case errors.Is(err, ErrNameTaken):
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": fallback})
+	}
+}
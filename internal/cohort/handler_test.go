@@ -0,0 +1,89 @@
+package cohort
+
+import (
+	"bytes"
+	"encoding/json"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"net/http"
+	"net/http/httptest"
+	"student_go/internal/audit"
+	"student_go/internal/dto/request"
+	"student_go/internal/dto/response"
+	"student_go/internal/mocks"
+	"testing"
+)
+
+func setupHandlerTest() (*gin.Engine, *mocks.CohortServiceMock, *Handler) {
+	gin.SetMode(gin.TestMode)
+	mockService := new(mocks.CohortServiceMock)
+	handler := &Handler{Service: mockService}
+	r := gin.Default()
+	return r, mockService, handler
+}
+
+func TestCreateCohortHandler_NameTaken(t *testing.T) {
+	r, mockService, handler := setupHandlerTest()
+	input := request.CohortRequest{Name: "CS-2025-A"}
+	mockService.On("CreateCohort", input).Return(nil, ErrNameTaken)
+
+	r.POST("/cohorts", handler.CreateCohort)
+	body, _ := json.Marshal(input)
+	req := httptest.NewRequest(http.MethodPost, "/cohorts", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusConflict, resp.Code)
+}
+
+func TestAddStudentsHandler_EmptyList(t *testing.T) {
+	r, mockService, handler := setupHandlerTest()
+
+	r.POST("/cohorts/:id/students", handler.AddStudents)
+	req := httptest.NewRequest(http.MethodPost, "/cohorts/1/students", bytes.NewBufferString(`{"studentIds":[]}`))
+	req.Header.Set("Content-Type", "application/json")
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	mockService.AssertNotCalled(t, "AddStudents", mock.Anything, mock.Anything)
+}
+
+func TestEnrollInCourseHandler(t *testing.T) {
+	r, mockService, handler := setupHandlerTest()
+	report := &response.CohortEnrollmentResponse{
+		CohortID: 1,
+		CourseID: 7,
+		Enrolled: 1,
+		Failed:   1,
+		Results: []response.StudentEnrollmentResult{
+			{StudentID: 4, Enrolled: true},
+			{StudentID: 5, Error: "minor student must have a guardian contact before enrolling"},
+		},
+	}
+	mockService.On("EnrollInCourse", uint(1), uint(7), audit.Meta{}).Return(report, nil)
+
+	r.POST("/cohorts/:id/courses/:courseId", handler.EnrollInCourse)
+	req := httptest.NewRequest(http.MethodPost, "/cohorts/1/courses/7", nil)
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	var got response.CohortEnrollmentResponse
+	assert.NoError(t, json.Unmarshal(resp.Body.Bytes(), &got))
+	assert.Equal(t, *report, got)
+}
+
+func TestEnrollInCourseHandler_CourseNotFound(t *testing.T) {
+	r, mockService, handler := setupHandlerTest()
+	mockService.On("EnrollInCourse", uint(1), uint(7), audit.Meta{}).Return(nil, ErrCourseNotFound)
+
+	r.POST("/cohorts/:id/courses/:courseId", handler.EnrollInCourse)
+	req := httptest.NewRequest(http.MethodPost, "/cohorts/1/courses/7", nil)
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusNotFound, resp.Code)
+}
@@ -0,0 +1,163 @@
+package cohort
+
+import (
+	"errors"
+	"gorm.io/gorm/clause"
+	"student_go/internal/entity"
+	"student_go/pkg/dbcontext"
+)
+
+const (
+	// Postgres SQLSTATE codes.
+	foreignKeyViolation = "23503"
+	uniqueViolation     = "23505"
+)
+
+type Repository interface {
+	ExistsById(id uint) (bool, error)
+	Save(cohort *entity.Cohort) (*entity.Cohort, error)
+	Update(cohort *entity.Cohort) (*entity.Cohort, error)
+	FindById(id uint) (*entity.Cohort, error)
+	FindAll(page, limit int) ([]entity.Cohort, error)
+	DeleteById(id uint) error
+	Count() (int, error)
+	AddStudents(cohortId uint, studentIds []uint) error
+	RemoveStudent(cohortId, studentId uint) (bool, error)
+	FindStudentIds(cohortId uint) ([]uint, error)
+}
+
+type cohortStudent struct {
+	CohortID  uint
+	StudentID uint
+}
+
+func (cohortStudent) TableName() string {
+	return "cohort_student"
+}
+
+type repository struct{}
+
+func NewCohortRepository() Repository {
+	return &repository{}
+}
+
+func (r *repository) ExistsById(id uint) (bool, error) {
+	var exists bool
+	err := dbcontext.DB.
+		Model(&entity.Cohort{}).
+		Select("count(*) > 0").
+		Where("id = ?", id).
+		Find(&exists).
+		Error
+
+	return exists, err
+}
+
+func (r *repository) Save(cohort *entity.Cohort) (*entity.Cohort, error) {
+	err := dbcontext.DB.Create(cohort).Error
+	if isViolation(err, uniqueViolation) {
+		return nil, ErrNameTaken
+	}
+
+	return cohort, err
+}
+
+func (r *repository) Update(cohort *entity.Cohort) (*entity.Cohort, error) {
+	err := dbcontext.DB.Model(&entity.Cohort{}).
+		Where("id = ?", cohort.ID).
+		Updates(map[string]interface{}{
+			"name": cohort.Name,
+		}).Error
+
+	if isViolation(err, uniqueViolation) {
+		return nil, ErrNameTaken
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return r.FindById(cohort.ID)
+}
+
+func (r *repository) FindById(id uint) (*entity.Cohort, error) {
+	var cohort entity.Cohort
+	result := dbcontext.DB.First(&cohort, id)
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return &cohort, nil
+}
+
+func (r *repository) FindAll(page, limit int) ([]entity.Cohort, error) {
+	var cohorts []entity.Cohort
+
+	offset := (page - 1) * limit
+
+	result := dbcontext.DB.
+		Order("name").
+		Limit(limit).
+		Offset(offset).
+		Find(&cohorts)
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return cohorts, nil
+}
+
+func (r *repository) DeleteById(id uint) error {
+	result := dbcontext.DB.Delete(&entity.Cohort{}, id)
+
+	return result.Error
+}
+
+func (r *repository) Count() (int, error) {
+	var count int64
+	err := dbcontext.DB.Model(&entity.Cohort{}).Count(&count).Error
+	return int(count), err
+}
+
+// AddStudents adds the students to the cohort; existing members are left
+// as they are.
+func (r *repository) AddStudents(cohortId uint, studentIds []uint) error {
+	rows := make([]cohortStudent, 0, len(studentIds))
+	for _, studentId := range studentIds {
+		rows = append(rows, cohortStudent{CohortID: cohortId, StudentID: studentId})
+	}
+
+	err := dbcontext.DB.Clauses(clause.OnConflict{DoNothing: true}).Create(&rows).Error
+	if isViolation(err, foreignKeyViolation) {
+		return ErrStudentNotFound
+	}
+
+	return err
+}
+
+// RemoveStudent reports whether the student was a member of the cohort.
+func (r *repository) RemoveStudent(cohortId, studentId uint) (bool, error) {
+	result := dbcontext.DB.
+		Where("cohort_id = ? AND student_id = ?", cohortId, studentId).
+		Delete(&cohortStudent{})
+
+	return result.RowsAffected > 0, result.Error
+}
+
+func (r *repository) FindStudentIds(cohortId uint) ([]uint, error) {
+	var studentIds []uint
+	err := dbcontext.DB.
+		Model(&cohortStudent{}).
+		Where("cohort_id = ?", cohortId).
+		Order("student_id").
+		Pluck("student_id", &studentIds).
+		Error
+
+	return studentIds, err
+}
+
+func isViolation(err error, code string) bool {
+	var state interface{ SQLState() string }
+	return errors.As(err, &state) && state.SQLState() == code
+}
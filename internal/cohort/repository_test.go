@@ -0,0 +1,99 @@
+package cohort
+
+import (
+	"database/sql"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"regexp"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+
+	"student_go/internal/entity"
+	"student_go/pkg/dbcontext"
+)
+
+func setupTestDB(t *testing.T) (*sql.DB, sqlmock.Sqlmock, *gorm.DB) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+
+	dialector := postgres.New(postgres.Config{
+		Conn:                 db,
+		PreferSimpleProtocol: true,
+	})
+
+	gormDB, err := gorm.Open(dialector, &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	assert.NoError(t, err)
+
+	dbcontext.DB = gormDB
+	return db, mock, gormDB
+}
+
+func TestCohortSave_NameTaken(t *testing.T) {
+	db, mock, _ := setupTestDB(t)
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "cohorts"`)).
+		WillReturnError(&pgconn.PgError{Code: uniqueViolation})
+	mock.ExpectRollback()
+
+	repo := NewCohortRepository()
+	cohort, err := repo.Save(&entity.Cohort{Name: "CS-2025-A"})
+
+	assert.Nil(t, cohort)
+	assert.ErrorIs(t, err, ErrNameTaken)
+}
+
+func TestCohortAddStudents(t *testing.T) {
+	db, mock, _ := setupTestDB(t)
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO "cohort_student" ("cohort_id","student_id") VALUES ($1,$2),($3,$4) ON CONFLICT DO NOTHING`)).
+		WithArgs(1, 4, 1, 5).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectCommit()
+
+	repo := NewCohortRepository()
+	err := repo.AddStudents(1, []uint{4, 5})
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCohortAddStudents_UnknownStudent(t *testing.T) {
+	db, mock, _ := setupTestDB(t)
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO "cohort_student"`)).
+		WillReturnError(&pgconn.PgError{Code: foreignKeyViolation})
+	mock.ExpectRollback()
+
+	repo := NewCohortRepository()
+	err := repo.AddStudents(1, []uint{99})
+
+	assert.ErrorIs(t, err, ErrStudentNotFound)
+}
+
+func TestCohortFindStudentIds(t *testing.T) {
+	db, mock, _ := setupTestDB(t)
+	defer db.Close()
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT "student_id" FROM "cohort_student" WHERE cohort_id = $1 ORDER BY student_id`)).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"student_id"}).AddRow(4).AddRow(5))
+
+	repo := NewCohortRepository()
+	ids, err := repo.FindStudentIds(1)
+
+	require.NoError(t, err)
+	assert.Equal(t, []uint{4, 5}, ids)
+}
@@ -0,0 +1,256 @@
+package cohort
+
+import (
+	"errors"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"student_go/internal/audit"
+	"student_go/internal/course"
+	"student_go/internal/dto/request"
+	"student_go/internal/dto/response"
+	"student_go/internal/entity"
+	"student_go/internal/student"
+	"student_go/pkg/log"
+	"student_go/pkg/patch"
+)
+
+var (
+	ErrCohortNotFound  = errors.New("cohort not found")
+	ErrCourseNotFound  = errors.New("course not found")
+	ErrStudentNotFound = errors.New("student not found")
+	ErrNotMember       = errors.New("student is not a member of the cohort")
+	ErrNameTaken       = errors.New("cohort name is already taken")
+)
+
+type Service interface {
+	CreateCohort(input request.CohortRequest) (*response.CohortResponse, error)
+	UpdateCohort(id uint, input request.CohortPatch, fields patch.Fields) (*response.CohortResponse, error)
+	FindCohortById(id uint) (*response.CohortResponse, error)
+	FindAllCohorts(page, limit int) ([]*response.CohortResponse, error)
+	DeleteCohortById(id uint) error
+	AddStudents(id uint, input request.CohortMembersRequest) error
+	RemoveStudent(id, studentId uint) error
+	EnrollInCourse(id, courseId uint, meta audit.Meta) (*response.CohortEnrollmentResponse, error)
+	Count() (int, error)
+}
+
+type service struct {
+	cohortRepository Repository
+	courseRepository course.Repository
+	studentService   student.Service
+}
+
+// NewCohortService creates the service. Cohort enrollment goes through
+// studentService so that every member is enrolled under the same rules as
+// a single enrollment.
+func NewCohortService(
+	cohortRepository Repository,
+	courseRepository course.Repository,
+	studentService student.Service,
+) Service {
+	return &service{
+		cohortRepository: cohortRepository,
+		courseRepository: courseRepository,
+		studentService:   studentService,
+	}
+}
+
+func (s *service) CreateCohort(input request.CohortRequest) (*response.CohortResponse, error) {
+	log.Log.Info("CreateCohort (service) called", zap.String("name", input.Name))
+
+	savedCohort, err := s.cohortRepository.Save(&entity.Cohort{Name: input.Name})
+	if err != nil {
+		return nil, err
+	}
+
+	return toCohortResponse(savedCohort), nil
+}
+
+func (s *service) UpdateCohort(id uint, input request.CohortPatch, fields patch.Fields) (*response.CohortResponse, error) {
+	log.Log.Info("UpdateCohort (service) called", zap.Uint("id", id), zap.Strings("fields", fields.Names()))
+
+	if !fields.Has("name") {
+		return s.FindCohortById(id)
+	}
+
+	if err := s.checkCohort(id); err != nil {
+		return nil, err
+	}
+
+	updatedCohort, err := s.cohortRepository.Update(&entity.Cohort{ID: id, Name: patch.Value(input.Name)})
+	if err != nil {
+		return nil, err
+	}
+
+	return toCohortResponse(updatedCohort), nil
+}
+
+func (s *service) FindCohortById(id uint) (*response.CohortResponse, error) {
+	log.Log.Info("FindCohortById (service) called", zap.Uint("id", id))
+
+	cohort, err := s.cohortRepository.FindById(id)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrCohortNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return toCohortResponse(cohort), nil
+}
+
+func (s *service) FindAllCohorts(page, limit int) ([]*response.CohortResponse, error) {
+	log.Log.Info("FindAllCohorts (service) called", zap.Int("page", page), zap.Int("limit", limit))
+
+	cohorts, err := s.cohortRepository.FindAll(page, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	cohortResponses := make([]*response.CohortResponse, 0, len(cohorts))
+	for i := range cohorts {
+		cohortResponses = append(cohortResponses, toCohortResponse(&cohorts[i]))
+	}
+
+	return cohortResponses, nil
+}
+
+func (s *service) DeleteCohortById(id uint) error {
+	log.Log.Info("DeleteCohortById (service) called", zap.Uint("id", id))
+	return s.cohortRepository.DeleteById(id)
+}
+
+func (s *service) AddStudents(id uint, input request.CohortMembersRequest) error {
+	log.Log.Info("AddStudents (service) called", zap.Uint("id", id), zap.Int("students", len(input.StudentIDs)))
+
+	if err := s.checkCohort(id); err != nil {
+		return err
+	}
+
+	return s.cohortRepository.AddStudents(id, input.StudentIDs)
+}
+
+func (s *service) RemoveStudent(id, studentId uint) error {
+	log.Log.Info("RemoveStudent (service) called", zap.Uint("id", id), zap.Uint("student_id", studentId))
+
+	if err := s.checkCohort(id); err != nil {
+		return err
+	}
+
+	removed, err := s.cohortRepository.RemoveStudent(id, studentId)
+	if err != nil {
+		return err
+	}
+	if !removed {
+		return ErrNotMember
+	}
+
+	return nil
+}
+
+// EnrollInCourse enrolls every member of the cohort into the course. A
+// student who cannot be enrolled does not stop the others; the report
+// lists the outcome for each member.
+func (s *service) EnrollInCourse(id, courseId uint, meta audit.Meta) (*response.CohortEnrollmentResponse, error) {
+	log.Log.Info("EnrollInCourse (service) called", zap.Uint("id", id), zap.Uint("course_id", courseId))
+
+	if err := s.checkCohort(id); err != nil {
+		return nil, err
+	}
+
+	exists, err := s.courseRepository.ExistsById(courseId)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, ErrCourseNotFound
+	}
+
+	studentIds, err := s.cohortRepository.FindStudentIds(id)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &response.CohortEnrollmentResponse{
+		CohortID: id,
+		CourseID: courseId,
+		Results:  make([]response.StudentEnrollmentResult, 0, len(studentIds)),
+	}
+	for _, studentId := range studentIds {
+		result := s.enroll(id, studentId, courseId, meta)
+
+		switch {
+		case result.Enrolled:
+			report.Enrolled++
+		case result.AlreadyEnrolled:
+			report.AlreadyEnrolled++
+		default:
+			report.Failed++
+		}
+		report.Results = append(report.Results, result)
+	}
+
+	return report, nil
+}
+
+// enroll enrolls one cohort member, unless they are in the course already.
+func (s *service) enroll(id, studentId, courseId uint, meta audit.Meta) response.StudentEnrollmentResult {
+	result := response.StudentEnrollmentResult{StudentID: studentId}
+
+	enrolled, err := s.courseRepository.HasStudent(courseId, studentId)
+	if err == nil && enrolled {
+		result.AlreadyEnrolled = true
+		return result
+	}
+	if err == nil {
+		_, err = s.studentService.AddCourseToStudent(studentId, courseId, meta)
+	}
+	if err != nil {
+		log.Log.Warn("Failed to enroll cohort member",
+			zap.Uint("cohort_id", id),
+			zap.Uint("student_id", studentId),
+			zap.Uint("course_id", courseId),
+			zap.Error(err),
+		)
+		result.Error = enrollmentError(err)
+		return result
+	}
+
+	result.Enrolled = true
+	return result
+}
+
+func (s *service) Count() (int, error) {
+	return s.cohortRepository.Count()
+}
+
+func (s *service) checkCohort(id uint) error {
+	exists, err := s.cohortRepository.ExistsById(id)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return ErrCohortNotFound
+	}
+
+	return nil
+}
+
+// enrollmentError returns the reason shown in the report. Rule violations
+// are passed through; anything else is hidden behind a generic message.
+func enrollmentError(err error) string {
+	var holdErr *student.HoldError
+	if errors.Is(err, student.ErrGuardianRequired) || errors.As(err, &holdErr) || err.Error() == "student not found" {
+		return err.Error()
+	}
+
+	return "failed to enroll student"
+}
+
+func toCohortResponse(cohort *entity.Cohort) *response.CohortResponse {
+	return &response.CohortResponse{
+		ID:        cohort.ID,
+		Name:      cohort.Name,
+		CreatedAt: cohort.CreatedAt,
+	}
+}
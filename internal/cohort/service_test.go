@@ -0,0 +1,150 @@
+package cohort
+
+import (
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"student_go/internal/audit"
+	"student_go/internal/dto/request"
+	"student_go/internal/dto/response"
+	"student_go/internal/entity"
+	mocks2 "student_go/internal/mocks"
+	"student_go/internal/student"
+	"student_go/pkg/log"
+	"student_go/pkg/patch"
+	"testing"
+)
+
+func init() {
+	logger, _ := zap.NewDevelopment()
+	log.Log = logger
+}
+
+func newTestCohortService() (Service, *mocks2.CohortRepository, *mocks2.CourseRepository, *mocks2.StudentServiceMock) {
+	mockCohortRepo := new(mocks2.CohortRepository)
+	mockCourseRepo := new(mocks2.CourseRepository)
+	mockStudentService := new(mocks2.StudentServiceMock)
+
+	svc := NewCohortService(mockCohortRepo, mockCourseRepo, mockStudentService)
+	return svc, mockCohortRepo, mockCourseRepo, mockStudentService
+}
+
+func TestCreateCohort(t *testing.T) {
+	svc, mockCohortRepo, _, _ := newTestCohortService()
+	mockCohortRepo.On("Save", &entity.Cohort{Name: "CS-2025-A"}).Return(&entity.Cohort{ID: 1, Name: "CS-2025-A"}, nil)
+
+	result, err := svc.CreateCohort(request.CohortRequest{Name: "CS-2025-A"})
+
+	require.NoError(t, err)
+	assert.Equal(t, uint(1), result.ID)
+	assert.Equal(t, "CS-2025-A", result.Name)
+}
+
+func TestCreateCohort_NameTaken(t *testing.T) {
+	svc, mockCohortRepo, _, _ := newTestCohortService()
+	mockCohortRepo.On("Save", mock.Anything).Return(nil, ErrNameTaken)
+
+	result, err := svc.CreateCohort(request.CohortRequest{Name: "CS-2025-A"})
+
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, ErrNameTaken)
+}
+
+func TestUpdateCohort_EmptyPatch(t *testing.T) {
+	svc, mockCohortRepo, _, _ := newTestCohortService()
+	mockCohortRepo.On("FindById", uint(1)).Return(&entity.Cohort{ID: 1, Name: "CS-2025-A"}, nil)
+
+	result, err := svc.UpdateCohort(1, request.CohortPatch{}, patch.Fields{})
+
+	require.NoError(t, err)
+	assert.Equal(t, "CS-2025-A", result.Name)
+	mockCohortRepo.AssertNotCalled(t, "Update", mock.Anything)
+}
+
+func TestFindCohortById_NotFound(t *testing.T) {
+	svc, mockCohortRepo, _, _ := newTestCohortService()
+	mockCohortRepo.On("FindById", uint(9)).Return(nil, gorm.ErrRecordNotFound)
+
+	result, err := svc.FindCohortById(9)
+
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, ErrCohortNotFound)
+}
+
+func TestAddStudents(t *testing.T) {
+	svc, mockCohortRepo, _, _ := newTestCohortService()
+	mockCohortRepo.On("ExistsById", uint(1)).Return(true, nil)
+	mockCohortRepo.On("AddStudents", uint(1), []uint{4, 5}).Return(nil)
+
+	err := svc.AddStudents(1, request.CohortMembersRequest{StudentIDs: []uint{4, 5}})
+
+	assert.NoError(t, err)
+	mockCohortRepo.AssertExpectations(t)
+}
+
+func TestRemoveStudent_NotMember(t *testing.T) {
+	svc, mockCohortRepo, _, _ := newTestCohortService()
+	mockCohortRepo.On("ExistsById", uint(1)).Return(true, nil)
+	mockCohortRepo.On("RemoveStudent", uint(1), uint(4)).Return(false, nil)
+
+	err := svc.RemoveStudent(1, 4)
+
+	assert.ErrorIs(t, err, ErrNotMember)
+}
+
+func TestEnrollInCourse(t *testing.T) {
+	svc, mockCohortRepo, mockCourseRepo, mockStudentService := newTestCohortService()
+	mockCohortRepo.On("ExistsById", uint(1)).Return(true, nil)
+	mockCourseRepo.On("ExistsById", uint(7)).Return(true, nil)
+	mockCohortRepo.On("FindStudentIds", uint(1)).Return([]uint{4, 5, 6, 8}, nil)
+	mockCourseRepo.On("HasStudent", uint(7), uint(4)).Return(false, nil)
+	mockCourseRepo.On("HasStudent", uint(7), uint(5)).Return(false, nil)
+	mockCourseRepo.On("HasStudent", uint(7), uint(6)).Return(false, nil)
+	mockCourseRepo.On("HasStudent", uint(7), uint(8)).Return(true, nil)
+	mockStudentService.On("AddCourseToStudent", uint(4), uint(7), audit.Meta{}).Return(&response.StudentResponse{ID: 4}, nil)
+	mockStudentService.On("AddCourseToStudent", uint(5), uint(7), audit.Meta{}).Return(nil, student.ErrGuardianRequired)
+	mockStudentService.On("AddCourseToStudent", uint(6), uint(7), audit.Meta{}).Return(nil, errors.New("connection reset"))
+
+	report, err := svc.EnrollInCourse(1, 7, audit.Meta{})
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, report.Enrolled)
+	assert.Equal(t, 1, report.AlreadyEnrolled)
+	assert.Equal(t, 2, report.Failed)
+	require.Len(t, report.Results, 4)
+	assert.True(t, report.Results[0].Enrolled)
+	assert.False(t, report.Results[1].Enrolled)
+	assert.Equal(t, student.ErrGuardianRequired.Error(), report.Results[1].Error)
+	assert.Equal(t, "failed to enroll student", report.Results[2].Error)
+	assert.False(t, report.Results[3].Enrolled)
+	assert.True(t, report.Results[3].AlreadyEnrolled)
+	assert.Empty(t, report.Results[3].Error)
+	mockStudentService.AssertExpectations(t)
+	mockStudentService.AssertNotCalled(t, "AddCourseToStudent", uint(8), uint(7), audit.Meta{})
+}
+
+func TestEnrollInCourse_CourseNotFound(t *testing.T) {
+	svc, mockCohortRepo, mockCourseRepo, mockStudentService := newTestCohortService()
+	mockCohortRepo.On("ExistsById", uint(1)).Return(true, nil)
+	mockCourseRepo.On("ExistsById", uint(7)).Return(false, nil)
+
+	report, err := svc.EnrollInCourse(1, 7, audit.Meta{})
+
+	assert.Nil(t, report)
+	assert.ErrorIs(t, err, ErrCourseNotFound)
+	mockCohortRepo.AssertNotCalled(t, "FindStudentIds", mock.Anything)
+	mockStudentService.AssertNotCalled(t, "AddCourseToStudent", mock.Anything, mock.Anything)
+}
+
+func TestEnrollInCourse_CohortNotFound(t *testing.T) {
+	svc, mockCohortRepo, _, _ := newTestCohortService()
+	mockCohortRepo.On("ExistsById", uint(1)).Return(false, nil)
+
+	report, err := svc.EnrollInCourse(1, 7, audit.Meta{})
+
+	assert.Nil(t, report)
+	assert.ErrorIs(t, err, ErrCohortNotFound)
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"github.com/spf13/viper"
 	"strings"
+	"time"
 )
 
 type AppConfig struct {
@@ -19,6 +20,81 @@ type AppConfig struct {
 		Password string `mapstructure:"password"`
 		SSLMode  string `mapstructure:"sslmode"`
 	} `mapstructure:"db"`
+
+	Notification struct {
+		// Sender is either "smtp" or "file"; the file sender only logs
+		// messages unless FileDir is set.
+		Sender        string        `mapstructure:"sender"`
+		From          string        `mapstructure:"from"`
+		DefaultLocale string        `mapstructure:"default_locale"`
+		FileDir       string        `mapstructure:"file_dir"`
+		PollInterval  time.Duration `mapstructure:"poll_interval"`
+		BatchSize     int           `mapstructure:"batch_size"`
+		MaxAttempts   int           `mapstructure:"max_attempts"`
+		BaseBackoff   time.Duration `mapstructure:"base_backoff"`
+		MaxBackoff    time.Duration `mapstructure:"max_backoff"`
+
+		SMTP struct {
+			Host     string `mapstructure:"host"`
+			Port     int    `mapstructure:"port"`
+			Username string `mapstructure:"username"`
+			Password string `mapstructure:"password"`
+		} `mapstructure:"smtp"`
+	} `mapstructure:"notification"`
+
+	OfficeHours struct {
+		// Timezone in which recurring office-hour windows are defined.
+		Timezone string `mapstructure:"timezone"`
+		// MaxActiveBookings limits how many upcoming appointments a student
+		// may hold with the same teacher.
+		MaxActiveBookings int `mapstructure:"max_active_bookings"`
+	} `mapstructure:"office_hours"`
+
+	Students struct {
+		// NumberFormat is the template for generated student numbers. It
+		// supports {year}, {yy}, {dept} or {dept:N} (the department ID
+		// given at creation) and {seq} or {seq:N} (zero-padded to N).
+		NumberFormat string `mapstructure:"number_format"`
+		// BlockingHolds lists the hold types that prevent enrollment; when
+		// empty every type does.
+		BlockingHolds []string `mapstructure:"blocking_holds"`
+		// DuplicateThreshold is the name similarity (0-1) from which two
+		// students are reported as possible duplicates. Values below
+		// pg_trgm.similarity_threshold (0.3 by default) act like it.
+		DuplicateThreshold float64 `mapstructure:"duplicate_threshold"`
+	} `mapstructure:"students"`
+
+	Workload struct {
+		// MaxCourses and MaxStudents cap a teacher's load; zero means no
+		// limit. Students are counted per enrollment.
+		MaxCourses  int `mapstructure:"max_courses"`
+		MaxStudents int `mapstructure:"max_students"`
+	} `mapstructure:"workload"`
+
+	Storage struct {
+		// Driver is either "local" or "s3".
+		Driver   string `mapstructure:"driver"`
+		LocalDir string `mapstructure:"local_dir"`
+		// MaxUploadSize is the largest accepted attachment in bytes.
+		MaxUploadSize int64 `mapstructure:"max_upload_size"`
+		// AllowedTypes lists sniffed content types accepted for upload.
+		AllowedTypes []string `mapstructure:"allowed_types"`
+
+		S3 struct {
+			Endpoint  string `mapstructure:"endpoint"`
+			Region    string `mapstructure:"region"`
+			Bucket    string `mapstructure:"bucket"`
+			AccessKey string `mapstructure:"access_key"`
+			SecretKey string `mapstructure:"secret_key"`
+		} `mapstructure:"s3"`
+	} `mapstructure:"storage"`
+
+	SoftDelete struct {
+		// Retention is how long deleted students, teachers, courses and
+		// departments can be restored before the purge job removes them.
+		Retention     time.Duration `mapstructure:"retention"`
+		PurgeInterval time.Duration `mapstructure:"purge_interval"`
+	} `mapstructure:"soft_delete"`
 }
 
 var Config *AppConfig
@@ -50,6 +126,12 @@ func Load() error {
 	for _, k := range []string{
 		"db.host", "db.port", "db.name",
 		"db.user", "db.password", "db.sslmode",
+		"notification.sender", "notification.from",
+		"notification.smtp.host", "notification.smtp.port",
+		"notification.smtp.username", "notification.smtp.password",
+		"office_hours.timezone",
+		"storage.s3.endpoint", "storage.s3.bucket",
+		"storage.s3.access_key", "storage.s3.secret_key",
 	} {
 		_ = v.BindEnv(k)
 	}
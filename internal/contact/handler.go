@@ -0,0 +1,192 @@
+package contact
+
+import (
+	"errors"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+	"net/http"
+	"strconv"
+	"student_go/internal/actor"
+	"student_go/internal/dto/request"
+	"student_go/internal/student"
+	"student_go/pkg/log"
+	"student_go/pkg/patch"
+)
+
+type Handler struct {
+	Service Service
+}
+
+func NewContactHandler() *Handler {
+	return &Handler{
+		Service: NewContactService(NewContactRepository(), student.NewStudentRepository()),
+	}
+}
+
+func (h *Handler) CreateContact(c *gin.Context) {
+	studentId, ok := parseID(c, "id", "invalid student ID")
+	if !ok {
+		return
+	}
+
+	a, err := actor.FromContext(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+
+	var req request.ContactRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		log.Log.Warn("Invalid request in CreateContact", zap.Error(err))
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	log.Log.Info("CreateContact called", zap.Uint("student_id", studentId))
+
+	contactResp, err := h.Service.CreateContact(studentId, a, req)
+	if err != nil {
+		writeError(c, err, "failed to save contact")
+		return
+	}
+
+	c.JSON(http.StatusCreated, contactResp)
+}
+
+func (h *Handler) UpdateContact(c *gin.Context) {
+	studentId, ok := parseID(c, "id", "invalid student ID")
+	if !ok {
+		return
+	}
+	id, ok := parseID(c, "contactId", "invalid contact ID")
+	if !ok {
+		return
+	}
+
+	a, err := actor.FromContext(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+
+	var req request.ContactPatch
+	fields, err := patch.Bind(c.Request, &req)
+	if err != nil {
+		log.Log.Warn("Invalid request in UpdateContact", zap.Error(err))
+		if errors.Is(err, patch.ErrUnsupportedMediaType) {
+			c.JSON(http.StatusUnsupportedMediaType, gin.H{"error": err.Error()})
+		} else {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	log.Log.Info("UpdateContact called", zap.Uint("student_id", studentId), zap.Uint("id", id), zap.Strings("fields", fields.Names()))
+
+	contactResp, err := h.Service.UpdateContact(studentId, id, a, req, fields)
+	if err != nil {
+		writeError(c, err, "failed to update contact")
+		return
+	}
+
+	c.JSON(http.StatusOK, contactResp)
+}
+
+func (h *Handler) FindContactById(c *gin.Context) {
+	studentId, ok := parseID(c, "id", "invalid student ID")
+	if !ok {
+		return
+	}
+	id, ok := parseID(c, "contactId", "invalid contact ID")
+	if !ok {
+		return
+	}
+
+	a, err := actor.FromContext(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+
+	log.Log.Info("FindContactById called", zap.Uint("student_id", studentId), zap.Uint("id", id))
+
+	contactResp, err := h.Service.FindContactById(studentId, id, a)
+	if err != nil {
+		writeError(c, err, "something went wrong")
+		return
+	}
+
+	c.JSON(http.StatusOK, contactResp)
+}
+
+func (h *Handler) FindAllContacts(c *gin.Context) {
+	studentId, ok := parseID(c, "id", "invalid student ID")
+	if !ok {
+		return
+	}
+
+	a, err := actor.FromContext(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+
+	log.Log.Info("FindAllContacts called", zap.Uint("student_id", studentId))
+
+	contacts, err := h.Service.FindAllContacts(studentId, a)
+	if err != nil {
+		writeError(c, err, "failed to get contacts")
+		return
+	}
+
+	c.JSON(http.StatusOK, contacts)
+}
+
+func (h *Handler) DeleteContactById(c *gin.Context) {
+	studentId, ok := parseID(c, "id", "invalid student ID")
+	if !ok {
+		return
+	}
+	id, ok := parseID(c, "contactId", "invalid contact ID")
+	if !ok {
+		return
+	}
+
+	a, err := actor.FromContext(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+
+	log.Log.Info("DeleteContactById called", zap.Uint("student_id", studentId), zap.Uint("id", id))
+
+	if err := h.Service.DeleteContactById(studentId, id, a); err != nil {
+		writeError(c, err, "failed to delete contact")
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func parseID(c *gin.Context, name string, message string) (uint, bool) {
+	param := c.Param(name)
+	parsed, err := strconv.ParseUint(param, 10, 32)
+	if err != nil {
+		log.Log.Warn("Invalid path parameter", zap.String(name, param), zap.Error(err))
+		c.JSON(http.StatusBadRequest, gin.H{"error": message})
+		return 0, false
+	}
+
+	return uint(parsed), true
+}
+
+func writeError(c *gin.Context, err error, fallback string) {
+	switch {
+	case errors.Is(err, ErrStudentNotFound), errors.Is(err, ErrContactNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	case errors.Is(err, ErrForbidden):
+		c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": fallback})
+	}
+}
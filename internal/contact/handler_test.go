@@ -0,0 +1,92 @@
+package contact
+
+import (
+	"bytes"
+	"encoding/json"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"net/http"
+	"net/http/httptest"
+	"student_go/internal/actor"
+	"student_go/internal/dto/response"
+	"student_go/internal/mocks"
+	"testing"
+)
+
+func setupHandlerTest() (*gin.Engine, *mocks.ContactServiceMock, *Handler) {
+	gin.SetMode(gin.TestMode)
+	mockService := new(mocks.ContactServiceMock)
+	handler := &Handler{Service: mockService}
+	r := gin.Default()
+	return r, mockService, handler
+}
+
+func setActor(req *http.Request, id string, role string) {
+	req.Header.Set(actor.HeaderUserID, id)
+	req.Header.Set(actor.HeaderUserRole, role)
+}
+
+func TestCreateContactHandler(t *testing.T) {
+	r, mockService, handler := setupHandlerTest()
+	mockService.On("CreateContact", uint(5), self, mother).Return(&response.ContactResponse{ID: 1}, nil)
+
+	r.POST("/students/:id/contacts", handler.CreateContact)
+	body, _ := json.Marshal(mother)
+	req := httptest.NewRequest(http.MethodPost, "/students/5/contacts", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	setActor(req, "5", "student")
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusCreated, resp.Code)
+	mockService.AssertExpectations(t)
+}
+
+func TestCreateContactHandler_InvalidPhone(t *testing.T) {
+	r, mockService, handler := setupHandlerTest()
+
+	for _, phone := range []string{"89001234567", "+7 900 123-45-67", "+12"} {
+		input := mother
+		input.Phone = phone
+
+		r.POST("/students/:id/contacts", handler.CreateContact)
+		body, _ := json.Marshal(input)
+		req := httptest.NewRequest(http.MethodPost, "/students/5/contacts", bytes.NewBuffer(body))
+		req.Header.Set("Content-Type", "application/json")
+		setActor(req, "5", "student")
+		resp := httptest.NewRecorder()
+		r.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code, phone)
+		r = gin.New()
+	}
+	mockService.AssertNotCalled(t, "CreateContact", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestFindAllContactsHandler_Forbidden(t *testing.T) {
+	r, mockService, handler := setupHandlerTest()
+	teacher := actor.Actor{ID: 3, Role: actor.RoleTeacher}
+	mockService.On("FindAllContacts", uint(5), teacher).Return(nil, ErrForbidden)
+
+	r.GET("/students/:id/contacts", handler.FindAllContacts)
+	req := httptest.NewRequest(http.MethodGet, "/students/5/contacts", nil)
+	setActor(req, "3", "teacher")
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusForbidden, resp.Code)
+}
+
+func TestDeleteContactHandler(t *testing.T) {
+	r, mockService, handler := setupHandlerTest()
+	mockService.On("DeleteContactById", uint(5), uint(1), admin).Return(nil)
+
+	r.DELETE("/students/:id/contacts/:contactId", handler.DeleteContactById)
+	req := httptest.NewRequest(http.MethodDelete, "/students/5/contacts/1", nil)
+	setActor(req, "1", "admin")
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusNoContent, resp.Code)
+}
@@ -0,0 +1,80 @@
+package contact
+
+import (
+	"student_go/internal/entity"
+	"student_go/pkg/dbcontext"
+)
+
+type Repository interface {
+	Save(contact *entity.StudentContact) (*entity.StudentContact, error)
+	Update(contact *entity.StudentContact) (*entity.StudentContact, error)
+	FindById(studentId uint, id uint) (*entity.StudentContact, error)
+	FindAll(studentId uint) ([]entity.StudentContact, error)
+	DeleteById(id uint) error
+}
+
+type repository struct{}
+
+func NewContactRepository() Repository {
+	return &repository{}
+}
+
+func (r *repository) Save(contact *entity.StudentContact) (*entity.StudentContact, error) {
+	err := dbcontext.DB.Create(contact).Error
+	return contact, err
+}
+
+func (r *repository) Update(contact *entity.StudentContact) (*entity.StudentContact, error) {
+	err := dbcontext.DB.Model(&entity.StudentContact{}).
+		Where("id = ?", contact.ID).
+		Updates(map[string]interface{}{
+			"name":               contact.Name,
+			"relationship":       contact.Relationship,
+			"phone":              contact.Phone,
+			"email":              contact.Email,
+			"address":            contact.Address,
+			"is_guardian":        contact.IsGuardian,
+			"is_emergency":       contact.IsEmergency,
+			"can_receive_grades": contact.CanReceiveGrades,
+		}).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return r.FindById(contact.StudentID, contact.ID)
+}
+
+func (r *repository) FindById(studentId uint, id uint) (*entity.StudentContact, error) {
+	var contact entity.StudentContact
+	result := dbcontext.DB.
+		Where("student_id = ?", studentId).
+		First(&contact, id)
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return &contact, nil
+}
+
+func (r *repository) FindAll(studentId uint) ([]entity.StudentContact, error) {
+	var contacts []entity.StudentContact
+
+	result := dbcontext.DB.
+		Where("student_id = ?", studentId).
+		Order("is_guardian DESC").
+		Order("id").
+		Find(&contacts)
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return contacts, nil
+}
+
+func (r *repository) DeleteById(id uint) error {
+	result := dbcontext.DB.Delete(&entity.StudentContact{}, id)
+
+	return result.Error
+}
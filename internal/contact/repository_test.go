@@ -0,0 +1,89 @@
+package contact
+
+import (
+	"database/sql"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"regexp"
+	"testing"
+
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+
+	"student_go/internal/entity"
+	"student_go/pkg/dbcontext"
+)
+
+func setupTestDB(t *testing.T) (*sql.DB, sqlmock.Sqlmock, *gorm.DB) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+
+	dialector := postgres.New(postgres.Config{
+		Conn:                 db,
+		PreferSimpleProtocol: true,
+	})
+
+	gormDB, err := gorm.Open(dialector, &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	assert.NoError(t, err)
+
+	dbcontext.DB = gormDB
+	return db, mock, gormDB
+}
+
+func TestContactFindAll(t *testing.T) {
+	db, mock, _ := setupTestDB(t)
+	defer db.Close()
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "student_contacts" WHERE student_id = $1 ORDER BY is_guardian DESC,id`)).
+		WithArgs(5).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "student_id", "name", "is_guardian"}).
+			AddRow(1, 5, "Anna", true).
+			AddRow(2, 5, "Boris", false))
+
+	repo := NewContactRepository()
+	contacts, err := repo.FindAll(5)
+
+	require.NoError(t, err)
+	require.Len(t, contacts, 2)
+	assert.True(t, contacts[0].IsGuardian)
+}
+
+func TestContactUpdate(t *testing.T) {
+	db, mock, _ := setupTestDB(t)
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE "student_contacts" SET`)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "student_contacts" WHERE student_id = $1 AND "student_contacts"."id" = $2`)).
+		WithArgs(5, 1, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "student_id", "phone"}).AddRow(1, 5, "+79001234567"))
+
+	repo := NewContactRepository()
+	contact, err := repo.Update(&entity.StudentContact{ID: 1, StudentID: 5, Phone: "+79001234567"})
+
+	require.NoError(t, err)
+	assert.Equal(t, "+79001234567", contact.Phone)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestContactDeleteById(t *testing.T) {
+	db, mock, _ := setupTestDB(t)
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "student_contacts" WHERE "student_contacts"."id" = $1`)).
+		WithArgs(1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	repo := NewContactRepository()
+	err := repo.DeleteById(1)
+
+	assert.NoError(t, err)
+}
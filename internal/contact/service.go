@@ -0,0 +1,211 @@
+package contact
+
+import (
+	"errors"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"student_go/internal/actor"
+	"student_go/internal/dto/request"
+	"student_go/internal/dto/response"
+	"student_go/internal/entity"
+	"student_go/internal/student"
+	"student_go/pkg/log"
+	"student_go/pkg/patch"
+)
+
+var (
+	ErrStudentNotFound = errors.New("student not found")
+	ErrContactNotFound = errors.New("contact not found")
+	ErrForbidden       = errors.New("forbidden")
+)
+
+type Service interface {
+	CreateContact(studentId uint, a actor.Actor, input request.ContactRequest) (*response.ContactResponse, error)
+	UpdateContact(studentId uint, id uint, a actor.Actor, input request.ContactPatch, fields patch.Fields) (*response.ContactResponse, error)
+	FindContactById(studentId uint, id uint, a actor.Actor) (*response.ContactResponse, error)
+	FindAllContacts(studentId uint, a actor.Actor) ([]*response.ContactResponse, error)
+	DeleteContactById(studentId uint, id uint, a actor.Actor) error
+}
+
+type service struct {
+	contactRepository Repository
+	studentRepository student.Repository
+}
+
+func NewContactService(
+	contactRepository Repository,
+	studentRepository student.Repository,
+) Service {
+	return &service{
+		contactRepository: contactRepository,
+		studentRepository: studentRepository,
+	}
+}
+
+func (s *service) CreateContact(studentId uint, a actor.Actor, input request.ContactRequest) (*response.ContactResponse, error) {
+	log.Log.Info("CreateContact (service) called", zap.Uint("student_id", studentId), zap.String("relationship", input.Relationship))
+
+	if err := s.authorize(studentId, a); err != nil {
+		return nil, err
+	}
+
+	contact := toContact(input)
+	contact.StudentID = studentId
+
+	saved, err := s.contactRepository.Save(contact)
+	if err != nil {
+		return nil, err
+	}
+
+	return toContactResponse(saved), nil
+}
+
+func (s *service) UpdateContact(studentId uint, id uint, a actor.Actor, input request.ContactPatch, fields patch.Fields) (*response.ContactResponse, error) {
+	log.Log.Info("UpdateContact (service) called", zap.Uint("student_id", studentId), zap.Uint("id", id))
+
+	if err := s.authorize(studentId, a); err != nil {
+		return nil, err
+	}
+
+	contact, err := s.findContact(studentId, id)
+	if err != nil {
+		return nil, err
+	}
+	if len(fields) == 0 {
+		return toContactResponse(contact), nil
+	}
+
+	if fields.Has("name") {
+		contact.Name = patch.Value(input.Name)
+	}
+	if fields.Has("relationship") {
+		contact.Relationship = patch.Value(input.Relationship)
+	}
+	if fields.Has("phone") {
+		contact.Phone = patch.Value(input.Phone)
+	}
+	if fields.Has("email") {
+		contact.Email = patch.Value(input.Email)
+	}
+	if fields.Has("address") {
+		contact.Address = patch.Value(input.Address)
+	}
+	if fields.Has("isGuardian") {
+		contact.IsGuardian = patch.Value(input.IsGuardian)
+	}
+	if fields.Has("isEmergency") {
+		contact.IsEmergency = patch.Value(input.IsEmergency)
+	}
+	if fields.Has("canReceiveGrades") {
+		contact.CanReceiveGrades = patch.Value(input.CanReceiveGrades)
+	}
+
+	updated, err := s.contactRepository.Update(contact)
+	if err != nil {
+		return nil, err
+	}
+
+	return toContactResponse(updated), nil
+}
+
+func (s *service) FindContactById(studentId uint, id uint, a actor.Actor) (*response.ContactResponse, error) {
+	log.Log.Info("FindContactById (service) called", zap.Uint("student_id", studentId), zap.Uint("id", id))
+
+	if err := s.authorize(studentId, a); err != nil {
+		return nil, err
+	}
+
+	contact, err := s.findContact(studentId, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return toContactResponse(contact), nil
+}
+
+func (s *service) FindAllContacts(studentId uint, a actor.Actor) ([]*response.ContactResponse, error) {
+	log.Log.Info("FindAllContacts (service) called", zap.Uint("student_id", studentId))
+
+	if err := s.authorize(studentId, a); err != nil {
+		return nil, err
+	}
+
+	contacts, err := s.contactRepository.FindAll(studentId)
+	if err != nil {
+		return nil, err
+	}
+
+	contactResponses := make([]*response.ContactResponse, 0, len(contacts))
+	for i := range contacts {
+		contactResponses = append(contactResponses, toContactResponse(&contacts[i]))
+	}
+
+	return contactResponses, nil
+}
+
+func (s *service) DeleteContactById(studentId uint, id uint, a actor.Actor) error {
+	log.Log.Info("DeleteContactById (service) called", zap.Uint("student_id", studentId), zap.Uint("id", id))
+
+	if err := s.authorize(studentId, a); err != nil {
+		return err
+	}
+
+	if _, err := s.findContact(studentId, id); err != nil {
+		return err
+	}
+
+	return s.contactRepository.DeleteById(id)
+}
+
+// authorize allows admins and the student themselves; contact details are
+// not shown to teachers.
+func (s *service) authorize(studentId uint, a actor.Actor) error {
+	exists, err := s.studentRepository.ExistsById(studentId)
+	if err != nil || !exists {
+		return ErrStudentNotFound
+	}
+	if !a.IsAdmin() && !a.IsStudent(studentId) {
+		return ErrForbidden
+	}
+
+	return nil
+}
+
+func (s *service) findContact(studentId uint, id uint) (*entity.StudentContact, error) {
+	contact, err := s.contactRepository.FindById(studentId, id)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrContactNotFound
+	}
+
+	return contact, err
+}
+
+func toContact(input request.ContactRequest) *entity.StudentContact {
+	return &entity.StudentContact{
+		Name:             input.Name,
+		Relationship:     input.Relationship,
+		Phone:            input.Phone,
+		Email:            input.Email,
+		Address:          input.Address,
+		IsGuardian:       input.IsGuardian,
+		IsEmergency:      input.IsEmergency,
+		CanReceiveGrades: input.CanReceiveGrades,
+	}
+}
+
+func toContactResponse(contact *entity.StudentContact) *response.ContactResponse {
+	return &response.ContactResponse{
+		ID:               contact.ID,
+		StudentID:        contact.StudentID,
+		Name:             contact.Name,
+		Relationship:     contact.Relationship,
+		Phone:            contact.Phone,
+		Email:            contact.Email,
+		Address:          contact.Address,
+		IsGuardian:       contact.IsGuardian,
+		IsEmergency:      contact.IsEmergency,
+		CanReceiveGrades: contact.CanReceiveGrades,
+		CreatedAt:        contact.CreatedAt,
+		UpdatedAt:        contact.UpdatedAt,
+	}
+}
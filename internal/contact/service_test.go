@@ -0,0 +1,113 @@
+package contact
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"student_go/internal/actor"
+	"student_go/internal/dto/request"
+	"student_go/internal/entity"
+	mocks2 "student_go/internal/mocks"
+	"student_go/pkg/log"
+	"student_go/pkg/patch"
+	"testing"
+)
+
+func init() {
+	logger, _ := zap.NewDevelopment()
+	log.Log = logger
+}
+
+var (
+	admin       = actor.Actor{ID: 1, Role: actor.RoleAdmin}
+	self        = actor.Actor{ID: 5, Role: actor.RoleStudent}
+	mother      = request.ContactRequest{Name: "Anna", Relationship: "mother", Phone: "+79001234567", IsGuardian: true}
+	motherSaved = &entity.StudentContact{ID: 1, StudentID: 5, Name: "Anna", Relationship: "mother", Phone: "+79001234567", IsGuardian: true}
+)
+
+func newTestContactService() (Service, *mocks2.ContactRepository, *mocks2.StudentRepository) {
+	mockContactRepo := new(mocks2.ContactRepository)
+	mockStudentRepo := new(mocks2.StudentRepository)
+
+	mockStudentRepo.On("ExistsById", uint(5)).Return(true, nil).Maybe()
+
+	svc := NewContactService(mockContactRepo, mockStudentRepo)
+	return svc, mockContactRepo, mockStudentRepo
+}
+
+func TestCreateContact(t *testing.T) {
+	svc, mockContactRepo, _ := newTestContactService()
+
+	mockContactRepo.On("Save", mock.MatchedBy(func(c *entity.StudentContact) bool {
+		return c.StudentID == 5 && c.IsGuardian && c.Phone == "+79001234567"
+	})).Return(motherSaved, nil)
+
+	result, err := svc.CreateContact(5, self, mother)
+
+	assert.NoError(t, err)
+	assert.Equal(t, uint(1), result.ID)
+	assert.True(t, result.IsGuardian)
+	mockContactRepo.AssertExpectations(t)
+}
+
+func TestCreateContact_OtherStudentForbidden(t *testing.T) {
+	svc, mockContactRepo, _ := newTestContactService()
+
+	result, err := svc.CreateContact(5, actor.Actor{ID: 6, Role: actor.RoleStudent}, mother)
+
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, ErrForbidden)
+	mockContactRepo.AssertNotCalled(t, "Save", mock.Anything)
+}
+
+func TestCreateContact_StudentNotFound(t *testing.T) {
+	svc, _, mockStudentRepo := newTestContactService()
+
+	mockStudentRepo.On("ExistsById", uint(7)).Return(false, nil)
+
+	result, err := svc.CreateContact(7, admin, mother)
+
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, ErrStudentNotFound)
+}
+
+func TestUpdateContact(t *testing.T) {
+	svc, mockContactRepo, _ := newTestContactService()
+
+	current := *motherSaved
+	canReceiveGrades := true
+	mockContactRepo.On("FindById", uint(5), uint(1)).Return(&current, nil)
+	mockContactRepo.On("Update", mock.MatchedBy(func(c *entity.StudentContact) bool {
+		return c.ID == 1 && c.StudentID == 5 && c.CanReceiveGrades && c.Name == "Anna" && c.IsGuardian
+	})).Return(&entity.StudentContact{ID: 1, StudentID: 5, CanReceiveGrades: true}, nil)
+
+	result, err := svc.UpdateContact(5, 1, admin, request.ContactPatch{CanReceiveGrades: &canReceiveGrades},
+		patch.Fields{"canReceiveGrades": true})
+
+	assert.NoError(t, err)
+	assert.True(t, result.CanReceiveGrades)
+	mockContactRepo.AssertExpectations(t)
+}
+
+func TestFindAllContacts(t *testing.T) {
+	svc, mockContactRepo, _ := newTestContactService()
+
+	mockContactRepo.On("FindAll", uint(5)).Return([]entity.StudentContact{*motherSaved, {ID: 2, StudentID: 5}}, nil)
+
+	result, err := svc.FindAllContacts(5, self)
+
+	assert.NoError(t, err)
+	assert.Len(t, result, 2)
+}
+
+func TestDeleteContact_NotFound(t *testing.T) {
+	svc, mockContactRepo, _ := newTestContactService()
+
+	mockContactRepo.On("FindById", uint(5), uint(9)).Return(nil, gorm.ErrRecordNotFound)
+
+	err := svc.DeleteContactById(5, 9, self)
+
+	assert.ErrorIs(t, err, ErrContactNotFound)
+	mockContactRepo.AssertNotCalled(t, "DeleteById", mock.Anything)
+}
@@ -0,0 +1,37 @@
+package course
+
+import (
+	"student_go/internal/actor"
+)
+
+// Access is the level of access an actor has to a course.
+type Access int
+
+const (
+	AccessNone Access = iota
+	// AccessMember is granted to students enrolled in the course.
+	AccessMember
+	// AccessStaff is granted to the course's teacher and to admins.
+	AccessStaff
+)
+
+func ResolveAccess(repo Repository, courseId uint, a actor.Actor) (Access, error) {
+	switch a.Role {
+	case actor.RoleAdmin:
+		return AccessStaff, nil
+	case actor.RoleTeacher:
+		ok, err := repo.HasTeacher(courseId, a.ID)
+		if err != nil || !ok {
+			return AccessNone, err
+		}
+		return AccessStaff, nil
+	case actor.RoleStudent:
+		ok, err := repo.HasStudent(courseId, a.ID)
+		if err != nil || !ok {
+			return AccessNone, err
+		}
+		return AccessMember, nil
+	}
+
+	return AccessNone, nil
+}
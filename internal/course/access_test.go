@@ -0,0 +1,36 @@
+package course
+
+import (
+	"github.com/stretchr/testify/assert"
+	"student_go/internal/actor"
+	mocks2 "student_go/internal/mocks"
+	"testing"
+)
+
+func TestResolveAccess(t *testing.T) {
+	repo := new(mocks2.CourseRepository)
+	repo.On("HasTeacher", uint(1), uint(10)).Return(true, nil)
+	repo.On("HasTeacher", uint(1), uint(11)).Return(false, nil)
+	repo.On("HasStudent", uint(1), uint(20)).Return(true, nil)
+	repo.On("HasStudent", uint(1), uint(21)).Return(false, nil)
+
+	tests := []struct {
+		name  string
+		actor actor.Actor
+		want  Access
+	}{
+		{"admin", actor.Actor{ID: 1, Role: actor.RoleAdmin}, AccessStaff},
+		{"course teacher", actor.Actor{ID: 10, Role: actor.RoleTeacher}, AccessStaff},
+		{"other teacher", actor.Actor{ID: 11, Role: actor.RoleTeacher}, AccessNone},
+		{"enrolled student", actor.Actor{ID: 20, Role: actor.RoleStudent}, AccessMember},
+		{"other student", actor.Actor{ID: 21, Role: actor.RoleStudent}, AccessNone},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			access, err := ResolveAccess(repo, 1, tt.actor)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, access)
+		})
+	}
+}
@@ -0,0 +1,131 @@
+//go:build integration
+
+package course
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang-migrate/migrate/v4"
+	_ "github.com/golang-migrate/migrate/v4/database/postgres"
+	_ "github.com/golang-migrate/migrate/v4/source/file"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/modules/postgres"
+	pgdriver "gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+
+	"student_go/internal/entity"
+	"student_go/pkg/dbcontext"
+	"student_go/pkg/query"
+)
+
+// Run with: go test -tags integration -run '^$' -bench . ./internal/course
+//
+// The seed has 500 courses with 40 students each, so listing a page of 100
+// courses with their rosters loads 4000 students.
+const (
+	benchCourses           = 500
+	benchStudentsPerCourse = 40
+	benchPageSize          = 100
+)
+
+func setupBenchDB(b *testing.B) {
+	b.Helper()
+	ctx := context.Background()
+
+	ctr, err := postgres.Run(ctx, "postgres:16.0",
+		postgres.WithDatabase("student"),
+		postgres.WithUsername("student"),
+		postgres.WithPassword("student"),
+		postgres.BasicWaitStrategies(),
+	)
+	testcontainers.CleanupContainer(b, ctr)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	dsn, err := ctr.ConnectionString(ctx, "sslmode=disable")
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	m, err := migrate.New("file://../../migrations", dsn)
+	if err != nil {
+		b.Fatal(err)
+	}
+	if err := m.Up(); err != nil && !errors.Is(err, migrate.ErrNoChange) {
+		b.Fatal(err)
+	}
+
+	db, err := gorm.Open(pgdriver.Open(dsn), &gorm.Config{Logger: logger.Default.LogMode(logger.Silent)})
+	if err != nil {
+		b.Fatal(err)
+	}
+	dbcontext.DB = db
+
+	seed := []string{
+		`INSERT INTO teachers (name, email)
+		 SELECT 'Teacher ' || i, 'teacher' || i || '@example.com' FROM generate_series(1, 50) i`,
+		`INSERT INTO courses (title, teacher_id)
+		 SELECT 'Course ' || i, i % 50 + 1 FROM generate_series(1, ?) i`,
+		`INSERT INTO students (name, email, student_number)
+		 SELECT 'Student ' || i, 'student' || i || '@example.com', 'B' || lpad(i::text, 6, '0')
+		 FROM generate_series(1, ?) i`,
+		`INSERT INTO course_student (course_id, student_id)
+		 SELECT c, (c - 1) * ? + s FROM generate_series(1, ?) c, generate_series(1, ?) s`,
+	}
+	args := [][]interface{}{
+		nil,
+		{benchCourses},
+		{benchCourses * benchStudentsPerCourse},
+		{benchStudentsPerCourse, benchCourses, benchStudentsPerCourse},
+	}
+	for i, statement := range seed {
+		if err := db.Exec(statement, args[i]...).Error; err != nil {
+			b.Fatal(err)
+		}
+	}
+	if err := db.Exec("ANALYZE").Error; err != nil {
+		b.Fatal(err)
+	}
+}
+
+func BenchmarkCourseList(b *testing.B) {
+	setupBenchDB(b)
+	repo := NewCourseRepository()
+
+	// The list query before summaries: every roster preloaded, one page.
+	b.Run("PreloadRosters", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			var courses []entity.Course
+			err := dbcontext.DB.
+				Preload("Students").
+				Preload("Teacher").
+				Limit(benchPageSize).
+				Find(&courses).
+				Error
+			if err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+
+	b.Run("Summary", func(b *testing.B) {
+		spec := query.Spec{Include: []string{"teacher"}}
+		for i := 0; i < b.N; i++ {
+			if _, err := repo.FindAll(1, benchPageSize, spec); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+
+	b.Run("RosterPage", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			if _, err := repo.FindStudents(uint(i%benchCourses+1), 1, benchStudentsPerCourse); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
@@ -7,19 +7,42 @@ import (
 	"gorm.io/gorm"
 	"net/http"
 	"strconv"
+	"student_go/internal/actor"
+	"student_go/internal/audit"
+	"student_go/internal/department"
 	"student_go/internal/dto/request"
+	"student_go/internal/dto/response"
+	"student_go/internal/notification"
+	"student_go/internal/qualification"
 	"student_go/internal/teacher"
+	"student_go/internal/workload"
+	"student_go/pkg/etag"
 	"student_go/pkg/log"
 	"student_go/pkg/pagination"
+	"student_go/pkg/patch"
+	"student_go/pkg/query"
 )
 
+// listAttributes are the fields courses lists can be limited to.
+var listAttributes = query.AttributesOf(response.CourseSummaryResponse{})
+
 type Handler struct {
 	Service Service
 }
 
-func NewCourseHandler() *Handler {
+func NewCourseHandler(notifier notification.Notifier) *Handler {
 	return &Handler{
-		Service: NewCourseService(NewCourseRepository(), teacher.NewTeacherRepository()),
+		Service: NewCourseService(
+			NewCourseRepository(),
+			teacher.NewTeacherRepository(),
+			qualification.NewQualificationRepository(),
+			workload.NewWorkloadService(
+				workload.NewWorkloadRepository(),
+				teacher.NewTeacherRepository(),
+				department.NewDepartmentRepository(),
+			),
+			notifier,
+		),
 	}
 }
 
@@ -34,7 +57,7 @@ func (h *Handler) CreateCourse(c *gin.Context) {
 
 	log.Log.Info("CreateCourse called", zap.String("title", req.Title))
 
-	courseResp, err := h.Service.CreateCourse(req)
+	courseResp, err := h.Service.CreateCourse(audit.FromContext(c), req)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to save course"})
 		return
@@ -44,7 +67,7 @@ func (h *Handler) CreateCourse(c *gin.Context) {
 }
 
 func (h *Handler) UpdateCourse(c *gin.Context) {
-	var req request.CourseRequest
+	var req request.CoursePatch
 
 	idParam := c.Param("id")
 	parsedID, err := strconv.ParseUint(idParam, 10, 32)
@@ -55,20 +78,38 @@ func (h *Handler) UpdateCourse(c *gin.Context) {
 	}
 	id := uint(parsedID)
 
-	if err := c.ShouldBindJSON(&req); err != nil {
+	version, err := etag.IfMatch(c.Request)
+	if err != nil {
+		c.JSON(http.StatusPreconditionFailed, gin.H{"error": err.Error()})
+		return
+	}
+
+	fields, err := patch.Bind(c.Request, &req)
+	if err != nil {
 		log.Log.Warn("Invalid request in UpdateCourse", zap.Error(err))
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		if errors.Is(err, patch.ErrUnsupportedMediaType) {
+			c.JSON(http.StatusUnsupportedMediaType, gin.H{"error": err.Error()})
+		} else {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		}
 		return
 	}
 
-	log.Log.Info("UpdateCourse called", zap.Uint("id", id), zap.String("title", req.Title))
+	log.Log.Info("UpdateCourse called", zap.Uint("id", id), zap.Strings("fields", fields.Names()))
 
-	courseResp, err := h.Service.UpdateCourse(id, req)
+	courseResp, err := h.Service.UpdateCourse(id, version, audit.FromContext(c), req, fields)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to update course"})
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "course not found"})
+		} else if errors.Is(err, etag.ErrMismatch) {
+			c.JSON(http.StatusPreconditionFailed, gin.H{"error": err.Error()})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to update course"})
+		}
 		return
 	}
 
+	c.Header(etag.HeaderETag, etag.Format(courseResp.Version, courseResp))
 	c.JSON(http.StatusOK, courseResp)
 }
 
@@ -94,11 +135,36 @@ func (h *Handler) FindCourseById(c *gin.Context) {
 		return
 	}
 
+	tag := etag.Format(courseResp.Version, courseResp)
+	c.Header(etag.HeaderETag, tag)
+	if etag.NoneMatch(c.Request, tag) {
+		c.Status(http.StatusNotModified)
+		return
+	}
+
 	c.JSON(http.StatusOK, courseResp)
 }
 
 func (h *Handler) FindAllCourses(c *gin.Context) {
-	count, err := h.Service.Count()
+	spec, err := query.NewFromRequest(c.Request, listFields, listRelations, listAttributes)
+	if err != nil {
+		log.Log.Warn("Invalid query in FindAllCourses", zap.Error(err))
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if spec.IncludeDeleted {
+		if a, err := actor.FromContext(c); err != nil || !a.IsAdmin() {
+			c.JSON(http.StatusForbidden, gin.H{"error": "only admins can list deleted courses"})
+			return
+		}
+	}
+
+	if pagination.IsCursorRequest(c.Request) {
+		h.findAllCoursesByCursor(c, spec)
+		return
+	}
+
+	count, err := h.Service.Count(spec)
 	if err != nil {
 		log.Log.Error("Failed to count courses", zap.Error(err))
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to count courses"})
@@ -113,13 +179,99 @@ func (h *Handler) FindAllCourses(c *gin.Context) {
 		zap.Int("total_count", pages.TotalCount),
 	)
 
-	courses, err := h.Service.FindAllCourse(pages.Page, pages.PerPage)
+	courses, err := h.Service.FindAllCourse(pages.Page, pages.PerPage, spec)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to get all courses"})
 		return
 	}
 
-	pages.Items = courses
+	items, err := spec.Render(courses, listRelations)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	pages.Items = items
+	c.JSON(http.StatusOK, pages)
+}
+
+// findAllCoursesByCursor serves FindAllCourses with cursor pagination. It only counts
+// the courses when the client asks for include_total.
+func (h *Handler) findAllCoursesByCursor(c *gin.Context, spec query.Spec) {
+	keyset, err := pagination.NewKeysetFromRequest(c.Request)
+	if err != nil {
+		log.Log.Warn("Invalid cursor in FindAllCourses", zap.Error(err))
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if len(spec.Sorts) > 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "sort is not supported with cursor pagination"})
+		return
+	}
+
+	page, err := h.Service.FindAllCourseByCursor(keyset, spec)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to get all courses"})
+		return
+	}
+
+	if c.Query(pagination.TotalVar) == "true" {
+		count, err := h.Service.Count(spec)
+		if err != nil {
+			log.Log.Error("Failed to count courses", zap.Error(err))
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to count courses"})
+			return
+		}
+		page.TotalCount = &count
+	}
+
+	page.Items, err = spec.Render(page.Items, listRelations)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, page)
+}
+
+// FindCourseStudents pages through the roster of one course.
+func (h *Handler) FindCourseStudents(c *gin.Context) {
+	idParam := c.Param("id")
+	parsedID, err := strconv.ParseUint(idParam, 10, 32)
+	if err != nil {
+		log.Log.Warn("Invalid course ID in FindCourseStudents", zap.String("id", idParam), zap.Error(err))
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid course ID"})
+		return
+	}
+	id := uint(parsedID)
+
+	count, err := h.Service.CountCourseStudents(id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "course not found"})
+		} else {
+			log.Log.Error("Failed to count course students", zap.Error(err))
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to count students"})
+		}
+		return
+	}
+
+	pages := pagination.NewFromRequest(c.Request, count)
+
+	log.Log.Info("FindCourseStudents called",
+		zap.Uint("id", id),
+		zap.Int("page", pages.Page),
+		zap.Int("per_page", pages.PerPage),
+		zap.Int("total_count", pages.TotalCount),
+	)
+
+	students, err := h.Service.FindCourseStudents(id, pages.Page, pages.PerPage)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to get students"})
+		return
+	}
+
+	pages.Items = students
 	c.JSON(http.StatusOK, pages)
 }
 
@@ -133,19 +285,63 @@ func (h *Handler) DeleteCourseById(c *gin.Context) {
 	}
 	id := uint(parsedID)
 
-	log.Log.Info("DeleteCourseById called", zap.Uint("id", id))
+	version, err := etag.IfMatch(c.Request)
+	if err != nil {
+		c.JSON(http.StatusPreconditionFailed, gin.H{"error": err.Error()})
+		return
+	}
+
+	log.Log.Info("DeleteCourseById called", zap.Uint("id", id), zap.Uint("version", version))
 
-	err = h.Service.DeleteCourseById(id)
+	err = h.Service.DeleteCourseById(id, version, audit.FromContext(c))
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		if errors.Is(err, etag.ErrMismatch) {
+			c.JSON(http.StatusPreconditionFailed, gin.H{"error": err.Error()})
+		} else if errors.Is(err, gorm.ErrRecordNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "course not found"})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
 		return
 	}
 
 	c.Status(http.StatusNoContent)
 }
 
+// RestoreCourse brings back a deleted course with its roster, as long as
+// the purge job has not removed it yet.
+func (h *Handler) RestoreCourse(c *gin.Context) {
+	idParam := c.Param("id")
+	parsedID, err := strconv.ParseUint(idParam, 10, 32)
+	if err != nil {
+		log.Log.Warn("Invalid course ID in RestoreCourse", zap.String("id", idParam), zap.Error(err))
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid course ID"})
+		return
+	}
+	id := uint(parsedID)
+
+	log.Log.Info("RestoreCourse called", zap.Uint("id", id))
+
+	courseResp, err := h.Service.RestoreCourse(id, audit.FromContext(c))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "deleted course not found"})
+		} else if errors.Is(err, ErrTitleTaken) {
+			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to restore course"})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, courseResp)
+}
+
+// SetTeacherToCourse assigns a teacher to the course. Admins may pass
+// override=true to assign a teacher who is not qualified for the subject or
+// would go over their workload limits.
 func (h *Handler) SetTeacherToCourse(c *gin.Context) {
-	courseIdParam := c.Param("courseId")
+	courseIdParam := c.Param("id")
 	parsedCourseID, err := strconv.ParseUint(courseIdParam, 10, 32)
 	if err != nil {
 		log.Log.Warn("Invalid course ID in SetTeacherToCourse", zap.String("course_id", courseIdParam), zap.Error(err))
@@ -164,15 +360,31 @@ func (h *Handler) SetTeacherToCourse(c *gin.Context) {
 	courseId := uint(parsedCourseID)
 	teacherId := uint(parsedTeacherID)
 
+	override := c.Query("override") == "true"
+	if override {
+		a, err := actor.FromContext(c)
+		if err != nil {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+			return
+		}
+		if !a.IsAdmin() {
+			c.JSON(http.StatusForbidden, gin.H{"error": "only an admin can override assignment checks"})
+			return
+		}
+	}
+
 	log.Log.Info("SetTeacherToCourse called",
 		zap.Uint("course_id", courseId),
 		zap.Uint("teacher_id", teacherId),
+		zap.Bool("override", override),
 	)
 
-	courseResp, err := h.Service.SetTeacherToCourse(courseId, teacherId)
+	courseResp, err := h.Service.SetTeacherToCourse(courseId, teacherId, override, audit.FromContext(c))
 	if err != nil {
 		if err.Error() == "course not found" || err.Error() == "teacher not found" {
 			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		} else if errors.Is(err, ErrNotQualified) || errors.Is(err, workload.ErrLimitExceeded) {
+			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
 		} else {
 			c.JSON(http.StatusInternalServerError, gin.H{"error": "internal server error"})
 		}
@@ -181,3 +393,28 @@ func (h *Handler) SetTeacherToCourse(c *gin.Context) {
 
 	c.JSON(http.StatusOK, courseResp)
 }
+
+func (h *Handler) FindQualifiedTeachers(c *gin.Context) {
+	idParam := c.Param("id")
+	parsedID, err := strconv.ParseUint(idParam, 10, 32)
+	if err != nil {
+		log.Log.Warn("Invalid course ID in FindQualifiedTeachers", zap.String("id", idParam), zap.Error(err))
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid course ID"})
+		return
+	}
+	id := uint(parsedID)
+
+	log.Log.Info("FindQualifiedTeachers called", zap.Uint("id", id))
+
+	teachers, err := h.Service.FindQualifiedTeachers(id)
+	if err != nil {
+		if err.Error() == "course not found" {
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to get qualified teachers"})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, teachers)
+}
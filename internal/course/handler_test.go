@@ -5,11 +5,18 @@ import (
 	"encoding/json"
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
 	"net/http"
 	"net/http/httptest"
+	"student_go/internal/actor"
+	"student_go/internal/audit"
 	"student_go/internal/dto/request"
 	"student_go/internal/dto/response"
 	"student_go/internal/mocks"
+	"student_go/pkg/etag"
+	"student_go/pkg/patch"
+	"student_go/pkg/query"
 	"testing"
 )
 
@@ -25,7 +32,7 @@ func TestCreateCourseHandler(t *testing.T) {
 	r, mockService, handler := setupHandlerTest()
 	input := request.CourseRequest{Title: "Physics"}
 	expected := &response.CourseResponse{ID: 1, Title: "Physics"}
-	mockService.On("CreateCourse", input).Return(expected, nil)
+	mockService.On("CreateCourse", audit.Meta{}, input).Return(expected, nil)
 
 	r.POST("/courses", handler.CreateCourse)
 	body, _ := json.Marshal(input)
@@ -40,13 +47,13 @@ func TestCreateCourseHandler(t *testing.T) {
 
 func TestUpdateCourseHandler(t *testing.T) {
 	r, mockService, handler := setupHandlerTest()
-	input := request.CourseRequest{Title: "Updated"}
+	title := "Updated"
 	expected := &response.CourseResponse{ID: 1, Title: "Updated"}
-	mockService.On("UpdateCourse", uint(1), input).Return(expected, nil)
+	mockService.On("UpdateCourse", uint(1), uint(0), audit.Meta{}, request.CoursePatch{Title: &title}, patch.Fields{"title": true, "teacher": true}).
+		Return(expected, nil)
 
 	r.PATCH("/courses/:id", handler.UpdateCourse)
-	body, _ := json.Marshal(input)
-	req := httptest.NewRequest(http.MethodPatch, "/courses/1", bytes.NewBuffer(body))
+	req := httptest.NewRequest(http.MethodPatch, "/courses/1", bytes.NewBufferString(`{"title":"Updated","teacher":null}`))
 	req.Header.Set("Content-Type", "application/json")
 	resp := httptest.NewRecorder()
 	r.ServeHTTP(resp, req)
@@ -55,6 +62,19 @@ func TestUpdateCourseHandler(t *testing.T) {
 	mockService.AssertExpectations(t)
 }
 
+func TestUpdateCourseHandler_AssignsTeacher(t *testing.T) {
+	r, mockService, handler := setupHandlerTest()
+
+	r.PATCH("/courses/:id", handler.UpdateCourse)
+	req := httptest.NewRequest(http.MethodPatch, "/courses/1", bytes.NewBufferString(`{"teacher":{"id":5}}`))
+	req.Header.Set("Content-Type", patch.ContentType)
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	mockService.AssertNotCalled(t, "UpdateCourse", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
 func TestFindCourseByIdHandler(t *testing.T) {
 	r, mockService, handler := setupHandlerTest()
 	expected := &response.CourseResponse{ID: 2, Title: "Math"}
@@ -69,11 +89,27 @@ func TestFindCourseByIdHandler(t *testing.T) {
 	mockService.AssertExpectations(t)
 }
 
+func TestFindCourseByIdHandler_NotModified(t *testing.T) {
+	r, mockService, handler := setupHandlerTest()
+	course := &response.CourseResponse{ID: 2, Title: "Math", Version: 6}
+	mockService.On("FindCourseById", uint(2)).Return(course, nil)
+
+	r.GET("/courses/:id", handler.FindCourseById)
+	req := httptest.NewRequest(http.MethodGet, "/courses/2", nil)
+	req.Header.Set("If-None-Match", etag.Format(6, course))
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusNotModified, resp.Code)
+	assert.Equal(t, etag.Format(6, course), resp.Header().Get("ETag"))
+	assert.Empty(t, resp.Body.String())
+}
+
 func TestFindAllCoursesHandler(t *testing.T) {
 	r, mockService, handler := setupHandlerTest()
-	courses := []*response.CourseResponse{{ID: 1, Title: "X"}}
-	mockService.On("Count").Return(1, nil)
-	mockService.On("FindAllCourse", 1, 10).Return(courses, nil)
+	courses := []*response.CourseSummaryResponse{{ID: 1, Title: "X", StudentCount: 3}}
+	mockService.On("Count", query.Spec{}).Return(1, nil)
+	mockService.On("FindAllCourse", 1, 10, query.Spec{}).Return(courses, nil)
 
 	r.GET("/courses", handler.FindAllCourses)
 	req := httptest.NewRequest(http.MethodGet, "/courses?page=1&per_page=10", nil)
@@ -84,12 +120,55 @@ func TestFindAllCoursesHandler(t *testing.T) {
 	mockService.AssertExpectations(t)
 }
 
+func TestFindCourseStudentsHandler(t *testing.T) {
+	r, mockService, handler := setupHandlerTest()
+	students := []*response.StudentResponse{{ID: 11, Name: "Alice"}}
+	mockService.On("CountCourseStudents", uint(1)).Return(25, nil)
+	mockService.On("FindCourseStudents", uint(1), 2, 10).Return(students, nil)
+
+	r.GET("/courses/:id/students", handler.FindCourseStudents)
+	req := httptest.NewRequest(http.MethodGet, "/courses/1/students?page=2&per_page=10", nil)
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Contains(t, resp.Body.String(), `"total_count":25`)
+	mockService.AssertExpectations(t)
+}
+
+func TestFindCourseStudentsHandler_NotFound(t *testing.T) {
+	r, mockService, handler := setupHandlerTest()
+	mockService.On("CountCourseStudents", uint(9)).Return(0, gorm.ErrRecordNotFound)
+
+	r.GET("/courses/:id/students", handler.FindCourseStudents)
+	req := httptest.NewRequest(http.MethodGet, "/courses/9/students", nil)
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusNotFound, resp.Code)
+	mockService.AssertNotCalled(t, "FindCourseStudents", mock.Anything, mock.Anything, mock.Anything)
+}
+
 func TestDeleteCourseHandler(t *testing.T) {
 	r, mockService, handler := setupHandlerTest()
-	mockService.On("DeleteCourseById", uint(3)).Return(nil)
+	mockService.On("DeleteCourseById", uint(3), uint(0), audit.Meta{}).Return(nil)
+
+	r.DELETE("/courses/:id", handler.DeleteCourseById)
+	req := httptest.NewRequest(http.MethodDelete, "/courses/3", nil)
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusNoContent, resp.Code)
+	mockService.AssertExpectations(t)
+}
+
+func TestDeleteCourseHandler_IfMatch(t *testing.T) {
+	r, mockService, handler := setupHandlerTest()
+	mockService.On("DeleteCourseById", uint(3), uint(6), audit.Meta{}).Return(nil)
 
 	r.DELETE("/courses/:id", handler.DeleteCourseById)
 	req := httptest.NewRequest(http.MethodDelete, "/courses/3", nil)
+	req.Header.Set("If-Match", `"6"`)
 	resp := httptest.NewRecorder()
 	r.ServeHTTP(resp, req)
 
@@ -97,12 +176,37 @@ func TestDeleteCourseHandler(t *testing.T) {
 	mockService.AssertExpectations(t)
 }
 
+func TestRestoreCourseHandler(t *testing.T) {
+	r, mockService, handler := setupHandlerTest()
+	mockService.On("RestoreCourse", uint(3), audit.Meta{}).Return(&response.CourseResponse{ID: 3, Title: "Physics"}, nil)
+
+	r.POST("/courses/:id/restore", handler.RestoreCourse)
+	req := httptest.NewRequest(http.MethodPost, "/courses/3/restore", nil)
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	mockService.AssertExpectations(t)
+}
+
+func TestRestoreCourseHandler_TitleTaken(t *testing.T) {
+	r, mockService, handler := setupHandlerTest()
+	mockService.On("RestoreCourse", uint(3), audit.Meta{}).Return(nil, ErrTitleTaken)
+
+	r.POST("/courses/:id/restore", handler.RestoreCourse)
+	req := httptest.NewRequest(http.MethodPost, "/courses/3/restore", nil)
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusConflict, resp.Code)
+}
+
 func TestSetTeacherToCourseHandler(t *testing.T) {
 	r, mockService, handler := setupHandlerTest()
 	expected := &response.CourseResponse{ID: 1, Title: "Physics"}
-	mockService.On("SetTeacherToCourse", uint(1), uint(2)).Return(expected, nil)
+	mockService.On("SetTeacherToCourse", uint(1), uint(2), false, audit.Meta{}).Return(expected, nil)
 
-	r.POST("/courses/:courseId/teachers/:teacherId", handler.SetTeacherToCourse)
+	r.POST("/courses/:id/teachers/:teacherId", handler.SetTeacherToCourse)
 	req := httptest.NewRequest(http.MethodPost, "/courses/1/teachers/2", nil)
 	resp := httptest.NewRecorder()
 	r.ServeHTTP(resp, req)
@@ -110,3 +214,48 @@ func TestSetTeacherToCourseHandler(t *testing.T) {
 	assert.Equal(t, http.StatusOK, resp.Code)
 	mockService.AssertExpectations(t)
 }
+
+func TestSetTeacherToCourseHandler_NotQualified(t *testing.T) {
+	r, mockService, handler := setupHandlerTest()
+	mockService.On("SetTeacherToCourse", uint(1), uint(2), false, audit.Meta{}).Return(nil, ErrNotQualified)
+
+	r.POST("/courses/:id/teachers/:teacherId", handler.SetTeacherToCourse)
+	req := httptest.NewRequest(http.MethodPost, "/courses/1/teachers/2", nil)
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusConflict, resp.Code)
+}
+
+func TestSetTeacherToCourseHandler_OverrideByAdmin(t *testing.T) {
+	r, mockService, handler := setupHandlerTest()
+	expected := &response.CourseResponse{ID: 1, Title: "Physics"}
+	adminId := uint(1)
+	meta := audit.Meta{ActorID: &adminId, ActorRole: "admin", RequestID: "req-1"}
+	mockService.On("SetTeacherToCourse", uint(1), uint(2), true, meta).Return(expected, nil)
+
+	r.POST("/courses/:id/teachers/:teacherId", handler.SetTeacherToCourse)
+	req := httptest.NewRequest(http.MethodPost, "/courses/1/teachers/2?override=true", nil)
+	req.Header.Set(actor.HeaderUserID, "1")
+	req.Header.Set(actor.HeaderUserRole, "admin")
+	req.Header.Set(audit.HeaderRequestID, "req-1")
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	mockService.AssertExpectations(t)
+}
+
+func TestSetTeacherToCourseHandler_OverrideRequiresAdmin(t *testing.T) {
+	r, mockService, handler := setupHandlerTest()
+
+	r.POST("/courses/:id/teachers/:teacherId", handler.SetTeacherToCourse)
+	req := httptest.NewRequest(http.MethodPost, "/courses/1/teachers/2?override=true", nil)
+	req.Header.Set(actor.HeaderUserID, "2")
+	req.Header.Set(actor.HeaderUserRole, "teacher")
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusForbidden, resp.Code)
+	mockService.AssertNotCalled(t, "SetTeacherToCourse", mock.Anything, mock.Anything, mock.Anything)
+}
@@ -1,18 +1,59 @@
 package course
 
 import (
+	"errors"
+	"gorm.io/gorm"
+	"student_go/internal/audit"
 	"student_go/internal/entity"
 	"student_go/pkg/dbcontext"
+	"student_go/pkg/etag"
+	"student_go/pkg/pagination"
+	"student_go/pkg/query"
 )
 
 type Repository interface {
 	ExistsById(id uint) (bool, error)
-	Save(course *entity.Course) (*entity.Course, error)
-	Update(course *entity.Course) (*entity.Course, error)
+	// Save, Update, DeleteById and Restore record the change in the audit
+	// log in the same transaction.
+	Save(course *entity.Course, meta audit.Meta) (*entity.Course, error)
+	// Update sets the given columns only and bumps the version. It fails
+	// with etag.ErrMismatch when version is not zero and the row has
+	// another one.
+	Update(id uint, version uint, changes map[string]interface{}, meta audit.Meta) (*entity.Course, error)
 	FindById(id uint) (*entity.Course, error)
-	FindAll(page, limit int) ([]entity.Course, error)
-	DeleteById(id uint) error
-	Count() (int, error)
+	// FindAll and Count only return the rows matching spec.
+	FindAll(page, limit int, spec query.Spec) ([]entity.Course, error)
+	// FindAllByCursor returns the rows selected by keyset.Apply; pass them
+	// through pagination.Slice to get the page.
+	FindAllByCursor(keyset pagination.Keyset, spec query.Spec) ([]entity.Course, error)
+	// DeleteById soft-deletes the course and keeps its enrollments; Restore
+	// undoes it until the purge job removes the row. A version that is not
+	// zero must match the row's.
+	DeleteById(id uint, version uint, meta audit.Meta) error
+	Restore(id uint, meta audit.Meta) (bool, error)
+	Count(spec query.Spec) (int, error)
+	// FindStudents and CountStudents page through the course roster.
+	FindStudents(courseId uint, page, limit int) ([]entity.Student, error)
+	CountStudents(courseId uint) (int, error)
+	HasTeacher(courseId uint, teacherId uint) (bool, error)
+	HasStudent(courseId uint, studentId uint) (bool, error)
+	IsStudentTaughtBy(studentId uint, teacherId uint) (bool, error)
+}
+
+// uniqueViolation is the Postgres SQLSTATE for a duplicate course title.
+const uniqueViolation = "23505"
+
+// listFields are the fields courses can be filtered and sorted by.
+var listFields = query.Schema{
+	"id":         {Column: "id", Type: query.Int},
+	"title":      {Column: "title", Type: query.String},
+	"subject":    {Column: "subject", Type: query.String},
+	"teacher_id": {Column: "teacher_id", Type: query.Int, Nullable: true},
+}
+
+// listRelations are the relations courses lists can include.
+var listRelations = query.Relations{
+	"teacher": "Teacher",
 }
 
 type repository struct{}
@@ -33,17 +74,26 @@ func (r *repository) ExistsById(id uint) (bool, error) {
 	return exists, err
 }
 
-func (r *repository) Save(course *entity.Course) (*entity.Course, error) {
-	err := dbcontext.DB.Create(course).Error
+func (r *repository) Save(course *entity.Course, meta audit.Meta) (*entity.Course, error) {
+	err := audit.Create(dbcontext.DB, meta, course)
 	return course, err
 }
 
-func (r *repository) Update(course *entity.Course) (*entity.Course, error) {
-	err := dbcontext.DB.Model(&entity.Course{}).
-		Where("id = ?", course.ID).
-		Updates(map[string]interface{}{
-			"title": course.Title,
-		}).Error
+func (r *repository) Update(id uint, version uint, changes map[string]interface{}, meta audit.Meta) (*entity.Course, error) {
+	err := audit.Track(dbcontext.DB, meta, &entity.Course{}, id, entity.AuditUpdate, func(tx *gorm.DB) error {
+		current, err := etag.Check(tx, &entity.Course{}, id, version)
+		if err != nil {
+			return err
+		}
+
+		columns := make(map[string]interface{}, len(changes)+1)
+		for column, value := range changes {
+			columns[column] = value
+		}
+		columns["version"] = current + 1
+
+		return tx.Model(&entity.Course{}).Where("id = ?", id).Updates(columns).Error
+	})
 
 	if err != nil {
 		return nil, err
@@ -52,9 +102,8 @@ func (r *repository) Update(course *entity.Course) (*entity.Course, error) {
 	var updated *entity.Course
 
 	err = dbcontext.DB.
-		Preload("Students").
 		Preload("Teacher").
-		First(&updated, course.ID).Error
+		First(&updated, id).Error
 
 	if err != nil {
 		return nil, err
@@ -66,7 +115,6 @@ func (r *repository) Update(course *entity.Course) (*entity.Course, error) {
 func (r *repository) FindById(id uint) (*entity.Course, error) {
 	var course entity.Course
 	result := dbcontext.DB.
-		Preload("Students").
 		Preload("Teacher").
 		First(&course, id)
 
@@ -77,14 +125,14 @@ func (r *repository) FindById(id uint) (*entity.Course, error) {
 	return &course, nil
 }
 
-func (r *repository) FindAll(page, limit int) ([]entity.Course, error) {
+func (r *repository) FindAll(page, limit int, spec query.Spec) ([]entity.Course, error) {
 	var courses []entity.Course
 
 	offset := (page - 1) * limit
 
-	result := dbcontext.DB.
-		Preload("Students").
-		Preload("Teacher").
+	db := withStudentCount(spec.Apply(dbcontext.DB))
+	result := spec.Preload(db, listRelations).
+		Limit(limit).
 		Offset(offset).
 		Find(&courses)
 
@@ -95,14 +143,137 @@ func (r *repository) FindAll(page, limit int) ([]entity.Course, error) {
 	return courses, nil
 }
 
-func (r *repository) DeleteById(id uint) error {
-	result := dbcontext.DB.Delete(&entity.Course{}, id)
+func (r *repository) FindAllByCursor(keyset pagination.Keyset, spec query.Spec) ([]entity.Course, error) {
+	var courses []entity.Course
+
+	db := withStudentCount(keyset.Apply(spec.Where(dbcontext.DB)))
+	result := spec.Preload(db, listRelations).
+		Find(&courses)
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return courses, nil
+}
+
+// withStudentCount fills Course.StudentCount in SQL instead of loading the
+// rosters.
+func withStudentCount(db *gorm.DB) *gorm.DB {
+	return db.Select(`"courses".*, (SELECT count(*) FROM course_student
+		JOIN students ON students.id = course_student.student_id AND students.deleted_at IS NULL
+		WHERE course_student.course_id = "courses"."id") AS student_count`)
+}
+
+func (r *repository) DeleteById(id uint, version uint, meta audit.Meta) error {
+	return audit.Track(dbcontext.DB, meta, &entity.Course{}, id, entity.AuditDelete, func(tx *gorm.DB) error {
+		if version != 0 {
+			if _, err := etag.Check(tx, &entity.Course{}, id, version); err != nil {
+				return err
+			}
+		}
+		return tx.Delete(&entity.Course{}, id).Error
+	})
+}
+
+// Restore reports false when no deleted course has the ID.
+func (r *repository) Restore(id uint, meta audit.Meta) (bool, error) {
+	restored := false
+	err := audit.Track(dbcontext.DB, meta, &entity.Course{}, id, entity.AuditRestore, func(tx *gorm.DB) error {
+		result := tx.
+			Unscoped().
+			Model(&entity.Course{}).
+			Where("id = ? AND deleted_at IS NOT NULL", id).
+			Update("deleted_at", nil)
+		restored = result.RowsAffected > 0
+		return result.Error
+	})
 
-	return result.Error
+	if isViolation(err, uniqueViolation) {
+		return false, ErrTitleTaken
+	}
+
+	return restored, err
 }
 
-func (r *repository) Count() (int, error) {
+func (r *repository) Count(spec query.Spec) (int, error) {
 	var count int64
-	err := dbcontext.DB.Model(&entity.Course{}).Count(&count).Error
+	err := spec.Where(dbcontext.DB.Model(&entity.Course{})).Count(&count).Error
 	return int(count), err
 }
+
+func (r *repository) FindStudents(courseId uint, page, limit int) ([]entity.Student, error) {
+	var students []entity.Student
+
+	offset := (page - 1) * limit
+
+	result := dbcontext.DB.
+		Joins("JOIN course_student ON course_student.student_id = students.id").
+		Where("course_student.course_id = ?", courseId).
+		Order("students.id").
+		Limit(limit).
+		Offset(offset).
+		Find(&students)
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return students, nil
+}
+
+func (r *repository) CountStudents(courseId uint) (int, error) {
+	var count int64
+	err := dbcontext.DB.
+		Model(&entity.Student{}).
+		Joins("JOIN course_student ON course_student.student_id = students.id").
+		Where("course_student.course_id = ?", courseId).
+		Count(&count).
+		Error
+
+	return int(count), err
+}
+
+func (r *repository) HasTeacher(courseId uint, teacherId uint) (bool, error) {
+	var exists bool
+	err := dbcontext.DB.
+		Model(&entity.Course{}).
+		Select("count(*) > 0").
+		Where("id = ? AND teacher_id = ?", courseId, teacherId).
+		Find(&exists).
+		Error
+
+	return exists, err
+}
+
+func (r *repository) HasStudent(courseId uint, studentId uint) (bool, error) {
+	var exists bool
+	err := dbcontext.DB.
+		Table("course_student").
+		Select("count(*) > 0").
+		Where("course_id = ? AND student_id = ?", courseId, studentId).
+		Find(&exists).
+		Error
+
+	return exists, err
+}
+
+// IsStudentTaughtBy reports whether the student is enrolled in any course
+// taught by the teacher.
+func (r *repository) IsStudentTaughtBy(studentId uint, teacherId uint) (bool, error) {
+	var exists bool
+	err := dbcontext.DB.
+		Table("course_student").
+		Select("count(*) > 0").
+		Joins("JOIN courses ON courses.id = course_student.course_id").
+		Where("course_student.student_id = ? AND courses.teacher_id = ? AND courses.deleted_at IS NULL", studentId, teacherId).
+		Find(&exists).
+		Error
+
+	return exists, err
+}
+
+func isViolation(err error, code string) bool {
+	var state interface{ SQLState() string }
+	return errors.As(err, &state) && state.SQLState() == code
+}
@@ -7,13 +7,17 @@ import (
 	"github.com/stretchr/testify/require"
 	"regexp"
 	"testing"
+	"time"
 
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
 	"gorm.io/gorm/logger"
 
+	"student_go/internal/audit"
 	"student_go/internal/entity"
 	"student_go/pkg/dbcontext"
+	"student_go/pkg/etag"
+	"student_go/pkg/query"
 )
 
 func setupTestDB(t *testing.T) (*sql.DB, sqlmock.Sqlmock, *gorm.DB) {
@@ -54,14 +58,18 @@ func TestCourseSave(t *testing.T) {
 	defer db.Close()
 
 	mock.ExpectBegin()
-	mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "courses" ("title","teacher_id") VALUES ($1,$2) RETURNING "id"`)).
-		WithArgs("Math", nil).
+	mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "courses" ("title","subject","teacher_id","version","deleted_at") VALUES ($1,$2,$3,$4,$5) RETURNING "id"`)).
+		WithArgs("Math", "MATH", nil, 1, nil).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "audit_entries" ("entity","entity_id","action","actor_id","actor_role","request_id","before","after","created_at")`)).
+		WithArgs("course", 1, "create", nil, "", "", nil,
+			`{"deleted_at":null,"id":1,"subject":"MATH","teacher_id":null,"title":"Math","version":1}`, sqlmock.AnyArg()).
 		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
 	mock.ExpectCommit()
 
 	repo := NewCourseRepository()
-	course := &entity.Course{Title: "Math"}
-	result, err := repo.Save(course)
+	course := &entity.Course{Title: "Math", Subject: "MATH"}
+	result, err := repo.Save(course, audit.Meta{})
 
 	assert.NoError(t, err)
 	assert.Equal(t, "Math", result.Title)
@@ -71,23 +79,11 @@ func TestCourseFindById(t *testing.T) {
 	db, mock, _ := setupTestDB(t)
 	defer db.Close()
 
-	mock.ExpectQuery(`SELECT \* FROM "courses" WHERE "courses"\."id" = \$1 ORDER BY "courses"\."id" LIMIT .*`).
+	mock.ExpectQuery(`SELECT \* FROM "courses" WHERE "courses"\."id" = \$1 AND "courses"\."deleted_at" IS NULL ORDER BY "courses"\."id" LIMIT .*`).
 		WithArgs(1, 1).
 		WillReturnRows(sqlmock.NewRows([]string{"id", "title", "teacher_id"}).
 			AddRow(1, "Math", 101))
 
-	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "course_student" WHERE "course_student"."course_id" = $1`)).
-		WithArgs(1).
-		WillReturnRows(sqlmock.NewRows([]string{"course_id", "student_id"}).
-			AddRow(1, 1).
-			AddRow(1, 2))
-
-	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "students" WHERE "students"."id" IN ($1,$2)`)).
-		WithArgs(1, 2).
-		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "email"}).
-			AddRow(1, "Alice", "alice@example.com").
-			AddRow(2, "Bob", "bob@example.com"))
-
 	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "teachers" WHERE "teachers"."id" = $1`)).
 		WithArgs(101).
 		WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).
@@ -99,10 +95,9 @@ func TestCourseFindById(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, course)
 	assert.Equal(t, "Math", course.Title)
-	require.Len(t, course.Students, 2)
-	assert.Equal(t, "Alice", course.Students[0].Name)
-	assert.Equal(t, "Bob", course.Students[1].Name)
+	assert.Nil(t, course.Students)
 	assert.Equal(t, "Dr. Smith", course.Teacher.Name)
+	assert.NoError(t, mock.ExpectationsWereMet())
 }
 
 func TestCourseUpdate(t *testing.T) {
@@ -110,41 +105,63 @@ func TestCourseUpdate(t *testing.T) {
 	defer db.Close()
 
 	mock.ExpectBegin()
-	mock.ExpectExec(regexp.QuoteMeta(`UPDATE "courses" SET "title"=$1 WHERE id = $2`)).
-		WithArgs("Updated Title", 1).
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "courses" WHERE "courses"."id" = $1 LIMIT $2 FOR UPDATE`)).
+		WithArgs(1, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "title", "subject", "teacher_id", "version"}).
+			AddRow(1, "Old Title", "PHYS", 101, 3))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT "version" FROM "courses" WHERE id = $1 AND "courses"."deleted_at" IS NULL`)).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"version"}).AddRow(3))
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE "courses" SET "title"=$1,"version"=$2 WHERE id = $3`)).
+		WithArgs("Updated Title", 4, 1).
 		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "courses" WHERE "courses"."id" = $1 LIMIT $2`)).
+		WithArgs(1, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "title", "subject", "teacher_id", "version"}).
+			AddRow(1, "Updated Title", "PHYS", 101, 4))
+	mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "audit_entries"`)).
+		WithArgs("course", 1, "update", nil, "", "", `{"title":"Old Title","version":3}`, `{"title":"Updated Title","version":4}`, sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
 	mock.ExpectCommit()
 
-	mock.ExpectQuery(`SELECT \* FROM "courses" WHERE "courses"\."id" = \$1 ORDER BY "courses"\."id" LIMIT .*`).
+	mock.ExpectQuery(`SELECT \* FROM "courses" WHERE "courses"\."id" = \$1 AND "courses"\."deleted_at" IS NULL ORDER BY "courses"\."id" LIMIT .*`).
 		WithArgs(1, 1).
 		WillReturnRows(sqlmock.NewRows([]string{"id", "title", "teacher_id"}).
 			AddRow(1, "Updated Title", 101))
 
-	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "course_student" WHERE "course_student"."course_id" = $1`)).
-		WithArgs(1).
-		WillReturnRows(sqlmock.NewRows([]string{"course_id", "student_id"}).
-			AddRow(1, 1))
-
-	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "students" WHERE "students"."id" = $1`)).
-		WithArgs(1).
-		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "email"}).
-			AddRow(1, "Alice", "alice@example.com"))
-
 	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "teachers" WHERE "teachers"."id" = $1`)).
 		WithArgs(101).
 		WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).
 			AddRow(101, "Dr. Smith"))
 
 	repo := NewCourseRepository()
-	c := &entity.Course{ID: 1, Title: "Updated Title"}
-	updated, err := repo.Update(c)
+	updated, err := repo.Update(1, 3, map[string]interface{}{"title": "Updated Title"}, audit.Meta{})
 
 	require.NoError(t, err)
 	require.NotNil(t, updated)
 	assert.Equal(t, "Updated Title", updated.Title)
 	assert.Equal(t, "Dr. Smith", updated.Teacher.Name)
-	assert.Len(t, updated.Students, 1)
-	assert.Equal(t, "Alice", updated.Students[0].Name)
+	assert.Nil(t, updated.Students)
+}
+
+func TestCourseUpdate_VersionMismatch(t *testing.T) {
+	db, mock, _ := setupTestDB(t)
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "courses" WHERE "courses"."id" = $1 LIMIT $2 FOR UPDATE`)).
+		WithArgs(1, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "title", "version"}).AddRow(1, "Old Title", 4))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT "version" FROM "courses" WHERE id = $1`)).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"version"}).AddRow(4))
+	mock.ExpectRollback()
+
+	repo := NewCourseRepository()
+	_, err := repo.Update(1, 3, map[string]interface{}{"title": "Updated Title"}, audit.Meta{})
+
+	assert.ErrorIs(t, err, etag.ErrMismatch)
+	assert.NoError(t, mock.ExpectationsWereMet())
 }
 
 func TestCourseFindAll(t *testing.T) {
@@ -154,22 +171,11 @@ func TestCourseFindAll(t *testing.T) {
 	page := 1
 	limit := 2
 
-	mock.ExpectQuery(`SELECT \* FROM "courses"`).
-		WillReturnRows(sqlmock.NewRows([]string{"id", "title", "teacher_id"}).
-			AddRow(1, "Math", 101).
-			AddRow(2, "Physics", 102))
-
-	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "course_student" WHERE "course_student"."course_id" IN ($1,$2)`)).
-		WithArgs(1, 2).
-		WillReturnRows(sqlmock.NewRows([]string{"course_id", "student_id"}).
-			AddRow(1, 1).
-			AddRow(2, 2))
-
-	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "students" WHERE "students"."id" IN ($1,$2)`)).
-		WithArgs(1, 2).
-		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "email"}).
-			AddRow(1, "Alice", "alice@example.com").
-			AddRow(2, "Bob", "bob@example.com"))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT "courses".*, (SELECT count(*) FROM course_student JOIN students ON students.id = course_student.student_id AND students.deleted_at IS NULL WHERE course_student.course_id = "courses"."id") AS student_count FROM "courses" WHERE "courses"."deleted_at" IS NULL ORDER BY "courses"."id" LIMIT $1`)).
+		WithArgs(limit).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "title", "teacher_id", "student_count"}).
+			AddRow(1, "Math", 101, 30).
+			AddRow(2, "Physics", 102, 0))
 
 	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "teachers" WHERE "teachers"."id" IN ($1,$2)`)).
 		WithArgs(101, 102).
@@ -178,7 +184,7 @@ func TestCourseFindAll(t *testing.T) {
 			AddRow(102, "Prof. Jane"))
 
 	repo := NewCourseRepository()
-	courses, err := repo.FindAll(page, limit)
+	courses, err := repo.FindAll(page, limit, query.Spec{Include: []string{"teacher"}})
 
 	require.NoError(t, err)
 	require.Len(t, courses, 2)
@@ -186,22 +192,96 @@ func TestCourseFindAll(t *testing.T) {
 	assert.Equal(t, "Physics", courses[1].Title)
 	assert.Equal(t, "Dr. Smith", courses[0].Teacher.Name)
 	assert.Equal(t, "Prof. Jane", courses[1].Teacher.Name)
+	assert.Equal(t, 30, courses[0].StudentCount)
+	assert.Nil(t, courses[0].Students)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCourseFindStudents(t *testing.T) {
+	db, mock, _ := setupTestDB(t)
+	defer db.Close()
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT "students"."id","students"."student_number","students"."name","students"."preferred_name","students"."pronouns","students"."email","students"."phone","students"."locale","students"."date_of_birth","students"."version","students"."deleted_at" FROM "students" JOIN course_student ON course_student.student_id = students.id WHERE course_student.course_id = $1 AND "students"."deleted_at" IS NULL ORDER BY students.id LIMIT $2 OFFSET $3`)).
+		WithArgs(1, 10, 10).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).
+			AddRow(11, "Alice"))
+
+	repo := NewCourseRepository()
+	students, err := repo.FindStudents(1, 2, 10)
+
+	require.NoError(t, err)
+	require.Len(t, students, 1)
+	assert.Equal(t, "Alice", students[0].Name)
+}
+
+func TestCourseCountStudents(t *testing.T) {
+	db, mock, _ := setupTestDB(t)
+	defer db.Close()
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "students" JOIN course_student ON course_student.student_id = students.id WHERE course_student.course_id = $1 AND "students"."deleted_at" IS NULL`)).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(25))
+
+	repo := NewCourseRepository()
+	count, err := repo.CountStudents(1)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 25, count)
 }
 
 func TestCourseDeleteById(t *testing.T) {
 	db, mock, _ := setupTestDB(t)
 	defer db.Close()
 
+	deletedAt := time.Date(2024, 5, 1, 9, 0, 0, 0, time.UTC)
+	actorId := uint(7)
+	meta := audit.Meta{ActorID: &actorId, ActorRole: "admin", RequestID: "req-1"}
+
 	mock.ExpectBegin()
-	mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "courses" WHERE "courses"."id" = $1`)).
-		WithArgs(1).
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "courses" WHERE "courses"."id" = $1 LIMIT $2 FOR UPDATE`)).
+		WithArgs(1, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "title", "deleted_at"}).AddRow(1, "Math", nil))
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE "courses" SET "deleted_at"=$1 WHERE "courses"."id" = $2 AND "courses"."deleted_at" IS NULL`)).
+		WithArgs(sqlmock.AnyArg(), 1).
 		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "courses" WHERE "courses"."id" = $1 LIMIT $2`)).
+		WithArgs(1, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "title", "deleted_at"}).AddRow(1, "Math", deletedAt))
+	mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "audit_entries"`)).
+		WithArgs("course", 1, "delete", 7, "admin", "req-1",
+			`{"deleted_at":null}`, `{"deleted_at":"2024-05-01T09:00:00Z"}`, sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectCommit()
+
+	repo := NewCourseRepository()
+	err := repo.DeleteById(1, 0, meta)
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCourseRestore(t *testing.T) {
+	db, mock, _ := setupTestDB(t)
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "courses" WHERE "courses"."id" = $1 LIMIT $2 FOR UPDATE`)).
+		WithArgs(1, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "title", "deleted_at"}).AddRow(1, "Math", nil))
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE "courses" SET "deleted_at"=$1 WHERE id = $2 AND deleted_at IS NOT NULL`)).
+		WithArgs(nil, 1).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "courses" WHERE "courses"."id" = $1 LIMIT $2`)).
+		WithArgs(1, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "title", "deleted_at"}).AddRow(1, "Math", nil))
+	// The course was not deleted, so there is nothing to record.
 	mock.ExpectCommit()
 
 	repo := NewCourseRepository()
-	err := repo.DeleteById(1)
+	restored, err := repo.Restore(1, audit.Meta{})
 
 	assert.NoError(t, err)
+	assert.False(t, restored)
 }
 
 func TestCourseCount(t *testing.T) {
@@ -212,8 +292,53 @@ func TestCourseCount(t *testing.T) {
 		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
 
 	repo := NewCourseRepository()
-	count, err := repo.Count()
+	count, err := repo.Count(query.Spec{})
 
 	assert.NoError(t, err)
 	assert.Equal(t, 2, count)
 }
+
+func TestCourseHasTeacher(t *testing.T) {
+	db, mock, _ := setupTestDB(t)
+	defer db.Close()
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) > 0 FROM "courses" WHERE (id = $1 AND teacher_id = $2) AND "courses"."deleted_at" IS NULL`)).
+		WithArgs(1, 2).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(true))
+
+	repo := NewCourseRepository()
+	ok, err := repo.HasTeacher(1, 2)
+
+	assert.NoError(t, err)
+	assert.True(t, ok)
+}
+
+func TestCourseHasStudent(t *testing.T) {
+	db, mock, _ := setupTestDB(t)
+	defer db.Close()
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) > 0 FROM "course_student" WHERE course_id = $1 AND student_id = $2`)).
+		WithArgs(1, 3).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(false))
+
+	repo := NewCourseRepository()
+	ok, err := repo.HasStudent(1, 3)
+
+	assert.NoError(t, err)
+	assert.False(t, ok)
+}
+
+func TestCourseIsStudentTaughtBy(t *testing.T) {
+	db, mock, _ := setupTestDB(t)
+	defer db.Close()
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) > 0 FROM "course_student" JOIN courses ON courses.id = course_student.course_id WHERE course_student.student_id = $1 AND courses.teacher_id = $2`)).
+		WithArgs(3, 2).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(true))
+
+	repo := NewCourseRepository()
+	ok, err := repo.IsStudentTaughtBy(3, 2)
+
+	assert.NoError(t, err)
+	assert.True(t, ok)
+}
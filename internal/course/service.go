@@ -1,69 +1,133 @@
 package course
 
 import (
+	"errors"
 	"fmt"
 	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"sort"
+	"student_go/internal/audit"
 	"student_go/internal/dto/request"
 	response3 "student_go/internal/dto/response"
 	"student_go/internal/entity"
+	"student_go/internal/notification"
+	"student_go/internal/qualification"
 	"student_go/internal/teacher"
+	"student_go/internal/workload"
 	"student_go/pkg/dbcontext"
+	"student_go/pkg/etag"
 	"student_go/pkg/log"
+	"student_go/pkg/pagination"
+	"student_go/pkg/patch"
+	"student_go/pkg/query"
+	"time"
+)
+
+var (
+	ErrNotQualified = errors.New("teacher is not qualified to teach this course")
+	ErrTitleTaken   = errors.New("another course has the same title")
 )
 
 type Service interface {
-	CreateCourse(input request.CourseRequest) (*response3.CourseResponse, error)
-	UpdateCourse(id uint, input request.CourseRequest) (*response3.CourseResponse, error)
+	CreateCourse(meta audit.Meta, input request.CourseRequest) (*response3.CourseResponse, error)
+	// UpdateCourse and DeleteCourseById fail with etag.ErrMismatch when
+	// version is not zero and the course has another one.
+	// UpdateCourse changes the members the patch sets only; setting the
+	// teacher to null unassigns them.
+	UpdateCourse(id uint, version uint, meta audit.Meta, input request.CoursePatch, fields patch.Fields) (*response3.CourseResponse, error)
 	FindCourseById(id uint) (*response3.CourseResponse, error)
-	FindAllCourse(page, limit int) ([]*response3.CourseResponse, error)
-	DeleteCourseById(id uint) error
-	SetTeacherToCourse(courseId uint, teacherId uint) (*response3.CourseResponse, error)
-	Count() (int, error)
+	FindAllCourse(page, limit int, spec query.Spec) ([]*response3.CourseSummaryResponse, error)
+	FindAllCourseByCursor(keyset pagination.Keyset, spec query.Spec) (*pagination.CursorPage, error)
+	DeleteCourseById(id uint, version uint, meta audit.Meta) error
+	RestoreCourse(id uint, meta audit.Meta) (*response3.CourseResponse, error)
+	// SetTeacherToCourse assigns the teacher if they are qualified for the
+	// course subject and within their workload limits; override skips both
+	// checks.
+	SetTeacherToCourse(courseId uint, teacherId uint, override bool, meta audit.Meta) (*response3.CourseResponse, error)
+	FindQualifiedTeachers(courseId uint) ([]*response3.QualifiedTeacherResponse, error)
+	Count(spec query.Spec) (int, error)
+	FindCourseStudents(courseId uint, page, limit int) ([]*response3.StudentResponse, error)
+	// CountCourseStudents returns gorm.ErrRecordNotFound for an unknown
+	// course.
+	CountCourseStudents(courseId uint) (int, error)
 }
 
 type service struct {
-	courseRepository  Repository
-	teacherRepository teacher.Repository
+	courseRepository        Repository
+	teacherRepository       teacher.Repository
+	qualificationRepository qualification.Repository
+	workloadService         workload.Service
+	notifier                notification.Notifier
+	now                     func() time.Time
 }
 
 func NewCourseService(
 	courseRepository Repository,
-	teacherRepository teacher.Repository) Service {
+	teacherRepository teacher.Repository,
+	qualificationRepository qualification.Repository,
+	workloadService workload.Service,
+	notifier notification.Notifier) Service {
 	return &service{
-		courseRepository:  courseRepository,
-		teacherRepository: teacherRepository,
+		courseRepository:        courseRepository,
+		teacherRepository:       teacherRepository,
+		qualificationRepository: qualificationRepository,
+		workloadService:         workloadService,
+		notifier:                notifier,
+		now:                     time.Now,
 	}
 }
 
-func (s *service) CreateCourse(input request.CourseRequest) (*response3.CourseResponse, error) {
+func (s *service) CreateCourse(meta audit.Meta, input request.CourseRequest) (*response3.CourseResponse, error) {
 	log.Log.Info("CreateCourse (service) called", zap.String("title", input.Title))
 
 	course := entity.Course{
-		Title: input.Title,
+		Title:   input.Title,
+		Subject: qualification.NormalizeSubject(input.Subject),
 	}
-	savedCourse, err := s.courseRepository.Save(&course)
+	savedCourse, err := s.courseRepository.Save(&course, meta)
 	if err != nil {
 		return nil, err
 	}
 
 	resp := &response3.CourseResponse{
-		ID:    savedCourse.ID,
-		Title: savedCourse.Title,
+		ID:      savedCourse.ID,
+		Title:   savedCourse.Title,
+		Subject: savedCourse.Subject,
+		Version: savedCourse.Version,
 	}
 	return resp, nil
 }
 
-func (s *service) UpdateCourse(id uint, input request.CourseRequest) (*response3.CourseResponse, error) {
+func (s *service) UpdateCourse(id uint, version uint, meta audit.Meta, input request.CoursePatch, fields patch.Fields) (*response3.CourseResponse, error) {
 	log.Log.Info("UpdateCourse (service) called",
 		zap.Uint("id", id),
-		zap.String("title", input.Title),
+		zap.Strings("fields", fields.Names()),
 	)
 
-	course := entity.Course{
-		ID:    id,
-		Title: input.Title,
+	// An empty patch changes nothing, so the version is left alone.
+	if len(fields) == 0 {
+		current, err := s.FindCourseById(id)
+		if err != nil {
+			return nil, err
+		}
+		if version != 0 && current.Version != version {
+			return nil, etag.ErrMismatch
+		}
+		return current, nil
+	}
+
+	changes := make(map[string]interface{})
+	if fields.Has("title") {
+		changes["title"] = patch.Value(input.Title)
+	}
+	if fields.Has("subject") {
+		changes["subject"] = qualification.NormalizeSubject(patch.Value(input.Subject))
 	}
-	updatedCourse, err := s.courseRepository.Update(&course)
+	if fields.Has("teacher") {
+		changes["teacher_id"] = nil
+	}
+	updatedCourse, err := s.courseRepository.Update(id, version, changes, meta)
 	if err != nil {
 		return nil, err
 	}
@@ -71,25 +135,18 @@ func (s *service) UpdateCourse(id uint, input request.CourseRequest) (*response3
 	var teacherResp *response3.TeacherResponse
 	if updatedCourse.Teacher != nil {
 		teacherResp = &response3.TeacherResponse{
-			ID:   updatedCourse.Teacher.ID,
-			Name: updatedCourse.Teacher.Name,
+			ID:    updatedCourse.Teacher.ID,
+			Name:  updatedCourse.Teacher.Name,
+			Email: updatedCourse.Teacher.Email,
 		}
 	}
 
-	studentsResp := make([]response3.StudentResponse, 0, len(updatedCourse.Students))
-	for _, student := range updatedCourse.Students {
-		studentsResp = append(studentsResp, response3.StudentResponse{
-			ID:    student.ID,
-			Name:  student.Name,
-			Email: student.Email,
-		})
-	}
-
 	courseResp := &response3.CourseResponse{
-		ID:       course.ID,
-		Title:    course.Title,
-		Teacher:  teacherResp,
-		Students: studentsResp,
+		ID:      updatedCourse.ID,
+		Title:   updatedCourse.Title,
+		Subject: updatedCourse.Subject,
+		Teacher: teacherResp,
+		Version: updatedCourse.Version,
 	}
 	return courseResp, nil
 }
@@ -105,77 +162,83 @@ func (s *service) FindCourseById(id uint) (*response3.CourseResponse, error) {
 	var teacherResp *response3.TeacherResponse
 	if course.Teacher != nil {
 		teacherResp = &response3.TeacherResponse{
-			ID:   course.Teacher.ID,
-			Name: course.Teacher.Name,
+			ID:    course.Teacher.ID,
+			Name:  course.Teacher.Name,
+			Email: course.Teacher.Email,
 		}
 	}
 
-	studentsResp := make([]response3.StudentResponse, 0, len(course.Students))
-	for _, student := range course.Students {
-		studentsResp = append(studentsResp, response3.StudentResponse{
-			ID:    student.ID,
-			Name:  student.Name,
-			Email: student.Email,
-		})
-	}
-
 	courseResp := &response3.CourseResponse{
-		ID:       course.ID,
-		Title:    course.Title,
-		Teacher:  teacherResp,
-		Students: studentsResp,
+		ID:      course.ID,
+		Title:   course.Title,
+		Subject: course.Subject,
+		Teacher: teacherResp,
+		Version: course.Version,
 	}
 	return courseResp, nil
 }
 
-func (s *service) FindAllCourse(page, limit int) ([]*response3.CourseResponse, error) {
+func (s *service) FindAllCourse(page, limit int, spec query.Spec) ([]*response3.CourseSummaryResponse, error) {
 	log.Log.Info("FindAllCourse (service) called", zap.Int("page", page), zap.Int("limit", limit))
 
-	courses, err := s.courseRepository.FindAll(page, limit)
+	courses, err := s.courseRepository.FindAll(page, limit, spec)
 	if err != nil {
 		return nil, err
 	}
 
-	var courseResponses []*response3.CourseResponse
-	for _, course := range courses {
-		var teacherResp *response3.TeacherResponse
-		if course.Teacher != nil {
-			teacherResp = &response3.TeacherResponse{
-				ID:   course.Teacher.ID,
-				Name: course.Teacher.Name,
-			}
-		}
+	var courseResponses []*response3.CourseSummaryResponse
+	for i := range courses {
+		courseResponses = append(courseResponses, toCourseSummaryResponse(&courses[i]))
+	}
 
-		studentsResp := make([]response3.StudentResponse, 0, len(course.Students))
-		for _, student := range course.Students {
-			studentsResp = append(studentsResp, response3.StudentResponse{
-				ID:    student.ID,
-				Name:  student.Name,
-				Email: student.Email,
-			})
-		}
+	return courseResponses, nil
+}
 
-		resp := &response3.CourseResponse{
-			ID:       course.ID,
-			Title:    course.Title,
-			Teacher:  teacherResp,
-			Students: studentsResp,
-		}
-		courseResponses = append(courseResponses, resp)
+func (s *service) FindAllCourseByCursor(keyset pagination.Keyset, spec query.Spec) (*pagination.CursorPage, error) {
+	log.Log.Info("FindAllCourseByCursor (service) called", zap.Int("limit", keyset.Limit))
+
+	courses, err := s.courseRepository.FindAllByCursor(keyset, spec)
+	if err != nil {
+		return nil, err
 	}
 
-	return courseResponses, nil
+	courses, page := pagination.Slice(keyset, courses, func(c entity.Course) uint { return c.ID })
+
+	items := make([]*response3.CourseSummaryResponse, 0, len(courses))
+	for i := range courses {
+		items = append(items, toCourseSummaryResponse(&courses[i]))
+	}
+	page.Items = items
+
+	return page, nil
+}
+
+func (s *service) DeleteCourseById(id uint, version uint, meta audit.Meta) error {
+	log.Log.Info("DeleteCourseById (service) called", zap.Uint("id", id), zap.Uint("version", version))
+	return s.courseRepository.DeleteById(id, version, meta)
 }
 
-func (s *service) DeleteCourseById(id uint) error {
-	log.Log.Info("DeleteCourseById (service) called", zap.Uint("id", id))
-	return s.courseRepository.DeleteById(id)
+// RestoreCourse undoes a soft delete. It fails with ErrTitleTaken when
+// another course has taken the title in the meantime.
+func (s *service) RestoreCourse(id uint, meta audit.Meta) (*response3.CourseResponse, error) {
+	log.Log.Info("RestoreCourse (service) called", zap.Uint("id", id))
+
+	restored, err := s.courseRepository.Restore(id, meta)
+	if err != nil {
+		return nil, err
+	}
+	if !restored {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	return s.FindCourseById(id)
 }
 
-func (s *service) SetTeacherToCourse(courseId uint, teacherId uint) (*response3.CourseResponse, error) {
+func (s *service) SetTeacherToCourse(courseId uint, teacherId uint, override bool, meta audit.Meta) (*response3.CourseResponse, error) {
 	log.Log.Info("SetTeacherToCourse (service) called",
 		zap.Uint("course_id", courseId),
 		zap.Uint("teacher_id", teacherId),
+		zap.Bool("override", override),
 	)
 
 	exists, err := s.courseRepository.ExistsById(courseId)
@@ -188,7 +251,43 @@ func (s *service) SetTeacherToCourse(courseId uint, teacherId uint) (*response3.
 		return nil, fmt.Errorf("teacher not found")
 	}
 
-	err = dbcontext.DB.Model(&entity.Course{}).Where("id = ?", courseId).Update("teacher_id", teacherId).Error
+	// Reassigning the current teacher changes nothing, so it is not
+	// audited or announced.
+	assigned, err := s.courseRepository.HasTeacher(courseId, teacherId)
+	if err != nil {
+		return nil, err
+	}
+	if assigned {
+		return s.FindCourseById(courseId)
+	}
+
+	err = audit.Track(dbcontext.DB, meta, &entity.Course{}, courseId, entity.AuditAssignTeacher, func(tx *gorm.DB) error {
+		// Locking the teacher serializes their assignments, so the
+		// workload check counts the ones committed meanwhile.
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Select("id").First(&entity.Teacher{}, teacherId).Error; err != nil {
+			return err
+		}
+		if err := s.checkAssignment(courseId, teacherId, override); err != nil {
+			return err
+		}
+
+		// The condition skips a teacher assigned concurrently since the
+		// check above.
+		result := tx.Model(&entity.Course{}).
+			Where("id = ? AND teacher_id IS DISTINCT FROM ?", courseId, teacherId).
+			Updates(map[string]interface{}{
+				"teacher_id": teacherId,
+				"version":    gorm.Expr("version + 1"),
+			})
+		if result.Error != nil || result.RowsAffected == 0 {
+			return result.Error
+		}
+
+		return s.notifyTeacherAssigned(tx, courseId, teacherId)
+	})
+	if errors.Is(err, ErrNotQualified) || errors.Is(err, workload.ErrLimitExceeded) {
+		return nil, err
+	}
 	if err != nil {
 		return nil, fmt.Errorf("failed to assign teacher to course: %w", err)
 	}
@@ -196,6 +295,181 @@ func (s *service) SetTeacherToCourse(courseId uint, teacherId uint) (*response3.
 	return s.FindCourseById(courseId)
 }
 
-func (s *service) Count() (int, error) {
-	return s.courseRepository.Count()
+// checkAssignment rejects teachers without a valid qualification for the
+// course subject and teachers who would go over their workload limits,
+// unless the checks are overridden.
+func (s *service) checkAssignment(courseId uint, teacherId uint, override bool) error {
+	course, err := s.courseRepository.FindById(courseId)
+	if err != nil {
+		return err
+	}
+
+	if course.Subject != "" {
+		qualified, err := s.qualificationRepository.IsQualified(teacherId, course.Subject, s.now())
+		if err != nil {
+			return err
+		}
+		if !qualified {
+			if !override {
+				return ErrNotQualified
+			}
+			log.Log.Warn("Unqualified teacher assigned by override",
+				zap.Uint("course_id", courseId),
+				zap.Uint("teacher_id", teacherId),
+				zap.String("subject", course.Subject),
+			)
+		}
+	}
+
+	// Reassigning the current teacher does not add to their load.
+	if course.TeacherID != nil && *course.TeacherID == teacherId {
+		return nil
+	}
+
+	students, err := s.courseRepository.CountStudents(courseId)
+	if err != nil {
+		return err
+	}
+
+	err = s.workloadService.CheckAssignment(teacherId, students)
+	if errors.Is(err, workload.ErrLimitExceeded) && override {
+		log.Log.Warn("Workload limit exceeded by override",
+			zap.Uint("course_id", courseId),
+			zap.Uint("teacher_id", teacherId),
+			zap.Error(err),
+		)
+		return nil
+	}
+
+	return err
+}
+
+// FindQualifiedTeachers lists the teachers who may teach the course, the
+// least loaded first.
+func (s *service) FindQualifiedTeachers(courseId uint) ([]*response3.QualifiedTeacherResponse, error) {
+	log.Log.Info("FindQualifiedTeachers (service) called", zap.Uint("course_id", courseId))
+
+	course, err := s.courseRepository.FindById(courseId)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, fmt.Errorf("course not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	teachers, err := s.qualificationRepository.FindQualifiedTeachers(course.Subject, s.now())
+	if err != nil {
+		return nil, err
+	}
+
+	teacherResponses := make([]*response3.QualifiedTeacherResponse, 0, len(teachers))
+	for _, t := range teachers {
+		var certifiedUntil *string
+		if len(t.Qualifications) > 0 {
+			certifiedUntil = qualification.FormatDate(t.Qualifications[0].CertifiedUntil)
+		}
+
+		teacherResponses = append(teacherResponses, &response3.QualifiedTeacherResponse{
+			ID:             t.ID,
+			Name:           t.Name,
+			Email:          t.Email,
+			CertifiedUntil: certifiedUntil,
+			CourseCount:    len(t.Courses),
+		})
+	}
+
+	// Teachers come ordered by name, so equally loaded ones stay in that order.
+	sort.SliceStable(teacherResponses, func(i, j int) bool {
+		return teacherResponses[i].CourseCount < teacherResponses[j].CourseCount
+	})
+
+	return teacherResponses, nil
+}
+
+// notifyTeacherAssigned queues the assignment email in tx for teachers
+// that have an email address, so that it is sent if and only if the
+// assignment commits.
+func (s *service) notifyTeacherAssigned(tx *gorm.DB, courseId uint, teacherId uint) error {
+	var teacher entity.Teacher
+	if err := tx.Select("name", "email", "locale").First(&teacher, teacherId).Error; err != nil {
+		return err
+	}
+	if teacher.Email == "" {
+		return nil
+	}
+
+	var course entity.Course
+	if err := tx.Select("title").First(&course, courseId).Error; err != nil {
+		return err
+	}
+
+	return s.notifier.Notify(tx, notification.EventTeacherAssigned,
+		notification.Recipient{Email: teacher.Email, Locale: teacher.Locale},
+		map[string]interface{}{
+			"TeacherName": teacher.Name,
+			"CourseTitle": course.Title,
+		},
+	)
+}
+
+func (s *service) Count(spec query.Spec) (int, error) {
+	return s.courseRepository.Count(spec)
+}
+
+func (s *service) FindCourseStudents(courseId uint, page, limit int) ([]*response3.StudentResponse, error) {
+	log.Log.Info("FindCourseStudents (service) called", zap.Uint("course_id", courseId), zap.Int("page", page), zap.Int("limit", limit))
+
+	students, err := s.courseRepository.FindStudents(courseId, page, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	studentResponses := make([]*response3.StudentResponse, 0, len(students))
+	for _, student := range students {
+		studentResponses = append(studentResponses, &response3.StudentResponse{
+			ID:            student.ID,
+			StudentNumber: student.StudentNumber,
+			Name:          student.Name,
+			Email:         student.Email,
+		})
+	}
+
+	return studentResponses, nil
+}
+
+func (s *service) CountCourseStudents(courseId uint) (int, error) {
+	exists, err := s.courseRepository.ExistsById(courseId)
+	if err != nil {
+		return 0, err
+	}
+	if !exists {
+		return 0, gorm.ErrRecordNotFound
+	}
+
+	return s.courseRepository.CountStudents(courseId)
+}
+
+func toCourseSummaryResponse(course *entity.Course) *response3.CourseSummaryResponse {
+	var teacherResp *response3.TeacherResponse
+	if course.Teacher != nil {
+		teacherResp = &response3.TeacherResponse{
+			ID:    course.Teacher.ID,
+			Name:  course.Teacher.Name,
+			Email: course.Teacher.Email,
+		}
+	}
+
+	courseResp := &response3.CourseSummaryResponse{
+		ID:           course.ID,
+		Title:        course.Title,
+		Subject:      course.Subject,
+		Teacher:      teacherResp,
+		StudentCount: course.StudentCount,
+		Version:      course.Version,
+	}
+	if course.DeletedAt.Valid {
+		courseResp.DeletedAt = &course.DeletedAt.Time
+	}
+
+	return courseResp
 }
@@ -2,14 +2,25 @@ package course
 
 import (
 	"errors"
+	"fmt"
+	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"regexp"
+	"student_go/internal/audit"
 	"student_go/internal/dto/request"
 	"student_go/internal/entity"
 	mocks2 "student_go/internal/mocks"
+	"student_go/internal/workload"
+	"student_go/pkg/etag"
 	"student_go/pkg/log"
+	"student_go/pkg/patch"
+	"student_go/pkg/query"
 	"testing"
+	"time"
 )
 
 func init() {
@@ -17,23 +28,25 @@ func init() {
 	log.Log = logger
 }
 
-func newTestCourseService() (Service, *mocks2.CourseRepository, *mocks2.TeacherRepository) {
+func newTestCourseService() (Service, *mocks2.CourseRepository, *mocks2.TeacherRepository, *mocks2.QualificationRepository, *mocks2.WorkloadServiceMock) {
 	mockCourseRepo := new(mocks2.CourseRepository)
 	mockTeacherRepo := new(mocks2.TeacherRepository)
+	mockQualificationRepo := new(mocks2.QualificationRepository)
+	mockWorkloadService := new(mocks2.WorkloadServiceMock)
 
-	svc := NewCourseService(mockCourseRepo, mockTeacherRepo)
-	return svc, mockCourseRepo, mockTeacherRepo
+	svc := NewCourseService(mockCourseRepo, mockTeacherRepo, mockQualificationRepo, mockWorkloadService, new(mocks2.Notifier))
+	return svc, mockCourseRepo, mockTeacherRepo, mockQualificationRepo, mockWorkloadService
 }
 
 func TestCreateCourse(t *testing.T) {
-	svc, mockCourseRepo, _ := newTestCourseService()
+	svc, mockCourseRepo, _, _, _ := newTestCourseService()
 
 	input := request.CourseRequest{Title: "Math"}
 	saved := &entity.Course{ID: 1, Title: "Math"}
 
-	mockCourseRepo.On("Save", mock.AnythingOfType("*entity.Course")).Return(saved, nil)
+	mockCourseRepo.On("Save", mock.AnythingOfType("*entity.Course"), audit.Meta{}).Return(saved, nil)
 
-	result, err := svc.CreateCourse(input)
+	result, err := svc.CreateCourse(audit.Meta{}, input)
 
 	assert.NoError(t, err)
 	assert.NotNil(t, result)
@@ -44,12 +57,12 @@ func TestCreateCourse(t *testing.T) {
 }
 
 func TestCreateCourse_Error(t *testing.T) {
-	svc, mockCourseRepo, _ := newTestCourseService()
+	svc, mockCourseRepo, _, _, _ := newTestCourseService()
 
 	input := request.CourseRequest{Title: "Physics"}
-	mockCourseRepo.On("Save", mock.Anything).Return(nil, errors.New("db error"))
+	mockCourseRepo.On("Save", mock.Anything, audit.Meta{}).Return(nil, errors.New("db error"))
 
-	result, err := svc.CreateCourse(input)
+	result, err := svc.CreateCourse(audit.Meta{}, input)
 
 	assert.Nil(t, result)
 	assert.EqualError(t, err, "db error")
@@ -58,7 +71,7 @@ func TestCreateCourse_Error(t *testing.T) {
 }
 
 func TestFindCourseById(t *testing.T) {
-	svc, mockCourseRepo, _ := newTestCourseService()
+	svc, mockCourseRepo, _, _, _ := newTestCourseService()
 
 	mockCourse := &entity.Course{
 		ID:    1,
@@ -67,9 +80,6 @@ func TestFindCourseById(t *testing.T) {
 			ID:   2,
 			Name: "Dr. Euler",
 		},
-		Students: []entity.Student{
-			{ID: 3, Name: "Bob", Email: "bob@example.com"},
-		},
 	}
 
 	mockCourseRepo.On("FindById", uint(1)).Return(mockCourse, nil)
@@ -80,14 +90,12 @@ func TestFindCourseById(t *testing.T) {
 	assert.NotNil(t, result)
 	assert.Equal(t, "Math", result.Title)
 	assert.Equal(t, "Dr. Euler", result.Teacher.Name)
-	assert.Len(t, result.Students, 1)
-	assert.Equal(t, "Bob", result.Students[0].Name)
 
 	mockCourseRepo.AssertExpectations(t)
 }
 
 func TestFindCourseById_Error(t *testing.T) {
-	svc, mockCourseRepo, _ := newTestCourseService()
+	svc, mockCourseRepo, _, _, _ := newTestCourseService()
 
 	mockCourseRepo.On("FindById", uint(999)).Return(nil, errors.New("not found"))
 
@@ -100,7 +108,7 @@ func TestFindCourseById_Error(t *testing.T) {
 }
 
 func TestFindAllCourse(t *testing.T) {
-	svc, mockCourseRepo, _ := newTestCourseService()
+	svc, mockCourseRepo, _, _, _ := newTestCourseService()
 
 	mockCourses := []entity.Course{
 		{
@@ -110,30 +118,41 @@ func TestFindAllCourse(t *testing.T) {
 				ID:   10,
 				Name: "Dr. Darwin",
 			},
-			Students: []entity.Student{
-				{ID: 11, Name: "Alice", Email: "alice@example.com"},
-			},
+			StudentCount: 1,
 		},
 	}
 
-	mockCourseRepo.On("FindAll", 1, 5).Return(mockCourses, nil)
+	mockCourseRepo.On("FindAll", 1, 5, query.Spec{}).Return(mockCourses, nil)
 
-	result, err := svc.FindAllCourse(1, 5)
+	result, err := svc.FindAllCourse(1, 5, query.Spec{})
 
 	assert.NoError(t, err)
 	assert.Len(t, result, 1)
 	assert.Equal(t, "Biology", result[0].Title)
 	assert.Equal(t, "Dr. Darwin", result[0].Teacher.Name)
+	assert.Equal(t, 1, result[0].StudentCount)
 
 	mockCourseRepo.AssertExpectations(t)
 }
 
+func TestCountCourseStudents_NotFound(t *testing.T) {
+	svc, mockCourseRepo, _, _, _ := newTestCourseService()
+
+	mockCourseRepo.On("ExistsById", uint(9)).Return(false, nil)
+
+	count, err := svc.CountCourseStudents(9)
+
+	assert.Zero(t, count)
+	assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+	mockCourseRepo.AssertNotCalled(t, "CountStudents", mock.Anything)
+}
+
 func TestFindAllCourse_Error(t *testing.T) {
-	svc, mockCourseRepo, _ := newTestCourseService()
+	svc, mockCourseRepo, _, _, _ := newTestCourseService()
 
-	mockCourseRepo.On("FindAll", 1, 5).Return(nil, errors.New("db error"))
+	mockCourseRepo.On("FindAll", 1, 5, query.Spec{}).Return(nil, errors.New("db error"))
 
-	result, err := svc.FindAllCourse(1, 5)
+	result, err := svc.FindAllCourse(1, 5, query.Spec{})
 
 	assert.Nil(t, result)
 	assert.EqualError(t, err, "db error")
@@ -142,9 +161,10 @@ func TestFindAllCourse_Error(t *testing.T) {
 }
 
 func TestUpdateCourse(t *testing.T) {
-	svc, mockCourseRepo, _ := newTestCourseService()
+	svc, mockCourseRepo, _, _, _ := newTestCourseService()
 
-	input := request.CourseRequest{Title: "Updated"}
+	title := "Updated"
+	input := request.CoursePatch{Title: &title}
 	mockUpdated := &entity.Course{
 		ID:    5,
 		Title: "Updated",
@@ -152,30 +172,26 @@ func TestUpdateCourse(t *testing.T) {
 			ID:   1,
 			Name: "Dr. Taylor",
 		},
-		Students: []entity.Student{
-			{ID: 3, Name: "Eve", Email: "eve@example.com"},
-		},
 	}
 
-	mockCourseRepo.On("Update", mock.Anything).Return(mockUpdated, nil)
+	mockCourseRepo.On("Update", uint(5), uint(0), map[string]interface{}{"title": "Updated"}, audit.Meta{}).Return(mockUpdated, nil)
 
-	result, err := svc.UpdateCourse(5, input)
+	result, err := svc.UpdateCourse(5, 0, audit.Meta{}, input, patch.Fields{"title": true})
 
 	assert.NoError(t, err)
 	assert.Equal(t, "Updated", result.Title)
 	assert.Equal(t, "Dr. Taylor", result.Teacher.Name)
-	assert.Len(t, result.Students, 1)
-	assert.Equal(t, "Eve", result.Students[0].Name)
 
 	mockCourseRepo.AssertExpectations(t)
 }
 
 func TestUpdateCourse_Error(t *testing.T) {
-	svc, mockCourseRepo, _ := newTestCourseService()
+	svc, mockCourseRepo, _, _, _ := newTestCourseService()
 
-	mockCourseRepo.On("Update", mock.Anything).Return(nil, errors.New("update error"))
+	mockCourseRepo.On("Update", uint(1), uint(0), mock.Anything, audit.Meta{}).Return(nil, errors.New("update error"))
 
-	result, err := svc.UpdateCourse(1, request.CourseRequest{Title: "X"})
+	title := "Physics"
+	result, err := svc.UpdateCourse(1, 0, audit.Meta{}, request.CoursePatch{Title: &title}, patch.Fields{"title": true})
 
 	assert.Nil(t, result)
 	assert.EqualError(t, err, "update error")
@@ -183,34 +199,81 @@ func TestUpdateCourse_Error(t *testing.T) {
 	mockCourseRepo.AssertExpectations(t)
 }
 
+func TestUpdateCourse_EmptyPatch(t *testing.T) {
+	tests := []struct {
+		name    string
+		version uint
+		err     error
+	}{
+		{"no If-Match", 0, nil},
+		{"current version", 4, nil},
+		{"stale version", 3, etag.ErrMismatch},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, mockCourseRepo, _, _, _ := newTestCourseService()
+			mockCourseRepo.On("FindById", uint(1)).Return(&entity.Course{ID: 1, Title: "Math", Version: 4}, nil)
+
+			result, err := svc.UpdateCourse(1, tt.version, audit.Meta{}, request.CoursePatch{}, patch.Fields{})
+
+			if tt.err != nil {
+				assert.Nil(t, result)
+				assert.ErrorIs(t, err, tt.err)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, uint(4), result.Version)
+			}
+			mockCourseRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestUpdateCourse_UnassignsTeacher(t *testing.T) {
+	svc, mockCourseRepo, _, _, _ := newTestCourseService()
+
+	subject := " phys "
+	changes := map[string]interface{}{"subject": "PHYS", "teacher_id": nil}
+	mockCourseRepo.On("Update", uint(5), uint(3), changes, audit.Meta{}).
+		Return(&entity.Course{ID: 5, Title: "Physics", Subject: "PHYS", Version: 4}, nil)
+
+	fields := patch.Fields{"subject": true, "teacher": true}
+	result, err := svc.UpdateCourse(5, 3, audit.Meta{}, request.CoursePatch{Subject: &subject}, fields)
+
+	assert.NoError(t, err)
+	assert.Nil(t, result.Teacher)
+	assert.Equal(t, uint(4), result.Version)
+	mockCourseRepo.AssertExpectations(t)
+}
+
 func TestDeleteCourseById(t *testing.T) {
-	svc, mockCourseRepo, _ := newTestCourseService()
+	svc, mockCourseRepo, _, _, _ := newTestCourseService()
 
-	mockCourseRepo.On("DeleteById", uint(1)).Return(nil)
+	mockCourseRepo.On("DeleteById", uint(1), uint(0), audit.Meta{}).Return(nil)
 
-	err := svc.DeleteCourseById(1)
+	err := svc.DeleteCourseById(1, 0, audit.Meta{})
 
 	assert.NoError(t, err)
 	mockCourseRepo.AssertExpectations(t)
 }
 
 func TestDeleteCourseById_Error(t *testing.T) {
-	svc, mockCourseRepo, _ := newTestCourseService()
+	svc, mockCourseRepo, _, _, _ := newTestCourseService()
 
-	mockCourseRepo.On("DeleteById", uint(2)).Return(errors.New("delete error"))
+	mockCourseRepo.On("DeleteById", uint(2), uint(0), audit.Meta{}).Return(errors.New("delete error"))
 
-	err := svc.DeleteCourseById(2)
+	err := svc.DeleteCourseById(2, 0, audit.Meta{})
 
 	assert.EqualError(t, err, "delete error")
 	mockCourseRepo.AssertExpectations(t)
 }
 
 func TestSetTeacherToCourse_CourseNotFound(t *testing.T) {
-	svc, mockCourseRepo, _ := newTestCourseService()
+	svc, mockCourseRepo, _, _, _ := newTestCourseService()
 
 	mockCourseRepo.On("ExistsById", uint(1)).Return(false, nil)
 
-	result, err := svc.SetTeacherToCourse(1, 10)
+	result, err := svc.SetTeacherToCourse(1, 10, false, audit.Meta{})
 
 	assert.Nil(t, result)
 	assert.EqualError(t, err, "course not found")
@@ -219,12 +282,12 @@ func TestSetTeacherToCourse_CourseNotFound(t *testing.T) {
 }
 
 func TestSetTeacherToCourse_TeacherNotFound(t *testing.T) {
-	svc, mockCourseRepo, mockTeacherRepo := newTestCourseService()
+	svc, mockCourseRepo, mockTeacherRepo, _, _ := newTestCourseService()
 
 	mockCourseRepo.On("ExistsById", uint(1)).Return(true, nil)
 	mockTeacherRepo.On("ExistsById", uint(2)).Return(false, nil)
 
-	result, err := svc.SetTeacherToCourse(1, 2)
+	result, err := svc.SetTeacherToCourse(1, 2, false, audit.Meta{})
 
 	assert.Nil(t, result)
 	assert.EqualError(t, err, "teacher not found")
@@ -232,3 +295,133 @@ func TestSetTeacherToCourse_TeacherNotFound(t *testing.T) {
 	mockCourseRepo.AssertExpectations(t)
 	mockTeacherRepo.AssertExpectations(t)
 }
+
+// expectAssignmentLocks expects the course and then the teacher to be
+// locked before the assignment is checked.
+func expectAssignmentLocks(sqlMock sqlmock.Sqlmock, courseId, teacherId uint) {
+	sqlMock.ExpectBegin()
+	sqlMock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "courses" WHERE "courses"."id" = $1 LIMIT $2 FOR UPDATE`)).
+		WithArgs(courseId, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(courseId))
+	sqlMock.ExpectQuery(regexp.QuoteMeta(`SELECT "id" FROM "teachers" WHERE "teachers"."id" = $1 AND "teachers"."deleted_at" IS NULL ORDER BY "teachers"."id" LIMIT $2 FOR UPDATE`)).
+		WithArgs(teacherId, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(teacherId))
+}
+
+func TestSetTeacherToCourse_NotQualified(t *testing.T) {
+	svc, mockCourseRepo, mockTeacherRepo, mockQualificationRepo, _ := newTestCourseService()
+	db, sqlMock, _ := setupTestDB(t)
+	defer db.Close()
+	expectAssignmentLocks(sqlMock, 1, 2)
+	sqlMock.ExpectRollback()
+
+	mockCourseRepo.On("ExistsById", uint(1)).Return(true, nil)
+	mockTeacherRepo.On("ExistsById", uint(2)).Return(true, nil)
+	mockCourseRepo.On("HasTeacher", uint(1), uint(2)).Return(false, nil)
+	mockCourseRepo.On("FindById", uint(1)).Return(&entity.Course{ID: 1, Title: "Algebra", Subject: "MATH"}, nil)
+	mockQualificationRepo.On("IsQualified", uint(2), "MATH", mock.AnythingOfType("time.Time")).Return(false, nil)
+
+	result, err := svc.SetTeacherToCourse(1, 2, false, audit.Meta{})
+
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, ErrNotQualified)
+	mockQualificationRepo.AssertExpectations(t)
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func TestSetTeacherToCourse_LimitExceeded(t *testing.T) {
+	svc, mockCourseRepo, mockTeacherRepo, _, mockWorkloadService := newTestCourseService()
+	limitErr := fmt.Errorf("%w: 6 courses, limit is 5", workload.ErrLimitExceeded)
+	db, sqlMock, _ := setupTestDB(t)
+	defer db.Close()
+	expectAssignmentLocks(sqlMock, 1, 2)
+	sqlMock.ExpectRollback()
+
+	mockCourseRepo.On("ExistsById", uint(1)).Return(true, nil)
+	mockTeacherRepo.On("ExistsById", uint(2)).Return(true, nil)
+	mockCourseRepo.On("HasTeacher", uint(1), uint(2)).Return(false, nil)
+	mockCourseRepo.On("FindById", uint(1)).Return(&entity.Course{ID: 1}, nil)
+	mockCourseRepo.On("CountStudents", uint(1)).Return(2, nil)
+	mockWorkloadService.On("CheckAssignment", uint(2), 2).Return(limitErr)
+
+	result, err := svc.SetTeacherToCourse(1, 2, false, audit.Meta{})
+
+	assert.Nil(t, result)
+	assert.EqualError(t, err, limitErr.Error())
+	mockWorkloadService.AssertExpectations(t)
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func TestSetTeacherToCourse_SameTeacher(t *testing.T) {
+	svc, mockCourseRepo, mockTeacherRepo, _, mockWorkloadService := newTestCourseService()
+	teacherId := uint(2)
+
+	mockCourseRepo.On("ExistsById", uint(1)).Return(true, nil)
+	mockTeacherRepo.On("ExistsById", uint(2)).Return(true, nil)
+	mockCourseRepo.On("HasTeacher", uint(1), uint(2)).Return(true, nil)
+	mockCourseRepo.On("FindById", uint(1)).Return(&entity.Course{ID: 1, Title: "Algebra", TeacherID: &teacherId,
+		Teacher: &entity.Teacher{ID: 2, Name: "Euler", Email: "euler@example.com"}}, nil)
+
+	result, err := svc.SetTeacherToCourse(1, 2, false, audit.Meta{})
+
+	require.NoError(t, err)
+	assert.Equal(t, "Euler", result.Teacher.Name)
+	mockWorkloadService.AssertNotCalled(t, "CheckAssignment", mock.Anything, mock.Anything)
+	svc.(*service).notifier.(*mocks2.Notifier).AssertNotCalled(t, "Notify", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestCheckAssignment_SameTeacherSkipsWorkload(t *testing.T) {
+	svc, mockCourseRepo, _, _, mockWorkloadService := newTestCourseService()
+	teacherId := uint(2)
+
+	mockCourseRepo.On("FindById", uint(1)).Return(&entity.Course{ID: 1, TeacherID: &teacherId}, nil)
+
+	err := svc.(*service).checkAssignment(1, 2, false)
+
+	assert.NoError(t, err)
+	mockWorkloadService.AssertNotCalled(t, "CheckAssignment", mock.Anything, mock.Anything)
+}
+
+func TestCheckAssignment_OverrideLimit(t *testing.T) {
+	svc, mockCourseRepo, _, _, mockWorkloadService := newTestCourseService()
+
+	mockCourseRepo.On("FindById", uint(1)).Return(&entity.Course{ID: 1}, nil)
+	mockCourseRepo.On("CountStudents", uint(1)).Return(0, nil)
+	mockWorkloadService.On("CheckAssignment", uint(2), 0).Return(workload.ErrLimitExceeded)
+
+	err := svc.(*service).checkAssignment(1, 2, true)
+
+	assert.NoError(t, err)
+	mockWorkloadService.AssertExpectations(t)
+}
+
+func TestFindQualifiedTeachers(t *testing.T) {
+	svc, mockCourseRepo, _, mockQualificationRepo, _ := newTestCourseService()
+	until := time.Date(2027, 6, 30, 0, 0, 0, 0, time.UTC)
+
+	mockCourseRepo.On("FindById", uint(1)).Return(&entity.Course{ID: 1, Subject: "MATH"}, nil)
+	mockQualificationRepo.On("FindQualifiedTeachers", "MATH", mock.AnythingOfType("time.Time")).Return([]entity.Teacher{
+		{ID: 3, Name: "Anna", Courses: []entity.Course{{ID: 5}, {ID: 6}}},
+		{ID: 4, Name: "Boris", Courses: []entity.Course{{ID: 7}}, Qualifications: []entity.TeacherQualification{{CertifiedUntil: &until}}},
+		{ID: 5, Name: "Clara"},
+	}, nil)
+
+	result, err := svc.FindQualifiedTeachers(1)
+
+	assert.NoError(t, err)
+	assert.Len(t, result, 3)
+	assert.Equal(t, []uint{5, 4, 3}, []uint{result[0].ID, result[1].ID, result[2].ID})
+	assert.Equal(t, 1, result[1].CourseCount)
+	assert.Equal(t, "2027-06-30", *result[1].CertifiedUntil)
+}
+
+func TestFindQualifiedTeachers_CourseNotFound(t *testing.T) {
+	svc, mockCourseRepo, _, mockQualificationRepo, _ := newTestCourseService()
+	mockCourseRepo.On("FindById", uint(1)).Return(nil, gorm.ErrRecordNotFound)
+
+	result, err := svc.FindQualifiedTeachers(1)
+
+	assert.Nil(t, result)
+	assert.EqualError(t, err, "course not found")
+	mockQualificationRepo.AssertNotCalled(t, "FindQualifiedTeachers", mock.Anything, mock.Anything)
+}
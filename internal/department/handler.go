@@ -7,12 +7,21 @@ import (
 	"gorm.io/gorm"
 	"net/http"
 	"strconv"
+	"student_go/internal/actor"
+	"student_go/internal/audit"
 	"student_go/internal/dto/request"
+	"student_go/internal/dto/response"
 	"student_go/internal/teacher"
+	"student_go/pkg/etag"
 	"student_go/pkg/log"
 	"student_go/pkg/pagination"
+	"student_go/pkg/patch"
+	"student_go/pkg/query"
 )
 
+// listAttributes are the fields departments lists can be limited to.
+var listAttributes = query.AttributesOf(response.DepartmentResponse{})
+
 type DepartmentHandler struct {
 	Service Service
 }
@@ -34,7 +43,7 @@ func (h *DepartmentHandler) CreateDepartment(c *gin.Context) {
 
 	log.Log.Info("CreateDepartment called", zap.String("name", req.Name))
 
-	deptResp, err := h.Service.CreateDepartment(req)
+	deptResp, err := h.Service.CreateDepartment(audit.FromContext(c), req)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to save department"})
 		return
@@ -44,7 +53,7 @@ func (h *DepartmentHandler) CreateDepartment(c *gin.Context) {
 }
 
 func (h *DepartmentHandler) UpdateDepartment(c *gin.Context) {
-	var req request.DepartmentRequest
+	var req request.DepartmentPatch
 
 	idParam := c.Param("id")
 	parsedID, err := strconv.ParseUint(idParam, 10, 32)
@@ -55,20 +64,38 @@ func (h *DepartmentHandler) UpdateDepartment(c *gin.Context) {
 	}
 	id := uint(parsedID)
 
-	if err := c.ShouldBindJSON(&req); err != nil {
+	version, err := etag.IfMatch(c.Request)
+	if err != nil {
+		c.JSON(http.StatusPreconditionFailed, gin.H{"error": err.Error()})
+		return
+	}
+
+	fields, err := patch.Bind(c.Request, &req)
+	if err != nil {
 		log.Log.Warn("Invalid request in UpdateDepartment", zap.Error(err))
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		if errors.Is(err, patch.ErrUnsupportedMediaType) {
+			c.JSON(http.StatusUnsupportedMediaType, gin.H{"error": err.Error()})
+		} else {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		}
 		return
 	}
 
-	log.Log.Info("UpdateDepartment called", zap.Uint("id", id), zap.String("name", req.Name))
+	log.Log.Info("UpdateDepartment called", zap.Uint("id", id), zap.Strings("fields", fields.Names()))
 
-	deptResp, err := h.Service.UpdateDepartment(id, req)
+	deptResp, err := h.Service.UpdateDepartment(id, version, audit.FromContext(c), req, fields)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to update department"})
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "department not found"})
+		} else if errors.Is(err, etag.ErrMismatch) {
+			c.JSON(http.StatusPreconditionFailed, gin.H{"error": err.Error()})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to update department"})
+		}
 		return
 	}
 
+	c.Header(etag.HeaderETag, etag.Format(deptResp.Version, deptResp))
 	c.JSON(http.StatusOK, deptResp)
 }
 
@@ -94,11 +121,36 @@ func (h *DepartmentHandler) FindDepartmentById(c *gin.Context) {
 		return
 	}
 
+	tag := etag.Format(deptResp.Version, deptResp)
+	c.Header(etag.HeaderETag, tag)
+	if etag.NoneMatch(c.Request, tag) {
+		c.Status(http.StatusNotModified)
+		return
+	}
+
 	c.JSON(http.StatusOK, deptResp)
 }
 
 func (h *DepartmentHandler) FindAllDepartments(c *gin.Context) {
-	count, err := h.Service.Count()
+	spec, err := query.NewFromRequest(c.Request, listFields, listRelations, listAttributes)
+	if err != nil {
+		log.Log.Warn("Invalid query in FindAllDepartments", zap.Error(err))
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if spec.IncludeDeleted {
+		if a, err := actor.FromContext(c); err != nil || !a.IsAdmin() {
+			c.JSON(http.StatusForbidden, gin.H{"error": "only admins can list deleted departments"})
+			return
+		}
+	}
+
+	if pagination.IsCursorRequest(c.Request) {
+		h.findAllDepartmentsByCursor(c, spec)
+		return
+	}
+
+	count, err := h.Service.Count(spec)
 	if err != nil {
 		log.Log.Error("Failed to count departments", zap.Error(err))
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to count departments"})
@@ -113,16 +165,61 @@ func (h *DepartmentHandler) FindAllDepartments(c *gin.Context) {
 		zap.Int("total_count", pages.TotalCount),
 	)
 
-	depts, err := h.Service.FindAllDepartments(pages.Page, pages.PerPage)
+	depts, err := h.Service.FindAllDepartments(pages.Page, pages.PerPage, spec)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to get departments"})
 		return
 	}
 
-	pages.Items = depts
+	items, err := spec.Render(depts, listRelations)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	pages.Items = items
 	c.JSON(http.StatusOK, pages)
 }
 
+// findAllDepartmentsByCursor serves FindAllDepartments with cursor pagination. It only counts
+// the departments when the client asks for include_total.
+func (h *DepartmentHandler) findAllDepartmentsByCursor(c *gin.Context, spec query.Spec) {
+	keyset, err := pagination.NewKeysetFromRequest(c.Request)
+	if err != nil {
+		log.Log.Warn("Invalid cursor in FindAllDepartments", zap.Error(err))
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if len(spec.Sorts) > 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "sort is not supported with cursor pagination"})
+		return
+	}
+
+	page, err := h.Service.FindAllDepartmentsByCursor(keyset, spec)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to get departments"})
+		return
+	}
+
+	if c.Query(pagination.TotalVar) == "true" {
+		count, err := h.Service.Count(spec)
+		if err != nil {
+			log.Log.Error("Failed to count departments", zap.Error(err))
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to count departments"})
+			return
+		}
+		page.TotalCount = &count
+	}
+
+	page.Items, err = spec.Render(page.Items, listRelations)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, page)
+}
+
 func (h *DepartmentHandler) DeleteDepartmentById(c *gin.Context) {
 	idParam := c.Param("id")
 	parsedID, err := strconv.ParseUint(idParam, 10, 32)
@@ -133,19 +230,58 @@ func (h *DepartmentHandler) DeleteDepartmentById(c *gin.Context) {
 	}
 	id := uint(parsedID)
 
-	log.Log.Info("DeleteDepartmentById called", zap.Uint("id", id))
+	version, err := etag.IfMatch(c.Request)
+	if err != nil {
+		c.JSON(http.StatusPreconditionFailed, gin.H{"error": err.Error()})
+		return
+	}
+
+	log.Log.Info("DeleteDepartmentById called", zap.Uint("id", id), zap.Uint("version", version))
 
-	err = h.Service.DeleteDepartmentById(id)
+	err = h.Service.DeleteDepartmentById(id, version, audit.FromContext(c))
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		if errors.Is(err, etag.ErrMismatch) {
+			c.JSON(http.StatusPreconditionFailed, gin.H{"error": err.Error()})
+		} else if errors.Is(err, gorm.ErrRecordNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "department not found"})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
 		return
 	}
 
 	c.Status(http.StatusNoContent)
 }
 
+// RestoreDepartment brings back a deleted department, as long as the purge
+// job has not removed it yet.
+func (h *DepartmentHandler) RestoreDepartment(c *gin.Context) {
+	idParam := c.Param("id")
+	parsedID, err := strconv.ParseUint(idParam, 10, 32)
+	if err != nil {
+		log.Log.Warn("Invalid department ID in RestoreDepartment", zap.String("id", idParam), zap.Error(err))
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid department ID"})
+		return
+	}
+	id := uint(parsedID)
+
+	log.Log.Info("RestoreDepartment called", zap.Uint("id", id))
+
+	deptResp, err := h.Service.RestoreDepartment(id, audit.FromContext(c))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "deleted department not found"})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to restore department"})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, deptResp)
+}
+
 func (h *DepartmentHandler) DepartmentSetTeacher(c *gin.Context) {
-	departmentIdParam := c.Param("departmentId")
+	departmentIdParam := c.Param("id")
 	parsedDepartmentID, err := strconv.ParseUint(departmentIdParam, 10, 32)
 	if err != nil {
 		log.Log.Warn("Invalid department ID in DepartmentSetTeacher", zap.String("department_id", departmentIdParam), zap.Error(err))
@@ -169,7 +305,7 @@ func (h *DepartmentHandler) DepartmentSetTeacher(c *gin.Context) {
 		zap.Uint("teacher_id", teacherId),
 	)
 
-	departmentResp, err := h.Service.DepartmentSetTeacher(departmentId, teacherId)
+	departmentResp, err := h.Service.DepartmentSetTeacher(departmentId, teacherId, audit.FromContext(c))
 	if err != nil {
 		if err.Error() == "department not found" || err.Error() == "teacher not found" {
 			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
@@ -5,9 +5,12 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"student_go/internal/audit"
 	"student_go/internal/dto/request"
 	"student_go/internal/dto/response"
 	"student_go/internal/mocks"
+	"student_go/pkg/patch"
+	"student_go/pkg/query"
 	"testing"
 
 	"github.com/gin-gonic/gin"
@@ -26,7 +29,7 @@ func TestCreateDepartmentHandler(t *testing.T) {
 	r, mockService, handler := setupHandlerTest()
 	input := request.DepartmentRequest{Name: "Physics"}
 	expected := &response.DepartmentResponse{ID: 1, Name: "Physics"}
-	mockService.On("CreateDepartment", input).Return(expected, nil)
+	mockService.On("CreateDepartment", audit.Meta{}, input).Return(expected, nil)
 
 	r.POST("/departments", handler.CreateDepartment)
 	body, _ := json.Marshal(input)
@@ -41,13 +44,13 @@ func TestCreateDepartmentHandler(t *testing.T) {
 
 func TestUpdateDepartmentHandler(t *testing.T) {
 	r, mockService, handler := setupHandlerTest()
-	input := request.DepartmentRequest{Name: "Updated"}
+	name := "Updated"
 	expected := &response.DepartmentResponse{ID: 1, Name: "Updated"}
-	mockService.On("UpdateDepartment", uint(1), input).Return(expected, nil)
+	mockService.On("UpdateDepartment", uint(1), uint(0), audit.Meta{}, request.DepartmentPatch{Name: &name}, patch.Fields{"name": true}).
+		Return(expected, nil)
 
 	r.PATCH("/departments/:id", handler.UpdateDepartment)
-	body, _ := json.Marshal(input)
-	req := httptest.NewRequest(http.MethodPatch, "/departments/1", bytes.NewBuffer(body))
+	req := httptest.NewRequest(http.MethodPatch, "/departments/1", bytes.NewBufferString(`{"name":"Updated"}`))
 	req.Header.Set("Content-Type", "application/json")
 	resp := httptest.NewRecorder()
 	r.ServeHTTP(resp, req)
@@ -73,8 +76,8 @@ func TestFindDepartmentByIdHandler(t *testing.T) {
 func TestFindAllDepartmentsHandler(t *testing.T) {
 	r, mockService, handler := setupHandlerTest()
 	depts := []*response.DepartmentResponse{{ID: 1, Name: "X"}}
-	mockService.On("Count").Return(1, nil)
-	mockService.On("FindAllDepartments", 1, 10).Return(depts, nil)
+	mockService.On("Count", query.Spec{}).Return(1, nil)
+	mockService.On("FindAllDepartments", 1, 10, query.Spec{}).Return(depts, nil)
 
 	r.GET("/departments", handler.FindAllDepartments)
 	req := httptest.NewRequest(http.MethodGet, "/departments?page=1&per_page=10", nil)
@@ -87,7 +90,7 @@ func TestFindAllDepartmentsHandler(t *testing.T) {
 
 func TestDeleteDepartmentHandler(t *testing.T) {
 	r, mockService, handler := setupHandlerTest()
-	mockService.On("DeleteDepartmentById", uint(3)).Return(nil)
+	mockService.On("DeleteDepartmentById", uint(3), uint(0), audit.Meta{}).Return(nil)
 
 	r.DELETE("/departments/:id", handler.DeleteDepartmentById)
 	req := httptest.NewRequest(http.MethodDelete, "/departments/3", nil)
@@ -98,12 +101,25 @@ func TestDeleteDepartmentHandler(t *testing.T) {
 	mockService.AssertExpectations(t)
 }
 
+func TestRestoreDepartmentHandler(t *testing.T) {
+	r, mockService, handler := setupHandlerTest()
+	mockService.On("RestoreDepartment", uint(3), audit.Meta{}).Return(&response.DepartmentResponse{ID: 3, Name: "Science"}, nil)
+
+	r.POST("/departments/:id/restore", handler.RestoreDepartment)
+	req := httptest.NewRequest(http.MethodPost, "/departments/3/restore", nil)
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	mockService.AssertExpectations(t)
+}
+
 func TestDepartmentSetTeacherHandler(t *testing.T) {
 	r, mockService, handler := setupHandlerTest()
 	expected := &response.DepartmentResponse{ID: 1, Name: "Physics"}
-	mockService.On("DepartmentSetTeacher", uint(1), uint(2)).Return(expected, nil)
+	mockService.On("DepartmentSetTeacher", uint(1), uint(2), audit.Meta{}).Return(expected, nil)
 
-	r.POST("/departments/:departmentId/teachers/:teacherId", handler.DepartmentSetTeacher)
+	r.POST("/departments/:id/teachers/:teacherId", handler.DepartmentSetTeacher)
 	req := httptest.NewRequest(http.MethodPost, "/departments/1/teachers/2", nil)
 	resp := httptest.NewRecorder()
 	r.ServeHTTP(resp, req)
@@ -1,18 +1,49 @@
 package department
 
 import (
+	"gorm.io/gorm"
+	"student_go/internal/audit"
 	"student_go/internal/entity"
 	"student_go/pkg/dbcontext"
+	"student_go/pkg/etag"
+	"student_go/pkg/pagination"
+	"student_go/pkg/query"
 )
 
 type Repository interface {
 	ExistsById(id uint) (bool, error)
-	Save(department *entity.Department) (*entity.Department, error)
-	Update(department *entity.Department) (*entity.Department, error)
+	HasHead(departmentId uint, teacherId uint) (bool, error)
+	// Save, Update, DeleteById and Restore record the change in the audit
+	// log in the same transaction.
+	Save(department *entity.Department, meta audit.Meta) (*entity.Department, error)
+	// Update sets the given columns only and bumps the version. It fails
+	// with etag.ErrMismatch when version is not zero and the row has
+	// another one.
+	Update(id uint, version uint, changes map[string]interface{}, meta audit.Meta) (*entity.Department, error)
 	FindById(id uint) (*entity.Department, error)
-	FindAll(page, limit int) ([]entity.Department, error)
-	DeleteById(id uint) error
-	Count() (int, error)
+	// FindAll and Count only return the rows matching spec.
+	FindAll(page, limit int, spec query.Spec) ([]entity.Department, error)
+	// FindAllByCursor returns the rows selected by keyset.Apply; pass them
+	// through pagination.Slice to get the page.
+	FindAllByCursor(keyset pagination.Keyset, spec query.Spec) ([]entity.Department, error)
+	// DeleteById soft-deletes the department; Restore undoes it until the
+	// purge job removes the row. A version that is not zero must match the
+	// row's.
+	DeleteById(id uint, version uint, meta audit.Meta) error
+	Restore(id uint, meta audit.Meta) (bool, error)
+	Count(spec query.Spec) (int, error)
+}
+
+// listFields are the fields departments can be filtered and sorted by.
+var listFields = query.Schema{
+	"id":                    {Column: "id", Type: query.Int},
+	"name":                  {Column: "name", Type: query.String},
+	"head_of_department_id": {Column: "head_of_department_id", Type: query.Int, Nullable: true},
+}
+
+// listRelations are the relations departments lists can include.
+var listRelations = query.Relations{
+	"headOfDepartment": "HeadOfDepartment",
 }
 
 type repository struct{}
@@ -33,17 +64,38 @@ func (r *repository) ExistsById(id uint) (bool, error) {
 	return exists, err
 }
 
-func (r *repository) Save(department *entity.Department) (*entity.Department, error) {
-	err := dbcontext.DB.Create(department).Error
+func (r *repository) HasHead(departmentId uint, teacherId uint) (bool, error) {
+	var exists bool
+	err := dbcontext.DB.
+		Model(&entity.Department{}).
+		Select("count(*) > 0").
+		Where("id = ? AND head_of_department_id = ?", departmentId, teacherId).
+		Find(&exists).
+		Error
+
+	return exists, err
+}
+
+func (r *repository) Save(department *entity.Department, meta audit.Meta) (*entity.Department, error) {
+	err := audit.Create(dbcontext.DB, meta, department)
 	return department, err
 }
 
-func (r *repository) Update(department *entity.Department) (*entity.Department, error) {
-	err := dbcontext.DB.Model(&entity.Department{}).
-		Where("id = ?", department.ID).
-		Updates(map[string]interface{}{
-			"name": department.Name,
-		}).Error
+func (r *repository) Update(id uint, version uint, changes map[string]interface{}, meta audit.Meta) (*entity.Department, error) {
+	err := audit.Track(dbcontext.DB, meta, &entity.Department{}, id, entity.AuditUpdate, func(tx *gorm.DB) error {
+		current, err := etag.Check(tx, &entity.Department{}, id, version)
+		if err != nil {
+			return err
+		}
+
+		columns := make(map[string]interface{}, len(changes)+1)
+		for column, value := range changes {
+			columns[column] = value
+		}
+		columns["version"] = current + 1
+
+		return tx.Model(&entity.Department{}).Where("id = ?", id).Updates(columns).Error
+	})
 
 	if err != nil {
 		return nil, err
@@ -53,7 +105,7 @@ func (r *repository) Update(department *entity.Department) (*entity.Department,
 
 	err = dbcontext.DB.
 		Preload("HeadOfDepartment").
-		First(&updatedDepartment, department.ID).Error
+		First(&updatedDepartment, id).Error
 
 	if err != nil {
 		return nil, err
@@ -75,13 +127,13 @@ func (r *repository) FindById(id uint) (*entity.Department, error) {
 	return &department, nil
 }
 
-func (r *repository) FindAll(page, limit int) ([]entity.Department, error) {
+func (r *repository) FindAll(page, limit int, spec query.Spec) ([]entity.Department, error) {
 	var departments []entity.Department
 
 	offset := (page - 1) * limit
 
-	result := dbcontext.DB.
-		Preload("HeadOfDepartment").
+	db := spec.Apply(dbcontext.DB)
+	result := spec.Preload(db, listRelations).
 		Offset(offset).
 		Find(&departments)
 
@@ -92,14 +144,49 @@ func (r *repository) FindAll(page, limit int) ([]entity.Department, error) {
 	return departments, nil
 }
 
-func (r *repository) DeleteById(id uint) error {
-	result := dbcontext.DB.Delete(&entity.Department{}, id)
+func (r *repository) FindAllByCursor(keyset pagination.Keyset, spec query.Spec) ([]entity.Department, error) {
+	var departments []entity.Department
+
+	db := keyset.Apply(spec.Where(dbcontext.DB))
+	result := spec.Preload(db, listRelations).
+		Find(&departments)
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return departments, nil
+}
+
+func (r *repository) DeleteById(id uint, version uint, meta audit.Meta) error {
+	return audit.Track(dbcontext.DB, meta, &entity.Department{}, id, entity.AuditDelete, func(tx *gorm.DB) error {
+		if version != 0 {
+			if _, err := etag.Check(tx, &entity.Department{}, id, version); err != nil {
+				return err
+			}
+		}
+		return tx.Delete(&entity.Department{}, id).Error
+	})
+}
 
-	return result.Error
+// Restore reports false when no deleted department has the ID.
+func (r *repository) Restore(id uint, meta audit.Meta) (bool, error) {
+	restored := false
+	err := audit.Track(dbcontext.DB, meta, &entity.Department{}, id, entity.AuditRestore, func(tx *gorm.DB) error {
+		result := tx.
+			Unscoped().
+			Model(&entity.Department{}).
+			Where("id = ? AND deleted_at IS NOT NULL", id).
+			Update("deleted_at", nil)
+		restored = result.RowsAffected > 0
+		return result.Error
+	})
+
+	return restored, err
 }
 
-func (r *repository) Count() (int, error) {
+func (r *repository) Count(spec query.Spec) (int, error) {
 	var count int64
-	err := dbcontext.DB.Model(&entity.Department{}).Count(&count).Error
+	err := spec.Where(dbcontext.DB.Model(&entity.Department{})).Count(&count).Error
 	return int(count), err
 }
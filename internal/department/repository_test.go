@@ -7,13 +7,17 @@ import (
 	"github.com/stretchr/testify/require"
 	"regexp"
 	"testing"
+	"time"
 
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
 	"gorm.io/gorm/logger"
 
+	"student_go/internal/audit"
 	"student_go/internal/entity"
 	"student_go/pkg/dbcontext"
+	"student_go/pkg/etag"
+	"student_go/pkg/query"
 )
 
 func setupTestDB(t *testing.T) (*sql.DB, sqlmock.Sqlmock, *gorm.DB) {
@@ -49,19 +53,37 @@ func TestDepartmentExistsById(t *testing.T) {
 	assert.True(t, exists)
 }
 
+func TestDepartmentHasHead(t *testing.T) {
+	db, mock, _ := setupTestDB(t)
+	defer db.Close()
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) > 0 FROM "departments" WHERE (id = $1 AND head_of_department_id = $2)`)).
+		WithArgs(1, 2).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(true))
+
+	repo := NewDepartmentRepository()
+	ok, err := repo.HasHead(1, 2)
+
+	assert.NoError(t, err)
+	assert.True(t, ok)
+}
+
 func TestDepartmentSave(t *testing.T) {
 	db, mock, _ := setupTestDB(t)
 	defer db.Close()
 
 	mock.ExpectBegin()
-	mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "departments" ("name","head_of_department_id") VALUES ($1,$2) RETURNING "id"`)).
-		WithArgs("Science", nil).
+	mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "departments" ("name","head_of_department_id","version","deleted_at") VALUES ($1,$2,$3,$4) RETURNING "id"`)).
+		WithArgs("Science", nil, 1, nil).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "audit_entries"`)).
+		WithArgs("department", 1, "create", nil, "", "", nil, `{"deleted_at":null,"head_of_department_id":null,"id":1,"name":"Science","version":1}`, sqlmock.AnyArg()).
 		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
 	mock.ExpectCommit()
 
 	repo := NewDepartmentRepository()
 	dept := &entity.Department{Name: "Science"}
-	result, err := repo.Save(dept)
+	result, err := repo.Save(dept, audit.Meta{})
 
 	assert.NoError(t, err)
 	assert.Equal(t, "Science", result.Name)
@@ -71,7 +93,7 @@ func TestDepartmentFindById(t *testing.T) {
 	db, mock, _ := setupTestDB(t)
 	defer db.Close()
 
-	mock.ExpectQuery(`SELECT \* FROM "departments" WHERE "departments"\."id" = \$1 ORDER BY "departments"\."id" LIMIT .*`).
+	mock.ExpectQuery(`SELECT \* FROM "departments" WHERE "departments"\."id" = \$1 AND "departments"\."deleted_at" IS NULL ORDER BY "departments"\."id" LIMIT .*`).
 		WithArgs(1, 1).
 		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "head_of_department_id"}).
 			AddRow(1, "Science", 10))
@@ -95,12 +117,24 @@ func TestDepartmentUpdate(t *testing.T) {
 	defer db.Close()
 
 	mock.ExpectBegin()
-	mock.ExpectExec(regexp.QuoteMeta(`UPDATE "departments" SET "name"=$1 WHERE id = $2`)).
-		WithArgs("Updated", 1).
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "departments" WHERE "departments"."id" = $1 LIMIT $2 FOR UPDATE`)).
+		WithArgs(1, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "version", "deleted_at"}).AddRow(1, "Science", 1, nil))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT "version" FROM "departments" WHERE id = $1 AND "departments"."deleted_at" IS NULL`)).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"version"}).AddRow(1))
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE "departments" SET "name"=$1,"version"=$2 WHERE id = $3`)).
+		WithArgs("Updated", 2, 1).
 		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "departments" WHERE "departments"."id" = $1 LIMIT $2`)).
+		WithArgs(1, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "version", "deleted_at"}).AddRow(1, "Updated", 2, nil))
+	mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "audit_entries"`)).
+		WithArgs("department", 1, "update", nil, "", "", `{"name":"Science","version":1}`, `{"name":"Updated","version":2}`, sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
 	mock.ExpectCommit()
 
-	mock.ExpectQuery(`SELECT \* FROM "departments" WHERE "departments"\."id" = \$1 ORDER BY "departments"\."id" LIMIT .*`).
+	mock.ExpectQuery(`SELECT \* FROM "departments" WHERE "departments"\."id" = \$1 AND "departments"\."deleted_at" IS NULL ORDER BY "departments"\."id" LIMIT .*`).
 		WithArgs(1, 1).
 		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "head_of_department_id"}).
 			AddRow(1, "Updated", 11))
@@ -111,7 +145,7 @@ func TestDepartmentUpdate(t *testing.T) {
 			AddRow(11, "Prof. Jane"))
 
 	repo := NewDepartmentRepository()
-	updated, err := repo.Update(&entity.Department{ID: 1, Name: "Updated"})
+	updated, err := repo.Update(1, 0, map[string]interface{}{"name": "Updated"}, audit.Meta{})
 
 	require.NoError(t, err)
 	require.NotNil(t, updated)
@@ -138,7 +172,7 @@ func TestDepartmentFindAll(t *testing.T) {
 			AddRow(11, "Prof. Jane"))
 
 	repo := NewDepartmentRepository()
-	depts, err := repo.FindAll(page, limit)
+	depts, err := repo.FindAll(page, limit, query.Spec{Include: []string{"headOfDepartment"}})
 
 	require.NoError(t, err)
 	require.Len(t, depts, 2)
@@ -148,20 +182,92 @@ func TestDepartmentFindAll(t *testing.T) {
 	assert.Equal(t, "Prof. Jane", depts[1].HeadOfDepartment.Name)
 }
 
+func TestDepartmentFindAll_WithoutInclude(t *testing.T) {
+	db, mock, _ := setupTestDB(t)
+	defer db.Close()
+
+	mock.ExpectQuery(`SELECT \* FROM "departments"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "head_of_department_id"}).
+			AddRow(1, "Science", 10))
+
+	repo := NewDepartmentRepository()
+	depts, err := repo.FindAll(1, 2, query.Spec{})
+
+	require.NoError(t, err)
+	require.Len(t, depts, 1)
+	assert.Nil(t, depts[0].HeadOfDepartment)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
 func TestDepartmentDeleteById(t *testing.T) {
 	db, mock, _ := setupTestDB(t)
 	defer db.Close()
 
+	deletedAt := time.Date(2024, 5, 1, 9, 0, 0, 0, time.UTC)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "departments" WHERE "departments"."id" = $1 LIMIT $2 FOR UPDATE`)).
+		WithArgs(1, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "deleted_at"}).AddRow(1, "Science", nil))
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE "departments" SET "deleted_at"=$1 WHERE "departments"."id" = $2 AND "departments"."deleted_at" IS NULL`)).
+		WithArgs(sqlmock.AnyArg(), 1).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "departments" WHERE "departments"."id" = $1 LIMIT $2`)).
+		WithArgs(1, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "deleted_at"}).AddRow(1, "Science", deletedAt))
+	mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "audit_entries"`)).
+		WithArgs("department", 1, "delete", nil, "", "", `{"deleted_at":null}`, `{"deleted_at":"2024-05-01T09:00:00Z"}`, sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectCommit()
+
+	repo := NewDepartmentRepository()
+	err := repo.DeleteById(1, 0, audit.Meta{})
+
+	assert.NoError(t, err)
+}
+
+func TestDepartmentDeleteById_VersionMismatch(t *testing.T) {
+	db, mock, _ := setupTestDB(t)
+	defer db.Close()
+
 	mock.ExpectBegin()
-	mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "departments" WHERE "departments"."id" = $1`)).
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "departments" WHERE "departments"."id" = $1 LIMIT $2 FOR UPDATE`)).
+		WithArgs(1, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "version"}).AddRow(1, "Science", 5))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT "version" FROM "departments" WHERE id = $1 AND "departments"."deleted_at" IS NULL`)).
 		WithArgs(1).
-		WillReturnResult(sqlmock.NewResult(1, 1))
+		WillReturnRows(sqlmock.NewRows([]string{"version"}).AddRow(5))
+	mock.ExpectRollback()
+
+	repo := NewDepartmentRepository()
+	err := repo.DeleteById(1, 4, audit.Meta{})
+
+	assert.ErrorIs(t, err, etag.ErrMismatch)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDepartmentRestore(t *testing.T) {
+	db, mock, _ := setupTestDB(t)
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "departments" WHERE "departments"."id" = $1 LIMIT $2 FOR UPDATE`)).
+		WithArgs(1, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "deleted_at"}).AddRow(1, "Science", nil))
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE "departments" SET "deleted_at"=$1 WHERE id = $2 AND deleted_at IS NOT NULL`)).
+		WithArgs(nil, 1).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "departments" WHERE "departments"."id" = $1 LIMIT $2`)).
+		WithArgs(1, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "deleted_at"}).AddRow(1, "Science", nil))
+	// The row was not deleted, so there is nothing to record.
 	mock.ExpectCommit()
 
 	repo := NewDepartmentRepository()
-	err := repo.DeleteById(1)
+	restored, err := repo.Restore(1, audit.Meta{})
 
 	assert.NoError(t, err)
+	assert.False(t, restored)
 }
 
 func TestDepartmentCount(t *testing.T) {
@@ -172,7 +278,7 @@ func TestDepartmentCount(t *testing.T) {
 		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
 
 	repo := NewDepartmentRepository()
-	count, err := repo.Count()
+	count, err := repo.Count(query.Spec{})
 
 	assert.NoError(t, err)
 	assert.Equal(t, 2, count)
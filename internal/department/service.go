@@ -3,22 +3,34 @@ package department
 import (
 	"fmt"
 	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"student_go/internal/audit"
 	"student_go/internal/dto/request"
 	"student_go/internal/dto/response"
 	"student_go/internal/entity"
 	"student_go/internal/teacher"
 	"student_go/pkg/dbcontext"
+	"student_go/pkg/etag"
 	"student_go/pkg/log"
+	"student_go/pkg/pagination"
+	"student_go/pkg/patch"
+	"student_go/pkg/query"
 )
 
 type Service interface {
-	CreateDepartment(input request.DepartmentRequest) (*response.DepartmentResponse, error)
-	UpdateDepartment(id uint, input request.DepartmentRequest) (*response.DepartmentResponse, error)
+	CreateDepartment(meta audit.Meta, input request.DepartmentRequest) (*response.DepartmentResponse, error)
+	// UpdateDepartment and DeleteDepartmentById fail with etag.ErrMismatch
+	// when version is not zero and the department has another one.
+	// UpdateDepartment changes the members the patch sets only; setting
+	// the head to null removes them.
+	UpdateDepartment(id uint, version uint, meta audit.Meta, input request.DepartmentPatch, fields patch.Fields) (*response.DepartmentResponse, error)
 	FindDepartmentById(id uint) (*response.DepartmentResponse, error)
-	FindAllDepartments(page, limit int) ([]*response.DepartmentResponse, error)
-	DeleteDepartmentById(id uint) error
-	DepartmentSetTeacher(departmentId uint, teacherId uint) (*response.DepartmentResponse, error)
-	Count() (int, error)
+	FindAllDepartments(page, limit int, spec query.Spec) ([]*response.DepartmentResponse, error)
+	FindAllDepartmentsByCursor(keyset pagination.Keyset, spec query.Spec) (*pagination.CursorPage, error)
+	DeleteDepartmentById(id uint, version uint, meta audit.Meta) error
+	RestoreDepartment(id uint, meta audit.Meta) (*response.DepartmentResponse, error)
+	DepartmentSetTeacher(departmentId uint, teacherId uint, meta audit.Meta) (*response.DepartmentResponse, error)
+	Count(spec query.Spec) (int, error)
 }
 
 type service struct {
@@ -36,32 +48,48 @@ func NewDepartmentService(
 	}
 }
 
-func (s *service) CreateDepartment(input request.DepartmentRequest) (*response.DepartmentResponse, error) {
+func (s *service) CreateDepartment(meta audit.Meta, input request.DepartmentRequest) (*response.DepartmentResponse, error) {
 	log.Log.Info("CreateDepartment (service) called", zap.String("name", input.Name))
 
 	dept := entity.Department{
 		Name: input.Name,
 	}
-	savedDept, err := s.departmentRepository.Save(&dept)
+	savedDept, err := s.departmentRepository.Save(&dept, meta)
 	if err != nil {
 		return nil, err
 	}
 
 	resp := &response.DepartmentResponse{
-		ID:   savedDept.ID,
-		Name: savedDept.Name,
+		ID:      savedDept.ID,
+		Name:    savedDept.Name,
+		Version: savedDept.Version,
 	}
 	return resp, nil
 }
 
-func (s *service) UpdateDepartment(id uint, input request.DepartmentRequest) (*response.DepartmentResponse, error) {
-	log.Log.Info("UpdateDepartment (service) called", zap.Uint("id", id), zap.String("name", input.Name))
+func (s *service) UpdateDepartment(id uint, version uint, meta audit.Meta, input request.DepartmentPatch, fields patch.Fields) (*response.DepartmentResponse, error) {
+	log.Log.Info("UpdateDepartment (service) called", zap.Uint("id", id), zap.Strings("fields", fields.Names()))
 
-	dept := entity.Department{
-		ID:   id,
-		Name: input.Name,
+	// An empty patch changes nothing, so the version is left alone.
+	if len(fields) == 0 {
+		current, err := s.FindDepartmentById(id)
+		if err != nil {
+			return nil, err
+		}
+		if version != 0 && current.Version != version {
+			return nil, etag.ErrMismatch
+		}
+		return current, nil
+	}
+
+	changes := make(map[string]interface{})
+	if fields.Has("name") {
+		changes["name"] = patch.Value(input.Name)
 	}
-	updatedDept, err := s.departmentRepository.Update(&dept)
+	if fields.Has("headOfDepartment") {
+		changes["head_of_department_id"] = nil
+	}
+	updatedDept, err := s.departmentRepository.Update(id, version, changes, meta)
 	if err != nil {
 		return nil, err
 	}
@@ -69,8 +97,9 @@ func (s *service) UpdateDepartment(id uint, input request.DepartmentRequest) (*r
 	var headOfDepartment *response.TeacherResponse
 	if updatedDept.HeadOfDepartment != nil {
 		headOfDepartment = &response.TeacherResponse{
-			ID:   updatedDept.HeadOfDepartment.ID,
-			Name: updatedDept.HeadOfDepartment.Name,
+			ID:    updatedDept.HeadOfDepartment.ID,
+			Name:  updatedDept.HeadOfDepartment.Name,
+			Email: updatedDept.HeadOfDepartment.Email,
 		}
 	}
 
@@ -78,6 +107,7 @@ func (s *service) UpdateDepartment(id uint, input request.DepartmentRequest) (*r
 		ID:               updatedDept.ID,
 		Name:             updatedDept.Name,
 		HeadOfDepartment: headOfDepartment,
+		Version:          updatedDept.Version,
 	}
 	return departmentResp, nil
 }
@@ -93,8 +123,9 @@ func (s *service) FindDepartmentById(id uint) (*response.DepartmentResponse, err
 	var headOfDepartment *response.TeacherResponse
 	if dept.HeadOfDepartment != nil {
 		headOfDepartment = &response.TeacherResponse{
-			ID:   dept.HeadOfDepartment.ID,
-			Name: dept.HeadOfDepartment.Name,
+			ID:    dept.HeadOfDepartment.ID,
+			Name:  dept.HeadOfDepartment.Name,
+			Email: dept.HeadOfDepartment.Email,
 		}
 	}
 
@@ -102,45 +133,67 @@ func (s *service) FindDepartmentById(id uint) (*response.DepartmentResponse, err
 		ID:               dept.ID,
 		Name:             dept.Name,
 		HeadOfDepartment: headOfDepartment,
+		Version:          dept.Version,
 	}
 	return departmentResp, nil
 }
 
-func (s *service) FindAllDepartments(page, limit int) ([]*response.DepartmentResponse, error) {
+func (s *service) FindAllDepartments(page, limit int, spec query.Spec) ([]*response.DepartmentResponse, error) {
 	log.Log.Info("FindAllDepartments (service) called", zap.Int("page", page), zap.Int("limit", limit))
 
-	depts, err := s.departmentRepository.FindAll(page, limit)
+	depts, err := s.departmentRepository.FindAll(page, limit, spec)
 	if err != nil {
 		return nil, err
 	}
 
 	var deptResponses []*response.DepartmentResponse
-	for _, dept := range depts {
-		var headOfDepartment *response.TeacherResponse
-		if dept.HeadOfDepartment != nil {
-			headOfDepartment = &response.TeacherResponse{
-				ID:   dept.HeadOfDepartment.ID,
-				Name: dept.HeadOfDepartment.Name,
-			}
-		}
-
-		departmentResp := &response.DepartmentResponse{
-			ID:               dept.ID,
-			Name:             dept.Name,
-			HeadOfDepartment: headOfDepartment,
-		}
-		deptResponses = append(deptResponses, departmentResp)
+	for i := range depts {
+		deptResponses = append(deptResponses, toDepartmentResponse(&depts[i]))
 	}
 
 	return deptResponses, nil
 }
 
-func (s *service) DeleteDepartmentById(id uint) error {
-	log.Log.Info("DeleteDepartmentById (service) called", zap.Uint("id", id))
-	return s.departmentRepository.DeleteById(id)
+func (s *service) FindAllDepartmentsByCursor(keyset pagination.Keyset, spec query.Spec) (*pagination.CursorPage, error) {
+	log.Log.Info("FindAllDepartmentsByCursor (service) called", zap.Int("limit", keyset.Limit))
+
+	depts, err := s.departmentRepository.FindAllByCursor(keyset, spec)
+	if err != nil {
+		return nil, err
+	}
+
+	depts, page := pagination.Slice(keyset, depts, func(d entity.Department) uint { return d.ID })
+
+	items := make([]*response.DepartmentResponse, 0, len(depts))
+	for i := range depts {
+		items = append(items, toDepartmentResponse(&depts[i]))
+	}
+	page.Items = items
+
+	return page, nil
+}
+
+func (s *service) DeleteDepartmentById(id uint, version uint, meta audit.Meta) error {
+	log.Log.Info("DeleteDepartmentById (service) called", zap.Uint("id", id), zap.Uint("version", version))
+	return s.departmentRepository.DeleteById(id, version, meta)
+}
+
+// RestoreDepartment undoes a soft delete.
+func (s *service) RestoreDepartment(id uint, meta audit.Meta) (*response.DepartmentResponse, error) {
+	log.Log.Info("RestoreDepartment (service) called", zap.Uint("id", id))
+
+	restored, err := s.departmentRepository.Restore(id, meta)
+	if err != nil {
+		return nil, err
+	}
+	if !restored {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	return s.FindDepartmentById(id)
 }
 
-func (s *service) DepartmentSetTeacher(departmentId uint, teacherId uint) (*response.DepartmentResponse, error) {
+func (s *service) DepartmentSetTeacher(departmentId uint, teacherId uint, meta audit.Meta) (*response.DepartmentResponse, error) {
 	log.Log.Info("DepartmentSetTeacher (service) called",
 		zap.Uint("department_id", departmentId),
 		zap.Uint("teacher_id", teacherId),
@@ -156,9 +209,25 @@ func (s *service) DepartmentSetTeacher(departmentId uint, teacherId uint) (*resp
 		return nil, fmt.Errorf("teacher not found")
 	}
 
-	err = dbcontext.DB.Model(&entity.Department{}).
-		Where("id = ?", departmentId).
-		Update("head_of_department_id", teacherId).Error
+	// Reassigning the current head changes nothing, so it is not audited.
+	assigned, err := s.departmentRepository.HasHead(departmentId, teacherId)
+	if err != nil {
+		return nil, err
+	}
+	if assigned {
+		return s.FindDepartmentById(departmentId)
+	}
+
+	err = audit.Track(dbcontext.DB, meta, &entity.Department{}, departmentId, entity.AuditAssignTeacher, func(tx *gorm.DB) error {
+		// The condition skips a head assigned concurrently since the
+		// check above.
+		return tx.Model(&entity.Department{}).
+			Where("id = ? AND head_of_department_id IS DISTINCT FROM ?", departmentId, teacherId).
+			Updates(map[string]interface{}{
+				"head_of_department_id": teacherId,
+				"version":               gorm.Expr("version + 1"),
+			}).Error
+	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to assign teacher to department: %w", err)
 	}
@@ -166,6 +235,29 @@ func (s *service) DepartmentSetTeacher(departmentId uint, teacherId uint) (*resp
 	return s.FindDepartmentById(departmentId)
 }
 
-func (s *service) Count() (int, error) {
-	return s.departmentRepository.Count()
+func (s *service) Count(spec query.Spec) (int, error) {
+	return s.departmentRepository.Count(spec)
+}
+
+func toDepartmentResponse(dept *entity.Department) *response.DepartmentResponse {
+	var headOfDepartment *response.TeacherResponse
+	if dept.HeadOfDepartment != nil {
+		headOfDepartment = &response.TeacherResponse{
+			ID:    dept.HeadOfDepartment.ID,
+			Name:  dept.HeadOfDepartment.Name,
+			Email: dept.HeadOfDepartment.Email,
+		}
+	}
+
+	departmentResp := &response.DepartmentResponse{
+		ID:               dept.ID,
+		Name:             dept.Name,
+		HeadOfDepartment: headOfDepartment,
+		Version:          dept.Version,
+	}
+	if dept.DeletedAt.Valid {
+		departmentResp.DeletedAt = &dept.DeletedAt.Time
+	}
+
+	return departmentResp
 }
@@ -5,10 +5,13 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"go.uber.org/zap"
+	"student_go/internal/audit"
 	"student_go/internal/dto/request"
 	"student_go/internal/entity"
 	mocks2 "student_go/internal/mocks"
 	"student_go/pkg/log"
+	"student_go/pkg/patch"
+	"student_go/pkg/query"
 	"testing"
 )
 
@@ -30,9 +33,9 @@ func TestCreateDepartment(t *testing.T) {
 	input := request.DepartmentRequest{Name: "Math"}
 	saved := &entity.Department{ID: 1, Name: "Math"}
 
-	mockRepo.On("Save", mock.AnythingOfType("*entity.Department")).Return(saved, nil)
+	mockRepo.On("Save", mock.AnythingOfType("*entity.Department"), audit.Meta{}).Return(saved, nil)
 
-	result, err := svc.CreateDepartment(input)
+	result, err := svc.CreateDepartment(audit.Meta{}, input)
 
 	assert.NoError(t, err)
 	assert.Equal(t, uint(1), result.ID)
@@ -45,9 +48,9 @@ func TestCreateDepartment_Error(t *testing.T) {
 	svc, mockRepo, _ := newTestDepartmentService()
 
 	input := request.DepartmentRequest{Name: "Physics"}
-	mockRepo.On("Save", mock.Anything).Return(nil, errors.New("db error"))
+	mockRepo.On("Save", mock.Anything, audit.Meta{}).Return(nil, errors.New("db error"))
 
-	result, err := svc.CreateDepartment(input)
+	result, err := svc.CreateDepartment(audit.Meta{}, input)
 
 	assert.Nil(t, result)
 	assert.EqualError(t, err, "db error")
@@ -58,7 +61,8 @@ func TestCreateDepartment_Error(t *testing.T) {
 func TestUpdateDepartment(t *testing.T) {
 	svc, mockRepo, _ := newTestDepartmentService()
 
-	input := request.DepartmentRequest{Name: "Updated"}
+	name := "Updated"
+	input := request.DepartmentPatch{Name: &name}
 	updated := &entity.Department{
 		ID:   2,
 		Name: "Updated",
@@ -68,9 +72,9 @@ func TestUpdateDepartment(t *testing.T) {
 		},
 	}
 
-	mockRepo.On("Update", mock.Anything).Return(updated, nil)
+	mockRepo.On("Update", uint(2), uint(0), map[string]interface{}{"name": "Updated"}, audit.Meta{}).Return(updated, nil)
 
-	result, err := svc.UpdateDepartment(2, input)
+	result, err := svc.UpdateDepartment(2, 0, audit.Meta{}, input, patch.Fields{"name": true})
 
 	assert.NoError(t, err)
 	assert.Equal(t, "Updated", result.Name)
@@ -82,9 +86,9 @@ func TestUpdateDepartment(t *testing.T) {
 func TestUpdateDepartment_Error(t *testing.T) {
 	svc, mockRepo, _ := newTestDepartmentService()
 
-	mockRepo.On("Update", mock.Anything).Return(nil, errors.New("update error"))
+	mockRepo.On("Update", uint(3), uint(0), mock.Anything, audit.Meta{}).Return(nil, errors.New("update error"))
 
-	result, err := svc.UpdateDepartment(3, request.DepartmentRequest{Name: "X"})
+	result, err := svc.UpdateDepartment(3, 0, audit.Meta{}, request.DepartmentPatch{}, patch.Fields{"headOfDepartment": true})
 
 	assert.Nil(t, result)
 	assert.EqualError(t, err, "update error")
@@ -142,9 +146,9 @@ func TestFindAllDepartments(t *testing.T) {
 		},
 	}
 
-	mockRepo.On("FindAll", 1, 5).Return(mockDepts, nil)
+	mockRepo.On("FindAll", 1, 5, query.Spec{}).Return(mockDepts, nil)
 
-	result, err := svc.FindAllDepartments(1, 5)
+	result, err := svc.FindAllDepartments(1, 5, query.Spec{})
 
 	assert.NoError(t, err)
 	assert.Len(t, result, 1)
@@ -157,9 +161,9 @@ func TestFindAllDepartments(t *testing.T) {
 func TestFindAllDepartments_Error(t *testing.T) {
 	svc, mockRepo, _ := newTestDepartmentService()
 
-	mockRepo.On("FindAll", 1, 5).Return(nil, errors.New("db error"))
+	mockRepo.On("FindAll", 1, 5, query.Spec{}).Return(nil, errors.New("db error"))
 
-	result, err := svc.FindAllDepartments(1, 5)
+	result, err := svc.FindAllDepartments(1, 5, query.Spec{})
 
 	assert.Nil(t, result)
 	assert.EqualError(t, err, "db error")
@@ -170,9 +174,9 @@ func TestFindAllDepartments_Error(t *testing.T) {
 func TestDeleteDepartmentById(t *testing.T) {
 	svc, mockRepo, _ := newTestDepartmentService()
 
-	mockRepo.On("DeleteById", uint(1)).Return(nil)
+	mockRepo.On("DeleteById", uint(1), uint(0), audit.Meta{}).Return(nil)
 
-	err := svc.DeleteDepartmentById(1)
+	err := svc.DeleteDepartmentById(1, 0, audit.Meta{})
 
 	assert.NoError(t, err)
 	mockRepo.AssertExpectations(t)
@@ -181,9 +185,9 @@ func TestDeleteDepartmentById(t *testing.T) {
 func TestDeleteDepartmentById_Error(t *testing.T) {
 	svc, mockRepo, _ := newTestDepartmentService()
 
-	mockRepo.On("DeleteById", uint(999)).Return(errors.New("delete error"))
+	mockRepo.On("DeleteById", uint(999), uint(0), audit.Meta{}).Return(errors.New("delete error"))
 
-	err := svc.DeleteDepartmentById(999)
+	err := svc.DeleteDepartmentById(999, 0, audit.Meta{})
 
 	assert.EqualError(t, err, "delete error")
 	mockRepo.AssertExpectations(t)
@@ -194,7 +198,7 @@ func TestDepartmentSetTeacher_DepartmentNotFound(t *testing.T) {
 
 	mockDeptRepo.On("ExistsById", uint(1)).Return(false, nil)
 
-	result, err := svc.DepartmentSetTeacher(1, 10)
+	result, err := svc.DepartmentSetTeacher(1, 10, audit.Meta{})
 
 	assert.Nil(t, result)
 	assert.EqualError(t, err, "department not found")
@@ -208,7 +212,7 @@ func TestDepartmentSetTeacher_TeacherNotFound(t *testing.T) {
 	mockDeptRepo.On("ExistsById", uint(1)).Return(true, nil)
 	mockTeacherRepo.On("ExistsById", uint(10)).Return(false, nil)
 
-	result, err := svc.DepartmentSetTeacher(1, 10)
+	result, err := svc.DepartmentSetTeacher(1, 10, audit.Meta{})
 
 	assert.Nil(t, result)
 	assert.EqualError(t, err, "teacher not found")
@@ -216,3 +220,23 @@ func TestDepartmentSetTeacher_TeacherNotFound(t *testing.T) {
 	mockDeptRepo.AssertExpectations(t)
 	mockTeacherRepo.AssertExpectations(t)
 }
+
+func TestDepartmentSetTeacher_SameHead(t *testing.T) {
+	svc, mockDeptRepo, mockTeacherRepo := newTestDepartmentService()
+	headId := uint(10)
+
+	mockDeptRepo.On("ExistsById", uint(1)).Return(true, nil)
+	mockTeacherRepo.On("ExistsById", uint(10)).Return(true, nil)
+	mockDeptRepo.On("HasHead", uint(1), uint(10)).Return(true, nil)
+	mockDeptRepo.On("FindById", uint(1)).Return(&entity.Department{ID: 1, Name: "Math", HeadOfDepartmentID: &headId,
+		HeadOfDepartment: &entity.Teacher{ID: 10, Name: "Euler"}, Version: 3}, nil)
+
+	result, err := svc.DepartmentSetTeacher(1, 10, audit.Meta{})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Euler", result.HeadOfDepartment.Name)
+	assert.Equal(t, uint(3), result.Version)
+
+	mockDeptRepo.AssertExpectations(t)
+	mockTeacherRepo.AssertExpectations(t)
+}
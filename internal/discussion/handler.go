@@ -0,0 +1,344 @@
+package discussion
+
+import (
+	"errors"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+	"net/http"
+	"strconv"
+	"student_go/internal/actor"
+	"student_go/internal/course"
+	"student_go/internal/dto/request"
+	"student_go/pkg/log"
+	"student_go/pkg/pagination"
+	"student_go/pkg/patch"
+)
+
+type Handler struct {
+	Service Service
+}
+
+func NewDiscussionHandler() *Handler {
+	return &Handler{
+		Service: NewDiscussionService(NewDiscussionRepository(), course.NewCourseRepository()),
+	}
+}
+
+func (h *Handler) CreateThread(c *gin.Context) {
+	courseId, ok := parseID(c, "id", "invalid course ID")
+	if !ok {
+		return
+	}
+
+	a, err := actor.FromContext(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+
+	var req request.ThreadRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		log.Log.Warn("Invalid request in CreateThread", zap.Error(err))
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	log.Log.Info("CreateThread called", zap.Uint("course_id", courseId), zap.String("title", req.Title))
+
+	threadResp, err := h.Service.CreateThread(courseId, a, req)
+	if err != nil {
+		writeError(c, err, "failed to save thread")
+		return
+	}
+
+	c.JSON(http.StatusCreated, threadResp)
+}
+
+func (h *Handler) PinThread(c *gin.Context) {
+	h.setPinned(c, true)
+}
+
+func (h *Handler) UnpinThread(c *gin.Context) {
+	h.setPinned(c, false)
+}
+
+func (h *Handler) setPinned(c *gin.Context, pinned bool) {
+	courseId, ok := parseID(c, "id", "invalid course ID")
+	if !ok {
+		return
+	}
+	threadId, ok := parseID(c, "threadId", "invalid thread ID")
+	if !ok {
+		return
+	}
+
+	a, err := actor.FromContext(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+
+	log.Log.Info("PinThread called",
+		zap.Uint("course_id", courseId),
+		zap.Uint("thread_id", threadId),
+		zap.Bool("pinned", pinned),
+	)
+
+	threadResp, err := h.Service.PinThread(courseId, threadId, a, pinned)
+	if err != nil {
+		writeError(c, err, "failed to pin thread")
+		return
+	}
+
+	c.JSON(http.StatusOK, threadResp)
+}
+
+func (h *Handler) FindThreadById(c *gin.Context) {
+	courseId, ok := parseID(c, "id", "invalid course ID")
+	if !ok {
+		return
+	}
+	threadId, ok := parseID(c, "threadId", "invalid thread ID")
+	if !ok {
+		return
+	}
+
+	a, err := actor.FromContext(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+
+	log.Log.Info("FindThreadById called", zap.Uint("course_id", courseId), zap.Uint("thread_id", threadId))
+
+	threadResp, err := h.Service.FindThreadById(courseId, threadId, a)
+	if err != nil {
+		writeError(c, err, "something went wrong")
+		return
+	}
+
+	c.JSON(http.StatusOK, threadResp)
+}
+
+func (h *Handler) FindAllThreads(c *gin.Context) {
+	courseId, ok := parseID(c, "id", "invalid course ID")
+	if !ok {
+		return
+	}
+
+	a, err := actor.FromContext(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+
+	count, err := h.Service.CountThreads(courseId, a)
+	if err != nil {
+		log.Log.Error("Failed to count threads", zap.Error(err))
+		writeError(c, err, "failed to count threads")
+		return
+	}
+
+	pages := pagination.NewFromRequest(c.Request, count)
+
+	log.Log.Info("FindAllThreads called",
+		zap.Uint("course_id", courseId),
+		zap.Int("page", pages.Page),
+		zap.Int("per_page", pages.PerPage),
+		zap.Int("total_count", pages.TotalCount),
+	)
+
+	threads, err := h.Service.FindAllThreads(courseId, a, pages.Page, pages.PerPage)
+	if err != nil {
+		writeError(c, err, "failed to get threads")
+		return
+	}
+
+	pages.Items = threads
+	c.JSON(http.StatusOK, pages)
+}
+
+func (h *Handler) CreatePost(c *gin.Context) {
+	courseId, ok := parseID(c, "id", "invalid course ID")
+	if !ok {
+		return
+	}
+	threadId, ok := parseID(c, "threadId", "invalid thread ID")
+	if !ok {
+		return
+	}
+
+	a, err := actor.FromContext(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+
+	var req request.PostRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		log.Log.Warn("Invalid request in CreatePost", zap.Error(err))
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	log.Log.Info("CreatePost called", zap.Uint("course_id", courseId), zap.Uint("thread_id", threadId))
+
+	postResp, err := h.Service.CreatePost(courseId, threadId, a, req)
+	if err != nil {
+		writeError(c, err, "failed to save post")
+		return
+	}
+
+	c.JSON(http.StatusCreated, postResp)
+}
+
+func (h *Handler) UpdatePost(c *gin.Context) {
+	courseId, ok := parseID(c, "id", "invalid course ID")
+	if !ok {
+		return
+	}
+	threadId, ok := parseID(c, "threadId", "invalid thread ID")
+	if !ok {
+		return
+	}
+	postId, ok := parseID(c, "postId", "invalid post ID")
+	if !ok {
+		return
+	}
+
+	a, err := actor.FromContext(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+
+	var req request.PostPatch
+	fields, err := patch.Bind(c.Request, &req)
+	if err != nil {
+		log.Log.Warn("Invalid request in UpdatePost", zap.Error(err))
+		if errors.Is(err, patch.ErrUnsupportedMediaType) {
+			c.JSON(http.StatusUnsupportedMediaType, gin.H{"error": err.Error()})
+		} else {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	log.Log.Info("UpdatePost called",
+		zap.Uint("course_id", courseId),
+		zap.Uint("thread_id", threadId),
+		zap.Uint("post_id", postId),
+		zap.Strings("fields", fields.Names()),
+	)
+
+	postResp, err := h.Service.UpdatePost(courseId, threadId, postId, a, req, fields)
+	if err != nil {
+		writeError(c, err, "failed to update post")
+		return
+	}
+
+	c.JSON(http.StatusOK, postResp)
+}
+
+func (h *Handler) FindAllPosts(c *gin.Context) {
+	courseId, ok := parseID(c, "id", "invalid course ID")
+	if !ok {
+		return
+	}
+	threadId, ok := parseID(c, "threadId", "invalid thread ID")
+	if !ok {
+		return
+	}
+
+	a, err := actor.FromContext(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+
+	count, err := h.Service.CountPosts(courseId, threadId, a)
+	if err != nil {
+		log.Log.Error("Failed to count posts", zap.Error(err))
+		writeError(c, err, "failed to count posts")
+		return
+	}
+
+	pages := pagination.NewFromRequest(c.Request, count)
+
+	log.Log.Info("FindAllPosts called",
+		zap.Uint("course_id", courseId),
+		zap.Uint("thread_id", threadId),
+		zap.Int("page", pages.Page),
+		zap.Int("per_page", pages.PerPage),
+		zap.Int("total_count", pages.TotalCount),
+	)
+
+	posts, err := h.Service.FindAllPosts(courseId, threadId, a, pages.Page, pages.PerPage)
+	if err != nil {
+		writeError(c, err, "failed to get posts")
+		return
+	}
+
+	pages.Items = posts
+	c.JSON(http.StatusOK, pages)
+}
+
+func (h *Handler) FindPostRevisions(c *gin.Context) {
+	courseId, ok := parseID(c, "id", "invalid course ID")
+	if !ok {
+		return
+	}
+	threadId, ok := parseID(c, "threadId", "invalid thread ID")
+	if !ok {
+		return
+	}
+	postId, ok := parseID(c, "postId", "invalid post ID")
+	if !ok {
+		return
+	}
+
+	a, err := actor.FromContext(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+
+	log.Log.Info("FindPostRevisions called",
+		zap.Uint("course_id", courseId),
+		zap.Uint("thread_id", threadId),
+		zap.Uint("post_id", postId),
+	)
+
+	revisions, err := h.Service.FindPostRevisions(courseId, threadId, postId, a)
+	if err != nil {
+		writeError(c, err, "failed to get post revisions")
+		return
+	}
+
+	c.JSON(http.StatusOK, revisions)
+}
+
+func parseID(c *gin.Context, name string, message string) (uint, bool) {
+	param := c.Param(name)
+	parsed, err := strconv.ParseUint(param, 10, 32)
+	if err != nil {
+		log.Log.Warn("Invalid path parameter", zap.String(name, param), zap.Error(err))
+		c.JSON(http.StatusBadRequest, gin.H{"error": message})
+		return 0, false
+	}
+
+	return uint(parsed), true
+}
+
+func writeError(c *gin.Context, err error, fallback string) {
+	switch {
+	case errors.Is(err, ErrCourseNotFound), errors.Is(err, ErrThreadNotFound), errors.Is(err, ErrPostNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	case errors.Is(err, ErrInvalidParent):
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	case errors.Is(err, ErrForbidden):
+		c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": fallback})
+	}
+}
@@ -0,0 +1,111 @@
+package discussion
+
+import (
+	"bytes"
+	"encoding/json"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"student_go/internal/actor"
+	"student_go/internal/dto/request"
+	"student_go/internal/dto/response"
+	"student_go/internal/mocks"
+	"student_go/pkg/patch"
+	"testing"
+)
+
+func setupHandlerTest() (*gin.Engine, *mocks.DiscussionServiceMock, *Handler) {
+	gin.SetMode(gin.TestMode)
+	mockService := new(mocks.DiscussionServiceMock)
+	handler := &Handler{Service: mockService}
+	r := gin.Default()
+	return r, mockService, handler
+}
+
+func setActor(req *http.Request, id string, role string) {
+	req.Header.Set(actor.HeaderUserID, id)
+	req.Header.Set(actor.HeaderUserRole, role)
+}
+
+func TestCreateThreadHandler(t *testing.T) {
+	r, mockService, handler := setupHandlerTest()
+	input := request.ThreadRequest{Title: "Question", Body: "How?"}
+	expected := &response.ThreadResponse{ID: 1, CourseID: 1, Title: "Question"}
+	mockService.On("CreateThread", uint(1), enrolledStudent, input).Return(expected, nil)
+
+	r.POST("/courses/:id/threads", handler.CreateThread)
+	body, _ := json.Marshal(input)
+	req := httptest.NewRequest(http.MethodPost, "/courses/1/threads", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	setActor(req, "20", "student")
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusCreated, resp.Code)
+	mockService.AssertExpectations(t)
+}
+
+func TestFindAllThreadsHandler(t *testing.T) {
+	r, mockService, handler := setupHandlerTest()
+	threads := []*response.ThreadResponse{{ID: 1, Title: "Question"}}
+	mockService.On("CountThreads", uint(1), enrolledStudent).Return(1, nil)
+	mockService.On("FindAllThreads", uint(1), enrolledStudent, 1, 10).Return(threads, nil)
+
+	r.GET("/courses/:id/threads", handler.FindAllThreads)
+	req := httptest.NewRequest(http.MethodGet, "/courses/1/threads?page=1&per_page=10", nil)
+	setActor(req, "20", "student")
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	mockService.AssertExpectations(t)
+}
+
+func TestCreatePostHandler_InvalidParent(t *testing.T) {
+	r, mockService, handler := setupHandlerTest()
+	parentId := uint(99)
+	input := request.PostRequest{ParentID: &parentId, Body: "reply"}
+	mockService.On("CreatePost", uint(1), uint(2), enrolledStudent, input).Return(nil, ErrInvalidParent)
+
+	r.POST("/courses/:id/threads/:threadId/posts", handler.CreatePost)
+	body, _ := json.Marshal(input)
+	req := httptest.NewRequest(http.MethodPost, "/courses/1/threads/2/posts", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	setActor(req, "20", "student")
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	mockService.AssertExpectations(t)
+}
+
+func TestUpdatePostHandler_Forbidden(t *testing.T) {
+	r, mockService, handler := setupHandlerTest()
+	edited := "edited"
+	input := request.PostPatch{Body: &edited}
+	mockService.On("UpdatePost", uint(1), uint(2), uint(3), enrolledStudent, input, patch.Fields{"body": true}).Return(nil, ErrForbidden)
+
+	r.PATCH("/courses/:id/threads/:threadId/posts/:postId", handler.UpdatePost)
+	body, _ := json.Marshal(input)
+	req := httptest.NewRequest(http.MethodPatch, "/courses/1/threads/2/posts/3", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	setActor(req, "20", "student")
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusForbidden, resp.Code)
+	mockService.AssertExpectations(t)
+}
+
+func TestPinThreadHandler_InvalidThreadID(t *testing.T) {
+	r, _, handler := setupHandlerTest()
+
+	r.POST("/courses/:id/threads/:threadId/pin", handler.PinThread)
+	req := httptest.NewRequest(http.MethodPost, "/courses/1/threads/abc/pin", nil)
+	setActor(req, "10", "teacher")
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+}
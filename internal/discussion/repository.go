@@ -0,0 +1,183 @@
+package discussion
+
+import (
+	"gorm.io/gorm"
+	"student_go/internal/entity"
+	"student_go/pkg/dbcontext"
+	"time"
+)
+
+type Repository interface {
+	SaveThread(thread *entity.DiscussionThread, post *entity.DiscussionPost) (*entity.DiscussionThread, error)
+	SetThreadPinned(id uint, pinned bool) error
+	FindThreadById(courseId uint, id uint) (*entity.DiscussionThread, error)
+	FindAllThreads(courseId uint, page, limit int) ([]entity.DiscussionThread, error)
+	CountThreads(courseId uint) (int, error)
+	SavePost(post *entity.DiscussionPost) (*entity.DiscussionPost, error)
+	UpdatePost(post *entity.DiscussionPost, revision *entity.DiscussionPostRevision) (*entity.DiscussionPost, error)
+	FindPostById(threadId uint, id uint) (*entity.DiscussionPost, error)
+	FindAllPosts(threadId uint, page, limit int) ([]entity.DiscussionPost, error)
+	CountPosts(threadId uint) (int, error)
+	FindPostRevisions(postId uint) ([]entity.DiscussionPostRevision, error)
+}
+
+type repository struct{}
+
+func NewDiscussionRepository() Repository {
+	return &repository{}
+}
+
+// SaveThread creates the thread together with its opening post.
+func (r *repository) SaveThread(thread *entity.DiscussionThread, post *entity.DiscussionPost) (*entity.DiscussionThread, error) {
+	err := dbcontext.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(thread).Error; err != nil {
+			return err
+		}
+
+		post.ThreadID = thread.ID
+		return tx.Create(post).Error
+	})
+
+	return thread, err
+}
+
+func (r *repository) SetThreadPinned(id uint, pinned bool) error {
+	return dbcontext.DB.Model(&entity.DiscussionThread{}).
+		Where("id = ?", id).
+		UpdateColumn("pinned", pinned).Error
+}
+
+func (r *repository) FindThreadById(courseId uint, id uint) (*entity.DiscussionThread, error) {
+	var thread entity.DiscussionThread
+	result := dbcontext.DB.
+		Where("course_id = ?", courseId).
+		First(&thread, id)
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return &thread, nil
+}
+
+func (r *repository) FindAllThreads(courseId uint, page, limit int) ([]entity.DiscussionThread, error) {
+	var threads []entity.DiscussionThread
+
+	offset := (page - 1) * limit
+
+	result := dbcontext.DB.
+		Where("course_id = ?", courseId).
+		Order("pinned DESC").
+		Order("updated_at DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&threads)
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return threads, nil
+}
+
+func (r *repository) CountThreads(courseId uint) (int, error) {
+	var count int64
+	err := dbcontext.DB.Model(&entity.DiscussionThread{}).
+		Where("course_id = ?", courseId).
+		Count(&count).Error
+	return int(count), err
+}
+
+// SavePost creates the post and bumps the thread's activity time so that
+// recently answered threads are listed first.
+func (r *repository) SavePost(post *entity.DiscussionPost) (*entity.DiscussionPost, error) {
+	err := dbcontext.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(post).Error; err != nil {
+			return err
+		}
+
+		return tx.Model(&entity.DiscussionThread{}).
+			Where("id = ?", post.ThreadID).
+			UpdateColumn("updated_at", time.Now()).Error
+	})
+
+	return post, err
+}
+
+// UpdatePost stores the previous body as a revision and applies the new one
+// in a single transaction.
+func (r *repository) UpdatePost(post *entity.DiscussionPost, revision *entity.DiscussionPostRevision) (*entity.DiscussionPost, error) {
+	err := dbcontext.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(revision).Error; err != nil {
+			return err
+		}
+
+		return tx.Model(&entity.DiscussionPost{}).
+			Where("id = ?", post.ID).
+			Updates(map[string]interface{}{
+				"body":      post.Body,
+				"edited_at": post.EditedAt,
+			}).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return r.FindPostById(post.ThreadID, post.ID)
+}
+
+func (r *repository) FindPostById(threadId uint, id uint) (*entity.DiscussionPost, error) {
+	var post entity.DiscussionPost
+	result := dbcontext.DB.
+		Where("thread_id = ?", threadId).
+		First(&post, id)
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return &post, nil
+}
+
+func (r *repository) FindAllPosts(threadId uint, page, limit int) ([]entity.DiscussionPost, error) {
+	var posts []entity.DiscussionPost
+
+	offset := (page - 1) * limit
+
+	result := dbcontext.DB.
+		Where("thread_id = ?", threadId).
+		Order("created_at").
+		Order("id").
+		Limit(limit).
+		Offset(offset).
+		Find(&posts)
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return posts, nil
+}
+
+func (r *repository) CountPosts(threadId uint) (int, error) {
+	var count int64
+	err := dbcontext.DB.Model(&entity.DiscussionPost{}).
+		Where("thread_id = ?", threadId).
+		Count(&count).Error
+	return int(count), err
+}
+
+func (r *repository) FindPostRevisions(postId uint) ([]entity.DiscussionPostRevision, error) {
+	var revisions []entity.DiscussionPostRevision
+
+	result := dbcontext.DB.
+		Where("post_id = ?", postId).
+		Order("created_at DESC").
+		Find(&revisions)
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return revisions, nil
+}
@@ -0,0 +1,94 @@
+package discussion
+
+import (
+	"database/sql"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"regexp"
+	"testing"
+
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+
+	"student_go/internal/entity"
+	"student_go/pkg/dbcontext"
+)
+
+func setupTestDB(t *testing.T) (*sql.DB, sqlmock.Sqlmock, *gorm.DB) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+
+	dialector := postgres.New(postgres.Config{
+		Conn:                 db,
+		PreferSimpleProtocol: true,
+	})
+
+	gormDB, err := gorm.Open(dialector, &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	assert.NoError(t, err)
+
+	dbcontext.DB = gormDB
+	return db, mock, gormDB
+}
+
+func TestDiscussionSaveThread(t *testing.T) {
+	db, mock, _ := setupTestDB(t)
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "discussion_threads"`)).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(5))
+	mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "discussion_posts"`)).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(11))
+	mock.ExpectCommit()
+
+	repo := NewDiscussionRepository()
+	post := &entity.DiscussionPost{Body: "How?"}
+	thread, err := repo.SaveThread(&entity.DiscussionThread{CourseID: 1, Title: "Question"}, post)
+
+	require.NoError(t, err)
+	assert.Equal(t, uint(5), thread.ID)
+	assert.Equal(t, uint(5), post.ThreadID)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDiscussionSavePost_TouchesThread(t *testing.T) {
+	db, mock, _ := setupTestDB(t)
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "discussion_posts"`)).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(12))
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE "discussion_threads" SET "updated_at"=$1 WHERE id = $2`)).
+		WithArgs(sqlmock.AnyArg(), 5).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	repo := NewDiscussionRepository()
+	post, err := repo.SavePost(&entity.DiscussionPost{ThreadID: 5, Body: "Answer"})
+
+	require.NoError(t, err)
+	assert.Equal(t, uint(12), post.ID)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDiscussionFindAllPosts(t *testing.T) {
+	db, mock, _ := setupTestDB(t)
+	defer db.Close()
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "discussion_posts" WHERE thread_id = $1 ORDER BY created_at,id LIMIT $2 OFFSET $3`)).
+		WithArgs(5, 2, 2).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "thread_id", "body"}).
+			AddRow(3, 5, "third").
+			AddRow(4, 5, "fourth"))
+
+	repo := NewDiscussionRepository()
+	posts, err := repo.FindAllPosts(5, 2, 2)
+
+	require.NoError(t, err)
+	require.Len(t, posts, 2)
+	assert.Equal(t, "third", posts[0].Body)
+}
@@ -0,0 +1,369 @@
+package discussion
+
+import (
+	"errors"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"student_go/internal/actor"
+	"student_go/internal/course"
+	"student_go/internal/dto/request"
+	"student_go/internal/dto/response"
+	"student_go/internal/entity"
+	"student_go/pkg/log"
+	"student_go/pkg/patch"
+	"time"
+)
+
+var (
+	ErrCourseNotFound = errors.New("course not found")
+	ErrThreadNotFound = errors.New("thread not found")
+	ErrPostNotFound   = errors.New("post not found")
+	ErrInvalidParent  = errors.New("parent post does not belong to this thread")
+	ErrForbidden      = errors.New("forbidden")
+)
+
+type Service interface {
+	CreateThread(courseId uint, a actor.Actor, input request.ThreadRequest) (*response.ThreadResponse, error)
+	PinThread(courseId uint, threadId uint, a actor.Actor, pinned bool) (*response.ThreadResponse, error)
+	FindThreadById(courseId uint, threadId uint, a actor.Actor) (*response.ThreadResponse, error)
+	FindAllThreads(courseId uint, a actor.Actor, page, limit int) ([]*response.ThreadResponse, error)
+	CountThreads(courseId uint, a actor.Actor) (int, error)
+	CreatePost(courseId uint, threadId uint, a actor.Actor, input request.PostRequest) (*response.PostResponse, error)
+	UpdatePost(courseId uint, threadId uint, postId uint, a actor.Actor, input request.PostPatch, fields patch.Fields) (*response.PostResponse, error)
+	FindAllPosts(courseId uint, threadId uint, a actor.Actor, page, limit int) ([]*response.PostResponse, error)
+	CountPosts(courseId uint, threadId uint, a actor.Actor) (int, error)
+	FindPostRevisions(courseId uint, threadId uint, postId uint, a actor.Actor) ([]*response.PostRevisionResponse, error)
+}
+
+type service struct {
+	discussionRepository Repository
+	courseRepository     course.Repository
+}
+
+func NewDiscussionService(
+	discussionRepository Repository,
+	courseRepository course.Repository,
+) Service {
+	return &service{
+		discussionRepository: discussionRepository,
+		courseRepository:     courseRepository,
+	}
+}
+
+func (s *service) CreateThread(courseId uint, a actor.Actor, input request.ThreadRequest) (*response.ThreadResponse, error) {
+	log.Log.Info("CreateThread (service) called", zap.Uint("course_id", courseId), zap.String("title", input.Title))
+
+	if err := s.authorize(courseId, a, course.AccessMember); err != nil {
+		return nil, err
+	}
+
+	thread := entity.DiscussionThread{
+		CourseID:   courseId,
+		AuthorRole: string(a.Role),
+		AuthorID:   a.ID,
+		Title:      input.Title,
+	}
+	post := entity.DiscussionPost{
+		AuthorRole: string(a.Role),
+		AuthorID:   a.ID,
+		Body:       input.Body,
+	}
+	saved, err := s.discussionRepository.SaveThread(&thread, &post)
+	if err != nil {
+		return nil, err
+	}
+
+	return toThreadResponse(saved), nil
+}
+
+func (s *service) PinThread(courseId uint, threadId uint, a actor.Actor, pinned bool) (*response.ThreadResponse, error) {
+	log.Log.Info("PinThread (service) called",
+		zap.Uint("course_id", courseId),
+		zap.Uint("thread_id", threadId),
+		zap.Bool("pinned", pinned),
+	)
+
+	if err := s.authorize(courseId, a, course.AccessStaff); err != nil {
+		return nil, err
+	}
+
+	thread, err := s.findThread(courseId, threadId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.discussionRepository.SetThreadPinned(thread.ID, pinned); err != nil {
+		return nil, err
+	}
+	thread.Pinned = pinned
+
+	return toThreadResponse(thread), nil
+}
+
+func (s *service) FindThreadById(courseId uint, threadId uint, a actor.Actor) (*response.ThreadResponse, error) {
+	log.Log.Info("FindThreadById (service) called", zap.Uint("course_id", courseId), zap.Uint("thread_id", threadId))
+
+	if err := s.authorize(courseId, a, course.AccessMember); err != nil {
+		return nil, err
+	}
+
+	thread, err := s.findThread(courseId, threadId)
+	if err != nil {
+		return nil, err
+	}
+
+	return toThreadResponse(thread), nil
+}
+
+func (s *service) FindAllThreads(courseId uint, a actor.Actor, page, limit int) ([]*response.ThreadResponse, error) {
+	log.Log.Info("FindAllThreads (service) called",
+		zap.Uint("course_id", courseId),
+		zap.Int("page", page),
+		zap.Int("limit", limit),
+	)
+
+	if err := s.authorize(courseId, a, course.AccessMember); err != nil {
+		return nil, err
+	}
+
+	threads, err := s.discussionRepository.FindAllThreads(courseId, page, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	threadResponses := make([]*response.ThreadResponse, 0, len(threads))
+	for i := range threads {
+		threadResponses = append(threadResponses, toThreadResponse(&threads[i]))
+	}
+
+	return threadResponses, nil
+}
+
+func (s *service) CountThreads(courseId uint, a actor.Actor) (int, error) {
+	if err := s.authorize(courseId, a, course.AccessMember); err != nil {
+		return 0, err
+	}
+
+	return s.discussionRepository.CountThreads(courseId)
+}
+
+func (s *service) CreatePost(courseId uint, threadId uint, a actor.Actor, input request.PostRequest) (*response.PostResponse, error) {
+	log.Log.Info("CreatePost (service) called", zap.Uint("course_id", courseId), zap.Uint("thread_id", threadId))
+
+	if err := s.authorize(courseId, a, course.AccessMember); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.findThread(courseId, threadId); err != nil {
+		return nil, err
+	}
+
+	if input.ParentID != nil {
+		_, err := s.discussionRepository.FindPostById(threadId, *input.ParentID)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrInvalidParent
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	post := entity.DiscussionPost{
+		ThreadID:   threadId,
+		ParentID:   input.ParentID,
+		AuthorRole: string(a.Role),
+		AuthorID:   a.ID,
+		Body:       input.Body,
+	}
+	saved, err := s.discussionRepository.SavePost(&post)
+	if err != nil {
+		return nil, err
+	}
+
+	return toPostResponse(saved), nil
+}
+
+// UpdatePost lets authors edit their own posts; the previous body is kept
+// as a revision.
+func (s *service) UpdatePost(courseId uint, threadId uint, postId uint, a actor.Actor, input request.PostPatch, fields patch.Fields) (*response.PostResponse, error) {
+	log.Log.Info("UpdatePost (service) called",
+		zap.Uint("course_id", courseId),
+		zap.Uint("thread_id", threadId),
+		zap.Uint("post_id", postId),
+	)
+
+	if err := s.authorize(courseId, a, course.AccessMember); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.findThread(courseId, threadId); err != nil {
+		return nil, err
+	}
+
+	post, err := s.findPost(threadId, postId)
+	if err != nil {
+		return nil, err
+	}
+
+	if post.AuthorRole != string(a.Role) || post.AuthorID != a.ID {
+		return nil, ErrForbidden
+	}
+	if !fields.Has("body") {
+		return toPostResponse(post), nil
+	}
+
+	revision := entity.DiscussionPostRevision{
+		PostID: post.ID,
+		Body:   post.Body,
+	}
+
+	now := time.Now()
+	post.Body = patch.Value(input.Body)
+	post.EditedAt = &now
+
+	updated, err := s.discussionRepository.UpdatePost(post, &revision)
+	if err != nil {
+		return nil, err
+	}
+
+	return toPostResponse(updated), nil
+}
+
+func (s *service) FindAllPosts(courseId uint, threadId uint, a actor.Actor, page, limit int) ([]*response.PostResponse, error) {
+	log.Log.Info("FindAllPosts (service) called",
+		zap.Uint("course_id", courseId),
+		zap.Uint("thread_id", threadId),
+		zap.Int("page", page),
+		zap.Int("limit", limit),
+	)
+
+	if err := s.authorize(courseId, a, course.AccessMember); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.findThread(courseId, threadId); err != nil {
+		return nil, err
+	}
+
+	posts, err := s.discussionRepository.FindAllPosts(threadId, page, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	postResponses := make([]*response.PostResponse, 0, len(posts))
+	for i := range posts {
+		postResponses = append(postResponses, toPostResponse(&posts[i]))
+	}
+
+	return postResponses, nil
+}
+
+func (s *service) CountPosts(courseId uint, threadId uint, a actor.Actor) (int, error) {
+	if err := s.authorize(courseId, a, course.AccessMember); err != nil {
+		return 0, err
+	}
+
+	if _, err := s.findThread(courseId, threadId); err != nil {
+		return 0, err
+	}
+
+	return s.discussionRepository.CountPosts(threadId)
+}
+
+func (s *service) FindPostRevisions(courseId uint, threadId uint, postId uint, a actor.Actor) ([]*response.PostRevisionResponse, error) {
+	log.Log.Info("FindPostRevisions (service) called",
+		zap.Uint("course_id", courseId),
+		zap.Uint("thread_id", threadId),
+		zap.Uint("post_id", postId),
+	)
+
+	if err := s.authorize(courseId, a, course.AccessMember); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.findThread(courseId, threadId); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.findPost(threadId, postId); err != nil {
+		return nil, err
+	}
+
+	revisions, err := s.discussionRepository.FindPostRevisions(postId)
+	if err != nil {
+		return nil, err
+	}
+
+	revisionResponses := make([]*response.PostRevisionResponse, 0, len(revisions))
+	for _, revision := range revisions {
+		revisionResponses = append(revisionResponses, &response.PostRevisionResponse{
+			ID:        revision.ID,
+			Body:      revision.Body,
+			CreatedAt: revision.CreatedAt,
+		})
+	}
+
+	return revisionResponses, nil
+}
+
+func (s *service) authorize(courseId uint, a actor.Actor, required course.Access) error {
+	exists, err := s.courseRepository.ExistsById(courseId)
+	if err != nil || !exists {
+		return ErrCourseNotFound
+	}
+
+	access, err := course.ResolveAccess(s.courseRepository, courseId, a)
+	if err != nil {
+		return err
+	}
+	if access < required {
+		return ErrForbidden
+	}
+
+	return nil
+}
+
+func (s *service) findThread(courseId uint, threadId uint) (*entity.DiscussionThread, error) {
+	thread, err := s.discussionRepository.FindThreadById(courseId, threadId)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrThreadNotFound
+	}
+
+	return thread, err
+}
+
+func (s *service) findPost(threadId uint, postId uint) (*entity.DiscussionPost, error) {
+	post, err := s.discussionRepository.FindPostById(threadId, postId)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrPostNotFound
+	}
+
+	return post, err
+}
+
+func toThreadResponse(thread *entity.DiscussionThread) *response.ThreadResponse {
+	return &response.ThreadResponse{
+		ID:         thread.ID,
+		CourseID:   thread.CourseID,
+		AuthorRole: thread.AuthorRole,
+		AuthorID:   thread.AuthorID,
+		Title:      thread.Title,
+		Pinned:     thread.Pinned,
+		CreatedAt:  thread.CreatedAt,
+		UpdatedAt:  thread.UpdatedAt,
+	}
+}
+
+func toPostResponse(post *entity.DiscussionPost) *response.PostResponse {
+	return &response.PostResponse{
+		ID:         post.ID,
+		ThreadID:   post.ThreadID,
+		ParentID:   post.ParentID,
+		AuthorRole: post.AuthorRole,
+		AuthorID:   post.AuthorID,
+		Body:       post.Body,
+		EditedAt:   post.EditedAt,
+		CreatedAt:  post.CreatedAt,
+		UpdatedAt:  post.UpdatedAt,
+	}
+}
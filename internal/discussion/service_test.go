@@ -0,0 +1,142 @@
+package discussion
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"student_go/internal/actor"
+	"student_go/internal/dto/request"
+	"student_go/internal/entity"
+	mocks2 "student_go/internal/mocks"
+	"student_go/pkg/log"
+	"student_go/pkg/patch"
+	"testing"
+)
+
+func init() {
+	logger, _ := zap.NewDevelopment()
+	log.Log = logger
+}
+
+var (
+	courseTeacher   = actor.Actor{ID: 10, Role: actor.RoleTeacher}
+	enrolledStudent = actor.Actor{ID: 20, Role: actor.RoleStudent}
+)
+
+func newTestDiscussionService() (Service, *mocks2.DiscussionRepository, *mocks2.CourseRepository) {
+	mockDiscussionRepo := new(mocks2.DiscussionRepository)
+	mockCourseRepo := new(mocks2.CourseRepository)
+
+	mockCourseRepo.On("ExistsById", uint(1)).Return(true, nil).Maybe()
+	mockCourseRepo.On("HasTeacher", uint(1), uint(10)).Return(true, nil).Maybe()
+	mockCourseRepo.On("HasStudent", uint(1), uint(20)).Return(true, nil).Maybe()
+
+	svc := NewDiscussionService(mockDiscussionRepo, mockCourseRepo)
+	return svc, mockDiscussionRepo, mockCourseRepo
+}
+
+func TestCreateThread(t *testing.T) {
+	svc, mockDiscussionRepo, _ := newTestDiscussionService()
+
+	input := request.ThreadRequest{Title: "Homework 1", Body: "Is task 3 optional?"}
+	mockDiscussionRepo.On("SaveThread",
+		mock.MatchedBy(func(th *entity.DiscussionThread) bool {
+			return th.CourseID == 1 && th.AuthorID == 20 && th.Title == "Homework 1"
+		}),
+		mock.MatchedBy(func(p *entity.DiscussionPost) bool {
+			return p.AuthorID == 20 && p.Body == "Is task 3 optional?"
+		}),
+	).Return(&entity.DiscussionThread{ID: 5, CourseID: 1, Title: "Homework 1"}, nil)
+
+	result, err := svc.CreateThread(1, enrolledStudent, input)
+
+	assert.NoError(t, err)
+	assert.Equal(t, uint(5), result.ID)
+	mockDiscussionRepo.AssertExpectations(t)
+}
+
+func TestPinThread_StudentForbidden(t *testing.T) {
+	svc, mockDiscussionRepo, _ := newTestDiscussionService()
+
+	result, err := svc.PinThread(1, 5, enrolledStudent, true)
+
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, ErrForbidden)
+	mockDiscussionRepo.AssertNotCalled(t, "SetThreadPinned", mock.Anything, mock.Anything)
+}
+
+func TestCreatePost_Reply(t *testing.T) {
+	svc, mockDiscussionRepo, _ := newTestDiscussionService()
+
+	parentId := uint(7)
+	mockDiscussionRepo.On("FindThreadById", uint(1), uint(5)).Return(&entity.DiscussionThread{ID: 5, CourseID: 1}, nil)
+	mockDiscussionRepo.On("FindPostById", uint(5), parentId).Return(&entity.DiscussionPost{ID: 7, ThreadID: 5}, nil)
+	mockDiscussionRepo.On("SavePost", mock.MatchedBy(func(p *entity.DiscussionPost) bool {
+		return p.ThreadID == 5 && p.ParentID != nil && *p.ParentID == 7 && p.AuthorRole == "teacher"
+	})).Return(&entity.DiscussionPost{ID: 8, ThreadID: 5, ParentID: &parentId, Body: "Yes"}, nil)
+
+	result, err := svc.CreatePost(1, 5, courseTeacher, request.PostRequest{ParentID: &parentId, Body: "Yes"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, uint(8), result.ID)
+	assert.Equal(t, parentId, *result.ParentID)
+	mockDiscussionRepo.AssertExpectations(t)
+}
+
+func TestCreatePost_ParentFromAnotherThread(t *testing.T) {
+	svc, mockDiscussionRepo, _ := newTestDiscussionService()
+
+	parentId := uint(99)
+	mockDiscussionRepo.On("FindThreadById", uint(1), uint(5)).Return(&entity.DiscussionThread{ID: 5, CourseID: 1}, nil)
+	mockDiscussionRepo.On("FindPostById", uint(5), parentId).Return(nil, gorm.ErrRecordNotFound)
+
+	result, err := svc.CreatePost(1, 5, enrolledStudent, request.PostRequest{ParentID: &parentId, Body: "?"})
+
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, ErrInvalidParent)
+}
+
+func TestUpdatePost_OnlyAuthor(t *testing.T) {
+	svc, mockDiscussionRepo, _ := newTestDiscussionService()
+
+	mockDiscussionRepo.On("FindThreadById", uint(1), uint(5)).Return(&entity.DiscussionThread{ID: 5, CourseID: 1}, nil)
+	mockDiscussionRepo.On("FindPostById", uint(5), uint(8)).
+		Return(&entity.DiscussionPost{ID: 8, ThreadID: 5, AuthorRole: "teacher", AuthorID: 10}, nil)
+
+	body := "edited"
+	result, err := svc.UpdatePost(1, 5, 8, enrolledStudent, request.PostPatch{Body: &body}, patch.Fields{"body": true})
+
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, ErrForbidden)
+}
+
+func TestUpdatePost_KeepsRevision(t *testing.T) {
+	svc, mockDiscussionRepo, _ := newTestDiscussionService()
+
+	mockDiscussionRepo.On("FindThreadById", uint(1), uint(5)).Return(&entity.DiscussionThread{ID: 5, CourseID: 1}, nil)
+	mockDiscussionRepo.On("FindPostById", uint(5), uint(8)).
+		Return(&entity.DiscussionPost{ID: 8, ThreadID: 5, AuthorRole: "student", AuthorID: 20, Body: "original"}, nil)
+	mockDiscussionRepo.On("UpdatePost",
+		mock.MatchedBy(func(p *entity.DiscussionPost) bool { return p.Body == "edited" && p.EditedAt != nil }),
+		mock.MatchedBy(func(r *entity.DiscussionPostRevision) bool { return r.PostID == 8 && r.Body == "original" }),
+	).Return(&entity.DiscussionPost{ID: 8, ThreadID: 5, Body: "edited"}, nil)
+
+	body := "edited"
+	result, err := svc.UpdatePost(1, 5, 8, enrolledStudent, request.PostPatch{Body: &body}, patch.Fields{"body": true})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "edited", result.Body)
+	mockDiscussionRepo.AssertExpectations(t)
+}
+
+func TestFindAllPosts_ThreadNotFound(t *testing.T) {
+	svc, mockDiscussionRepo, _ := newTestDiscussionService()
+
+	mockDiscussionRepo.On("FindThreadById", uint(1), uint(6)).Return(nil, gorm.ErrRecordNotFound)
+
+	result, err := svc.FindAllPosts(1, 6, enrolledStudent, 1, 10)
+
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, ErrThreadNotFound)
+}
@@ -0,0 +1,12 @@
+package request
+
+type AnnouncementRequest struct {
+	Title string `json:"title" binding:"required"`
+	Body  string `json:"body" binding:"required"`
+}
+
+// AnnouncementPatch is a merge patch of an announcement.
+type AnnouncementPatch struct {
+	Title *string `json:"title" binding:"required,min=1"`
+	Body  *string `json:"body" binding:"required,min=1"`
+}
@@ -0,0 +1,6 @@
+package request
+
+// AttachmentRequest holds the form fields sent next to the uploaded file.
+type AttachmentRequest struct {
+	Kind string `form:"kind" binding:"required,oneof=id_scan diploma submission other"`
+}
@@ -0,0 +1,24 @@
+package request
+
+// CalendarEventRequest describes a calendar entry. Dates are YYYY-MM-DD
+// and inclusive; Blackout defaults to true for holidays and false
+// otherwise.
+type CalendarEventRequest struct {
+	Term      string `json:"term"`
+	Kind      string `json:"kind" binding:"required,oneof=holiday exam_period reading_week"`
+	Name      string `json:"name" binding:"required"`
+	StartDate string `json:"startDate" binding:"required,datetime=2006-01-02"`
+	EndDate   string `json:"endDate" binding:"required,datetime=2006-01-02"`
+	Blackout  *bool  `json:"blackout"`
+}
+
+// CalendarEventPatch is a merge patch of a calendar entry. Null clears the
+// term and resets Blackout to the default for the kind.
+type CalendarEventPatch struct {
+	Term      *string `json:"term"`
+	Kind      *string `json:"kind" binding:"required,oneof=holiday exam_period reading_week"`
+	Name      *string `json:"name" binding:"required,min=1"`
+	StartDate *string `json:"startDate" binding:"required,datetime=2006-01-02"`
+	EndDate   *string `json:"endDate" binding:"required,datetime=2006-01-02"`
+	Blackout  *bool   `json:"blackout"`
+}
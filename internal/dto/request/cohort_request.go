@@ -0,0 +1,14 @@
+package request
+
+type CohortRequest struct {
+	Name string `json:"name" binding:"required"`
+}
+
+// CohortPatch is a merge patch of a cohort.
+type CohortPatch struct {
+	Name *string `json:"name" binding:"required,min=1"`
+}
+
+type CohortMembersRequest struct {
+	StudentIDs []uint `json:"studentIds" binding:"required,min=1"`
+}
@@ -0,0 +1,26 @@
+package request
+
+type ContactRequest struct {
+	Name         string `json:"name" binding:"required"`
+	Relationship string `json:"relationship" binding:"required"`
+	// Phone must be in E.164 format, e.g. +79001234567.
+	Phone            string `json:"phone" binding:"required,e164"`
+	Email            string `json:"email" binding:"omitempty,email"`
+	Address          string `json:"address"`
+	IsGuardian       bool   `json:"isGuardian"`
+	IsEmergency      bool   `json:"isEmergency"`
+	CanReceiveGrades bool   `json:"canReceiveGrades"`
+}
+
+// ContactPatch is a merge patch of a contact. Null clears the email and
+// the address and unsets the flags.
+type ContactPatch struct {
+	Name             *string `json:"name" binding:"required,min=1"`
+	Relationship     *string `json:"relationship" binding:"required,min=1"`
+	Phone            *string `json:"phone" binding:"required,e164"`
+	Email            *string `json:"email" binding:"omitempty,email"`
+	Address          *string `json:"address"`
+	IsGuardian       *bool   `json:"isGuardian"`
+	IsEmergency      *bool   `json:"isEmergency"`
+	CanReceiveGrades *bool   `json:"canReceiveGrades"`
+}
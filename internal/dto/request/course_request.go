@@ -1,5 +1,18 @@
 package request
 
+import "encoding/json"
+
 type CourseRequest struct {
-	Title string `json:"title" binding:"required"`
+	Title   string `json:"title" binding:"required"`
+	Subject string `json:"subject"`
+}
+
+// CoursePatch is a merge patch of a course. Null clears the subject.
+type CoursePatch struct {
+	Title   *string `json:"title" binding:"required,min=1"`
+	Subject *string `json:"subject"`
+	// Teacher can only be set to null, which unassigns the teacher;
+	// teachers are assigned with their own endpoint, which checks their
+	// qualifications and workload.
+	Teacher *json.RawMessage `json:"teacher" binding:"isdefault"`
 }
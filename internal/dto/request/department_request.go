@@ -1,5 +1,15 @@
 package request
 
+import "encoding/json"
+
 type DepartmentRequest struct {
 	Name string `json:"name" binding:"required"`
 }
+
+// DepartmentPatch is a merge patch of a department.
+type DepartmentPatch struct {
+	Name *string `json:"name" binding:"required,min=1"`
+	// HeadOfDepartment can only be set to null, which removes the head;
+	// heads are assigned with their own endpoint.
+	HeadOfDepartment *json.RawMessage `json:"headOfDepartment" binding:"isdefault"`
+}
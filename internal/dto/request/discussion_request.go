@@ -0,0 +1,16 @@
+package request
+
+type ThreadRequest struct {
+	Title string `json:"title" binding:"required"`
+	Body  string `json:"body" binding:"required"`
+}
+
+type PostRequest struct {
+	ParentID *uint  `json:"parentId"`
+	Body     string `json:"body" binding:"required"`
+}
+
+// PostPatch is a merge patch of a post.
+type PostPatch struct {
+	Body *string `json:"body" binding:"required,min=1"`
+}
@@ -0,0 +1,13 @@
+package request
+
+type HoldRequest struct {
+	Type   string `json:"type" binding:"required,oneof=financial disciplinary missing_documents"`
+	Reason string `json:"reason" binding:"required"`
+	// ReleaseDate (YYYY-MM-DD) is the day the hold stops applying; omit it
+	// for holds that stay until released.
+	ReleaseDate *string `json:"releaseDate" binding:"omitempty,datetime=2006-01-02"`
+}
+
+type HoldReleaseRequest struct {
+	Note string `json:"note"`
+}
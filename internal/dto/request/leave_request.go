@@ -0,0 +1,14 @@
+package request
+
+// LeaveRequestRequest asks for leave from StartDate to EndDate, both
+// inclusive and formatted as YYYY-MM-DD.
+type LeaveRequestRequest struct {
+	StartDate string `json:"startDate" binding:"required,datetime=2006-01-02"`
+	EndDate   string `json:"endDate" binding:"required,datetime=2006-01-02"`
+	Reason    string `json:"reason"`
+}
+
+type SubstituteRequest struct {
+	CourseID  uint `json:"courseId" binding:"required"`
+	TeacherID uint `json:"teacherId" binding:"required"`
+}
@@ -0,0 +1,19 @@
+package request
+
+import "time"
+
+type OfficeHourRequest struct {
+	// Weekday follows time.Weekday: 0 is Sunday.
+	Weekday     *int    `json:"weekday" binding:"required,min=0,max=6"`
+	StartTime   string  `json:"startTime" binding:"required"`
+	EndTime     string  `json:"endTime" binding:"required"`
+	SlotMinutes int     `json:"slotMinutes" binding:"required,min=5,max=240"`
+	Location    string  `json:"location"`
+	ValidFrom   string  `json:"validFrom" binding:"required"`
+	ValidUntil  *string `json:"validUntil"`
+}
+
+type AppointmentRequest struct {
+	StartsAt time.Time `json:"startsAt" binding:"required"`
+	Note     string    `json:"note"`
+}
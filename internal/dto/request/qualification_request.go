@@ -0,0 +1,8 @@
+package request
+
+type QualificationRequest struct {
+	Subject string `json:"subject" binding:"required"`
+	// CertifiedUntil is the last valid day (YYYY-MM-DD); omit it for
+	// qualifications that do not expire.
+	CertifiedUntil *string `json:"certifiedUntil" binding:"omitempty,datetime=2006-01-02"`
+}
@@ -1,6 +1,50 @@
 package request
 
 type StudentRequest struct {
-	Name  string `json:"name" binding:"required"`
-	Email string `json:"email" binding:"required,email"`
+	Name          string `json:"name" binding:"required"`
+	PreferredName string `json:"preferredName" binding:"max=100"`
+	Pronouns      string `json:"pronouns" binding:"max=32"`
+	Email         string `json:"email" binding:"required,email"`
+	// Phone must be in E.164 format, e.g. +79001234567.
+	Phone string `json:"phone" binding:"omitempty,e164"`
+	// Locale is a BCP 47 language tag, e.g. ru.
+	Locale string `json:"locale" binding:"omitempty,bcp47_language_tag"`
+	// DateOfBirth is formatted as YYYY-MM-DD.
+	DateOfBirth *string `json:"dateOfBirth" binding:"omitempty,datetime=2006-01-02"`
+	// Addresses may be omitted; a student has at most five.
+	Addresses []AddressRequest `json:"addresses" binding:"omitempty,max=5,dive"`
+	// DepartmentID fills the {dept} token of the student number format,
+	// which requires it.
+	DepartmentID uint `json:"departmentId"`
+}
+
+// StudentPatch is a merge patch of a student. Null clears the preferred
+// name, pronouns, phone, date of birth, locale and addresses; name and email
+// cannot be cleared.
+type StudentPatch struct {
+	Name          *string `json:"name" binding:"required,min=1"`
+	PreferredName *string `json:"preferredName" binding:"omitempty,max=100"`
+	Pronouns      *string `json:"pronouns" binding:"omitempty,max=32"`
+	Email         *string `json:"email" binding:"required,email"`
+	Phone         *string `json:"phone" binding:"omitempty,e164"`
+	DateOfBirth   *string `json:"dateOfBirth" binding:"omitempty,datetime=2006-01-02"`
+	Locale        *string `json:"locale" binding:"omitempty,bcp47_language_tag"`
+	// Addresses replace all stored ones.
+	Addresses *[]AddressRequest `json:"addresses" binding:"omitempty,max=5,dive"`
+}
+
+type AddressRequest struct {
+	Kind       string `json:"kind" binding:"required,oneof=home mailing term"`
+	Line1      string `json:"line1" binding:"required"`
+	Line2      string `json:"line2"`
+	City       string `json:"city" binding:"required"`
+	Region     string `json:"region"`
+	PostalCode string `json:"postalCode" binding:"required"`
+	Country    string `json:"country" binding:"required,iso3166_1_alpha2"`
+}
+
+type StudentMergeRequest struct {
+	// DuplicateID is the student merged into the one in the path and then
+	// deleted.
+	DuplicateID uint `json:"duplicateId" binding:"required"`
 }
@@ -1,5 +1,18 @@
 package request
 
 type TeacherRequest struct {
-	Name string `json:"name" binding:"required"`
+	Name  string `json:"name" binding:"required"`
+	Email string `json:"email" binding:"omitempty,email"`
+	// Locale is a BCP 47 language tag, e.g. ru.
+	Locale       string `json:"locale" binding:"omitempty,bcp47_language_tag"`
+	DepartmentID *uint  `json:"departmentId"`
+}
+
+// TeacherPatch is a merge patch of a teacher. Null clears the email, the
+// locale and the department.
+type TeacherPatch struct {
+	Name         *string `json:"name" binding:"required,min=1"`
+	Email        *string `json:"email" binding:"omitempty,email"`
+	Locale       *string `json:"locale" binding:"omitempty,bcp47_language_tag"`
+	DepartmentID *uint   `json:"departmentId"`
 }
@@ -0,0 +1,25 @@
+package response
+
+import "time"
+
+type AnnouncementResponse struct {
+	ID         uint       `json:"id"`
+	CourseID   uint       `json:"courseId"`
+	AuthorRole string     `json:"authorRole"`
+	AuthorID   uint       `json:"authorId"`
+	Title      string     `json:"title"`
+	Body       string     `json:"body"`
+	Pinned     bool       `json:"pinned"`
+	EditedAt   *time.Time `json:"editedAt"`
+	CreatedAt  time.Time  `json:"createdAt"`
+	UpdatedAt  time.Time  `json:"updatedAt"`
+}
+
+type AnnouncementRevisionResponse struct {
+	ID         uint      `json:"id"`
+	Title      string    `json:"title"`
+	Body       string    `json:"body"`
+	EditorRole string    `json:"editorRole"`
+	EditorID   uint      `json:"editorId"`
+	CreatedAt  time.Time `json:"createdAt"`
+}
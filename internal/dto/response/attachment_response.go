@@ -0,0 +1,15 @@
+package response
+
+import "time"
+
+type AttachmentResponse struct {
+	ID          uint      `json:"id"`
+	OwnerRole   string    `json:"ownerRole"`
+	OwnerID     uint      `json:"ownerId"`
+	Kind        string    `json:"kind"`
+	Filename    string    `json:"filename"`
+	ContentType string    `json:"contentType"`
+	Size        int64     `json:"size"`
+	SHA256      string    `json:"sha256"`
+	CreatedAt   time.Time `json:"createdAt"`
+}
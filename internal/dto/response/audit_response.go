@@ -0,0 +1,19 @@
+package response
+
+import (
+	"encoding/json"
+	"time"
+)
+
+type AuditEntryResponse struct {
+	ID        uint            `json:"id"`
+	Entity    string          `json:"entity"`
+	EntityID  uint            `json:"entityId"`
+	Action    string          `json:"action"`
+	ActorID   *uint           `json:"actorId"`
+	ActorRole string          `json:"actorRole"`
+	RequestID string          `json:"requestId"`
+	Before    json.RawMessage `json:"before"`
+	After     json.RawMessage `json:"after"`
+	CreatedAt time.Time       `json:"createdAt"`
+}
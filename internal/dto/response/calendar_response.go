@@ -0,0 +1,11 @@
+package response
+
+type CalendarEventResponse struct {
+	ID        uint   `json:"id"`
+	Term      string `json:"term"`
+	Kind      string `json:"kind"`
+	Name      string `json:"name"`
+	StartDate string `json:"startDate"`
+	EndDate   string `json:"endDate"`
+	Blackout  bool   `json:"blackout"`
+}
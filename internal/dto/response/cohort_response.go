@@ -0,0 +1,28 @@
+package response
+
+import "time"
+
+type CohortResponse struct {
+	ID        uint      `json:"id"`
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// CohortEnrollmentResponse reports the outcome of enrolling every member of
+// a cohort into a course. Members who were in the course before are counted
+// as already enrolled, not as enrolled.
+type CohortEnrollmentResponse struct {
+	CohortID        uint                      `json:"cohortId"`
+	CourseID        uint                      `json:"courseId"`
+	Enrolled        int                       `json:"enrolled"`
+	AlreadyEnrolled int                       `json:"alreadyEnrolled"`
+	Failed          int                       `json:"failed"`
+	Results         []StudentEnrollmentResult `json:"results"`
+}
+
+type StudentEnrollmentResult struct {
+	StudentID       uint   `json:"studentId"`
+	Enrolled        bool   `json:"enrolled"`
+	AlreadyEnrolled bool   `json:"alreadyEnrolled,omitempty"`
+	Error           string `json:"error,omitempty"`
+}
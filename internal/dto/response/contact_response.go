@@ -0,0 +1,18 @@
+package response
+
+import "time"
+
+type ContactResponse struct {
+	ID               uint      `json:"id"`
+	StudentID        uint      `json:"studentId"`
+	Name             string    `json:"name"`
+	Relationship     string    `json:"relationship"`
+	Phone            string    `json:"phone"`
+	Email            string    `json:"email"`
+	Address          string    `json:"address"`
+	IsGuardian       bool      `json:"isGuardian"`
+	IsEmergency      bool      `json:"isEmergency"`
+	CanReceiveGrades bool      `json:"canReceiveGrades"`
+	CreatedAt        time.Time `json:"createdAt"`
+	UpdatedAt        time.Time `json:"updatedAt"`
+}
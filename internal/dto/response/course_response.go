@@ -1,8 +1,26 @@
 package response
 
+import "time"
+
+// CourseResponse is a single course. The roster is served separately by
+// /courses/:id/students.
 type CourseResponse struct {
-	ID       uint              `json:"id"`
-	Title    string            `json:"title"`
-	Teacher  *TeacherResponse  `json:"teacher"`
-	Students []StudentResponse `json:"students"`
+	ID      uint             `json:"id"`
+	Title   string           `json:"title"`
+	Subject string           `json:"subject"`
+	Teacher *TeacherResponse `json:"teacher"`
+	Version uint             `json:"version,omitempty"`
+}
+
+// CourseSummaryResponse is the list view of a course. The roster is served
+// separately by /courses/:id/students.
+type CourseSummaryResponse struct {
+	ID           uint             `json:"id"`
+	Title        string           `json:"title"`
+	Subject      string           `json:"subject"`
+	Teacher      *TeacherResponse `json:"teacher"`
+	StudentCount int              `json:"studentCount"`
+	Version      uint             `json:"version,omitempty"`
+	// DeletedAt is only set on soft-deleted courses, which admins can list.
+	DeletedAt *time.Time `json:"deletedAt,omitempty"`
 }
@@ -1,7 +1,13 @@
 package response
 
+import "time"
+
 type DepartmentResponse struct {
 	ID               uint             `json:"id"`
 	Name             string           `json:"name"`
 	HeadOfDepartment *TeacherResponse `json:"headOfDepartment"`
+	Version          uint             `json:"version,omitempty"`
+	// DeletedAt is only set on soft-deleted departments, which admins can
+	// list.
+	DeletedAt *time.Time `json:"deletedAt,omitempty"`
 }
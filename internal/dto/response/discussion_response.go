@@ -0,0 +1,32 @@
+package response
+
+import "time"
+
+type ThreadResponse struct {
+	ID         uint      `json:"id"`
+	CourseID   uint      `json:"courseId"`
+	AuthorRole string    `json:"authorRole"`
+	AuthorID   uint      `json:"authorId"`
+	Title      string    `json:"title"`
+	Pinned     bool      `json:"pinned"`
+	CreatedAt  time.Time `json:"createdAt"`
+	UpdatedAt  time.Time `json:"updatedAt"`
+}
+
+type PostResponse struct {
+	ID         uint       `json:"id"`
+	ThreadID   uint       `json:"threadId"`
+	ParentID   *uint      `json:"parentId"`
+	AuthorRole string     `json:"authorRole"`
+	AuthorID   uint       `json:"authorId"`
+	Body       string     `json:"body"`
+	EditedAt   *time.Time `json:"editedAt"`
+	CreatedAt  time.Time  `json:"createdAt"`
+	UpdatedAt  time.Time  `json:"updatedAt"`
+}
+
+type PostRevisionResponse struct {
+	ID        uint      `json:"id"`
+	Body      string    `json:"body"`
+	CreatedAt time.Time `json:"createdAt"`
+}
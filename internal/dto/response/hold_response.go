@@ -0,0 +1,24 @@
+package response
+
+import "time"
+
+type HoldResponse struct {
+	ID          uint       `json:"id"`
+	StudentID   uint       `json:"studentId"`
+	Type        string     `json:"type"`
+	Reason      string     `json:"reason"`
+	PlacedBy    uint       `json:"placedBy"`
+	ReleaseDate *string    `json:"releaseDate"`
+	ReleasedAt  *time.Time `json:"releasedAt"`
+	ReleasedBy  *uint      `json:"releasedBy"`
+	Active      bool       `json:"active"`
+	CreatedAt   time.Time  `json:"createdAt"`
+}
+
+type HoldEventResponse struct {
+	ID        uint      `json:"id"`
+	Action    string    `json:"action"`
+	ActorID   uint      `json:"actorId"`
+	Note      string    `json:"note"`
+	CreatedAt time.Time `json:"createdAt"`
+}
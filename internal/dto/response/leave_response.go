@@ -0,0 +1,44 @@
+package response
+
+import "time"
+
+type LeaveRequestResponse struct {
+	ID        uint       `json:"id"`
+	TeacherID uint       `json:"teacherId"`
+	StartDate string     `json:"startDate"`
+	EndDate   string     `json:"endDate"`
+	Reason    string     `json:"reason"`
+	Status    string     `json:"status"`
+	DecidedBy *uint      `json:"decidedBy"`
+	DecidedAt *time.Time `json:"decidedAt"`
+	// AffectedCourses is only filled in for a single leave request.
+	AffectedCourses []AffectedCourseResponse `json:"affectedCourses,omitempty"`
+}
+
+// AffectedCourseResponse is a course taught by the teacher on leave and
+// its substitute, if one has been assigned.
+type AffectedCourseResponse struct {
+	CourseID     uint   `json:"courseId"`
+	Title        string `json:"title"`
+	SubstituteID *uint  `json:"substituteId"`
+}
+
+type SubstitutionResponse struct {
+	ID             uint   `json:"id"`
+	LeaveRequestID uint   `json:"leaveRequestId"`
+	CourseID       uint   `json:"courseId"`
+	SubstituteID   uint   `json:"substituteId"`
+	StartDate      string `json:"startDate"`
+	EndDate        string `json:"endDate"`
+}
+
+// CourseTeacherResponse is the teacher in charge of a course on a given
+// day: the substitute during an approved leave, otherwise the permanent
+// teacher.
+type CourseTeacherResponse struct {
+	CourseID       uint             `json:"courseId"`
+	Date           string           `json:"date"`
+	Teacher        *TeacherResponse `json:"teacher"`
+	Substitute     bool             `json:"substitute"`
+	LeaveRequestID *uint            `json:"leaveRequestId"`
+}
@@ -0,0 +1,36 @@
+package response
+
+import "time"
+
+type OfficeHourResponse struct {
+	ID          uint    `json:"id"`
+	TeacherID   uint    `json:"teacherId"`
+	Weekday     int     `json:"weekday"`
+	StartTime   string  `json:"startTime"`
+	EndTime     string  `json:"endTime"`
+	SlotMinutes int     `json:"slotMinutes"`
+	Location    string  `json:"location"`
+	ValidFrom   string  `json:"validFrom"`
+	ValidUntil  *string `json:"validUntil"`
+}
+
+type SlotResponse struct {
+	OfficeHourID uint      `json:"officeHourId"`
+	StartsAt     time.Time `json:"startsAt"`
+	EndsAt       time.Time `json:"endsAt"`
+	Location     string    `json:"location"`
+}
+
+type AppointmentResponse struct {
+	ID           uint       `json:"id"`
+	OfficeHourID uint       `json:"officeHourId"`
+	TeacherID    uint       `json:"teacherId"`
+	StudentID    uint       `json:"studentId"`
+	StartsAt     time.Time  `json:"startsAt"`
+	EndsAt       time.Time  `json:"endsAt"`
+	Status       string     `json:"status"`
+	Location     string     `json:"location"`
+	Note         string     `json:"note"`
+	CancelledAt  *time.Time `json:"cancelledAt"`
+	CreatedAt    time.Time  `json:"createdAt"`
+}
@@ -0,0 +1,19 @@
+package response
+
+type QualificationResponse struct {
+	ID             uint    `json:"id"`
+	TeacherID      uint    `json:"teacherId"`
+	Subject        string  `json:"subject"`
+	CertifiedUntil *string `json:"certifiedUntil"`
+	Expired        bool    `json:"expired"`
+}
+
+// QualifiedTeacherResponse is a teacher eligible to teach a course together
+// with their current workload.
+type QualifiedTeacherResponse struct {
+	ID             uint    `json:"id"`
+	Name           string  `json:"name"`
+	Email          string  `json:"email"`
+	CertifiedUntil *string `json:"certifiedUntil"`
+	CourseCount    int     `json:"courseCount"`
+}
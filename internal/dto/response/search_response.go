@@ -0,0 +1,11 @@
+package response
+
+// SearchHitResponse is one search result. Snippet is the matched text with
+// the matching words wrapped in <mark> tags; the rest of it is HTML-escaped.
+type SearchHitResponse struct {
+	Type    string  `json:"type"`
+	ID      uint    `json:"id"`
+	Title   string  `json:"title"`
+	Snippet string  `json:"snippet"`
+	Rank    float64 `json:"rank"`
+}
@@ -1,8 +1,39 @@
 package response
 
+import "time"
+
 type StudentResponse struct {
-	ID      uint             `json:"id"`
-	Name    string           `json:"name"`
-	Email   string           `json:"email"`
-	Courses []CourseResponse `json:"courses"`
+	ID            uint              `json:"id"`
+	StudentNumber string            `json:"studentNumber,omitempty"`
+	Name          string            `json:"name"`
+	PreferredName string            `json:"preferredName,omitempty"`
+	Pronouns      string            `json:"pronouns,omitempty"`
+	Email         string            `json:"email"`
+	Phone         string            `json:"phone,omitempty"`
+	Locale        string            `json:"locale,omitempty"`
+	DateOfBirth   *string           `json:"dateOfBirth,omitempty"`
+	Addresses     []AddressResponse `json:"addresses,omitempty"`
+	Courses       []CourseResponse  `json:"courses"`
+	Version       uint              `json:"version,omitempty"`
+	// DeletedAt is only set on soft-deleted students, which admins can list.
+	DeletedAt *time.Time `json:"deletedAt,omitempty"`
+}
+
+type AddressResponse struct {
+	Kind       string `json:"kind"`
+	Line1      string `json:"line1"`
+	Line2      string `json:"line2,omitempty"`
+	City       string `json:"city"`
+	Region     string `json:"region,omitempty"`
+	PostalCode string `json:"postalCode"`
+	Country    string `json:"country"`
+}
+
+type StudentDuplicateResponse struct {
+	ID             uint    `json:"id"`
+	StudentNumber  string  `json:"studentNumber"`
+	Name           string  `json:"name"`
+	Email          string  `json:"email"`
+	NameSimilarity float64 `json:"nameSimilarity"`
+	EmailMatch     bool    `json:"emailMatch"`
 }
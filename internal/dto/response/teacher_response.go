@@ -1,8 +1,16 @@
 package response
 
+import "time"
+
 type TeacherResponse struct {
-	ID          uint                 `json:"id"`
-	Name        string               `json:"name"`
-	Courses     []CourseResponse     `json:"courses"`
-	Departments []DepartmentResponse `json:"departments"`
+	ID           uint                 `json:"id"`
+	Name         string               `json:"name"`
+	Email        string               `json:"email"`
+	Locale       string               `json:"locale,omitempty"`
+	DepartmentID *uint                `json:"departmentId"`
+	Courses      []CourseResponse     `json:"courses"`
+	Departments  []DepartmentResponse `json:"departments"`
+	Version      uint                 `json:"version,omitempty"`
+	// DeletedAt is only set on soft-deleted teachers, which admins can list.
+	DeletedAt *time.Time `json:"deletedAt,omitempty"`
 }
@@ -0,0 +1,22 @@
+package response
+
+// WorkloadResponse is a teacher's current teaching load. Students are
+// counted per enrollment, so a student in two of the teacher's courses
+// counts twice. A zero maximum means no limit.
+type WorkloadResponse struct {
+	TeacherID   uint   `json:"teacherId"`
+	Name        string `json:"name"`
+	Courses     int    `json:"courses"`
+	Students    int    `json:"students"`
+	MaxCourses  int    `json:"maxCourses"`
+	MaxStudents int    `json:"maxStudents"`
+	Overloaded  bool   `json:"overloaded"`
+}
+
+type DepartmentWorkloadResponse struct {
+	DepartmentID uint               `json:"departmentId"`
+	Name         string             `json:"name"`
+	Courses      int                `json:"courses"`
+	Students     int                `json:"students"`
+	Teachers     []WorkloadResponse `json:"teachers"`
+}
@@ -0,0 +1,27 @@
+package entity
+
+import "time"
+
+type Announcement struct {
+	ID         uint `gorm:"primaryKey"`
+	CourseID   uint
+	AuthorRole string
+	AuthorID   uint
+	Title      string
+	Body       string
+	Pinned     bool
+	EditedAt   *time.Time
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// AnnouncementRevision keeps the content an announcement had before an edit.
+type AnnouncementRevision struct {
+	ID             uint `gorm:"primaryKey"`
+	AnnouncementID uint
+	Title          string
+	Body           string
+	EditorRole     string
+	EditorID       uint
+	CreatedAt      time.Time
+}
@@ -0,0 +1,25 @@
+package entity
+
+import "time"
+
+const (
+	AppointmentBooked    = "booked"
+	AppointmentCancelled = "cancelled"
+)
+
+type Appointment struct {
+	ID           uint `gorm:"primaryKey"`
+	OfficeHourID uint
+	TeacherID    uint
+	StudentID    uint
+	StartsAt     time.Time
+	EndsAt       time.Time
+	Status       string
+	Location     string
+	Note         string
+	CancelledAt  *time.Time
+	CreatedAt    time.Time
+	// Teacher and Student are only loaded by the calendar queries.
+	Teacher *Teacher `gorm:"foreignKey:TeacherID"`
+	Student *Student `gorm:"foreignKey:StudentID"`
+}
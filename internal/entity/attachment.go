@@ -0,0 +1,18 @@
+package entity
+
+import "time"
+
+// Attachment describes an uploaded document; the content itself lives in
+// the configured storage under StorageKey.
+type Attachment struct {
+	ID          uint `gorm:"primaryKey"`
+	OwnerRole   string
+	OwnerID     uint
+	Kind        string
+	Filename    string
+	ContentType string
+	Size        int64
+	SHA256      string `gorm:"column:sha256"`
+	StorageKey  string
+	CreatedAt   time.Time
+}
@@ -0,0 +1,33 @@
+package entity
+
+import "time"
+
+const (
+	AuditStudent    = "student"
+	AuditTeacher    = "teacher"
+	AuditCourse     = "course"
+	AuditDepartment = "department"
+
+	AuditCreate        = "create"
+	AuditUpdate        = "update"
+	AuditDelete        = "delete"
+	AuditRestore       = "restore"
+	AuditEnroll        = "enroll"
+	AuditMerge         = "merge"
+	AuditAssignTeacher = "assign_teacher"
+)
+
+// AuditEntry records one change to an entity. Before and After are JSON
+// objects with the changed columns only; Before is nil for a create.
+type AuditEntry struct {
+	ID        uint `gorm:"primaryKey"`
+	Entity    string
+	EntityID  uint
+	Action    string
+	ActorID   *uint
+	ActorRole string
+	RequestID string
+	Before    *string `gorm:"type:jsonb"`
+	After     *string `gorm:"type:jsonb"`
+	CreatedAt time.Time
+}
@@ -0,0 +1,23 @@
+package entity
+
+import "time"
+
+const (
+	CalendarHoliday     = "holiday"
+	CalendarExamPeriod  = "exam_period"
+	CalendarReadingWeek = "reading_week"
+)
+
+// CalendarEvent is an entry of the institution calendar covering the days
+// StartDate to EndDate, both inclusive. Nothing can be scheduled on
+// blackout days.
+type CalendarEvent struct {
+	ID        uint `gorm:"primaryKey"`
+	Term      string
+	Kind      string
+	Name      string
+	StartDate time.Time
+	EndDate   time.Time
+	Blackout  bool
+	CreatedAt time.Time
+}
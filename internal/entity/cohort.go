@@ -0,0 +1,11 @@
+package entity
+
+import "time"
+
+// Cohort groups students who study together, e.g. "CS-2025-A".
+type Cohort struct {
+	ID        uint `gorm:"primaryKey"`
+	Name      string
+	Students  []Student `gorm:"many2many:cohort_student"`
+	CreatedAt time.Time
+}
@@ -1,9 +1,18 @@
 package entity
 
+import "gorm.io/gorm"
+
 type Course struct {
-	ID        uint `gorm:"primaryKey"`
-	Title     string
+	ID    uint `gorm:"primaryKey"`
+	Title string
+	// Subject is the code a teacher must be qualified for, e.g. "MATH".
+	// Courses without a subject can be taught by any teacher.
+	Subject   string
 	TeacherID *uint
 	Students  []Student `gorm:"many2many:course_student"`
 	Teacher   *Teacher  `gorm:"foreignKey:TeacherID"`
+	// StudentCount is computed by the list queries and is zero elsewhere.
+	StudentCount int  `gorm:"->"`
+	Version      uint `gorm:"default:1"`
+	DeletedAt    gorm.DeletedAt
 }
@@ -1,8 +1,12 @@
 package entity
 
+import "gorm.io/gorm"
+
 type Department struct {
 	ID                 uint `gorm:"primaryKey"`
 	Name               string
 	HeadOfDepartmentID *uint
 	HeadOfDepartment   *Teacher `gorm:"foreignKey:HeadOfDepartmentID"`
+	Version            uint     `gorm:"default:1"`
+	DeletedAt          gorm.DeletedAt
 }
@@ -0,0 +1,34 @@
+package entity
+
+import "time"
+
+type DiscussionThread struct {
+	ID         uint `gorm:"primaryKey"`
+	CourseID   uint
+	AuthorRole string
+	AuthorID   uint
+	Title      string
+	Pinned     bool
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+type DiscussionPost struct {
+	ID         uint `gorm:"primaryKey"`
+	ThreadID   uint
+	ParentID   *uint
+	AuthorRole string
+	AuthorID   uint
+	Body       string
+	EditedAt   *time.Time
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// DiscussionPostRevision keeps the body a post had before an edit.
+type DiscussionPostRevision struct {
+	ID        uint `gorm:"primaryKey"`
+	PostID    uint
+	Body      string
+	CreatedAt time.Time
+}
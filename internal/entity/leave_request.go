@@ -0,0 +1,36 @@
+package entity
+
+import "time"
+
+const (
+	LeavePending  = "pending"
+	LeaveApproved = "approved"
+	LeaveRejected = "rejected"
+)
+
+// LeaveRequest is a teacher's absence for the days StartDate to EndDate,
+// both inclusive. It is decided by the head of the teacher's department.
+type LeaveRequest struct {
+	ID            uint `gorm:"primaryKey"`
+	TeacherID     uint
+	StartDate     time.Time
+	EndDate       time.Time
+	Reason        string
+	Status        string
+	DecidedBy     *uint
+	DecidedAt     *time.Time
+	CreatedAt     time.Time
+	Substitutions []Substitution `gorm:"foreignKey:LeaveRequestID"`
+}
+
+// Substitution covers one course of a teacher on leave. The course keeps
+// its permanent TeacherID; the substitute only teaches it on the leave days.
+type Substitution struct {
+	ID             uint `gorm:"primaryKey"`
+	LeaveRequestID uint
+	CourseID       uint
+	SubstituteID   uint
+	StartDate      time.Time
+	EndDate        time.Time
+	CreatedAt      time.Time
+}
@@ -0,0 +1,25 @@
+package entity
+
+import "time"
+
+const (
+	NotificationPending = "pending"
+	NotificationSent    = "sent"
+	NotificationFailed  = "failed"
+)
+
+// Notification is a rendered message waiting in the outbox to be sent.
+type Notification struct {
+	ID            uint `gorm:"primaryKey"`
+	Event         string
+	Recipient     string
+	Locale        string
+	Subject       string
+	Body          string
+	Status        string
+	Attempts      int
+	NextAttemptAt time.Time
+	LastError     string
+	CreatedAt     time.Time
+	SentAt        *time.Time
+}
@@ -0,0 +1,19 @@
+package entity
+
+import "time"
+
+// OfficeHour is a weekly recurring window in which students can book
+// appointments with a teacher. Times of day are minutes since midnight in
+// the configured office-hours time zone.
+type OfficeHour struct {
+	ID          uint `gorm:"primaryKey"`
+	TeacherID   uint
+	Weekday     int
+	StartMinute int
+	EndMinute   int
+	SlotMinutes int
+	Location    string
+	ValidFrom   time.Time
+	ValidUntil  *time.Time
+	CreatedAt   time.Time
+}
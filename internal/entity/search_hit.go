@@ -0,0 +1,18 @@
+package entity
+
+const (
+	SearchStudent    = "student"
+	SearchTeacher    = "teacher"
+	SearchCourse     = "course"
+	SearchDepartment = "department"
+)
+
+// SearchHit is one row of a full-text search. It is read from a query
+// across several tables and has no table of its own.
+type SearchHit struct {
+	Type    string
+	ID      uint
+	Title   string
+	Snippet string
+	Rank    float64
+}
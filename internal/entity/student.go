@@ -1,8 +1,48 @@
 package entity
 
+import (
+	"gorm.io/gorm"
+	"time"
+)
+
 type Student struct {
-	ID      uint `gorm:"primaryKey"`
-	Name    string
-	Email   string
-	Courses []Course `gorm:"many2many:course_student"`
+	ID uint `gorm:"primaryKey"`
+	// StudentNumber is generated on creation and never changes afterwards.
+	StudentNumber string
+	Name          string
+	PreferredName string
+	Pronouns      string
+	Email         string
+	Phone         string
+	// Locale is the language notifications are sent in; empty means the
+	// default one.
+	Locale      string
+	DateOfBirth *time.Time
+	Addresses   []StudentAddress `gorm:"foreignKey:StudentID"`
+	Courses     []Course         `gorm:"many2many:course_student"`
+	Version     uint             `gorm:"default:1"`
+	DeletedAt   gorm.DeletedAt
+}
+
+type StudentAddress struct {
+	ID         uint `gorm:"primaryKey"`
+	StudentID  uint
+	Kind       string
+	Line1      string
+	Line2      string
+	City       string
+	Region     string
+	PostalCode string
+	Country    string
+}
+
+// StudentDuplicate is a student who may be the same person as another one,
+// with the evidence for it.
+type StudentDuplicate struct {
+	ID             uint
+	StudentNumber  string
+	Name           string
+	Email          string
+	NameSimilarity float64
+	EmailMatch     bool
 }
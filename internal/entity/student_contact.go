@@ -0,0 +1,19 @@
+package entity
+
+import "time"
+
+// StudentContact is an emergency contact or guardian of a student.
+type StudentContact struct {
+	ID               uint `gorm:"primaryKey"`
+	StudentID        uint
+	Name             string
+	Relationship     string
+	Phone            string
+	Email            string
+	Address          string
+	IsGuardian       bool
+	IsEmergency      bool
+	CanReceiveGrades bool
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+}
@@ -0,0 +1,37 @@
+package entity
+
+import "time"
+
+const (
+	HoldFinancial        = "financial"
+	HoldDisciplinary     = "disciplinary"
+	HoldMissingDocuments = "missing_documents"
+
+	HoldPlaced   = "placed"
+	HoldReleased = "released"
+)
+
+// StudentHold restricts a student until it is released by hand or its
+// ReleaseDate is reached.
+type StudentHold struct {
+	ID          uint `gorm:"primaryKey"`
+	StudentID   uint
+	Type        string
+	Reason      string
+	PlacedBy    uint
+	ReleaseDate *time.Time
+	ReleasedAt  *time.Time
+	ReleasedBy  *uint
+	CreatedAt   time.Time
+	Events      []StudentHoldEvent `gorm:"foreignKey:HoldID"`
+}
+
+// StudentHoldEvent is one entry of a hold's audit history.
+type StudentHoldEvent struct {
+	ID        uint `gorm:"primaryKey"`
+	HoldID    uint
+	Action    string
+	ActorID   uint
+	Note      string
+	CreatedAt time.Time
+}
@@ -1,8 +1,20 @@
 package entity
 
+import "gorm.io/gorm"
+
 type Teacher struct {
-	ID          uint `gorm:"primaryKey"`
-	Name        string
-	Courses     []Course     `gorm:"foreignKey:TeacherID"`
-	Departments []Department `gorm:"foreignKey:HeadOfDepartmentID"`
+	ID    uint `gorm:"primaryKey"`
+	Name  string
+	Email string
+	// Locale is the language notifications are sent in; empty means the
+	// default one.
+	Locale string
+	// DepartmentID is the department the teacher belongs to; Departments
+	// are the ones they head.
+	DepartmentID   *uint
+	Courses        []Course               `gorm:"foreignKey:TeacherID"`
+	Departments    []Department           `gorm:"foreignKey:HeadOfDepartmentID"`
+	Qualifications []TeacherQualification `gorm:"foreignKey:TeacherID"`
+	Version        uint                   `gorm:"default:1"`
+	DeletedAt      gorm.DeletedAt
 }
@@ -0,0 +1,13 @@
+package entity
+
+import "time"
+
+// TeacherQualification allows a teacher to teach courses of one subject.
+// A nil CertifiedUntil means the certification does not expire.
+type TeacherQualification struct {
+	ID             uint `gorm:"primaryKey"`
+	TeacherID      uint
+	Subject        string
+	CertifiedUntil *time.Time
+	CreatedAt      time.Time
+}
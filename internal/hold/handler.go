@@ -0,0 +1,170 @@
+package hold
+
+import (
+	"errors"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+	"net/http"
+	"strconv"
+	"student_go/internal/actor"
+	"student_go/internal/dto/request"
+	"student_go/pkg/log"
+)
+
+type Handler struct {
+	Service Service
+}
+
+func NewHoldHandler() *Handler {
+	return &Handler{
+		Service: NewHoldService(NewHoldRepository()),
+	}
+}
+
+func (h *Handler) PlaceHold(c *gin.Context) {
+	studentId, ok := parseID(c, "id", "invalid student ID")
+	if !ok {
+		return
+	}
+
+	a, err := actor.FromContext(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+
+	var req request.HoldRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		log.Log.Warn("Invalid request in PlaceHold", zap.Error(err))
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	log.Log.Info("PlaceHold called", zap.Uint("student_id", studentId), zap.String("type", req.Type))
+
+	holdResp, err := h.Service.PlaceHold(studentId, a, req)
+	if err != nil {
+		writeError(c, err, "failed to place hold")
+		return
+	}
+
+	c.JSON(http.StatusCreated, holdResp)
+}
+
+// FindAllHolds lists the student's holds; active=true leaves out released
+// and expired ones.
+func (h *Handler) FindAllHolds(c *gin.Context) {
+	studentId, ok := parseID(c, "id", "invalid student ID")
+	if !ok {
+		return
+	}
+
+	a, err := actor.FromContext(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+
+	activeOnly := c.Query("active") == "true"
+
+	log.Log.Info("FindAllHolds called", zap.Uint("student_id", studentId), zap.Bool("active_only", activeOnly))
+
+	holds, err := h.Service.FindAllHolds(studentId, a, activeOnly)
+	if err != nil {
+		writeError(c, err, "failed to get holds")
+		return
+	}
+
+	c.JSON(http.StatusOK, holds)
+}
+
+func (h *Handler) ReleaseHold(c *gin.Context) {
+	studentId, ok := parseID(c, "id", "invalid student ID")
+	if !ok {
+		return
+	}
+	id, ok := parseID(c, "holdId", "invalid hold ID")
+	if !ok {
+		return
+	}
+
+	a, err := actor.FromContext(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+
+	// The note is optional, so an empty body is fine.
+	var req request.HoldReleaseRequest
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			log.Log.Warn("Invalid request in ReleaseHold", zap.Error(err))
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+	}
+
+	log.Log.Info("ReleaseHold called", zap.Uint("student_id", studentId), zap.Uint("id", id))
+
+	holdResp, err := h.Service.ReleaseHold(studentId, id, a, req)
+	if err != nil {
+		writeError(c, err, "failed to release hold")
+		return
+	}
+
+	c.JSON(http.StatusOK, holdResp)
+}
+
+func (h *Handler) FindHoldHistory(c *gin.Context) {
+	studentId, ok := parseID(c, "id", "invalid student ID")
+	if !ok {
+		return
+	}
+	id, ok := parseID(c, "holdId", "invalid hold ID")
+	if !ok {
+		return
+	}
+
+	a, err := actor.FromContext(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+
+	log.Log.Info("FindHoldHistory called", zap.Uint("student_id", studentId), zap.Uint("id", id))
+
+	history, err := h.Service.FindHoldHistory(studentId, id, a)
+	if err != nil {
+		writeError(c, err, "failed to get hold history")
+		return
+	}
+
+	c.JSON(http.StatusOK, history)
+}
+
+func parseID(c *gin.Context, name string, message string) (uint, bool) {
+	param := c.Param(name)
+	parsed, err := strconv.ParseUint(param, 10, 32)
+	if err != nil {
+		log.Log.Warn("Invalid path parameter", zap.String(name, param), zap.Error(err))
+		c.JSON(http.StatusBadRequest, gin.H{"error": message})
+		return 0, false
+	}
+
+	return uint(parsed), true
+}
+
+func writeError(c *gin.Context, err error, fallback string) {
+	switch {
+	case errors.Is(err, ErrStudentNotFound), errors.Is(err, ErrHoldNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	case errors.Is(err, ErrForbidden):
+		c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+	case errors.Is(err, ErrInvalidRelease):
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	case errors.Is(err, ErrAlreadyReleased):
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": fallback})
+	}
+}
@@ -0,0 +1,100 @@
+package hold
+
+import (
+	"bytes"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"net/http"
+	"net/http/httptest"
+	"student_go/internal/actor"
+	"student_go/internal/dto/request"
+	"student_go/internal/dto/response"
+	"student_go/internal/mocks"
+	"testing"
+)
+
+func setupHandlerTest() (*gin.Engine, *mocks.HoldServiceMock, *Handler) {
+	gin.SetMode(gin.TestMode)
+	mockService := new(mocks.HoldServiceMock)
+	handler := &Handler{Service: mockService}
+	r := gin.Default()
+	return r, mockService, handler
+}
+
+func setActor(req *http.Request, id string, role string) {
+	req.Header.Set(actor.HeaderUserID, id)
+	req.Header.Set(actor.HeaderUserRole, role)
+}
+
+func TestPlaceHoldHandler(t *testing.T) {
+	r, mockService, handler := setupHandlerTest()
+	input := request.HoldRequest{Type: "financial", Reason: "unpaid fees"}
+	mockService.On("PlaceHold", uint(5), admin, input).Return(&response.HoldResponse{ID: 3}, nil)
+
+	r.POST("/students/:id/holds", handler.PlaceHold)
+	req := httptest.NewRequest(http.MethodPost, "/students/5/holds",
+		bytes.NewBufferString(`{"type":"financial","reason":"unpaid fees"}`))
+	req.Header.Set("Content-Type", "application/json")
+	setActor(req, "1", "admin")
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusCreated, resp.Code)
+	mockService.AssertExpectations(t)
+}
+
+func TestPlaceHoldHandler_InvalidType(t *testing.T) {
+	r, mockService, handler := setupHandlerTest()
+
+	r.POST("/students/:id/holds", handler.PlaceHold)
+	req := httptest.NewRequest(http.MethodPost, "/students/5/holds",
+		bytes.NewBufferString(`{"type":"parking","reason":"ticket"}`))
+	req.Header.Set("Content-Type", "application/json")
+	setActor(req, "1", "admin")
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	mockService.AssertNotCalled(t, "PlaceHold", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestFindAllHoldsHandler_Active(t *testing.T) {
+	r, mockService, handler := setupHandlerTest()
+	mockService.On("FindAllHolds", uint(5), studentActor, true).Return([]*response.HoldResponse{{ID: 3}}, nil)
+
+	r.GET("/students/:id/holds", handler.FindAllHolds)
+	req := httptest.NewRequest(http.MethodGet, "/students/5/holds?active=true", nil)
+	setActor(req, "5", "student")
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	mockService.AssertExpectations(t)
+}
+
+func TestReleaseHoldHandler_EmptyBody(t *testing.T) {
+	r, mockService, handler := setupHandlerTest()
+	mockService.On("ReleaseHold", uint(5), uint(3), admin, request.HoldReleaseRequest{}).Return(nil, ErrAlreadyReleased)
+
+	r.POST("/students/:id/holds/:holdId/release", handler.ReleaseHold)
+	req := httptest.NewRequest(http.MethodPost, "/students/5/holds/3/release", nil)
+	setActor(req, "1", "admin")
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusConflict, resp.Code)
+}
+
+func TestFindHoldHistoryHandler_Forbidden(t *testing.T) {
+	r, mockService, handler := setupHandlerTest()
+	mockService.On("FindHoldHistory", uint(5), uint(3), studentActor).Return(nil, ErrForbidden)
+
+	r.GET("/students/:id/holds/:holdId/history", handler.FindHoldHistory)
+	req := httptest.NewRequest(http.MethodGet, "/students/5/holds/3/history", nil)
+	setActor(req, "5", "student")
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusForbidden, resp.Code)
+}
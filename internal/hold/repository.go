@@ -0,0 +1,143 @@
+package hold
+
+import (
+	"errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"student_go/internal/entity"
+	"student_go/pkg/dbcontext"
+	"time"
+)
+
+const foreignKeyViolation = "23503"
+
+type Repository interface {
+	// Save stores the hold together with its "placed" history entry. It
+	// returns ErrStudentNotFound for a missing or soft-deleted student.
+	Save(hold *entity.StudentHold) (*entity.StudentHold, error)
+	FindById(studentId uint, id uint) (*entity.StudentHold, error)
+	FindAll(studentId uint) ([]entity.StudentHold, error)
+	// FindActive lists the student's holds in effect on the given day,
+	// limited to types unless it is empty.
+	FindActive(studentId uint, types []string, on time.Time) ([]entity.StudentHold, error)
+	// Release ends an unreleased hold and records who did it; it reports
+	// false if the hold was already released.
+	Release(id uint, releasedBy uint, note string, releasedAt time.Time) (bool, error)
+}
+
+type repository struct{}
+
+func NewHoldRepository() Repository {
+	return &repository{}
+}
+
+func (r *repository) Save(hold *entity.StudentHold) (*entity.StudentHold, error) {
+	err := dbcontext.DB.Transaction(func(tx *gorm.DB) error {
+		// The foreign key alone accepts a soft-deleted student; the lock
+		// keeps the student from being deleted until the hold is saved.
+		err := tx.Clauses(clause.Locking{Strength: "SHARE"}).Select("id").First(&entity.Student{}, hold.StudentID).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrStudentNotFound
+		}
+		if err != nil {
+			return err
+		}
+
+		if err := tx.Create(hold).Error; err != nil {
+			return err
+		}
+
+		return tx.Create(&entity.StudentHoldEvent{
+			HoldID:  hold.ID,
+			Action:  entity.HoldPlaced,
+			ActorID: hold.PlacedBy,
+			Note:    hold.Reason,
+		}).Error
+	})
+	if isViolation(err, foreignKeyViolation) {
+		return nil, ErrStudentNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return hold, nil
+}
+
+func (r *repository) FindById(studentId uint, id uint) (*entity.StudentHold, error) {
+	var hold entity.StudentHold
+	result := dbcontext.DB.
+		Preload("Events", func(db *gorm.DB) *gorm.DB {
+			return db.Order("created_at").Order("id")
+		}).
+		Where("student_id = ?", studentId).
+		First(&hold, id)
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return &hold, nil
+}
+
+func (r *repository) FindAll(studentId uint) ([]entity.StudentHold, error) {
+	var holds []entity.StudentHold
+	result := dbcontext.DB.
+		Where("student_id = ?", studentId).
+		Order("created_at DESC").
+		Find(&holds)
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return holds, nil
+}
+
+func (r *repository) FindActive(studentId uint, types []string, on time.Time) ([]entity.StudentHold, error) {
+	var holds []entity.StudentHold
+
+	query := dbcontext.DB.
+		Where("student_id = ? AND released_at IS NULL", studentId).
+		Where("release_date IS NULL OR release_date > ?", on.Format(dateLayout))
+	if len(types) > 0 {
+		query = query.Where("type IN ?", types)
+	}
+
+	if err := query.Order("created_at").Find(&holds).Error; err != nil {
+		return nil, err
+	}
+
+	return holds, nil
+}
+
+func (r *repository) Release(id uint, releasedBy uint, note string, releasedAt time.Time) (bool, error) {
+	released := false
+	err := dbcontext.DB.Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&entity.StudentHold{}).
+			Where("id = ? AND released_at IS NULL", id).
+			Updates(map[string]interface{}{
+				"released_at": releasedAt,
+				"released_by": releasedBy,
+			})
+		if result.Error != nil || result.RowsAffected == 0 {
+			return result.Error
+		}
+
+		err := tx.Create(&entity.StudentHoldEvent{
+			HoldID:  id,
+			Action:  entity.HoldReleased,
+			ActorID: releasedBy,
+			Note:    note,
+		}).Error
+		released = err == nil
+		return err
+	})
+
+	return released, err
+}
+
+func isViolation(err error, code string) bool {
+	var state interface{ SQLState() string }
+	return errors.As(err, &state) && state.SQLState() == code
+}
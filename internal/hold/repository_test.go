@@ -0,0 +1,135 @@
+package hold
+
+import (
+	"database/sql"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"regexp"
+	"student_go/internal/entity"
+	"testing"
+	"time"
+
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+
+	"student_go/pkg/dbcontext"
+)
+
+func setupTestDB(t *testing.T) (*sql.DB, sqlmock.Sqlmock, *gorm.DB) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+
+	dialector := postgres.New(postgres.Config{
+		Conn:                 db,
+		PreferSimpleProtocol: true,
+	})
+
+	gormDB, err := gorm.Open(dialector, &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	assert.NoError(t, err)
+
+	dbcontext.DB = gormDB
+	return db, mock, gormDB
+}
+
+func expectStudentLock(mock sqlmock.Sqlmock, studentId uint, found bool) {
+	rows := sqlmock.NewRows([]string{"id"})
+	if found {
+		rows.AddRow(studentId)
+	}
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT "id" FROM "students" WHERE "students"."id" = $1 AND "students"."deleted_at" IS NULL ORDER BY "students"."id" LIMIT $2 FOR SHARE`)).
+		WithArgs(studentId, 1).
+		WillReturnRows(rows)
+}
+
+func TestHoldSave(t *testing.T) {
+	db, mock, _ := setupTestDB(t)
+	defer db.Close()
+
+	mock.ExpectBegin()
+	expectStudentLock(mock, 5, true)
+	mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "student_holds"`)).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(3))
+	mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "student_hold_events" ("hold_id","action","actor_id","note","created_at") VALUES ($1,$2,$3,$4,$5) RETURNING "id"`)).
+		WithArgs(3, entity.HoldPlaced, 1, "unpaid fees", sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectCommit()
+
+	repo := NewHoldRepository()
+	saved, err := repo.Save(&entity.StudentHold{StudentID: 5, Type: entity.HoldFinancial, Reason: "unpaid fees", PlacedBy: 1})
+
+	require.NoError(t, err)
+	assert.Equal(t, uint(3), saved.ID)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestHoldSave_StudentNotFound(t *testing.T) {
+	db, mock, _ := setupTestDB(t)
+	defer db.Close()
+
+	mock.ExpectBegin()
+	expectStudentLock(mock, 99, true)
+	mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "student_holds"`)).
+		WillReturnError(&pgconn.PgError{Code: foreignKeyViolation})
+	mock.ExpectRollback()
+
+	repo := NewHoldRepository()
+	saved, err := repo.Save(&entity.StudentHold{StudentID: 99, Type: entity.HoldFinancial, PlacedBy: 1})
+
+	assert.Nil(t, saved)
+	assert.ErrorIs(t, err, ErrStudentNotFound)
+}
+
+func TestHoldSave_StudentDeleted(t *testing.T) {
+	db, mock, _ := setupTestDB(t)
+	defer db.Close()
+
+	mock.ExpectBegin()
+	expectStudentLock(mock, 5, false)
+	mock.ExpectRollback()
+
+	repo := NewHoldRepository()
+	saved, err := repo.Save(&entity.StudentHold{StudentID: 5, Type: entity.HoldFinancial, PlacedBy: 1})
+
+	assert.Nil(t, saved)
+	assert.ErrorIs(t, err, ErrStudentNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestHoldFindActive(t *testing.T) {
+	db, mock, _ := setupTestDB(t)
+	defer db.Close()
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "student_holds" WHERE (student_id = $1 AND released_at IS NULL) AND (release_date IS NULL OR release_date > $2) AND type IN ($3,$4) ORDER BY created_at`)).
+		WithArgs(5, "2026-10-18", entity.HoldFinancial, entity.HoldDisciplinary).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "type"}).AddRow(3, entity.HoldFinancial))
+
+	repo := NewHoldRepository()
+	holds, err := repo.FindActive(5, []string{entity.HoldFinancial, entity.HoldDisciplinary}, time.Date(2026, 10, 18, 15, 0, 0, 0, time.UTC))
+
+	require.NoError(t, err)
+	assert.Len(t, holds, 1)
+}
+
+func TestHoldRelease_AlreadyReleased(t *testing.T) {
+	db, mock, _ := setupTestDB(t)
+	defer db.Close()
+	releasedAt := time.Date(2026, 10, 18, 15, 0, 0, 0, time.UTC)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE "student_holds" SET "released_at"=$1,"released_by"=$2 WHERE id = $3 AND released_at IS NULL`)).
+		WithArgs(releasedAt, 1, 3).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+
+	repo := NewHoldRepository()
+	released, err := repo.Release(3, 1, "paid", releasedAt)
+
+	require.NoError(t, err)
+	assert.False(t, released)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
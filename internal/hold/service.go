@@ -0,0 +1,197 @@
+package hold
+
+import (
+	"errors"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"student_go/internal/actor"
+	"student_go/internal/dto/request"
+	"student_go/internal/dto/response"
+	"student_go/internal/entity"
+	"student_go/pkg/log"
+	"time"
+)
+
+const dateLayout = "2006-01-02"
+
+var (
+	ErrStudentNotFound = errors.New("student not found")
+	ErrHoldNotFound    = errors.New("hold not found")
+	ErrForbidden       = errors.New("forbidden")
+	ErrAlreadyReleased = errors.New("hold is already released")
+	ErrInvalidRelease  = errors.New("release date must be in the future")
+)
+
+type Service interface {
+	PlaceHold(studentId uint, a actor.Actor, input request.HoldRequest) (*response.HoldResponse, error)
+	FindAllHolds(studentId uint, a actor.Actor, activeOnly bool) ([]*response.HoldResponse, error)
+	ReleaseHold(studentId uint, id uint, a actor.Actor, input request.HoldReleaseRequest) (*response.HoldResponse, error)
+	FindHoldHistory(studentId uint, id uint, a actor.Actor) ([]*response.HoldEventResponse, error)
+}
+
+type service struct {
+	holdRepository Repository
+	now            func() time.Time
+}
+
+func NewHoldService(holdRepository Repository) Service {
+	return &service{
+		holdRepository: holdRepository,
+		now:            time.Now,
+	}
+}
+
+// PlaceHold puts a hold on the student. Registrars and finance staff act
+// as admins.
+func (s *service) PlaceHold(studentId uint, a actor.Actor, input request.HoldRequest) (*response.HoldResponse, error) {
+	log.Log.Info("PlaceHold (service) called", zap.Uint("student_id", studentId), zap.String("type", input.Type))
+
+	if !a.IsAdmin() {
+		return nil, ErrForbidden
+	}
+
+	now := s.now()
+	hold := entity.StudentHold{
+		StudentID: studentId,
+		Type:      input.Type,
+		Reason:    input.Reason,
+		PlacedBy:  a.ID,
+	}
+	if input.ReleaseDate != nil {
+		releaseDate, err := time.Parse(dateLayout, *input.ReleaseDate)
+		if err != nil {
+			return nil, err
+		}
+		if releaseDate.Format(dateLayout) <= now.Format(dateLayout) {
+			return nil, ErrInvalidRelease
+		}
+		hold.ReleaseDate = &releaseDate
+	}
+
+	saved, err := s.holdRepository.Save(&hold)
+	if err != nil {
+		return nil, err
+	}
+
+	return ToHoldResponse(saved, now), nil
+}
+
+// FindAllHolds is visible to admins and the student.
+func (s *service) FindAllHolds(studentId uint, a actor.Actor, activeOnly bool) ([]*response.HoldResponse, error) {
+	log.Log.Info("FindAllHolds (service) called", zap.Uint("student_id", studentId), zap.Bool("active_only", activeOnly))
+
+	if !a.IsAdmin() && !a.IsStudent(studentId) {
+		return nil, ErrForbidden
+	}
+
+	now := s.now()
+	var holds []entity.StudentHold
+	var err error
+	if activeOnly {
+		holds, err = s.holdRepository.FindActive(studentId, nil, now)
+	} else {
+		holds, err = s.holdRepository.FindAll(studentId)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	holdResponses := make([]*response.HoldResponse, 0, len(holds))
+	for i := range holds {
+		holdResponses = append(holdResponses, ToHoldResponse(&holds[i], now))
+	}
+
+	return holdResponses, nil
+}
+
+func (s *service) ReleaseHold(studentId uint, id uint, a actor.Actor, input request.HoldReleaseRequest) (*response.HoldResponse, error) {
+	log.Log.Info("ReleaseHold (service) called", zap.Uint("student_id", studentId), zap.Uint("id", id))
+
+	if !a.IsAdmin() {
+		return nil, ErrForbidden
+	}
+
+	hold, err := s.findHold(studentId, id)
+	if err != nil {
+		return nil, err
+	}
+
+	now := s.now()
+	released, err := s.holdRepository.Release(id, a.ID, input.Note, now)
+	if err != nil {
+		return nil, err
+	}
+	if !released {
+		return nil, ErrAlreadyReleased
+	}
+
+	hold.ReleasedAt = &now
+	hold.ReleasedBy = &a.ID
+	return ToHoldResponse(hold, now), nil
+}
+
+// FindHoldHistory returns who placed and released the hold, oldest first.
+func (s *service) FindHoldHistory(studentId uint, id uint, a actor.Actor) ([]*response.HoldEventResponse, error) {
+	log.Log.Info("FindHoldHistory (service) called", zap.Uint("student_id", studentId), zap.Uint("id", id))
+
+	if !a.IsAdmin() {
+		return nil, ErrForbidden
+	}
+
+	hold, err := s.findHold(studentId, id)
+	if err != nil {
+		return nil, err
+	}
+
+	eventResponses := make([]*response.HoldEventResponse, 0, len(hold.Events))
+	for _, event := range hold.Events {
+		eventResponses = append(eventResponses, &response.HoldEventResponse{
+			ID:        event.ID,
+			Action:    event.Action,
+			ActorID:   event.ActorID,
+			Note:      event.Note,
+			CreatedAt: event.CreatedAt,
+		})
+	}
+
+	return eventResponses, nil
+}
+
+func (s *service) findHold(studentId uint, id uint) (*entity.StudentHold, error) {
+	hold, err := s.holdRepository.FindById(studentId, id)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrHoldNotFound
+	}
+
+	return hold, err
+}
+
+// IsActive reports whether the hold is in effect on the given day.
+func IsActive(hold *entity.StudentHold, on time.Time) bool {
+	if hold.ReleasedAt != nil {
+		return false
+	}
+
+	return hold.ReleaseDate == nil || hold.ReleaseDate.Format(dateLayout) > on.Format(dateLayout)
+}
+
+func ToHoldResponse(hold *entity.StudentHold, now time.Time) *response.HoldResponse {
+	var releaseDate *string
+	if hold.ReleaseDate != nil {
+		formatted := hold.ReleaseDate.Format(dateLayout)
+		releaseDate = &formatted
+	}
+
+	return &response.HoldResponse{
+		ID:          hold.ID,
+		StudentID:   hold.StudentID,
+		Type:        hold.Type,
+		Reason:      hold.Reason,
+		PlacedBy:    hold.PlacedBy,
+		ReleaseDate: releaseDate,
+		ReleasedAt:  hold.ReleasedAt,
+		ReleasedBy:  hold.ReleasedBy,
+		Active:      IsActive(hold, now),
+		CreatedAt:   hold.CreatedAt,
+	}
+}
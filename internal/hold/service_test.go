@@ -0,0 +1,159 @@
+package hold
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"student_go/internal/actor"
+	"student_go/internal/dto/request"
+	"student_go/internal/entity"
+	mocks2 "student_go/internal/mocks"
+	"student_go/pkg/log"
+	"testing"
+	"time"
+)
+
+func init() {
+	logger, _ := zap.NewDevelopment()
+	log.Log = logger
+}
+
+var (
+	admin        = actor.Actor{ID: 1, Role: actor.RoleAdmin}
+	studentActor = actor.Actor{ID: 5, Role: actor.RoleStudent}
+	testNow      = time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+)
+
+func newTestHoldService() (*service, *mocks2.HoldRepository) {
+	mockHoldRepo := new(mocks2.HoldRepository)
+
+	svc := NewHoldService(mockHoldRepo).(*service)
+	svc.now = func() time.Time { return testNow }
+	return svc, mockHoldRepo
+}
+
+func TestPlaceHold(t *testing.T) {
+	svc, mockHoldRepo := newTestHoldService()
+	releaseDate := "2026-12-01"
+
+	mockHoldRepo.On("Save", mock.MatchedBy(func(h *entity.StudentHold) bool {
+		return h.StudentID == 5 && h.Type == entity.HoldFinancial && h.PlacedBy == 1 &&
+			h.ReleaseDate.Format(dateLayout) == releaseDate
+	})).Return(func(h *entity.StudentHold) *entity.StudentHold {
+		h.ID = 3
+		return h
+	}, nil)
+
+	result, err := svc.PlaceHold(5, admin, request.HoldRequest{Type: entity.HoldFinancial, Reason: "unpaid fees", ReleaseDate: &releaseDate})
+
+	require.NoError(t, err)
+	assert.Equal(t, uint(3), result.ID)
+	assert.True(t, result.Active)
+	assert.Equal(t, releaseDate, *result.ReleaseDate)
+}
+
+func TestPlaceHold_NotAdmin(t *testing.T) {
+	svc, mockHoldRepo := newTestHoldService()
+
+	result, err := svc.PlaceHold(5, studentActor, request.HoldRequest{Type: entity.HoldFinancial, Reason: "unpaid fees"})
+
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, ErrForbidden)
+	mockHoldRepo.AssertNotCalled(t, "Save", mock.Anything)
+}
+
+func TestPlaceHold_ReleaseDateInPast(t *testing.T) {
+	svc, _ := newTestHoldService()
+	releaseDate := "2026-10-18"
+
+	result, err := svc.PlaceHold(5, admin, request.HoldRequest{Type: entity.HoldFinancial, Reason: "unpaid fees", ReleaseDate: &releaseDate})
+
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, ErrInvalidRelease)
+}
+
+func TestFindAllHolds_ActiveOnlyByStudent(t *testing.T) {
+	svc, mockHoldRepo := newTestHoldService()
+
+	mockHoldRepo.On("FindActive", uint(5), []string(nil), testNow).
+		Return([]entity.StudentHold{{ID: 3, StudentID: 5, Type: entity.HoldDisciplinary}}, nil)
+
+	result, err := svc.FindAllHolds(5, studentActor, true)
+
+	require.NoError(t, err)
+	assert.Len(t, result, 1)
+	mockHoldRepo.AssertNotCalled(t, "FindAll", mock.Anything)
+}
+
+func TestFindAllHolds_OtherStudentForbidden(t *testing.T) {
+	svc, _ := newTestHoldService()
+
+	result, err := svc.FindAllHolds(6, studentActor, false)
+
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, ErrForbidden)
+}
+
+func TestReleaseHold(t *testing.T) {
+	svc, mockHoldRepo := newTestHoldService()
+
+	mockHoldRepo.On("FindById", uint(5), uint(3)).Return(&entity.StudentHold{ID: 3, StudentID: 5, Type: entity.HoldFinancial}, nil)
+	mockHoldRepo.On("Release", uint(3), uint(1), "paid", testNow).Return(true, nil)
+
+	result, err := svc.ReleaseHold(5, 3, admin, request.HoldReleaseRequest{Note: "paid"})
+
+	require.NoError(t, err)
+	assert.False(t, result.Active)
+	assert.Equal(t, uint(1), *result.ReleasedBy)
+}
+
+func TestReleaseHold_AlreadyReleased(t *testing.T) {
+	svc, mockHoldRepo := newTestHoldService()
+
+	mockHoldRepo.On("FindById", uint(5), uint(3)).Return(&entity.StudentHold{ID: 3, StudentID: 5}, nil)
+	mockHoldRepo.On("Release", uint(3), uint(1), "", testNow).Return(false, nil)
+
+	result, err := svc.ReleaseHold(5, 3, admin, request.HoldReleaseRequest{})
+
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, ErrAlreadyReleased)
+}
+
+func TestFindHoldHistory(t *testing.T) {
+	svc, mockHoldRepo := newTestHoldService()
+
+	mockHoldRepo.On("FindById", uint(5), uint(3)).Return(&entity.StudentHold{ID: 3, Events: []entity.StudentHoldEvent{
+		{ID: 1, Action: entity.HoldPlaced, ActorID: 1, Note: "unpaid fees"},
+		{ID: 2, Action: entity.HoldReleased, ActorID: 2, Note: "paid"},
+	}}, nil)
+
+	result, err := svc.FindHoldHistory(5, 3, admin)
+
+	require.NoError(t, err)
+	require.Len(t, result, 2)
+	assert.Equal(t, entity.HoldReleased, result[1].Action)
+	assert.Equal(t, uint(2), result[1].ActorID)
+}
+
+func TestFindHoldHistory_NotFound(t *testing.T) {
+	svc, mockHoldRepo := newTestHoldService()
+
+	mockHoldRepo.On("FindById", uint(5), uint(3)).Return(nil, gorm.ErrRecordNotFound)
+
+	result, err := svc.FindHoldHistory(5, 3, admin)
+
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, ErrHoldNotFound)
+}
+
+func TestIsActive(t *testing.T) {
+	tomorrow := time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC)
+	today := time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC)
+
+	assert.True(t, IsActive(&entity.StudentHold{}, testNow))
+	assert.True(t, IsActive(&entity.StudentHold{ReleaseDate: &tomorrow}, testNow))
+	assert.False(t, IsActive(&entity.StudentHold{ReleaseDate: &today}, testNow))
+	assert.False(t, IsActive(&entity.StudentHold{ReleasedAt: &testNow}, testNow))
+}
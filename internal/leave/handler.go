@@ -0,0 +1,226 @@
+package leave
+
+import (
+	"errors"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+	"net/http"
+	"strconv"
+	"student_go/internal/actor"
+	"student_go/internal/course"
+	"student_go/internal/department"
+	"student_go/internal/dto/request"
+	"student_go/internal/dto/response"
+	"student_go/internal/teacher"
+	"student_go/pkg/log"
+	"time"
+)
+
+type Handler struct {
+	Service Service
+}
+
+func NewLeaveHandler() *Handler {
+	return &Handler{
+		Service: NewLeaveService(
+			NewLeaveRepository(),
+			teacher.NewTeacherRepository(),
+			department.NewDepartmentRepository(),
+			course.NewCourseRepository(),
+		),
+	}
+}
+
+func (h *Handler) CreateLeaveRequest(c *gin.Context) {
+	teacherId, ok := parseID(c, "id", "invalid teacher ID")
+	if !ok {
+		return
+	}
+
+	a, err := actor.FromContext(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+
+	var req request.LeaveRequestRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		log.Log.Warn("Invalid request in CreateLeaveRequest", zap.Error(err))
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	log.Log.Info("CreateLeaveRequest called", zap.Uint("teacher_id", teacherId))
+
+	leaveResp, err := h.Service.CreateLeaveRequest(teacherId, a, req)
+	if err != nil {
+		writeError(c, err, "failed to save leave request")
+		return
+	}
+
+	c.JSON(http.StatusCreated, leaveResp)
+}
+
+func (h *Handler) FindAllLeaveRequests(c *gin.Context) {
+	teacherId, ok := parseID(c, "id", "invalid teacher ID")
+	if !ok {
+		return
+	}
+
+	a, err := actor.FromContext(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+
+	log.Log.Info("FindAllLeaveRequests called", zap.Uint("teacher_id", teacherId))
+
+	leaveRequests, err := h.Service.FindAllLeaveRequests(teacherId, a)
+	if err != nil {
+		writeError(c, err, "failed to get leave requests")
+		return
+	}
+
+	c.JSON(http.StatusOK, leaveRequests)
+}
+
+func (h *Handler) FindLeaveRequestById(c *gin.Context) {
+	id, ok := parseID(c, "id", "invalid leave request ID")
+	if !ok {
+		return
+	}
+
+	a, err := actor.FromContext(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+
+	log.Log.Info("FindLeaveRequestById called", zap.Uint("id", id))
+
+	leaveResp, err := h.Service.FindLeaveRequestById(id, a)
+	if err != nil {
+		writeError(c, err, "failed to get leave request")
+		return
+	}
+
+	c.JSON(http.StatusOK, leaveResp)
+}
+
+func (h *Handler) ApproveLeaveRequest(c *gin.Context) {
+	h.decide(c, "ApproveLeaveRequest", h.Service.ApproveLeaveRequest)
+}
+
+func (h *Handler) RejectLeaveRequest(c *gin.Context) {
+	h.decide(c, "RejectLeaveRequest", h.Service.RejectLeaveRequest)
+}
+
+func (h *Handler) AssignSubstitute(c *gin.Context) {
+	id, ok := parseID(c, "id", "invalid leave request ID")
+	if !ok {
+		return
+	}
+
+	a, err := actor.FromContext(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+
+	var req request.SubstituteRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		log.Log.Warn("Invalid request in AssignSubstitute", zap.Error(err))
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	log.Log.Info("AssignSubstitute called", zap.Uint("leave_request_id", id), zap.Uint("course_id", req.CourseID))
+
+	substitutionResp, err := h.Service.AssignSubstitute(id, a, req)
+	if err != nil {
+		writeError(c, err, "failed to assign substitute")
+		return
+	}
+
+	c.JSON(http.StatusCreated, substitutionResp)
+}
+
+// FindCourseTeacher returns who teaches the course on ?date=YYYY-MM-DD,
+// today by default.
+func (h *Handler) FindCourseTeacher(c *gin.Context) {
+	courseId, ok := parseID(c, "id", "invalid course ID")
+	if !ok {
+		return
+	}
+
+	on := time.Now()
+	if date := c.Query("date"); date != "" {
+		parsed, err := time.Parse(dateLayout, date)
+		if err != nil {
+			log.Log.Warn("Invalid date in FindCourseTeacher", zap.String("date", date), zap.Error(err))
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid date, expected YYYY-MM-DD"})
+			return
+		}
+		on = parsed
+	}
+
+	log.Log.Info("FindCourseTeacher called", zap.Uint("course_id", courseId))
+
+	teacherResp, err := h.Service.FindCourseTeacher(courseId, on)
+	if err != nil {
+		writeError(c, err, "failed to get course teacher")
+		return
+	}
+
+	c.JSON(http.StatusOK, teacherResp)
+}
+
+func (h *Handler) decide(c *gin.Context, name string, decide func(uint, actor.Actor) (*response.LeaveRequestResponse, error)) {
+	id, ok := parseID(c, "id", "invalid leave request ID")
+	if !ok {
+		return
+	}
+
+	a, err := actor.FromContext(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+
+	log.Log.Info(name+" called", zap.Uint("id", id))
+
+	leaveResp, err := decide(id, a)
+	if err != nil {
+		writeError(c, err, "failed to decide leave request")
+		return
+	}
+
+	c.JSON(http.StatusOK, leaveResp)
+}
+
+func parseID(c *gin.Context, name string, message string) (uint, bool) {
+	param := c.Param(name)
+	parsed, err := strconv.ParseUint(param, 10, 32)
+	if err != nil {
+		log.Log.Warn("Invalid path parameter", zap.String(name, param), zap.Error(err))
+		c.JSON(http.StatusBadRequest, gin.H{"error": message})
+		return 0, false
+	}
+
+	return uint(parsed), true
+}
+
+func writeError(c *gin.Context, err error, fallback string) {
+	switch {
+	case errors.Is(err, ErrLeaveRequestNotFound), errors.Is(err, ErrTeacherNotFound), errors.Is(err, ErrCourseNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	case errors.Is(err, ErrForbidden):
+		c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+	case errors.Is(err, ErrInvalidRange), errors.Is(err, ErrCourseNotAffected), errors.Is(err, ErrInvalidSubstitute):
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	case errors.Is(err, ErrOverlap), errors.Is(err, ErrNotPending), errors.Is(err, ErrNotApproved), errors.Is(err, ErrAlreadyCovered):
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": fallback})
+	}
+}
@@ -0,0 +1,117 @@
+package leave
+
+import (
+	"bytes"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"net/http"
+	"net/http/httptest"
+	"student_go/internal/actor"
+	"student_go/internal/dto/request"
+	"student_go/internal/dto/response"
+	"student_go/internal/mocks"
+	"testing"
+	"time"
+)
+
+func setupHandlerTest() (*gin.Engine, *mocks.LeaveServiceMock, *Handler) {
+	gin.SetMode(gin.TestMode)
+	mockService := new(mocks.LeaveServiceMock)
+	handler := &Handler{Service: mockService}
+	r := gin.Default()
+	return r, mockService, handler
+}
+
+func setActor(req *http.Request, id string, role string) {
+	req.Header.Set(actor.HeaderUserID, id)
+	req.Header.Set(actor.HeaderUserRole, role)
+}
+
+func TestCreateLeaveRequestHandler(t *testing.T) {
+	r, mockService, handler := setupHandlerTest()
+	input := request.LeaveRequestRequest{StartDate: "2026-11-02", EndDate: "2026-11-06", Reason: "conference"}
+	mockService.On("CreateLeaveRequest", uint(3), teacherActor, input).Return(&response.LeaveRequestResponse{ID: 7}, nil)
+
+	r.POST("/teachers/:id/leave-requests", handler.CreateLeaveRequest)
+	req := httptest.NewRequest(http.MethodPost, "/teachers/3/leave-requests",
+		bytes.NewBufferString(`{"startDate":"2026-11-02","endDate":"2026-11-06","reason":"conference"}`))
+	req.Header.Set("Content-Type", "application/json")
+	setActor(req, "3", "teacher")
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusCreated, resp.Code)
+	mockService.AssertExpectations(t)
+}
+
+func TestCreateLeaveRequestHandler_InvalidDate(t *testing.T) {
+	r, mockService, handler := setupHandlerTest()
+
+	r.POST("/teachers/:id/leave-requests", handler.CreateLeaveRequest)
+	req := httptest.NewRequest(http.MethodPost, "/teachers/3/leave-requests",
+		bytes.NewBufferString(`{"startDate":"02.11.2026","endDate":"2026-11-06"}`))
+	req.Header.Set("Content-Type", "application/json")
+	setActor(req, "3", "teacher")
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	mockService.AssertNotCalled(t, "CreateLeaveRequest", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestApproveLeaveRequestHandler_NotPending(t *testing.T) {
+	r, mockService, handler := setupHandlerTest()
+	mockService.On("ApproveLeaveRequest", uint(7), headActor).Return(nil, ErrNotPending)
+
+	r.POST("/leave-requests/:id/approve", handler.ApproveLeaveRequest)
+	req := httptest.NewRequest(http.MethodPost, "/leave-requests/7/approve", nil)
+	setActor(req, "5", "teacher")
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusConflict, resp.Code)
+}
+
+func TestAssignSubstituteHandler(t *testing.T) {
+	r, mockService, handler := setupHandlerTest()
+	input := request.SubstituteRequest{CourseID: 10, TeacherID: 4}
+	mockService.On("AssignSubstitute", uint(7), admin, input).Return(&response.SubstitutionResponse{ID: 1}, nil)
+
+	r.POST("/leave-requests/:id/substitutes", handler.AssignSubstitute)
+	req := httptest.NewRequest(http.MethodPost, "/leave-requests/7/substitutes",
+		bytes.NewBufferString(`{"courseId":10,"teacherId":4}`))
+	req.Header.Set("Content-Type", "application/json")
+	setActor(req, "1", "admin")
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusCreated, resp.Code)
+	mockService.AssertExpectations(t)
+}
+
+func TestFindCourseTeacherHandler(t *testing.T) {
+	r, mockService, handler := setupHandlerTest()
+	on := time.Date(2026, 11, 3, 0, 0, 0, 0, time.UTC)
+	mockService.On("FindCourseTeacher", uint(10), on).Return(&response.CourseTeacherResponse{CourseID: 10, Substitute: true}, nil)
+
+	r.GET("/courses/:id/teacher", handler.FindCourseTeacher)
+	req := httptest.NewRequest(http.MethodGet, "/courses/10/teacher?date=2026-11-03", nil)
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Contains(t, resp.Body.String(), `"substitute":true`)
+}
+
+func TestFindCourseTeacherHandler_InvalidDate(t *testing.T) {
+	r, mockService, handler := setupHandlerTest()
+
+	r.GET("/courses/:id/teacher", handler.FindCourseTeacher)
+	req := httptest.NewRequest(http.MethodGet, "/courses/10/teacher?date=tomorrow", nil)
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	mockService.AssertNotCalled(t, "FindCourseTeacher", mock.Anything, mock.Anything)
+}
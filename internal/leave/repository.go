@@ -0,0 +1,121 @@
+package leave
+
+import (
+	"errors"
+	"student_go/internal/entity"
+	"student_go/pkg/dbcontext"
+	"time"
+)
+
+// exclusionViolation is raised when a leave overlaps another open leave of
+// the teacher or a substitution overlaps another one of the course.
+const exclusionViolation = "23P01"
+
+type Repository interface {
+	Save(leaveRequest *entity.LeaveRequest) (*entity.LeaveRequest, error)
+	FindById(id uint) (*entity.LeaveRequest, error)
+	FindAll(teacherId uint) ([]entity.LeaveRequest, error)
+	// Decide moves a pending leave request to status and reports whether
+	// it was still pending.
+	Decide(id uint, status string, decidedBy uint, decidedAt time.Time) (bool, error)
+	SaveSubstitution(substitution *entity.Substitution) (*entity.Substitution, error)
+	// IsOnLeave reports whether the teacher has an approved leave on any day
+	// between from and to.
+	IsOnLeave(teacherId uint, from, to time.Time) (bool, error)
+	FindSubstitution(courseId uint, on time.Time) (*entity.Substitution, error)
+}
+
+type repository struct{}
+
+func NewLeaveRepository() Repository {
+	return &repository{}
+}
+
+func (r *repository) Save(leaveRequest *entity.LeaveRequest) (*entity.LeaveRequest, error) {
+	err := dbcontext.DB.Create(leaveRequest).Error
+	if isViolation(err, exclusionViolation) {
+		return nil, ErrOverlap
+	}
+
+	return leaveRequest, err
+}
+
+func (r *repository) FindById(id uint) (*entity.LeaveRequest, error) {
+	var leaveRequest entity.LeaveRequest
+	result := dbcontext.DB.
+		Preload("Substitutions").
+		First(&leaveRequest, id)
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return &leaveRequest, nil
+}
+
+func (r *repository) FindAll(teacherId uint) ([]entity.LeaveRequest, error) {
+	var leaveRequests []entity.LeaveRequest
+	result := dbcontext.DB.
+		Where("teacher_id = ?", teacherId).
+		Order("start_date DESC").
+		Find(&leaveRequests)
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return leaveRequests, nil
+}
+
+func (r *repository) Decide(id uint, status string, decidedBy uint, decidedAt time.Time) (bool, error) {
+	result := dbcontext.DB.Model(&entity.LeaveRequest{}).
+		Where("id = ? AND status = ?", id, entity.LeavePending).
+		Updates(map[string]interface{}{
+			"status":     status,
+			"decided_by": decidedBy,
+			"decided_at": decidedAt,
+		})
+
+	return result.RowsAffected > 0, result.Error
+}
+
+func (r *repository) SaveSubstitution(substitution *entity.Substitution) (*entity.Substitution, error) {
+	err := dbcontext.DB.Create(substitution).Error
+	if isViolation(err, exclusionViolation) {
+		return nil, ErrAlreadyCovered
+	}
+
+	return substitution, err
+}
+
+func (r *repository) IsOnLeave(teacherId uint, from, to time.Time) (bool, error) {
+	var onLeave bool
+	err := dbcontext.DB.
+		Model(&entity.LeaveRequest{}).
+		Select("count(*) > 0").
+		Where("teacher_id = ? AND status = ? AND start_date <= ? AND end_date >= ?",
+			teacherId, entity.LeaveApproved, to.Format(dateLayout), from.Format(dateLayout)).
+		Find(&onLeave).
+		Error
+
+	return onLeave, err
+}
+
+func (r *repository) FindSubstitution(courseId uint, on time.Time) (*entity.Substitution, error) {
+	var substitution entity.Substitution
+	day := on.Format(dateLayout)
+	result := dbcontext.DB.
+		Where("course_id = ? AND start_date <= ? AND end_date >= ?", courseId, day, day).
+		First(&substitution)
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return &substitution, nil
+}
+
+func isViolation(err error, code string) bool {
+	var state interface{ SQLState() string }
+	return errors.As(err, &state) && state.SQLState() == code
+}
@@ -0,0 +1,104 @@
+package leave
+
+import (
+	"database/sql"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"regexp"
+	"student_go/internal/entity"
+	"testing"
+	"time"
+
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+
+	"student_go/pkg/dbcontext"
+)
+
+func setupTestDB(t *testing.T) (*sql.DB, sqlmock.Sqlmock, *gorm.DB) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+
+	dialector := postgres.New(postgres.Config{
+		Conn:                 db,
+		PreferSimpleProtocol: true,
+	})
+
+	gormDB, err := gorm.Open(dialector, &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	assert.NoError(t, err)
+
+	dbcontext.DB = gormDB
+	return db, mock, gormDB
+}
+
+func TestLeaveSave_Overlap(t *testing.T) {
+	db, mock, _ := setupTestDB(t)
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "leave_requests"`)).
+		WillReturnError(&pgconn.PgError{Code: exclusionViolation})
+	mock.ExpectRollback()
+
+	repo := NewLeaveRepository()
+	saved, err := repo.Save(&entity.LeaveRequest{TeacherID: 3, Status: entity.LeavePending})
+
+	assert.Nil(t, saved)
+	assert.ErrorIs(t, err, ErrOverlap)
+}
+
+func TestLeaveDecide(t *testing.T) {
+	db, mock, _ := setupTestDB(t)
+	defer db.Close()
+	decidedAt := time.Date(2026, 10, 18, 9, 0, 0, 0, time.UTC)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE "leave_requests" SET "decided_at"=$1,"decided_by"=$2,"status"=$3 WHERE id = $4 AND status = $5`)).
+		WithArgs(decidedAt, 5, entity.LeaveApproved, 7, entity.LeavePending).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+
+	repo := NewLeaveRepository()
+	decided, err := repo.Decide(7, entity.LeaveApproved, 5, decidedAt)
+
+	require.NoError(t, err)
+	assert.False(t, decided)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestLeaveIsOnLeave(t *testing.T) {
+	db, mock, _ := setupTestDB(t)
+	defer db.Close()
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) > 0 FROM "leave_requests" WHERE teacher_id = $1 AND status = $2 AND start_date <= $3 AND end_date >= $4`)).
+		WithArgs(4, entity.LeaveApproved, "2026-11-06", "2026-11-02").
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(true))
+
+	repo := NewLeaveRepository()
+	onLeave, err := repo.IsOnLeave(4,
+		time.Date(2026, 11, 2, 0, 0, 0, 0, time.UTC),
+		time.Date(2026, 11, 6, 0, 0, 0, 0, time.UTC))
+
+	require.NoError(t, err)
+	assert.True(t, onLeave)
+}
+
+func TestLeaveFindSubstitution(t *testing.T) {
+	db, mock, _ := setupTestDB(t)
+	defer db.Close()
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "substitutions" WHERE course_id = $1 AND start_date <= $2 AND end_date >= $3 ORDER BY "substitutions"."id" LIMIT $4`)).
+		WithArgs(2, "2026-11-03", "2026-11-03", 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "leave_request_id", "course_id", "substitute_id"}).AddRow(1, 7, 2, 4))
+
+	repo := NewLeaveRepository()
+	substitution, err := repo.FindSubstitution(2, time.Date(2026, 11, 3, 10, 0, 0, 0, time.UTC))
+
+	require.NoError(t, err)
+	assert.Equal(t, uint(4), substitution.SubstituteID)
+}
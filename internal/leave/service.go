@@ -0,0 +1,397 @@
+package leave
+
+import (
+	"errors"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"student_go/internal/actor"
+	"student_go/internal/course"
+	"student_go/internal/department"
+	"student_go/internal/dto/request"
+	"student_go/internal/dto/response"
+	"student_go/internal/entity"
+	"student_go/internal/teacher"
+	"student_go/pkg/log"
+	"time"
+)
+
+const dateLayout = "2006-01-02"
+
+var (
+	ErrLeaveRequestNotFound = errors.New("leave request not found")
+	ErrTeacherNotFound      = errors.New("teacher not found")
+	ErrCourseNotFound       = errors.New("course not found")
+	ErrForbidden            = errors.New("forbidden")
+	ErrInvalidRange         = errors.New("end date must not be before start date")
+	ErrOverlap              = errors.New("teacher already has leave on some of these days")
+	ErrNotPending           = errors.New("leave request has already been decided")
+	ErrNotApproved          = errors.New("leave request is not approved")
+	ErrCourseNotAffected    = errors.New("course is not taught by the teacher on leave")
+	ErrInvalidSubstitute    = errors.New("substitute must be another teacher who is not on leave")
+	ErrAlreadyCovered       = errors.New("course already has a substitute on some of these days")
+)
+
+type Service interface {
+	CreateLeaveRequest(teacherId uint, a actor.Actor, input request.LeaveRequestRequest) (*response.LeaveRequestResponse, error)
+	FindLeaveRequestById(id uint, a actor.Actor) (*response.LeaveRequestResponse, error)
+	FindAllLeaveRequests(teacherId uint, a actor.Actor) ([]*response.LeaveRequestResponse, error)
+	ApproveLeaveRequest(id uint, a actor.Actor) (*response.LeaveRequestResponse, error)
+	RejectLeaveRequest(id uint, a actor.Actor) (*response.LeaveRequestResponse, error)
+	AssignSubstitute(leaveRequestId uint, a actor.Actor, input request.SubstituteRequest) (*response.SubstitutionResponse, error)
+	FindCourseTeacher(courseId uint, on time.Time) (*response.CourseTeacherResponse, error)
+}
+
+type service struct {
+	leaveRepository      Repository
+	teacherRepository    teacher.Repository
+	departmentRepository department.Repository
+	courseRepository     course.Repository
+	now                  func() time.Time
+}
+
+func NewLeaveService(
+	leaveRepository Repository,
+	teacherRepository teacher.Repository,
+	departmentRepository department.Repository,
+	courseRepository course.Repository,
+) Service {
+	return &service{
+		leaveRepository:      leaveRepository,
+		teacherRepository:    teacherRepository,
+		departmentRepository: departmentRepository,
+		courseRepository:     courseRepository,
+		now:                  time.Now,
+	}
+}
+
+// CreateLeaveRequest files a pending leave. Teachers request leave for
+// themselves; admins may file it on a teacher's behalf.
+func (s *service) CreateLeaveRequest(teacherId uint, a actor.Actor, input request.LeaveRequestRequest) (*response.LeaveRequestResponse, error) {
+	log.Log.Info("CreateLeaveRequest (service) called",
+		zap.Uint("teacher_id", teacherId),
+		zap.String("start_date", input.StartDate),
+		zap.String("end_date", input.EndDate),
+	)
+
+	if !a.IsAdmin() && !a.IsTeacher(teacherId) {
+		return nil, ErrForbidden
+	}
+
+	startDate, err := time.Parse(dateLayout, input.StartDate)
+	if err != nil {
+		return nil, err
+	}
+	endDate, err := time.Parse(dateLayout, input.EndDate)
+	if err != nil {
+		return nil, err
+	}
+	if endDate.Before(startDate) {
+		return nil, ErrInvalidRange
+	}
+
+	if _, err := s.findTeacher(teacherId); err != nil {
+		return nil, err
+	}
+
+	saved, err := s.leaveRepository.Save(&entity.LeaveRequest{
+		TeacherID: teacherId,
+		StartDate: startDate,
+		EndDate:   endDate,
+		Reason:    input.Reason,
+		Status:    entity.LeavePending,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return toLeaveRequestResponse(saved), nil
+}
+
+// FindLeaveRequestById is visible to admins, the teacher and the head of
+// the teacher's department, and lists the courses the leave affects.
+func (s *service) FindLeaveRequestById(id uint, a actor.Actor) (*response.LeaveRequestResponse, error) {
+	log.Log.Info("FindLeaveRequestById (service) called", zap.Uint("id", id))
+
+	leaveRequest, leaveTeacher, err := s.findLeaveRequest(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !a.IsTeacher(leaveTeacher.ID) {
+		if err := s.checkHead(leaveTeacher, a); err != nil {
+			return nil, err
+		}
+	}
+
+	return withAffectedCourses(leaveRequest, leaveTeacher), nil
+}
+
+func (s *service) FindAllLeaveRequests(teacherId uint, a actor.Actor) ([]*response.LeaveRequestResponse, error) {
+	log.Log.Info("FindAllLeaveRequests (service) called", zap.Uint("teacher_id", teacherId))
+
+	leaveTeacher, err := s.findTeacher(teacherId)
+	if err != nil {
+		return nil, err
+	}
+
+	if !a.IsTeacher(teacherId) {
+		if err := s.checkHead(leaveTeacher, a); err != nil {
+			return nil, err
+		}
+	}
+
+	leaveRequests, err := s.leaveRepository.FindAll(teacherId)
+	if err != nil {
+		return nil, err
+	}
+
+	leaveRequestResponses := make([]*response.LeaveRequestResponse, 0, len(leaveRequests))
+	for i := range leaveRequests {
+		leaveRequestResponses = append(leaveRequestResponses, toLeaveRequestResponse(&leaveRequests[i]))
+	}
+
+	return leaveRequestResponses, nil
+}
+
+// ApproveLeaveRequest approves a pending leave and returns it with the
+// courses that need a substitute.
+func (s *service) ApproveLeaveRequest(id uint, a actor.Actor) (*response.LeaveRequestResponse, error) {
+	log.Log.Info("ApproveLeaveRequest (service) called", zap.Uint("id", id))
+
+	return s.decide(id, a, entity.LeaveApproved)
+}
+
+func (s *service) RejectLeaveRequest(id uint, a actor.Actor) (*response.LeaveRequestResponse, error) {
+	log.Log.Info("RejectLeaveRequest (service) called", zap.Uint("id", id))
+
+	return s.decide(id, a, entity.LeaveRejected)
+}
+
+// AssignSubstitute lets another teacher cover one course for the days of
+// an approved leave. The course's permanent teacher does not change.
+func (s *service) AssignSubstitute(leaveRequestId uint, a actor.Actor, input request.SubstituteRequest) (*response.SubstitutionResponse, error) {
+	log.Log.Info("AssignSubstitute (service) called",
+		zap.Uint("leave_request_id", leaveRequestId),
+		zap.Uint("course_id", input.CourseID),
+		zap.Uint("substitute_id", input.TeacherID),
+	)
+
+	leaveRequest, leaveTeacher, err := s.findLeaveRequest(leaveRequestId)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkHead(leaveTeacher, a); err != nil {
+		return nil, err
+	}
+	if leaveRequest.Status != entity.LeaveApproved {
+		return nil, ErrNotApproved
+	}
+	if !teaches(leaveTeacher, input.CourseID) {
+		return nil, ErrCourseNotAffected
+	}
+	if input.TeacherID == leaveTeacher.ID {
+		return nil, ErrInvalidSubstitute
+	}
+	if _, err := s.findTeacher(input.TeacherID); err != nil {
+		return nil, err
+	}
+
+	onLeave, err := s.leaveRepository.IsOnLeave(input.TeacherID, leaveRequest.StartDate, leaveRequest.EndDate)
+	if err != nil {
+		return nil, err
+	}
+	if onLeave {
+		return nil, ErrInvalidSubstitute
+	}
+
+	saved, err := s.leaveRepository.SaveSubstitution(&entity.Substitution{
+		LeaveRequestID: leaveRequest.ID,
+		CourseID:       input.CourseID,
+		SubstituteID:   input.TeacherID,
+		StartDate:      leaveRequest.StartDate,
+		EndDate:        leaveRequest.EndDate,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return toSubstitutionResponse(saved), nil
+}
+
+// FindCourseTeacher returns who teaches the course on the given day.
+func (s *service) FindCourseTeacher(courseId uint, on time.Time) (*response.CourseTeacherResponse, error) {
+	log.Log.Info("FindCourseTeacher (service) called", zap.Uint("course_id", courseId), zap.Time("on", on))
+
+	c, err := s.courseRepository.FindById(courseId)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrCourseNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &response.CourseTeacherResponse{
+		CourseID: c.ID,
+		Date:     on.Format(dateLayout),
+	}
+
+	substitution, err := s.leaveRepository.FindSubstitution(courseId, on)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+	if substitution == nil {
+		if c.Teacher != nil {
+			resp.Teacher = toTeacherResponse(c.Teacher)
+		}
+		return resp, nil
+	}
+
+	substitute, err := s.findTeacher(substitution.SubstituteID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp.Teacher = toTeacherResponse(substitute)
+	resp.Substitute = true
+	resp.LeaveRequestID = &substitution.LeaveRequestID
+	return resp, nil
+}
+
+func (s *service) decide(id uint, a actor.Actor, status string) (*response.LeaveRequestResponse, error) {
+	leaveRequest, leaveTeacher, err := s.findLeaveRequest(id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Heads of department cannot decide on their own leave.
+	if a.IsTeacher(leaveTeacher.ID) {
+		return nil, ErrForbidden
+	}
+	if err := s.checkHead(leaveTeacher, a); err != nil {
+		return nil, err
+	}
+
+	decidedAt := s.now()
+	decided, err := s.leaveRepository.Decide(id, status, a.ID, decidedAt)
+	if err != nil {
+		return nil, err
+	}
+	if !decided {
+		return nil, ErrNotPending
+	}
+
+	leaveRequest.Status = status
+	leaveRequest.DecidedBy = &a.ID
+	leaveRequest.DecidedAt = &decidedAt
+
+	return withAffectedCourses(leaveRequest, leaveTeacher), nil
+}
+
+func (s *service) findLeaveRequest(id uint) (*entity.LeaveRequest, *entity.Teacher, error) {
+	leaveRequest, err := s.leaveRepository.FindById(id)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil, ErrLeaveRequestNotFound
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	leaveTeacher, err := s.findTeacher(leaveRequest.TeacherID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return leaveRequest, leaveTeacher, nil
+}
+
+func (s *service) findTeacher(id uint) (*entity.Teacher, error) {
+	t, err := s.teacherRepository.FindById(id)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrTeacherNotFound
+	}
+
+	return t, err
+}
+
+// checkHead allows admins and the head of the teacher's department.
+func (s *service) checkHead(t *entity.Teacher, a actor.Actor) error {
+	if a.IsAdmin() {
+		return nil
+	}
+	if t.DepartmentID == nil || a.Role != actor.RoleTeacher {
+		return ErrForbidden
+	}
+
+	dept, err := s.departmentRepository.FindById(*t.DepartmentID)
+	if err != nil {
+		return err
+	}
+	if dept.HeadOfDepartmentID == nil || !a.IsTeacher(*dept.HeadOfDepartmentID) {
+		return ErrForbidden
+	}
+
+	return nil
+}
+
+func teaches(t *entity.Teacher, courseId uint) bool {
+	for _, c := range t.Courses {
+		if c.ID == courseId {
+			return true
+		}
+	}
+
+	return false
+}
+
+func withAffectedCourses(leaveRequest *entity.LeaveRequest, t *entity.Teacher) *response.LeaveRequestResponse {
+	resp := toLeaveRequestResponse(leaveRequest)
+
+	substitutes := make(map[uint]uint, len(leaveRequest.Substitutions))
+	for _, substitution := range leaveRequest.Substitutions {
+		substitutes[substitution.CourseID] = substitution.SubstituteID
+	}
+
+	resp.AffectedCourses = make([]response.AffectedCourseResponse, 0, len(t.Courses))
+	for _, c := range t.Courses {
+		affected := response.AffectedCourseResponse{CourseID: c.ID, Title: c.Title}
+		if substituteId, ok := substitutes[c.ID]; ok {
+			affected.SubstituteID = &substituteId
+		}
+		resp.AffectedCourses = append(resp.AffectedCourses, affected)
+	}
+
+	return resp
+}
+
+func toLeaveRequestResponse(leaveRequest *entity.LeaveRequest) *response.LeaveRequestResponse {
+	return &response.LeaveRequestResponse{
+		ID:        leaveRequest.ID,
+		TeacherID: leaveRequest.TeacherID,
+		StartDate: leaveRequest.StartDate.Format(dateLayout),
+		EndDate:   leaveRequest.EndDate.Format(dateLayout),
+		Reason:    leaveRequest.Reason,
+		Status:    leaveRequest.Status,
+		DecidedBy: leaveRequest.DecidedBy,
+		DecidedAt: leaveRequest.DecidedAt,
+	}
+}
+
+func toSubstitutionResponse(substitution *entity.Substitution) *response.SubstitutionResponse {
+	return &response.SubstitutionResponse{
+		ID:             substitution.ID,
+		LeaveRequestID: substitution.LeaveRequestID,
+		CourseID:       substitution.CourseID,
+		SubstituteID:   substitution.SubstituteID,
+		StartDate:      substitution.StartDate.Format(dateLayout),
+		EndDate:        substitution.EndDate.Format(dateLayout),
+	}
+}
+
+func toTeacherResponse(t *entity.Teacher) *response.TeacherResponse {
+	return &response.TeacherResponse{
+		ID:           t.ID,
+		Name:         t.Name,
+		Email:        t.Email,
+		DepartmentID: t.DepartmentID,
+	}
+}
@@ -0,0 +1,257 @@
+package leave
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"student_go/internal/actor"
+	"student_go/internal/dto/request"
+	"student_go/internal/entity"
+	mocks2 "student_go/internal/mocks"
+	"student_go/pkg/log"
+	"testing"
+	"time"
+)
+
+func init() {
+	logger, _ := zap.NewDevelopment()
+	log.Log = logger
+}
+
+var (
+	admin        = actor.Actor{ID: 1, Role: actor.RoleAdmin}
+	headActor    = actor.Actor{ID: 5, Role: actor.RoleTeacher}
+	teacherActor = actor.Actor{ID: 3, Role: actor.RoleTeacher}
+	otherActor   = actor.Actor{ID: 4, Role: actor.RoleTeacher}
+
+	leaveStart = time.Date(2026, 11, 2, 0, 0, 0, 0, time.UTC)
+	leaveEnd   = time.Date(2026, 11, 6, 0, 0, 0, 0, time.UTC)
+)
+
+type testMocks struct {
+	leaveRepo      *mocks2.LeaveRepository
+	teacherRepo    *mocks2.TeacherRepository
+	departmentRepo *mocks2.DepartmentRepository
+	courseRepo     *mocks2.CourseRepository
+}
+
+func newTestLeaveService() (*service, testMocks) {
+	m := testMocks{
+		leaveRepo:      new(mocks2.LeaveRepository),
+		teacherRepo:    new(mocks2.TeacherRepository),
+		departmentRepo: new(mocks2.DepartmentRepository),
+		courseRepo:     new(mocks2.CourseRepository),
+	}
+
+	departmentId := uint(2)
+	head := uint(5)
+	m.teacherRepo.On("FindById", uint(3)).Return(&entity.Teacher{
+		ID:           3,
+		Name:         "Anna",
+		DepartmentID: &departmentId,
+		Courses:      []entity.Course{{ID: 10, Title: "Algebra"}, {ID: 11, Title: "Geometry"}},
+	}, nil).Maybe()
+	m.teacherRepo.On("FindById", uint(4)).Return(&entity.Teacher{ID: 4, Name: "Boris"}, nil).Maybe()
+	m.departmentRepo.On("FindById", uint(2)).Return(&entity.Department{ID: 2, HeadOfDepartmentID: &head}, nil).Maybe()
+
+	svc := NewLeaveService(m.leaveRepo, m.teacherRepo, m.departmentRepo, m.courseRepo).(*service)
+	svc.now = func() time.Time { return time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC) }
+	return svc, m
+}
+
+func pendingLeave() *entity.LeaveRequest {
+	return &entity.LeaveRequest{ID: 7, TeacherID: 3, StartDate: leaveStart, EndDate: leaveEnd, Status: entity.LeavePending}
+}
+
+func approvedLeave() *entity.LeaveRequest {
+	leaveRequest := pendingLeave()
+	leaveRequest.Status = entity.LeaveApproved
+	return leaveRequest
+}
+
+func TestCreateLeaveRequest(t *testing.T) {
+	svc, m := newTestLeaveService()
+
+	m.leaveRepo.On("Save", mock.MatchedBy(func(l *entity.LeaveRequest) bool {
+		return l.TeacherID == 3 && l.StartDate.Equal(leaveStart) && l.EndDate.Equal(leaveEnd) && l.Status == entity.LeavePending
+	})).Return(func(l *entity.LeaveRequest) *entity.LeaveRequest {
+		l.ID = 7
+		return l
+	}, nil)
+
+	result, err := svc.CreateLeaveRequest(3, teacherActor, request.LeaveRequestRequest{StartDate: "2026-11-02", EndDate: "2026-11-06"})
+
+	require.NoError(t, err)
+	assert.Equal(t, uint(7), result.ID)
+	assert.Equal(t, "2026-11-06", result.EndDate)
+}
+
+func TestCreateLeaveRequest_ForAnotherTeacher(t *testing.T) {
+	svc, m := newTestLeaveService()
+
+	result, err := svc.CreateLeaveRequest(3, otherActor, request.LeaveRequestRequest{StartDate: "2026-11-02", EndDate: "2026-11-06"})
+
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, ErrForbidden)
+	m.leaveRepo.AssertNotCalled(t, "Save", mock.Anything)
+}
+
+func TestCreateLeaveRequest_InvalidRange(t *testing.T) {
+	svc, _ := newTestLeaveService()
+
+	result, err := svc.CreateLeaveRequest(3, teacherActor, request.LeaveRequestRequest{StartDate: "2026-11-06", EndDate: "2026-11-02"})
+
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, ErrInvalidRange)
+}
+
+func TestApproveLeaveRequest(t *testing.T) {
+	svc, m := newTestLeaveService()
+
+	m.leaveRepo.On("FindById", uint(7)).Return(pendingLeave(), nil)
+	m.leaveRepo.On("Decide", uint(7), entity.LeaveApproved, uint(5), svc.now()).Return(true, nil)
+
+	result, err := svc.ApproveLeaveRequest(7, headActor)
+
+	require.NoError(t, err)
+	assert.Equal(t, entity.LeaveApproved, result.Status)
+	assert.Equal(t, uint(5), *result.DecidedBy)
+	require.Len(t, result.AffectedCourses, 2)
+	assert.Equal(t, "Algebra", result.AffectedCourses[0].Title)
+	assert.Nil(t, result.AffectedCourses[0].SubstituteID)
+}
+
+func TestApproveLeaveRequest_NotHead(t *testing.T) {
+	svc, m := newTestLeaveService()
+
+	m.leaveRepo.On("FindById", uint(7)).Return(pendingLeave(), nil)
+
+	result, err := svc.ApproveLeaveRequest(7, otherActor)
+
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, ErrForbidden)
+	m.leaveRepo.AssertNotCalled(t, "Decide", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestApproveLeaveRequest_Own(t *testing.T) {
+	svc, m := newTestLeaveService()
+
+	m.leaveRepo.On("FindById", uint(7)).Return(pendingLeave(), nil)
+
+	result, err := svc.ApproveLeaveRequest(7, teacherActor)
+
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, ErrForbidden)
+}
+
+func TestRejectLeaveRequest_AlreadyDecided(t *testing.T) {
+	svc, m := newTestLeaveService()
+
+	m.leaveRepo.On("FindById", uint(7)).Return(approvedLeave(), nil)
+	m.leaveRepo.On("Decide", uint(7), entity.LeaveRejected, uint(1), svc.now()).Return(false, nil)
+
+	result, err := svc.RejectLeaveRequest(7, admin)
+
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, ErrNotPending)
+}
+
+func TestFindLeaveRequestById_NotFound(t *testing.T) {
+	svc, m := newTestLeaveService()
+
+	m.leaveRepo.On("FindById", uint(7)).Return(nil, gorm.ErrRecordNotFound)
+
+	result, err := svc.FindLeaveRequestById(7, admin)
+
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, ErrLeaveRequestNotFound)
+}
+
+func TestAssignSubstitute(t *testing.T) {
+	svc, m := newTestLeaveService()
+
+	m.leaveRepo.On("FindById", uint(7)).Return(approvedLeave(), nil)
+	m.leaveRepo.On("IsOnLeave", uint(4), leaveStart, leaveEnd).Return(false, nil)
+	m.leaveRepo.On("SaveSubstitution", mock.MatchedBy(func(s *entity.Substitution) bool {
+		return s.LeaveRequestID == 7 && s.CourseID == 10 && s.SubstituteID == 4 && s.EndDate.Equal(leaveEnd)
+	})).Return(func(s *entity.Substitution) *entity.Substitution {
+		s.ID = 1
+		return s
+	}, nil)
+
+	result, err := svc.AssignSubstitute(7, headActor, request.SubstituteRequest{CourseID: 10, TeacherID: 4})
+
+	require.NoError(t, err)
+	assert.Equal(t, uint(4), result.SubstituteID)
+	assert.Equal(t, "2026-11-02", result.StartDate)
+}
+
+func TestAssignSubstitute_NotApproved(t *testing.T) {
+	svc, m := newTestLeaveService()
+
+	m.leaveRepo.On("FindById", uint(7)).Return(pendingLeave(), nil)
+
+	result, err := svc.AssignSubstitute(7, admin, request.SubstituteRequest{CourseID: 10, TeacherID: 4})
+
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, ErrNotApproved)
+}
+
+func TestAssignSubstitute_CourseNotAffected(t *testing.T) {
+	svc, m := newTestLeaveService()
+
+	m.leaveRepo.On("FindById", uint(7)).Return(approvedLeave(), nil)
+
+	result, err := svc.AssignSubstitute(7, admin, request.SubstituteRequest{CourseID: 99, TeacherID: 4})
+
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, ErrCourseNotAffected)
+}
+
+func TestAssignSubstitute_SubstituteOnLeave(t *testing.T) {
+	svc, m := newTestLeaveService()
+
+	m.leaveRepo.On("FindById", uint(7)).Return(approvedLeave(), nil)
+	m.leaveRepo.On("IsOnLeave", uint(4), leaveStart, leaveEnd).Return(true, nil)
+
+	result, err := svc.AssignSubstitute(7, admin, request.SubstituteRequest{CourseID: 10, TeacherID: 4})
+
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, ErrInvalidSubstitute)
+	m.leaveRepo.AssertNotCalled(t, "SaveSubstitution", mock.Anything)
+}
+
+func TestFindCourseTeacher_Substitute(t *testing.T) {
+	svc, m := newTestLeaveService()
+	permanent := uint(3)
+	on := time.Date(2026, 11, 3, 0, 0, 0, 0, time.UTC)
+
+	m.courseRepo.On("FindById", uint(10)).Return(&entity.Course{ID: 10, TeacherID: &permanent, Teacher: &entity.Teacher{ID: 3}}, nil)
+	m.leaveRepo.On("FindSubstitution", uint(10), on).Return(&entity.Substitution{LeaveRequestID: 7, CourseID: 10, SubstituteID: 4}, nil)
+
+	result, err := svc.FindCourseTeacher(10, on)
+
+	require.NoError(t, err)
+	assert.True(t, result.Substitute)
+	assert.Equal(t, "Boris", result.Teacher.Name)
+	assert.Equal(t, uint(7), *result.LeaveRequestID)
+	assert.Equal(t, "2026-11-03", result.Date)
+}
+
+func TestFindCourseTeacher_Permanent(t *testing.T) {
+	svc, m := newTestLeaveService()
+	permanent := uint(3)
+	on := time.Date(2026, 11, 9, 0, 0, 0, 0, time.UTC)
+
+	m.courseRepo.On("FindById", uint(10)).Return(&entity.Course{ID: 10, TeacherID: &permanent, Teacher: &entity.Teacher{ID: 3, Name: "Anna"}}, nil)
+	m.leaveRepo.On("FindSubstitution", uint(10), on).Return(nil, gorm.ErrRecordNotFound)
+
+	result, err := svc.FindCourseTeacher(10, on)
+
+	require.NoError(t, err)
+	assert.False(t, result.Substitute)
+	assert.Equal(t, "Anna", result.Teacher.Name)
+	assert.Nil(t, result.LeaveRequestID)
+}
@@ -3,9 +3,15 @@
 package mocks
 
 import (
+	audit "student_go/internal/audit"
+
 	entity "student_go/internal/entity"
 
 	mock "github.com/stretchr/testify/mock"
+
+	pagination "student_go/pkg/pagination"
+
+	query "student_go/pkg/query"
 )
 
 // CourseRepository is an autogenerated mock type for the Repository type
@@ -21,9 +27,9 @@ func (_m *CourseRepository) EXPECT() *CourseRepository_Expecter {
 	return &CourseRepository_Expecter{mock: &_m.Mock}
 }
 
-// Count provides a mock function with no fields
-func (_m *CourseRepository) Count() (int, error) {
-	ret := _m.Called()
+// Count provides a mock function with given fields: spec
+func (_m *CourseRepository) Count(spec query.Spec) (int, error) {
+	ret := _m.Called(spec)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Count")
@@ -31,17 +37,17 @@ func (_m *CourseRepository) Count() (int, error) {
 
 	var r0 int
 	var r1 error
-	if rf, ok := ret.Get(0).(func() (int, error)); ok {
-		return rf()
+	if rf, ok := ret.Get(0).(func(query.Spec) (int, error)); ok {
+		return rf(spec)
 	}
-	if rf, ok := ret.Get(0).(func() int); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(query.Spec) int); ok {
+		r0 = rf(spec)
 	} else {
 		r0 = ret.Get(0).(int)
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(query.Spec) error); ok {
+		r1 = rf(spec)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -55,13 +61,14 @@ type CourseRepository_Count_Call struct {
 }
 
 // Count is a helper method to define mock.On call
-func (_e *CourseRepository_Expecter) Count() *CourseRepository_Count_Call {
-	return &CourseRepository_Count_Call{Call: _e.mock.On("Count")}
+//   - spec query.Spec
+func (_e *CourseRepository_Expecter) Count(spec interface{}) *CourseRepository_Count_Call {
+	return &CourseRepository_Count_Call{Call: _e.mock.On("Count", spec)}
 }
 
-func (_c *CourseRepository_Count_Call) Run(run func()) *CourseRepository_Count_Call {
+func (_c *CourseRepository_Count_Call) Run(run func(spec query.Spec)) *CourseRepository_Count_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
+		run(args[0].(query.Spec))
 	})
 	return _c
 }
@@ -71,22 +78,78 @@ func (_c *CourseRepository_Count_Call) Return(_a0 int, _a1 error) *CourseReposit
 	return _c
 }
 
-func (_c *CourseRepository_Count_Call) RunAndReturn(run func() (int, error)) *CourseRepository_Count_Call {
+func (_c *CourseRepository_Count_Call) RunAndReturn(run func(query.Spec) (int, error)) *CourseRepository_Count_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteById provides a mock function with given fields: id
-func (_m *CourseRepository) DeleteById(id uint) error {
-	ret := _m.Called(id)
+// CountStudents provides a mock function with given fields: courseId
+func (_m *CourseRepository) CountStudents(courseId uint) (int, error) {
+	ret := _m.Called(courseId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountStudents")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint) (int, error)); ok {
+		return rf(courseId)
+	}
+	if rf, ok := ret.Get(0).(func(uint) int); ok {
+		r0 = rf(courseId)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(courseId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CourseRepository_CountStudents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountStudents'
+type CourseRepository_CountStudents_Call struct {
+	*mock.Call
+}
+
+// CountStudents is a helper method to define mock.On call
+//   - courseId uint
+func (_e *CourseRepository_Expecter) CountStudents(courseId interface{}) *CourseRepository_CountStudents_Call {
+	return &CourseRepository_CountStudents_Call{Call: _e.mock.On("CountStudents", courseId)}
+}
+
+func (_c *CourseRepository_CountStudents_Call) Run(run func(courseId uint)) *CourseRepository_CountStudents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uint))
+	})
+	return _c
+}
+
+func (_c *CourseRepository_CountStudents_Call) Return(_a0 int, _a1 error) *CourseRepository_CountStudents_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CourseRepository_CountStudents_Call) RunAndReturn(run func(uint) (int, error)) *CourseRepository_CountStudents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteById provides a mock function with given fields: id, version, meta
+func (_m *CourseRepository) DeleteById(id uint, version uint, meta audit.Meta) error {
+	ret := _m.Called(id, version, meta)
 
 	if len(ret) == 0 {
 		panic("no return value specified for DeleteById")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(uint) error); ok {
-		r0 = rf(id)
+	if rf, ok := ret.Get(0).(func(uint, uint, audit.Meta) error); ok {
+		r0 = rf(id, version, meta)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -101,13 +164,15 @@ type CourseRepository_DeleteById_Call struct {
 
 // DeleteById is a helper method to define mock.On call
 //   - id uint
-func (_e *CourseRepository_Expecter) DeleteById(id interface{}) *CourseRepository_DeleteById_Call {
-	return &CourseRepository_DeleteById_Call{Call: _e.mock.On("DeleteById", id)}
+//   - version uint
+//   - meta audit.Meta
+func (_e *CourseRepository_Expecter) DeleteById(id interface{}, version interface{}, meta interface{}) *CourseRepository_DeleteById_Call {
+	return &CourseRepository_DeleteById_Call{Call: _e.mock.On("DeleteById", id, version, meta)}
 }
 
-func (_c *CourseRepository_DeleteById_Call) Run(run func(id uint)) *CourseRepository_DeleteById_Call {
+func (_c *CourseRepository_DeleteById_Call) Run(run func(id uint, version uint, meta audit.Meta)) *CourseRepository_DeleteById_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(uint))
+		run(args[0].(uint), args[1].(uint), args[2].(audit.Meta))
 	})
 	return _c
 }
@@ -117,7 +182,7 @@ func (_c *CourseRepository_DeleteById_Call) Return(_a0 error) *CourseRepository_
 	return _c
 }
 
-func (_c *CourseRepository_DeleteById_Call) RunAndReturn(run func(uint) error) *CourseRepository_DeleteById_Call {
+func (_c *CourseRepository_DeleteById_Call) RunAndReturn(run func(uint, uint, audit.Meta) error) *CourseRepository_DeleteById_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -178,9 +243,9 @@ func (_c *CourseRepository_ExistsById_Call) RunAndReturn(run func(uint) (bool, e
 	return _c
 }
 
-// FindAll provides a mock function with given fields: page, limit
-func (_m *CourseRepository) FindAll(page int, limit int) ([]entity.Course, error) {
-	ret := _m.Called(page, limit)
+// FindAll provides a mock function with given fields: page, limit, spec
+func (_m *CourseRepository) FindAll(page int, limit int, spec query.Spec) ([]entity.Course, error) {
+	ret := _m.Called(page, limit, spec)
 
 	if len(ret) == 0 {
 		panic("no return value specified for FindAll")
@@ -188,19 +253,19 @@ func (_m *CourseRepository) FindAll(page int, limit int) ([]entity.Course, error
 
 	var r0 []entity.Course
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int, int) ([]entity.Course, error)); ok {
-		return rf(page, limit)
+	if rf, ok := ret.Get(0).(func(int, int, query.Spec) ([]entity.Course, error)); ok {
+		return rf(page, limit, spec)
 	}
-	if rf, ok := ret.Get(0).(func(int, int) []entity.Course); ok {
-		r0 = rf(page, limit)
+	if rf, ok := ret.Get(0).(func(int, int, query.Spec) []entity.Course); ok {
+		r0 = rf(page, limit, spec)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]entity.Course)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(int, int) error); ok {
-		r1 = rf(page, limit)
+	if rf, ok := ret.Get(1).(func(int, int, query.Spec) error); ok {
+		r1 = rf(page, limit, spec)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -216,13 +281,14 @@ type CourseRepository_FindAll_Call struct {
 // FindAll is a helper method to define mock.On call
 //   - page int
 //   - limit int
-func (_e *CourseRepository_Expecter) FindAll(page interface{}, limit interface{}) *CourseRepository_FindAll_Call {
-	return &CourseRepository_FindAll_Call{Call: _e.mock.On("FindAll", page, limit)}
+//   - spec query.Spec
+func (_e *CourseRepository_Expecter) FindAll(page interface{}, limit interface{}, spec interface{}) *CourseRepository_FindAll_Call {
+	return &CourseRepository_FindAll_Call{Call: _e.mock.On("FindAll", page, limit, spec)}
 }
 
-func (_c *CourseRepository_FindAll_Call) Run(run func(page int, limit int)) *CourseRepository_FindAll_Call {
+func (_c *CourseRepository_FindAll_Call) Run(run func(page int, limit int, spec query.Spec)) *CourseRepository_FindAll_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(int), args[1].(int))
+		run(args[0].(int), args[1].(int), args[2].(query.Spec))
 	})
 	return _c
 }
@@ -232,7 +298,66 @@ func (_c *CourseRepository_FindAll_Call) Return(_a0 []entity.Course, _a1 error)
 	return _c
 }
 
-func (_c *CourseRepository_FindAll_Call) RunAndReturn(run func(int, int) ([]entity.Course, error)) *CourseRepository_FindAll_Call {
+func (_c *CourseRepository_FindAll_Call) RunAndReturn(run func(int, int, query.Spec) ([]entity.Course, error)) *CourseRepository_FindAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindAllByCursor provides a mock function with given fields: keyset, spec
+func (_m *CourseRepository) FindAllByCursor(keyset pagination.Keyset, spec query.Spec) ([]entity.Course, error) {
+	ret := _m.Called(keyset, spec)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindAllByCursor")
+	}
+
+	var r0 []entity.Course
+	var r1 error
+	if rf, ok := ret.Get(0).(func(pagination.Keyset, query.Spec) ([]entity.Course, error)); ok {
+		return rf(keyset, spec)
+	}
+	if rf, ok := ret.Get(0).(func(pagination.Keyset, query.Spec) []entity.Course); ok {
+		r0 = rf(keyset, spec)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Course)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(pagination.Keyset, query.Spec) error); ok {
+		r1 = rf(keyset, spec)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CourseRepository_FindAllByCursor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindAllByCursor'
+type CourseRepository_FindAllByCursor_Call struct {
+	*mock.Call
+}
+
+// FindAllByCursor is a helper method to define mock.On call
+//   - keyset pagination.Keyset
+//   - spec query.Spec
+func (_e *CourseRepository_Expecter) FindAllByCursor(keyset interface{}, spec interface{}) *CourseRepository_FindAllByCursor_Call {
+	return &CourseRepository_FindAllByCursor_Call{Call: _e.mock.On("FindAllByCursor", keyset, spec)}
+}
+
+func (_c *CourseRepository_FindAllByCursor_Call) Run(run func(keyset pagination.Keyset, spec query.Spec)) *CourseRepository_FindAllByCursor_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(pagination.Keyset), args[1].(query.Spec))
+	})
+	return _c
+}
+
+func (_c *CourseRepository_FindAllByCursor_Call) Return(_a0 []entity.Course, _a1 error) *CourseRepository_FindAllByCursor_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CourseRepository_FindAllByCursor_Call) RunAndReturn(run func(pagination.Keyset, query.Spec) ([]entity.Course, error)) *CourseRepository_FindAllByCursor_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -295,9 +420,297 @@ func (_c *CourseRepository_FindById_Call) RunAndReturn(run func(uint) (*entity.C
 	return _c
 }
 
-// Save provides a mock function with given fields: _a0
-func (_m *CourseRepository) Save(_a0 *entity.Course) (*entity.Course, error) {
-	ret := _m.Called(_a0)
+// FindStudents provides a mock function with given fields: courseId, page, limit
+func (_m *CourseRepository) FindStudents(courseId uint, page int, limit int) ([]entity.Student, error) {
+	ret := _m.Called(courseId, page, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindStudents")
+	}
+
+	var r0 []entity.Student
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, int, int) ([]entity.Student, error)); ok {
+		return rf(courseId, page, limit)
+	}
+	if rf, ok := ret.Get(0).(func(uint, int, int) []entity.Student); ok {
+		r0 = rf(courseId, page, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Student)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, int, int) error); ok {
+		r1 = rf(courseId, page, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CourseRepository_FindStudents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindStudents'
+type CourseRepository_FindStudents_Call struct {
+	*mock.Call
+}
+
+// FindStudents is a helper method to define mock.On call
+//   - courseId uint
+//   - page int
+//   - limit int
+func (_e *CourseRepository_Expecter) FindStudents(courseId interface{}, page interface{}, limit interface{}) *CourseRepository_FindStudents_Call {
+	return &CourseRepository_FindStudents_Call{Call: _e.mock.On("FindStudents", courseId, page, limit)}
+}
+
+func (_c *CourseRepository_FindStudents_Call) Run(run func(courseId uint, page int, limit int)) *CourseRepository_FindStudents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uint), args[1].(int), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *CourseRepository_FindStudents_Call) Return(_a0 []entity.Student, _a1 error) *CourseRepository_FindStudents_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CourseRepository_FindStudents_Call) RunAndReturn(run func(uint, int, int) ([]entity.Student, error)) *CourseRepository_FindStudents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// HasStudent provides a mock function with given fields: courseId, studentId
+func (_m *CourseRepository) HasStudent(courseId uint, studentId uint) (bool, error) {
+	ret := _m.Called(courseId, studentId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HasStudent")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, uint) (bool, error)); ok {
+		return rf(courseId, studentId)
+	}
+	if rf, ok := ret.Get(0).(func(uint, uint) bool); ok {
+		r0 = rf(courseId, studentId)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, uint) error); ok {
+		r1 = rf(courseId, studentId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CourseRepository_HasStudent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HasStudent'
+type CourseRepository_HasStudent_Call struct {
+	*mock.Call
+}
+
+// HasStudent is a helper method to define mock.On call
+//   - courseId uint
+//   - studentId uint
+func (_e *CourseRepository_Expecter) HasStudent(courseId interface{}, studentId interface{}) *CourseRepository_HasStudent_Call {
+	return &CourseRepository_HasStudent_Call{Call: _e.mock.On("HasStudent", courseId, studentId)}
+}
+
+func (_c *CourseRepository_HasStudent_Call) Run(run func(courseId uint, studentId uint)) *CourseRepository_HasStudent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uint), args[1].(uint))
+	})
+	return _c
+}
+
+func (_c *CourseRepository_HasStudent_Call) Return(_a0 bool, _a1 error) *CourseRepository_HasStudent_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CourseRepository_HasStudent_Call) RunAndReturn(run func(uint, uint) (bool, error)) *CourseRepository_HasStudent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// HasTeacher provides a mock function with given fields: courseId, teacherId
+func (_m *CourseRepository) HasTeacher(courseId uint, teacherId uint) (bool, error) {
+	ret := _m.Called(courseId, teacherId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HasTeacher")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, uint) (bool, error)); ok {
+		return rf(courseId, teacherId)
+	}
+	if rf, ok := ret.Get(0).(func(uint, uint) bool); ok {
+		r0 = rf(courseId, teacherId)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, uint) error); ok {
+		r1 = rf(courseId, teacherId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CourseRepository_HasTeacher_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HasTeacher'
+type CourseRepository_HasTeacher_Call struct {
+	*mock.Call
+}
+
+// HasTeacher is a helper method to define mock.On call
+//   - courseId uint
+//   - teacherId uint
+func (_e *CourseRepository_Expecter) HasTeacher(courseId interface{}, teacherId interface{}) *CourseRepository_HasTeacher_Call {
+	return &CourseRepository_HasTeacher_Call{Call: _e.mock.On("HasTeacher", courseId, teacherId)}
+}
+
+func (_c *CourseRepository_HasTeacher_Call) Run(run func(courseId uint, teacherId uint)) *CourseRepository_HasTeacher_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uint), args[1].(uint))
+	})
+	return _c
+}
+
+func (_c *CourseRepository_HasTeacher_Call) Return(_a0 bool, _a1 error) *CourseRepository_HasTeacher_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CourseRepository_HasTeacher_Call) RunAndReturn(run func(uint, uint) (bool, error)) *CourseRepository_HasTeacher_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsStudentTaughtBy provides a mock function with given fields: studentId, teacherId
+func (_m *CourseRepository) IsStudentTaughtBy(studentId uint, teacherId uint) (bool, error) {
+	ret := _m.Called(studentId, teacherId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsStudentTaughtBy")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, uint) (bool, error)); ok {
+		return rf(studentId, teacherId)
+	}
+	if rf, ok := ret.Get(0).(func(uint, uint) bool); ok {
+		r0 = rf(studentId, teacherId)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, uint) error); ok {
+		r1 = rf(studentId, teacherId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CourseRepository_IsStudentTaughtBy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsStudentTaughtBy'
+type CourseRepository_IsStudentTaughtBy_Call struct {
+	*mock.Call
+}
+
+// IsStudentTaughtBy is a helper method to define mock.On call
+//   - studentId uint
+//   - teacherId uint
+func (_e *CourseRepository_Expecter) IsStudentTaughtBy(studentId interface{}, teacherId interface{}) *CourseRepository_IsStudentTaughtBy_Call {
+	return &CourseRepository_IsStudentTaughtBy_Call{Call: _e.mock.On("IsStudentTaughtBy", studentId, teacherId)}
+}
+
+func (_c *CourseRepository_IsStudentTaughtBy_Call) Run(run func(studentId uint, teacherId uint)) *CourseRepository_IsStudentTaughtBy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uint), args[1].(uint))
+	})
+	return _c
+}
+
+func (_c *CourseRepository_IsStudentTaughtBy_Call) Return(_a0 bool, _a1 error) *CourseRepository_IsStudentTaughtBy_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CourseRepository_IsStudentTaughtBy_Call) RunAndReturn(run func(uint, uint) (bool, error)) *CourseRepository_IsStudentTaughtBy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Restore provides a mock function with given fields: id, meta
+func (_m *CourseRepository) Restore(id uint, meta audit.Meta) (bool, error) {
+	ret := _m.Called(id, meta)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Restore")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, audit.Meta) (bool, error)); ok {
+		return rf(id, meta)
+	}
+	if rf, ok := ret.Get(0).(func(uint, audit.Meta) bool); ok {
+		r0 = rf(id, meta)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, audit.Meta) error); ok {
+		r1 = rf(id, meta)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CourseRepository_Restore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Restore'
+type CourseRepository_Restore_Call struct {
+	*mock.Call
+}
+
+// Restore is a helper method to define mock.On call
+//   - id uint
+//   - meta audit.Meta
+func (_e *CourseRepository_Expecter) Restore(id interface{}, meta interface{}) *CourseRepository_Restore_Call {
+	return &CourseRepository_Restore_Call{Call: _e.mock.On("Restore", id, meta)}
+}
+
+func (_c *CourseRepository_Restore_Call) Run(run func(id uint, meta audit.Meta)) *CourseRepository_Restore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uint), args[1].(audit.Meta))
+	})
+	return _c
+}
+
+func (_c *CourseRepository_Restore_Call) Return(_a0 bool, _a1 error) *CourseRepository_Restore_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CourseRepository_Restore_Call) RunAndReturn(run func(uint, audit.Meta) (bool, error)) *CourseRepository_Restore_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Save provides a mock function with given fields: _a0, meta
+func (_m *CourseRepository) Save(_a0 *entity.Course, meta audit.Meta) (*entity.Course, error) {
+	ret := _m.Called(_a0, meta)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Save")
@@ -305,19 +718,19 @@ func (_m *CourseRepository) Save(_a0 *entity.Course) (*entity.Course, error) {
 
 	var r0 *entity.Course
 	var r1 error
-	if rf, ok := ret.Get(0).(func(*entity.Course) (*entity.Course, error)); ok {
-		return rf(_a0)
+	if rf, ok := ret.Get(0).(func(*entity.Course, audit.Meta) (*entity.Course, error)); ok {
+		return rf(_a0, meta)
 	}
-	if rf, ok := ret.Get(0).(func(*entity.Course) *entity.Course); ok {
-		r0 = rf(_a0)
+	if rf, ok := ret.Get(0).(func(*entity.Course, audit.Meta) *entity.Course); ok {
+		r0 = rf(_a0, meta)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*entity.Course)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(*entity.Course) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(*entity.Course, audit.Meta) error); ok {
+		r1 = rf(_a0, meta)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -332,13 +745,14 @@ type CourseRepository_Save_Call struct {
 
 // Save is a helper method to define mock.On call
 //   - _a0 *entity.Course
-func (_e *CourseRepository_Expecter) Save(_a0 interface{}) *CourseRepository_Save_Call {
-	return &CourseRepository_Save_Call{Call: _e.mock.On("Save", _a0)}
+//   - meta audit.Meta
+func (_e *CourseRepository_Expecter) Save(_a0 interface{}, meta interface{}) *CourseRepository_Save_Call {
+	return &CourseRepository_Save_Call{Call: _e.mock.On("Save", _a0, meta)}
 }
 
-func (_c *CourseRepository_Save_Call) Run(run func(_a0 *entity.Course)) *CourseRepository_Save_Call {
+func (_c *CourseRepository_Save_Call) Run(run func(_a0 *entity.Course, meta audit.Meta)) *CourseRepository_Save_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(*entity.Course))
+		run(args[0].(*entity.Course), args[1].(audit.Meta))
 	})
 	return _c
 }
@@ -348,14 +762,14 @@ func (_c *CourseRepository_Save_Call) Return(_a0 *entity.Course, _a1 error) *Cou
 	return _c
 }
 
-func (_c *CourseRepository_Save_Call) RunAndReturn(run func(*entity.Course) (*entity.Course, error)) *CourseRepository_Save_Call {
+func (_c *CourseRepository_Save_Call) RunAndReturn(run func(*entity.Course, audit.Meta) (*entity.Course, error)) *CourseRepository_Save_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Update provides a mock function with given fields: _a0
-func (_m *CourseRepository) Update(_a0 *entity.Course) (*entity.Course, error) {
-	ret := _m.Called(_a0)
+// Update provides a mock function with given fields: id, version, changes, meta
+func (_m *CourseRepository) Update(id uint, version uint, changes map[string]interface{}, meta audit.Meta) (*entity.Course, error) {
+	ret := _m.Called(id, version, changes, meta)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Update")
@@ -363,19 +777,19 @@ func (_m *CourseRepository) Update(_a0 *entity.Course) (*entity.Course, error) {
 
 	var r0 *entity.Course
 	var r1 error
-	if rf, ok := ret.Get(0).(func(*entity.Course) (*entity.Course, error)); ok {
-		return rf(_a0)
+	if rf, ok := ret.Get(0).(func(uint, uint, map[string]interface{}, audit.Meta) (*entity.Course, error)); ok {
+		return rf(id, version, changes, meta)
 	}
-	if rf, ok := ret.Get(0).(func(*entity.Course) *entity.Course); ok {
-		r0 = rf(_a0)
+	if rf, ok := ret.Get(0).(func(uint, uint, map[string]interface{}, audit.Meta) *entity.Course); ok {
+		r0 = rf(id, version, changes, meta)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*entity.Course)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(*entity.Course) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(uint, uint, map[string]interface{}, audit.Meta) error); ok {
+		r1 = rf(id, version, changes, meta)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -389,14 +803,17 @@ type CourseRepository_Update_Call struct {
 }
 
 // Update is a helper method to define mock.On call
-//   - _a0 *entity.Course
-func (_e *CourseRepository_Expecter) Update(_a0 interface{}) *CourseRepository_Update_Call {
-	return &CourseRepository_Update_Call{Call: _e.mock.On("Update", _a0)}
+//   - id uint
+//   - version uint
+//   - changes map[string]interface{}
+//   - meta audit.Meta
+func (_e *CourseRepository_Expecter) Update(id interface{}, version interface{}, changes interface{}, meta interface{}) *CourseRepository_Update_Call {
+	return &CourseRepository_Update_Call{Call: _e.mock.On("Update", id, version, changes, meta)}
 }
 
-func (_c *CourseRepository_Update_Call) Run(run func(_a0 *entity.Course)) *CourseRepository_Update_Call {
+func (_c *CourseRepository_Update_Call) Run(run func(id uint, version uint, changes map[string]interface{}, meta audit.Meta)) *CourseRepository_Update_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(*entity.Course))
+		run(args[0].(uint), args[1].(uint), args[2].(map[string]interface{}), args[3].(audit.Meta))
 	})
 	return _c
 }
@@ -406,7 +823,7 @@ func (_c *CourseRepository_Update_Call) Return(_a0 *entity.Course, _a1 error) *C
 	return _c
 }
 
-func (_c *CourseRepository_Update_Call) RunAndReturn(run func(*entity.Course) (*entity.Course, error)) *CourseRepository_Update_Call {
+func (_c *CourseRepository_Update_Call) RunAndReturn(run func(uint, uint, map[string]interface{}, audit.Meta) (*entity.Course, error)) *CourseRepository_Update_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -3,10 +3,18 @@
 package mocks
 
 import (
-	request "student_go/internal/dto/request"
+	audit "student_go/internal/audit"
 
 	mock "github.com/stretchr/testify/mock"
 
+	pagination "student_go/pkg/pagination"
+
+	patch "student_go/pkg/patch"
+
+	query "student_go/pkg/query"
+
+	request "student_go/internal/dto/request"
+
 	response "student_go/internal/dto/response"
 )
 
@@ -23,9 +31,9 @@ func (_m *CourseServiceMock) EXPECT() *CourseServiceMock_Expecter {
 	return &CourseServiceMock_Expecter{mock: &_m.Mock}
 }
 
-// Count provides a mock function with no fields
-func (_m *CourseServiceMock) Count() (int, error) {
-	ret := _m.Called()
+// Count provides a mock function with given fields: spec
+func (_m *CourseServiceMock) Count(spec query.Spec) (int, error) {
+	ret := _m.Called(spec)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Count")
@@ -33,17 +41,17 @@ func (_m *CourseServiceMock) Count() (int, error) {
 
 	var r0 int
 	var r1 error
-	if rf, ok := ret.Get(0).(func() (int, error)); ok {
-		return rf()
+	if rf, ok := ret.Get(0).(func(query.Spec) (int, error)); ok {
+		return rf(spec)
 	}
-	if rf, ok := ret.Get(0).(func() int); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(query.Spec) int); ok {
+		r0 = rf(spec)
 	} else {
 		r0 = ret.Get(0).(int)
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(query.Spec) error); ok {
+		r1 = rf(spec)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -57,13 +65,14 @@ type CourseServiceMock_Count_Call struct {
 }
 
 // Count is a helper method to define mock.On call
-func (_e *CourseServiceMock_Expecter) Count() *CourseServiceMock_Count_Call {
-	return &CourseServiceMock_Count_Call{Call: _e.mock.On("Count")}
+//   - spec query.Spec
+func (_e *CourseServiceMock_Expecter) Count(spec interface{}) *CourseServiceMock_Count_Call {
+	return &CourseServiceMock_Count_Call{Call: _e.mock.On("Count", spec)}
 }
 
-func (_c *CourseServiceMock_Count_Call) Run(run func()) *CourseServiceMock_Count_Call {
+func (_c *CourseServiceMock_Count_Call) Run(run func(spec query.Spec)) *CourseServiceMock_Count_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
+		run(args[0].(query.Spec))
 	})
 	return _c
 }
@@ -73,14 +82,70 @@ func (_c *CourseServiceMock_Count_Call) Return(_a0 int, _a1 error) *CourseServic
 	return _c
 }
 
-func (_c *CourseServiceMock_Count_Call) RunAndReturn(run func() (int, error)) *CourseServiceMock_Count_Call {
+func (_c *CourseServiceMock_Count_Call) RunAndReturn(run func(query.Spec) (int, error)) *CourseServiceMock_Count_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateCourse provides a mock function with given fields: input
-func (_m *CourseServiceMock) CreateCourse(input request.CourseRequest) (*response.CourseResponse, error) {
-	ret := _m.Called(input)
+// CountCourseStudents provides a mock function with given fields: courseId
+func (_m *CourseServiceMock) CountCourseStudents(courseId uint) (int, error) {
+	ret := _m.Called(courseId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountCourseStudents")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint) (int, error)); ok {
+		return rf(courseId)
+	}
+	if rf, ok := ret.Get(0).(func(uint) int); ok {
+		r0 = rf(courseId)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(courseId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CourseServiceMock_CountCourseStudents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountCourseStudents'
+type CourseServiceMock_CountCourseStudents_Call struct {
+	*mock.Call
+}
+
+// CountCourseStudents is a helper method to define mock.On call
+//   - courseId uint
+func (_e *CourseServiceMock_Expecter) CountCourseStudents(courseId interface{}) *CourseServiceMock_CountCourseStudents_Call {
+	return &CourseServiceMock_CountCourseStudents_Call{Call: _e.mock.On("CountCourseStudents", courseId)}
+}
+
+func (_c *CourseServiceMock_CountCourseStudents_Call) Run(run func(courseId uint)) *CourseServiceMock_CountCourseStudents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uint))
+	})
+	return _c
+}
+
+func (_c *CourseServiceMock_CountCourseStudents_Call) Return(_a0 int, _a1 error) *CourseServiceMock_CountCourseStudents_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CourseServiceMock_CountCourseStudents_Call) RunAndReturn(run func(uint) (int, error)) *CourseServiceMock_CountCourseStudents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateCourse provides a mock function with given fields: meta, input
+func (_m *CourseServiceMock) CreateCourse(meta audit.Meta, input request.CourseRequest) (*response.CourseResponse, error) {
+	ret := _m.Called(meta, input)
 
 	if len(ret) == 0 {
 		panic("no return value specified for CreateCourse")
@@ -88,19 +153,19 @@ func (_m *CourseServiceMock) CreateCourse(input request.CourseRequest) (*respons
 
 	var r0 *response.CourseResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(request.CourseRequest) (*response.CourseResponse, error)); ok {
-		return rf(input)
+	if rf, ok := ret.Get(0).(func(audit.Meta, request.CourseRequest) (*response.CourseResponse, error)); ok {
+		return rf(meta, input)
 	}
-	if rf, ok := ret.Get(0).(func(request.CourseRequest) *response.CourseResponse); ok {
-		r0 = rf(input)
+	if rf, ok := ret.Get(0).(func(audit.Meta, request.CourseRequest) *response.CourseResponse); ok {
+		r0 = rf(meta, input)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*response.CourseResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(request.CourseRequest) error); ok {
-		r1 = rf(input)
+	if rf, ok := ret.Get(1).(func(audit.Meta, request.CourseRequest) error); ok {
+		r1 = rf(meta, input)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -114,14 +179,15 @@ type CourseServiceMock_CreateCourse_Call struct {
 }
 
 // CreateCourse is a helper method to define mock.On call
+//   - meta audit.Meta
 //   - input request.CourseRequest
-func (_e *CourseServiceMock_Expecter) CreateCourse(input interface{}) *CourseServiceMock_CreateCourse_Call {
-	return &CourseServiceMock_CreateCourse_Call{Call: _e.mock.On("CreateCourse", input)}
+func (_e *CourseServiceMock_Expecter) CreateCourse(meta interface{}, input interface{}) *CourseServiceMock_CreateCourse_Call {
+	return &CourseServiceMock_CreateCourse_Call{Call: _e.mock.On("CreateCourse", meta, input)}
 }
 
-func (_c *CourseServiceMock_CreateCourse_Call) Run(run func(input request.CourseRequest)) *CourseServiceMock_CreateCourse_Call {
+func (_c *CourseServiceMock_CreateCourse_Call) Run(run func(meta audit.Meta, input request.CourseRequest)) *CourseServiceMock_CreateCourse_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(request.CourseRequest))
+		run(args[0].(audit.Meta), args[1].(request.CourseRequest))
 	})
 	return _c
 }
@@ -131,22 +197,22 @@ func (_c *CourseServiceMock_CreateCourse_Call) Return(_a0 *response.CourseRespon
 	return _c
 }
 
-func (_c *CourseServiceMock_CreateCourse_Call) RunAndReturn(run func(request.CourseRequest) (*response.CourseResponse, error)) *CourseServiceMock_CreateCourse_Call {
+func (_c *CourseServiceMock_CreateCourse_Call) RunAndReturn(run func(audit.Meta, request.CourseRequest) (*response.CourseResponse, error)) *CourseServiceMock_CreateCourse_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteCourseById provides a mock function with given fields: id
-func (_m *CourseServiceMock) DeleteCourseById(id uint) error {
-	ret := _m.Called(id)
+// DeleteCourseById provides a mock function with given fields: id, version, meta
+func (_m *CourseServiceMock) DeleteCourseById(id uint, version uint, meta audit.Meta) error {
+	ret := _m.Called(id, version, meta)
 
 	if len(ret) == 0 {
 		panic("no return value specified for DeleteCourseById")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(uint) error); ok {
-		r0 = rf(id)
+	if rf, ok := ret.Get(0).(func(uint, uint, audit.Meta) error); ok {
+		r0 = rf(id, version, meta)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -161,13 +227,15 @@ type CourseServiceMock_DeleteCourseById_Call struct {
 
 // DeleteCourseById is a helper method to define mock.On call
 //   - id uint
-func (_e *CourseServiceMock_Expecter) DeleteCourseById(id interface{}) *CourseServiceMock_DeleteCourseById_Call {
-	return &CourseServiceMock_DeleteCourseById_Call{Call: _e.mock.On("DeleteCourseById", id)}
+//   - version uint
+//   - meta audit.Meta
+func (_e *CourseServiceMock_Expecter) DeleteCourseById(id interface{}, version interface{}, meta interface{}) *CourseServiceMock_DeleteCourseById_Call {
+	return &CourseServiceMock_DeleteCourseById_Call{Call: _e.mock.On("DeleteCourseById", id, version, meta)}
 }
 
-func (_c *CourseServiceMock_DeleteCourseById_Call) Run(run func(id uint)) *CourseServiceMock_DeleteCourseById_Call {
+func (_c *CourseServiceMock_DeleteCourseById_Call) Run(run func(id uint, version uint, meta audit.Meta)) *CourseServiceMock_DeleteCourseById_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(uint))
+		run(args[0].(uint), args[1].(uint), args[2].(audit.Meta))
 	})
 	return _c
 }
@@ -177,34 +245,34 @@ func (_c *CourseServiceMock_DeleteCourseById_Call) Return(_a0 error) *CourseServ
 	return _c
 }
 
-func (_c *CourseServiceMock_DeleteCourseById_Call) RunAndReturn(run func(uint) error) *CourseServiceMock_DeleteCourseById_Call {
+func (_c *CourseServiceMock_DeleteCourseById_Call) RunAndReturn(run func(uint, uint, audit.Meta) error) *CourseServiceMock_DeleteCourseById_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// FindAllCourse provides a mock function with given fields: page, limit
-func (_m *CourseServiceMock) FindAllCourse(page int, limit int) ([]*response.CourseResponse, error) {
-	ret := _m.Called(page, limit)
+// FindAllCourse provides a mock function with given fields: page, limit, spec
+func (_m *CourseServiceMock) FindAllCourse(page int, limit int, spec query.Spec) ([]*response.CourseSummaryResponse, error) {
+	ret := _m.Called(page, limit, spec)
 
 	if len(ret) == 0 {
 		panic("no return value specified for FindAllCourse")
 	}
 
-	var r0 []*response.CourseResponse
+	var r0 []*response.CourseSummaryResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int, int) ([]*response.CourseResponse, error)); ok {
-		return rf(page, limit)
+	if rf, ok := ret.Get(0).(func(int, int, query.Spec) ([]*response.CourseSummaryResponse, error)); ok {
+		return rf(page, limit, spec)
 	}
-	if rf, ok := ret.Get(0).(func(int, int) []*response.CourseResponse); ok {
-		r0 = rf(page, limit)
+	if rf, ok := ret.Get(0).(func(int, int, query.Spec) []*response.CourseSummaryResponse); ok {
+		r0 = rf(page, limit, spec)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*response.CourseResponse)
+			r0 = ret.Get(0).([]*response.CourseSummaryResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(int, int) error); ok {
-		r1 = rf(page, limit)
+	if rf, ok := ret.Get(1).(func(int, int, query.Spec) error); ok {
+		r1 = rf(page, limit, spec)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -220,23 +288,83 @@ type CourseServiceMock_FindAllCourse_Call struct {
 // FindAllCourse is a helper method to define mock.On call
 //   - page int
 //   - limit int
-func (_e *CourseServiceMock_Expecter) FindAllCourse(page interface{}, limit interface{}) *CourseServiceMock_FindAllCourse_Call {
-	return &CourseServiceMock_FindAllCourse_Call{Call: _e.mock.On("FindAllCourse", page, limit)}
+//   - spec query.Spec
+func (_e *CourseServiceMock_Expecter) FindAllCourse(page interface{}, limit interface{}, spec interface{}) *CourseServiceMock_FindAllCourse_Call {
+	return &CourseServiceMock_FindAllCourse_Call{Call: _e.mock.On("FindAllCourse", page, limit, spec)}
 }
 
-func (_c *CourseServiceMock_FindAllCourse_Call) Run(run func(page int, limit int)) *CourseServiceMock_FindAllCourse_Call {
+func (_c *CourseServiceMock_FindAllCourse_Call) Run(run func(page int, limit int, spec query.Spec)) *CourseServiceMock_FindAllCourse_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(int), args[1].(int))
+		run(args[0].(int), args[1].(int), args[2].(query.Spec))
 	})
 	return _c
 }
 
-func (_c *CourseServiceMock_FindAllCourse_Call) Return(_a0 []*response.CourseResponse, _a1 error) *CourseServiceMock_FindAllCourse_Call {
+func (_c *CourseServiceMock_FindAllCourse_Call) Return(_a0 []*response.CourseSummaryResponse, _a1 error) *CourseServiceMock_FindAllCourse_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *CourseServiceMock_FindAllCourse_Call) RunAndReturn(run func(int, int) ([]*response.CourseResponse, error)) *CourseServiceMock_FindAllCourse_Call {
+func (_c *CourseServiceMock_FindAllCourse_Call) RunAndReturn(run func(int, int, query.Spec) ([]*response.CourseSummaryResponse, error)) *CourseServiceMock_FindAllCourse_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindAllCourseByCursor provides a mock function with given fields: keyset, spec
+func (_m *CourseServiceMock) FindAllCourseByCursor(keyset pagination.Keyset, spec query.Spec) (*pagination.CursorPage, error) {
+	ret := _m.Called(keyset, spec)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindAllCourseByCursor")
+	}
+
+	var r0 *pagination.CursorPage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(pagination.Keyset, query.Spec) (*pagination.CursorPage, error)); ok {
+		return rf(keyset, spec)
+	}
+	if rf, ok := ret.Get(0).(func(pagination.Keyset, query.Spec) *pagination.CursorPage); ok {
+		r0 = rf(keyset, spec)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pagination.CursorPage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(pagination.Keyset, query.Spec) error); ok {
+		r1 = rf(keyset, spec)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CourseServiceMock_FindAllCourseByCursor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindAllCourseByCursor'
+type CourseServiceMock_FindAllCourseByCursor_Call struct {
+	*mock.Call
+}
+
+// FindAllCourseByCursor is a helper method to define mock.On call
+//   - keyset pagination.Keyset
+//   - spec query.Spec
+func (_e *CourseServiceMock_Expecter) FindAllCourseByCursor(keyset interface{}, spec interface{}) *CourseServiceMock_FindAllCourseByCursor_Call {
+	return &CourseServiceMock_FindAllCourseByCursor_Call{Call: _e.mock.On("FindAllCourseByCursor", keyset, spec)}
+}
+
+func (_c *CourseServiceMock_FindAllCourseByCursor_Call) Run(run func(keyset pagination.Keyset, spec query.Spec)) *CourseServiceMock_FindAllCourseByCursor_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(pagination.Keyset), args[1].(query.Spec))
+	})
+	return _c
+}
+
+func (_c *CourseServiceMock_FindAllCourseByCursor_Call) Return(_a0 *pagination.CursorPage, _a1 error) *CourseServiceMock_FindAllCourseByCursor_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CourseServiceMock_FindAllCourseByCursor_Call) RunAndReturn(run func(pagination.Keyset, query.Spec) (*pagination.CursorPage, error)) *CourseServiceMock_FindAllCourseByCursor_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -299,9 +427,186 @@ func (_c *CourseServiceMock_FindCourseById_Call) RunAndReturn(run func(uint) (*r
 	return _c
 }
 
-// SetTeacherToCourse provides a mock function with given fields: courseId, teacherId
-func (_m *CourseServiceMock) SetTeacherToCourse(courseId uint, teacherId uint) (*response.CourseResponse, error) {
-	ret := _m.Called(courseId, teacherId)
+// FindCourseStudents provides a mock function with given fields: courseId, page, limit
+func (_m *CourseServiceMock) FindCourseStudents(courseId uint, page int, limit int) ([]*response.StudentResponse, error) {
+	ret := _m.Called(courseId, page, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindCourseStudents")
+	}
+
+	var r0 []*response.StudentResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, int, int) ([]*response.StudentResponse, error)); ok {
+		return rf(courseId, page, limit)
+	}
+	if rf, ok := ret.Get(0).(func(uint, int, int) []*response.StudentResponse); ok {
+		r0 = rf(courseId, page, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*response.StudentResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, int, int) error); ok {
+		r1 = rf(courseId, page, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CourseServiceMock_FindCourseStudents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindCourseStudents'
+type CourseServiceMock_FindCourseStudents_Call struct {
+	*mock.Call
+}
+
+// FindCourseStudents is a helper method to define mock.On call
+//   - courseId uint
+//   - page int
+//   - limit int
+func (_e *CourseServiceMock_Expecter) FindCourseStudents(courseId interface{}, page interface{}, limit interface{}) *CourseServiceMock_FindCourseStudents_Call {
+	return &CourseServiceMock_FindCourseStudents_Call{Call: _e.mock.On("FindCourseStudents", courseId, page, limit)}
+}
+
+func (_c *CourseServiceMock_FindCourseStudents_Call) Run(run func(courseId uint, page int, limit int)) *CourseServiceMock_FindCourseStudents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uint), args[1].(int), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *CourseServiceMock_FindCourseStudents_Call) Return(_a0 []*response.StudentResponse, _a1 error) *CourseServiceMock_FindCourseStudents_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CourseServiceMock_FindCourseStudents_Call) RunAndReturn(run func(uint, int, int) ([]*response.StudentResponse, error)) *CourseServiceMock_FindCourseStudents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindQualifiedTeachers provides a mock function with given fields: courseId
+func (_m *CourseServiceMock) FindQualifiedTeachers(courseId uint) ([]*response.QualifiedTeacherResponse, error) {
+	ret := _m.Called(courseId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindQualifiedTeachers")
+	}
+
+	var r0 []*response.QualifiedTeacherResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint) ([]*response.QualifiedTeacherResponse, error)); ok {
+		return rf(courseId)
+	}
+	if rf, ok := ret.Get(0).(func(uint) []*response.QualifiedTeacherResponse); ok {
+		r0 = rf(courseId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*response.QualifiedTeacherResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(courseId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CourseServiceMock_FindQualifiedTeachers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindQualifiedTeachers'
+type CourseServiceMock_FindQualifiedTeachers_Call struct {
+	*mock.Call
+}
+
+// FindQualifiedTeachers is a helper method to define mock.On call
+//   - courseId uint
+func (_e *CourseServiceMock_Expecter) FindQualifiedTeachers(courseId interface{}) *CourseServiceMock_FindQualifiedTeachers_Call {
+	return &CourseServiceMock_FindQualifiedTeachers_Call{Call: _e.mock.On("FindQualifiedTeachers", courseId)}
+}
+
+func (_c *CourseServiceMock_FindQualifiedTeachers_Call) Run(run func(courseId uint)) *CourseServiceMock_FindQualifiedTeachers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uint))
+	})
+	return _c
+}
+
+func (_c *CourseServiceMock_FindQualifiedTeachers_Call) Return(_a0 []*response.QualifiedTeacherResponse, _a1 error) *CourseServiceMock_FindQualifiedTeachers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CourseServiceMock_FindQualifiedTeachers_Call) RunAndReturn(run func(uint) ([]*response.QualifiedTeacherResponse, error)) *CourseServiceMock_FindQualifiedTeachers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RestoreCourse provides a mock function with given fields: id, meta
+func (_m *CourseServiceMock) RestoreCourse(id uint, meta audit.Meta) (*response.CourseResponse, error) {
+	ret := _m.Called(id, meta)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestoreCourse")
+	}
+
+	var r0 *response.CourseResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, audit.Meta) (*response.CourseResponse, error)); ok {
+		return rf(id, meta)
+	}
+	if rf, ok := ret.Get(0).(func(uint, audit.Meta) *response.CourseResponse); ok {
+		r0 = rf(id, meta)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*response.CourseResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, audit.Meta) error); ok {
+		r1 = rf(id, meta)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CourseServiceMock_RestoreCourse_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreCourse'
+type CourseServiceMock_RestoreCourse_Call struct {
+	*mock.Call
+}
+
+// RestoreCourse is a helper method to define mock.On call
+//   - id uint
+//   - meta audit.Meta
+func (_e *CourseServiceMock_Expecter) RestoreCourse(id interface{}, meta interface{}) *CourseServiceMock_RestoreCourse_Call {
+	return &CourseServiceMock_RestoreCourse_Call{Call: _e.mock.On("RestoreCourse", id, meta)}
+}
+
+func (_c *CourseServiceMock_RestoreCourse_Call) Run(run func(id uint, meta audit.Meta)) *CourseServiceMock_RestoreCourse_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uint), args[1].(audit.Meta))
+	})
+	return _c
+}
+
+func (_c *CourseServiceMock_RestoreCourse_Call) Return(_a0 *response.CourseResponse, _a1 error) *CourseServiceMock_RestoreCourse_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CourseServiceMock_RestoreCourse_Call) RunAndReturn(run func(uint, audit.Meta) (*response.CourseResponse, error)) *CourseServiceMock_RestoreCourse_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetTeacherToCourse provides a mock function with given fields: courseId, teacherId, override, meta
+func (_m *CourseServiceMock) SetTeacherToCourse(courseId uint, teacherId uint, override bool, meta audit.Meta) (*response.CourseResponse, error) {
+	ret := _m.Called(courseId, teacherId, override, meta)
 
 	if len(ret) == 0 {
 		panic("no return value specified for SetTeacherToCourse")
@@ -309,19 +614,19 @@ func (_m *CourseServiceMock) SetTeacherToCourse(courseId uint, teacherId uint) (
 
 	var r0 *response.CourseResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(uint, uint) (*response.CourseResponse, error)); ok {
-		return rf(courseId, teacherId)
+	if rf, ok := ret.Get(0).(func(uint, uint, bool, audit.Meta) (*response.CourseResponse, error)); ok {
+		return rf(courseId, teacherId, override, meta)
 	}
-	if rf, ok := ret.Get(0).(func(uint, uint) *response.CourseResponse); ok {
-		r0 = rf(courseId, teacherId)
+	if rf, ok := ret.Get(0).(func(uint, uint, bool, audit.Meta) *response.CourseResponse); ok {
+		r0 = rf(courseId, teacherId, override, meta)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*response.CourseResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(uint, uint) error); ok {
-		r1 = rf(courseId, teacherId)
+	if rf, ok := ret.Get(1).(func(uint, uint, bool, audit.Meta) error); ok {
+		r1 = rf(courseId, teacherId, override, meta)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -337,13 +642,15 @@ type CourseServiceMock_SetTeacherToCourse_Call struct {
 // SetTeacherToCourse is a helper method to define mock.On call
 //   - courseId uint
 //   - teacherId uint
-func (_e *CourseServiceMock_Expecter) SetTeacherToCourse(courseId interface{}, teacherId interface{}) *CourseServiceMock_SetTeacherToCourse_Call {
-	return &CourseServiceMock_SetTeacherToCourse_Call{Call: _e.mock.On("SetTeacherToCourse", courseId, teacherId)}
+//   - override bool
+//   - meta audit.Meta
+func (_e *CourseServiceMock_Expecter) SetTeacherToCourse(courseId interface{}, teacherId interface{}, override interface{}, meta interface{}) *CourseServiceMock_SetTeacherToCourse_Call {
+	return &CourseServiceMock_SetTeacherToCourse_Call{Call: _e.mock.On("SetTeacherToCourse", courseId, teacherId, override, meta)}
 }
 
-func (_c *CourseServiceMock_SetTeacherToCourse_Call) Run(run func(courseId uint, teacherId uint)) *CourseServiceMock_SetTeacherToCourse_Call {
+func (_c *CourseServiceMock_SetTeacherToCourse_Call) Run(run func(courseId uint, teacherId uint, override bool, meta audit.Meta)) *CourseServiceMock_SetTeacherToCourse_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(uint), args[1].(uint))
+		run(args[0].(uint), args[1].(uint), args[2].(bool), args[3].(audit.Meta))
 	})
 	return _c
 }
@@ -353,14 +660,14 @@ func (_c *CourseServiceMock_SetTeacherToCourse_Call) Return(_a0 *response.Course
 	return _c
 }
 
-func (_c *CourseServiceMock_SetTeacherToCourse_Call) RunAndReturn(run func(uint, uint) (*response.CourseResponse, error)) *CourseServiceMock_SetTeacherToCourse_Call {
+func (_c *CourseServiceMock_SetTeacherToCourse_Call) RunAndReturn(run func(uint, uint, bool, audit.Meta) (*response.CourseResponse, error)) *CourseServiceMock_SetTeacherToCourse_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateCourse provides a mock function with given fields: id, input
-func (_m *CourseServiceMock) UpdateCourse(id uint, input request.CourseRequest) (*response.CourseResponse, error) {
-	ret := _m.Called(id, input)
+// UpdateCourse provides a mock function with given fields: id, version, meta, input, fields
+func (_m *CourseServiceMock) UpdateCourse(id uint, version uint, meta audit.Meta, input request.CoursePatch, fields patch.Fields) (*response.CourseResponse, error) {
+	ret := _m.Called(id, version, meta, input, fields)
 
 	if len(ret) == 0 {
 		panic("no return value specified for UpdateCourse")
@@ -368,19 +675,19 @@ func (_m *CourseServiceMock) UpdateCourse(id uint, input request.CourseRequest)
 
 	var r0 *response.CourseResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(uint, request.CourseRequest) (*response.CourseResponse, error)); ok {
-		return rf(id, input)
+	if rf, ok := ret.Get(0).(func(uint, uint, audit.Meta, request.CoursePatch, patch.Fields) (*response.CourseResponse, error)); ok {
+		return rf(id, version, meta, input, fields)
 	}
-	if rf, ok := ret.Get(0).(func(uint, request.CourseRequest) *response.CourseResponse); ok {
-		r0 = rf(id, input)
+	if rf, ok := ret.Get(0).(func(uint, uint, audit.Meta, request.CoursePatch, patch.Fields) *response.CourseResponse); ok {
+		r0 = rf(id, version, meta, input, fields)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*response.CourseResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(uint, request.CourseRequest) error); ok {
-		r1 = rf(id, input)
+	if rf, ok := ret.Get(1).(func(uint, uint, audit.Meta, request.CoursePatch, patch.Fields) error); ok {
+		r1 = rf(id, version, meta, input, fields)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -395,14 +702,17 @@ type CourseServiceMock_UpdateCourse_Call struct {
 
 // UpdateCourse is a helper method to define mock.On call
 //   - id uint
-//   - input request.CourseRequest
-func (_e *CourseServiceMock_Expecter) UpdateCourse(id interface{}, input interface{}) *CourseServiceMock_UpdateCourse_Call {
-	return &CourseServiceMock_UpdateCourse_Call{Call: _e.mock.On("UpdateCourse", id, input)}
+//   - version uint
+//   - meta audit.Meta
+//   - input request.CoursePatch
+//   - fields patch.Fields
+func (_e *CourseServiceMock_Expecter) UpdateCourse(id interface{}, version interface{}, meta interface{}, input interface{}, fields interface{}) *CourseServiceMock_UpdateCourse_Call {
+	return &CourseServiceMock_UpdateCourse_Call{Call: _e.mock.On("UpdateCourse", id, version, meta, input, fields)}
 }
 
-func (_c *CourseServiceMock_UpdateCourse_Call) Run(run func(id uint, input request.CourseRequest)) *CourseServiceMock_UpdateCourse_Call {
+func (_c *CourseServiceMock_UpdateCourse_Call) Run(run func(id uint, version uint, meta audit.Meta, input request.CoursePatch, fields patch.Fields)) *CourseServiceMock_UpdateCourse_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(uint), args[1].(request.CourseRequest))
+		run(args[0].(uint), args[1].(uint), args[2].(audit.Meta), args[3].(request.CoursePatch), args[4].(patch.Fields))
 	})
 	return _c
 }
@@ -412,7 +722,7 @@ func (_c *CourseServiceMock_UpdateCourse_Call) Return(_a0 *response.CourseRespon
 	return _c
 }
 
-func (_c *CourseServiceMock_UpdateCourse_Call) RunAndReturn(run func(uint, request.CourseRequest) (*response.CourseResponse, error)) *CourseServiceMock_UpdateCourse_Call {
+func (_c *CourseServiceMock_UpdateCourse_Call) RunAndReturn(run func(uint, uint, audit.Meta, request.CoursePatch, patch.Fields) (*response.CourseResponse, error)) *CourseServiceMock_UpdateCourse_Call {
 	_c.Call.Return(run)
 	return _c
 }
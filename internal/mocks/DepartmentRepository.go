@@ -3,9 +3,15 @@
 package mocks
 
 import (
+	audit "student_go/internal/audit"
+
 	entity "student_go/internal/entity"
 
 	mock "github.com/stretchr/testify/mock"
+
+	pagination "student_go/pkg/pagination"
+
+	query "student_go/pkg/query"
 )
 
 // DepartmentRepository is an autogenerated mock type for the Repository type
@@ -21,9 +27,9 @@ func (_m *DepartmentRepository) EXPECT() *DepartmentRepository_Expecter {
 	return &DepartmentRepository_Expecter{mock: &_m.Mock}
 }
 
-// Count provides a mock function with no fields
-func (_m *DepartmentRepository) Count() (int, error) {
-	ret := _m.Called()
+// Count provides a mock function with given fields: spec
+func (_m *DepartmentRepository) Count(spec query.Spec) (int, error) {
+	ret := _m.Called(spec)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Count")
@@ -31,17 +37,17 @@ func (_m *DepartmentRepository) Count() (int, error) {
 
 	var r0 int
 	var r1 error
-	if rf, ok := ret.Get(0).(func() (int, error)); ok {
-		return rf()
+	if rf, ok := ret.Get(0).(func(query.Spec) (int, error)); ok {
+		return rf(spec)
 	}
-	if rf, ok := ret.Get(0).(func() int); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(query.Spec) int); ok {
+		r0 = rf(spec)
 	} else {
 		r0 = ret.Get(0).(int)
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(query.Spec) error); ok {
+		r1 = rf(spec)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -55,13 +61,14 @@ type DepartmentRepository_Count_Call struct {
 }
 
 // Count is a helper method to define mock.On call
-func (_e *DepartmentRepository_Expecter) Count() *DepartmentRepository_Count_Call {
-	return &DepartmentRepository_Count_Call{Call: _e.mock.On("Count")}
+//   - spec query.Spec
+func (_e *DepartmentRepository_Expecter) Count(spec interface{}) *DepartmentRepository_Count_Call {
+	return &DepartmentRepository_Count_Call{Call: _e.mock.On("Count", spec)}
 }
 
-func (_c *DepartmentRepository_Count_Call) Run(run func()) *DepartmentRepository_Count_Call {
+func (_c *DepartmentRepository_Count_Call) Run(run func(spec query.Spec)) *DepartmentRepository_Count_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
+		run(args[0].(query.Spec))
 	})
 	return _c
 }
@@ -71,22 +78,22 @@ func (_c *DepartmentRepository_Count_Call) Return(_a0 int, _a1 error) *Departmen
 	return _c
 }
 
-func (_c *DepartmentRepository_Count_Call) RunAndReturn(run func() (int, error)) *DepartmentRepository_Count_Call {
+func (_c *DepartmentRepository_Count_Call) RunAndReturn(run func(query.Spec) (int, error)) *DepartmentRepository_Count_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteById provides a mock function with given fields: id
-func (_m *DepartmentRepository) DeleteById(id uint) error {
-	ret := _m.Called(id)
+// DeleteById provides a mock function with given fields: id, version, meta
+func (_m *DepartmentRepository) DeleteById(id uint, version uint, meta audit.Meta) error {
+	ret := _m.Called(id, version, meta)
 
 	if len(ret) == 0 {
 		panic("no return value specified for DeleteById")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(uint) error); ok {
-		r0 = rf(id)
+	if rf, ok := ret.Get(0).(func(uint, uint, audit.Meta) error); ok {
+		r0 = rf(id, version, meta)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -101,13 +108,15 @@ type DepartmentRepository_DeleteById_Call struct {
 
 // DeleteById is a helper method to define mock.On call
 //   - id uint
-func (_e *DepartmentRepository_Expecter) DeleteById(id interface{}) *DepartmentRepository_DeleteById_Call {
-	return &DepartmentRepository_DeleteById_Call{Call: _e.mock.On("DeleteById", id)}
+//   - version uint
+//   - meta audit.Meta
+func (_e *DepartmentRepository_Expecter) DeleteById(id interface{}, version interface{}, meta interface{}) *DepartmentRepository_DeleteById_Call {
+	return &DepartmentRepository_DeleteById_Call{Call: _e.mock.On("DeleteById", id, version, meta)}
 }
 
-func (_c *DepartmentRepository_DeleteById_Call) Run(run func(id uint)) *DepartmentRepository_DeleteById_Call {
+func (_c *DepartmentRepository_DeleteById_Call) Run(run func(id uint, version uint, meta audit.Meta)) *DepartmentRepository_DeleteById_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(uint))
+		run(args[0].(uint), args[1].(uint), args[2].(audit.Meta))
 	})
 	return _c
 }
@@ -117,7 +126,7 @@ func (_c *DepartmentRepository_DeleteById_Call) Return(_a0 error) *DepartmentRep
 	return _c
 }
 
-func (_c *DepartmentRepository_DeleteById_Call) RunAndReturn(run func(uint) error) *DepartmentRepository_DeleteById_Call {
+func (_c *DepartmentRepository_DeleteById_Call) RunAndReturn(run func(uint, uint, audit.Meta) error) *DepartmentRepository_DeleteById_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -178,9 +187,66 @@ func (_c *DepartmentRepository_ExistsById_Call) RunAndReturn(run func(uint) (boo
 	return _c
 }
 
-// FindAll provides a mock function with given fields: page, limit
-func (_m *DepartmentRepository) FindAll(page int, limit int) ([]entity.Department, error) {
-	ret := _m.Called(page, limit)
+// HasHead provides a mock function with given fields: departmentId, teacherId
+func (_m *DepartmentRepository) HasHead(departmentId uint, teacherId uint) (bool, error) {
+	ret := _m.Called(departmentId, teacherId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HasHead")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, uint) (bool, error)); ok {
+		return rf(departmentId, teacherId)
+	}
+	if rf, ok := ret.Get(0).(func(uint, uint) bool); ok {
+		r0 = rf(departmentId, teacherId)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, uint) error); ok {
+		r1 = rf(departmentId, teacherId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DepartmentRepository_HasHead_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HasHead'
+type DepartmentRepository_HasHead_Call struct {
+	*mock.Call
+}
+
+// HasHead is a helper method to define mock.On call
+//   - departmentId uint
+//   - teacherId uint
+func (_e *DepartmentRepository_Expecter) HasHead(departmentId interface{}, teacherId interface{}) *DepartmentRepository_HasHead_Call {
+	return &DepartmentRepository_HasHead_Call{Call: _e.mock.On("HasHead", departmentId, teacherId)}
+}
+
+func (_c *DepartmentRepository_HasHead_Call) Run(run func(departmentId uint, teacherId uint)) *DepartmentRepository_HasHead_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uint), args[1].(uint))
+	})
+	return _c
+}
+
+func (_c *DepartmentRepository_HasHead_Call) Return(_a0 bool, _a1 error) *DepartmentRepository_HasHead_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DepartmentRepository_HasHead_Call) RunAndReturn(run func(uint, uint) (bool, error)) *DepartmentRepository_HasHead_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindAll provides a mock function with given fields: page, limit, spec
+func (_m *DepartmentRepository) FindAll(page int, limit int, spec query.Spec) ([]entity.Department, error) {
+	ret := _m.Called(page, limit, spec)
 
 	if len(ret) == 0 {
 		panic("no return value specified for FindAll")
@@ -188,19 +254,19 @@ func (_m *DepartmentRepository) FindAll(page int, limit int) ([]entity.Departmen
 
 	var r0 []entity.Department
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int, int) ([]entity.Department, error)); ok {
-		return rf(page, limit)
+	if rf, ok := ret.Get(0).(func(int, int, query.Spec) ([]entity.Department, error)); ok {
+		return rf(page, limit, spec)
 	}
-	if rf, ok := ret.Get(0).(func(int, int) []entity.Department); ok {
-		r0 = rf(page, limit)
+	if rf, ok := ret.Get(0).(func(int, int, query.Spec) []entity.Department); ok {
+		r0 = rf(page, limit, spec)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]entity.Department)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(int, int) error); ok {
-		r1 = rf(page, limit)
+	if rf, ok := ret.Get(1).(func(int, int, query.Spec) error); ok {
+		r1 = rf(page, limit, spec)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -216,13 +282,14 @@ type DepartmentRepository_FindAll_Call struct {
 // FindAll is a helper method to define mock.On call
 //   - page int
 //   - limit int
-func (_e *DepartmentRepository_Expecter) FindAll(page interface{}, limit interface{}) *DepartmentRepository_FindAll_Call {
-	return &DepartmentRepository_FindAll_Call{Call: _e.mock.On("FindAll", page, limit)}
+//   - spec query.Spec
+func (_e *DepartmentRepository_Expecter) FindAll(page interface{}, limit interface{}, spec interface{}) *DepartmentRepository_FindAll_Call {
+	return &DepartmentRepository_FindAll_Call{Call: _e.mock.On("FindAll", page, limit, spec)}
 }
 
-func (_c *DepartmentRepository_FindAll_Call) Run(run func(page int, limit int)) *DepartmentRepository_FindAll_Call {
+func (_c *DepartmentRepository_FindAll_Call) Run(run func(page int, limit int, spec query.Spec)) *DepartmentRepository_FindAll_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(int), args[1].(int))
+		run(args[0].(int), args[1].(int), args[2].(query.Spec))
 	})
 	return _c
 }
@@ -232,7 +299,66 @@ func (_c *DepartmentRepository_FindAll_Call) Return(_a0 []entity.Department, _a1
 	return _c
 }
 
-func (_c *DepartmentRepository_FindAll_Call) RunAndReturn(run func(int, int) ([]entity.Department, error)) *DepartmentRepository_FindAll_Call {
+func (_c *DepartmentRepository_FindAll_Call) RunAndReturn(run func(int, int, query.Spec) ([]entity.Department, error)) *DepartmentRepository_FindAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindAllByCursor provides a mock function with given fields: keyset, spec
+func (_m *DepartmentRepository) FindAllByCursor(keyset pagination.Keyset, spec query.Spec) ([]entity.Department, error) {
+	ret := _m.Called(keyset, spec)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindAllByCursor")
+	}
+
+	var r0 []entity.Department
+	var r1 error
+	if rf, ok := ret.Get(0).(func(pagination.Keyset, query.Spec) ([]entity.Department, error)); ok {
+		return rf(keyset, spec)
+	}
+	if rf, ok := ret.Get(0).(func(pagination.Keyset, query.Spec) []entity.Department); ok {
+		r0 = rf(keyset, spec)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Department)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(pagination.Keyset, query.Spec) error); ok {
+		r1 = rf(keyset, spec)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DepartmentRepository_FindAllByCursor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindAllByCursor'
+type DepartmentRepository_FindAllByCursor_Call struct {
+	*mock.Call
+}
+
+// FindAllByCursor is a helper method to define mock.On call
+//   - keyset pagination.Keyset
+//   - spec query.Spec
+func (_e *DepartmentRepository_Expecter) FindAllByCursor(keyset interface{}, spec interface{}) *DepartmentRepository_FindAllByCursor_Call {
+	return &DepartmentRepository_FindAllByCursor_Call{Call: _e.mock.On("FindAllByCursor", keyset, spec)}
+}
+
+func (_c *DepartmentRepository_FindAllByCursor_Call) Run(run func(keyset pagination.Keyset, spec query.Spec)) *DepartmentRepository_FindAllByCursor_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(pagination.Keyset), args[1].(query.Spec))
+	})
+	return _c
+}
+
+func (_c *DepartmentRepository_FindAllByCursor_Call) Return(_a0 []entity.Department, _a1 error) *DepartmentRepository_FindAllByCursor_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DepartmentRepository_FindAllByCursor_Call) RunAndReturn(run func(pagination.Keyset, query.Spec) ([]entity.Department, error)) *DepartmentRepository_FindAllByCursor_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -295,9 +421,66 @@ func (_c *DepartmentRepository_FindById_Call) RunAndReturn(run func(uint) (*enti
 	return _c
 }
 
-// Save provides a mock function with given fields: _a0
-func (_m *DepartmentRepository) Save(_a0 *entity.Department) (*entity.Department, error) {
-	ret := _m.Called(_a0)
+// Restore provides a mock function with given fields: id, meta
+func (_m *DepartmentRepository) Restore(id uint, meta audit.Meta) (bool, error) {
+	ret := _m.Called(id, meta)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Restore")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, audit.Meta) (bool, error)); ok {
+		return rf(id, meta)
+	}
+	if rf, ok := ret.Get(0).(func(uint, audit.Meta) bool); ok {
+		r0 = rf(id, meta)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, audit.Meta) error); ok {
+		r1 = rf(id, meta)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DepartmentRepository_Restore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Restore'
+type DepartmentRepository_Restore_Call struct {
+	*mock.Call
+}
+
+// Restore is a helper method to define mock.On call
+//   - id uint
+//   - meta audit.Meta
+func (_e *DepartmentRepository_Expecter) Restore(id interface{}, meta interface{}) *DepartmentRepository_Restore_Call {
+	return &DepartmentRepository_Restore_Call{Call: _e.mock.On("Restore", id, meta)}
+}
+
+func (_c *DepartmentRepository_Restore_Call) Run(run func(id uint, meta audit.Meta)) *DepartmentRepository_Restore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uint), args[1].(audit.Meta))
+	})
+	return _c
+}
+
+func (_c *DepartmentRepository_Restore_Call) Return(_a0 bool, _a1 error) *DepartmentRepository_Restore_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DepartmentRepository_Restore_Call) RunAndReturn(run func(uint, audit.Meta) (bool, error)) *DepartmentRepository_Restore_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Save provides a mock function with given fields: _a0, meta
+func (_m *DepartmentRepository) Save(_a0 *entity.Department, meta audit.Meta) (*entity.Department, error) {
+	ret := _m.Called(_a0, meta)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Save")
@@ -305,19 +488,19 @@ func (_m *DepartmentRepository) Save(_a0 *entity.Department) (*entity.Department
 
 	var r0 *entity.Department
 	var r1 error
-	if rf, ok := ret.Get(0).(func(*entity.Department) (*entity.Department, error)); ok {
-		return rf(_a0)
+	if rf, ok := ret.Get(0).(func(*entity.Department, audit.Meta) (*entity.Department, error)); ok {
+		return rf(_a0, meta)
 	}
-	if rf, ok := ret.Get(0).(func(*entity.Department) *entity.Department); ok {
-		r0 = rf(_a0)
+	if rf, ok := ret.Get(0).(func(*entity.Department, audit.Meta) *entity.Department); ok {
+		r0 = rf(_a0, meta)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*entity.Department)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(*entity.Department) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(*entity.Department, audit.Meta) error); ok {
+		r1 = rf(_a0, meta)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -332,13 +515,14 @@ type DepartmentRepository_Save_Call struct {
 
 // Save is a helper method to define mock.On call
 //   - _a0 *entity.Department
-func (_e *DepartmentRepository_Expecter) Save(_a0 interface{}) *DepartmentRepository_Save_Call {
-	return &DepartmentRepository_Save_Call{Call: _e.mock.On("Save", _a0)}
+//   - meta audit.Meta
+func (_e *DepartmentRepository_Expecter) Save(_a0 interface{}, meta interface{}) *DepartmentRepository_Save_Call {
+	return &DepartmentRepository_Save_Call{Call: _e.mock.On("Save", _a0, meta)}
 }
 
-func (_c *DepartmentRepository_Save_Call) Run(run func(_a0 *entity.Department)) *DepartmentRepository_Save_Call {
+func (_c *DepartmentRepository_Save_Call) Run(run func(_a0 *entity.Department, meta audit.Meta)) *DepartmentRepository_Save_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(*entity.Department))
+		run(args[0].(*entity.Department), args[1].(audit.Meta))
 	})
 	return _c
 }
@@ -348,14 +532,14 @@ func (_c *DepartmentRepository_Save_Call) Return(_a0 *entity.Department, _a1 err
 	return _c
 }
 
-func (_c *DepartmentRepository_Save_Call) RunAndReturn(run func(*entity.Department) (*entity.Department, error)) *DepartmentRepository_Save_Call {
+func (_c *DepartmentRepository_Save_Call) RunAndReturn(run func(*entity.Department, audit.Meta) (*entity.Department, error)) *DepartmentRepository_Save_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Update provides a mock function with given fields: _a0
-func (_m *DepartmentRepository) Update(_a0 *entity.Department) (*entity.Department, error) {
-	ret := _m.Called(_a0)
+// Update provides a mock function with given fields: id, version, changes, meta
+func (_m *DepartmentRepository) Update(id uint, version uint, changes map[string]interface{}, meta audit.Meta) (*entity.Department, error) {
+	ret := _m.Called(id, version, changes, meta)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Update")
@@ -363,19 +547,19 @@ func (_m *DepartmentRepository) Update(_a0 *entity.Department) (*entity.Departme
 
 	var r0 *entity.Department
 	var r1 error
-	if rf, ok := ret.Get(0).(func(*entity.Department) (*entity.Department, error)); ok {
-		return rf(_a0)
+	if rf, ok := ret.Get(0).(func(uint, uint, map[string]interface{}, audit.Meta) (*entity.Department, error)); ok {
+		return rf(id, version, changes, meta)
 	}
-	if rf, ok := ret.Get(0).(func(*entity.Department) *entity.Department); ok {
-		r0 = rf(_a0)
+	if rf, ok := ret.Get(0).(func(uint, uint, map[string]interface{}, audit.Meta) *entity.Department); ok {
+		r0 = rf(id, version, changes, meta)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*entity.Department)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(*entity.Department) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(uint, uint, map[string]interface{}, audit.Meta) error); ok {
+		r1 = rf(id, version, changes, meta)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -389,14 +573,17 @@ type DepartmentRepository_Update_Call struct {
 }
 
 // Update is a helper method to define mock.On call
-//   - _a0 *entity.Department
-func (_e *DepartmentRepository_Expecter) Update(_a0 interface{}) *DepartmentRepository_Update_Call {
-	return &DepartmentRepository_Update_Call{Call: _e.mock.On("Update", _a0)}
+//   - id uint
+//   - version uint
+//   - changes map[string]interface{}
+//   - meta audit.Meta
+func (_e *DepartmentRepository_Expecter) Update(id interface{}, version interface{}, changes interface{}, meta interface{}) *DepartmentRepository_Update_Call {
+	return &DepartmentRepository_Update_Call{Call: _e.mock.On("Update", id, version, changes, meta)}
 }
 
-func (_c *DepartmentRepository_Update_Call) Run(run func(_a0 *entity.Department)) *DepartmentRepository_Update_Call {
+func (_c *DepartmentRepository_Update_Call) Run(run func(id uint, version uint, changes map[string]interface{}, meta audit.Meta)) *DepartmentRepository_Update_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(*entity.Department))
+		run(args[0].(uint), args[1].(uint), args[2].(map[string]interface{}), args[3].(audit.Meta))
 	})
 	return _c
 }
@@ -406,7 +593,7 @@ func (_c *DepartmentRepository_Update_Call) Return(_a0 *entity.Department, _a1 e
 	return _c
 }
 
-func (_c *DepartmentRepository_Update_Call) RunAndReturn(run func(*entity.Department) (*entity.Department, error)) *DepartmentRepository_Update_Call {
+func (_c *DepartmentRepository_Update_Call) RunAndReturn(run func(uint, uint, map[string]interface{}, audit.Meta) (*entity.Department, error)) *DepartmentRepository_Update_Call {
 	_c.Call.Return(run)
 	return _c
 }
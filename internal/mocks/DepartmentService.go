@@ -3,10 +3,18 @@
 package mocks
 
 import (
-	request "student_go/internal/dto/request"
+	audit "student_go/internal/audit"
 
 	mock "github.com/stretchr/testify/mock"
 
+	pagination "student_go/pkg/pagination"
+
+	patch "student_go/pkg/patch"
+
+	query "student_go/pkg/query"
+
+	request "student_go/internal/dto/request"
+
 	response "student_go/internal/dto/response"
 )
 
@@ -23,9 +31,9 @@ func (_m *DepartmentServiceMock) EXPECT() *DepartmentServiceMock_Expecter {
 	return &DepartmentServiceMock_Expecter{mock: &_m.Mock}
 }
 
-// Count provides a mock function with no fields
-func (_m *DepartmentServiceMock) Count() (int, error) {
-	ret := _m.Called()
+// Count provides a mock function with given fields: spec
+func (_m *DepartmentServiceMock) Count(spec query.Spec) (int, error) {
+	ret := _m.Called(spec)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Count")
@@ -33,17 +41,17 @@ func (_m *DepartmentServiceMock) Count() (int, error) {
 
 	var r0 int
 	var r1 error
-	if rf, ok := ret.Get(0).(func() (int, error)); ok {
-		return rf()
+	if rf, ok := ret.Get(0).(func(query.Spec) (int, error)); ok {
+		return rf(spec)
 	}
-	if rf, ok := ret.Get(0).(func() int); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(query.Spec) int); ok {
+		r0 = rf(spec)
 	} else {
 		r0 = ret.Get(0).(int)
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(query.Spec) error); ok {
+		r1 = rf(spec)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -57,13 +65,14 @@ type DepartmentServiceMock_Count_Call struct {
 }
 
 // Count is a helper method to define mock.On call
-func (_e *DepartmentServiceMock_Expecter) Count() *DepartmentServiceMock_Count_Call {
-	return &DepartmentServiceMock_Count_Call{Call: _e.mock.On("Count")}
+//   - spec query.Spec
+func (_e *DepartmentServiceMock_Expecter) Count(spec interface{}) *DepartmentServiceMock_Count_Call {
+	return &DepartmentServiceMock_Count_Call{Call: _e.mock.On("Count", spec)}
 }
 
-func (_c *DepartmentServiceMock_Count_Call) Run(run func()) *DepartmentServiceMock_Count_Call {
+func (_c *DepartmentServiceMock_Count_Call) Run(run func(spec query.Spec)) *DepartmentServiceMock_Count_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
+		run(args[0].(query.Spec))
 	})
 	return _c
 }
@@ -73,14 +82,14 @@ func (_c *DepartmentServiceMock_Count_Call) Return(_a0 int, _a1 error) *Departme
 	return _c
 }
 
-func (_c *DepartmentServiceMock_Count_Call) RunAndReturn(run func() (int, error)) *DepartmentServiceMock_Count_Call {
+func (_c *DepartmentServiceMock_Count_Call) RunAndReturn(run func(query.Spec) (int, error)) *DepartmentServiceMock_Count_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateDepartment provides a mock function with given fields: input
-func (_m *DepartmentServiceMock) CreateDepartment(input request.DepartmentRequest) (*response.DepartmentResponse, error) {
-	ret := _m.Called(input)
+// CreateDepartment provides a mock function with given fields: meta, input
+func (_m *DepartmentServiceMock) CreateDepartment(meta audit.Meta, input request.DepartmentRequest) (*response.DepartmentResponse, error) {
+	ret := _m.Called(meta, input)
 
 	if len(ret) == 0 {
 		panic("no return value specified for CreateDepartment")
@@ -88,19 +97,19 @@ func (_m *DepartmentServiceMock) CreateDepartment(input request.DepartmentReques
 
 	var r0 *response.DepartmentResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(request.DepartmentRequest) (*response.DepartmentResponse, error)); ok {
-		return rf(input)
+	if rf, ok := ret.Get(0).(func(audit.Meta, request.DepartmentRequest) (*response.DepartmentResponse, error)); ok {
+		return rf(meta, input)
 	}
-	if rf, ok := ret.Get(0).(func(request.DepartmentRequest) *response.DepartmentResponse); ok {
-		r0 = rf(input)
+	if rf, ok := ret.Get(0).(func(audit.Meta, request.DepartmentRequest) *response.DepartmentResponse); ok {
+		r0 = rf(meta, input)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*response.DepartmentResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(request.DepartmentRequest) error); ok {
-		r1 = rf(input)
+	if rf, ok := ret.Get(1).(func(audit.Meta, request.DepartmentRequest) error); ok {
+		r1 = rf(meta, input)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -114,14 +123,15 @@ type DepartmentServiceMock_CreateDepartment_Call struct {
 }
 
 // CreateDepartment is a helper method to define mock.On call
+//   - meta audit.Meta
 //   - input request.DepartmentRequest
-func (_e *DepartmentServiceMock_Expecter) CreateDepartment(input interface{}) *DepartmentServiceMock_CreateDepartment_Call {
-	return &DepartmentServiceMock_CreateDepartment_Call{Call: _e.mock.On("CreateDepartment", input)}
+func (_e *DepartmentServiceMock_Expecter) CreateDepartment(meta interface{}, input interface{}) *DepartmentServiceMock_CreateDepartment_Call {
+	return &DepartmentServiceMock_CreateDepartment_Call{Call: _e.mock.On("CreateDepartment", meta, input)}
 }
 
-func (_c *DepartmentServiceMock_CreateDepartment_Call) Run(run func(input request.DepartmentRequest)) *DepartmentServiceMock_CreateDepartment_Call {
+func (_c *DepartmentServiceMock_CreateDepartment_Call) Run(run func(meta audit.Meta, input request.DepartmentRequest)) *DepartmentServiceMock_CreateDepartment_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(request.DepartmentRequest))
+		run(args[0].(audit.Meta), args[1].(request.DepartmentRequest))
 	})
 	return _c
 }
@@ -131,22 +141,22 @@ func (_c *DepartmentServiceMock_CreateDepartment_Call) Return(_a0 *response.Depa
 	return _c
 }
 
-func (_c *DepartmentServiceMock_CreateDepartment_Call) RunAndReturn(run func(request.DepartmentRequest) (*response.DepartmentResponse, error)) *DepartmentServiceMock_CreateDepartment_Call {
+func (_c *DepartmentServiceMock_CreateDepartment_Call) RunAndReturn(run func(audit.Meta, request.DepartmentRequest) (*response.DepartmentResponse, error)) *DepartmentServiceMock_CreateDepartment_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteDepartmentById provides a mock function with given fields: id
-func (_m *DepartmentServiceMock) DeleteDepartmentById(id uint) error {
-	ret := _m.Called(id)
+// DeleteDepartmentById provides a mock function with given fields: id, version, meta
+func (_m *DepartmentServiceMock) DeleteDepartmentById(id uint, version uint, meta audit.Meta) error {
+	ret := _m.Called(id, version, meta)
 
 	if len(ret) == 0 {
 		panic("no return value specified for DeleteDepartmentById")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(uint) error); ok {
-		r0 = rf(id)
+	if rf, ok := ret.Get(0).(func(uint, uint, audit.Meta) error); ok {
+		r0 = rf(id, version, meta)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -161,13 +171,15 @@ type DepartmentServiceMock_DeleteDepartmentById_Call struct {
 
 // DeleteDepartmentById is a helper method to define mock.On call
 //   - id uint
-func (_e *DepartmentServiceMock_Expecter) DeleteDepartmentById(id interface{}) *DepartmentServiceMock_DeleteDepartmentById_Call {
-	return &DepartmentServiceMock_DeleteDepartmentById_Call{Call: _e.mock.On("DeleteDepartmentById", id)}
+//   - version uint
+//   - meta audit.Meta
+func (_e *DepartmentServiceMock_Expecter) DeleteDepartmentById(id interface{}, version interface{}, meta interface{}) *DepartmentServiceMock_DeleteDepartmentById_Call {
+	return &DepartmentServiceMock_DeleteDepartmentById_Call{Call: _e.mock.On("DeleteDepartmentById", id, version, meta)}
 }
 
-func (_c *DepartmentServiceMock_DeleteDepartmentById_Call) Run(run func(id uint)) *DepartmentServiceMock_DeleteDepartmentById_Call {
+func (_c *DepartmentServiceMock_DeleteDepartmentById_Call) Run(run func(id uint, version uint, meta audit.Meta)) *DepartmentServiceMock_DeleteDepartmentById_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(uint))
+		run(args[0].(uint), args[1].(uint), args[2].(audit.Meta))
 	})
 	return _c
 }
@@ -177,14 +189,14 @@ func (_c *DepartmentServiceMock_DeleteDepartmentById_Call) Return(_a0 error) *De
 	return _c
 }
 
-func (_c *DepartmentServiceMock_DeleteDepartmentById_Call) RunAndReturn(run func(uint) error) *DepartmentServiceMock_DeleteDepartmentById_Call {
+func (_c *DepartmentServiceMock_DeleteDepartmentById_Call) RunAndReturn(run func(uint, uint, audit.Meta) error) *DepartmentServiceMock_DeleteDepartmentById_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DepartmentSetTeacher provides a mock function with given fields: departmentId, teacherId
-func (_m *DepartmentServiceMock) DepartmentSetTeacher(departmentId uint, teacherId uint) (*response.DepartmentResponse, error) {
-	ret := _m.Called(departmentId, teacherId)
+// DepartmentSetTeacher provides a mock function with given fields: departmentId, teacherId, meta
+func (_m *DepartmentServiceMock) DepartmentSetTeacher(departmentId uint, teacherId uint, meta audit.Meta) (*response.DepartmentResponse, error) {
+	ret := _m.Called(departmentId, teacherId, meta)
 
 	if len(ret) == 0 {
 		panic("no return value specified for DepartmentSetTeacher")
@@ -192,19 +204,19 @@ func (_m *DepartmentServiceMock) DepartmentSetTeacher(departmentId uint, teacher
 
 	var r0 *response.DepartmentResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(uint, uint) (*response.DepartmentResponse, error)); ok {
-		return rf(departmentId, teacherId)
+	if rf, ok := ret.Get(0).(func(uint, uint, audit.Meta) (*response.DepartmentResponse, error)); ok {
+		return rf(departmentId, teacherId, meta)
 	}
-	if rf, ok := ret.Get(0).(func(uint, uint) *response.DepartmentResponse); ok {
-		r0 = rf(departmentId, teacherId)
+	if rf, ok := ret.Get(0).(func(uint, uint, audit.Meta) *response.DepartmentResponse); ok {
+		r0 = rf(departmentId, teacherId, meta)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*response.DepartmentResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(uint, uint) error); ok {
-		r1 = rf(departmentId, teacherId)
+	if rf, ok := ret.Get(1).(func(uint, uint, audit.Meta) error); ok {
+		r1 = rf(departmentId, teacherId, meta)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -220,13 +232,14 @@ type DepartmentServiceMock_DepartmentSetTeacher_Call struct {
 // DepartmentSetTeacher is a helper method to define mock.On call
 //   - departmentId uint
 //   - teacherId uint
-func (_e *DepartmentServiceMock_Expecter) DepartmentSetTeacher(departmentId interface{}, teacherId interface{}) *DepartmentServiceMock_DepartmentSetTeacher_Call {
-	return &DepartmentServiceMock_DepartmentSetTeacher_Call{Call: _e.mock.On("DepartmentSetTeacher", departmentId, teacherId)}
+//   - meta audit.Meta
+func (_e *DepartmentServiceMock_Expecter) DepartmentSetTeacher(departmentId interface{}, teacherId interface{}, meta interface{}) *DepartmentServiceMock_DepartmentSetTeacher_Call {
+	return &DepartmentServiceMock_DepartmentSetTeacher_Call{Call: _e.mock.On("DepartmentSetTeacher", departmentId, teacherId, meta)}
 }
 
-func (_c *DepartmentServiceMock_DepartmentSetTeacher_Call) Run(run func(departmentId uint, teacherId uint)) *DepartmentServiceMock_DepartmentSetTeacher_Call {
+func (_c *DepartmentServiceMock_DepartmentSetTeacher_Call) Run(run func(departmentId uint, teacherId uint, meta audit.Meta)) *DepartmentServiceMock_DepartmentSetTeacher_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(uint), args[1].(uint))
+		run(args[0].(uint), args[1].(uint), args[2].(audit.Meta))
 	})
 	return _c
 }
@@ -236,14 +249,14 @@ func (_c *DepartmentServiceMock_DepartmentSetTeacher_Call) Return(_a0 *response.
 	return _c
 }
 
-func (_c *DepartmentServiceMock_DepartmentSetTeacher_Call) RunAndReturn(run func(uint, uint) (*response.DepartmentResponse, error)) *DepartmentServiceMock_DepartmentSetTeacher_Call {
+func (_c *DepartmentServiceMock_DepartmentSetTeacher_Call) RunAndReturn(run func(uint, uint, audit.Meta) (*response.DepartmentResponse, error)) *DepartmentServiceMock_DepartmentSetTeacher_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// FindAllDepartments provides a mock function with given fields: page, limit
-func (_m *DepartmentServiceMock) FindAllDepartments(page int, limit int) ([]*response.DepartmentResponse, error) {
-	ret := _m.Called(page, limit)
+// FindAllDepartments provides a mock function with given fields: page, limit, spec
+func (_m *DepartmentServiceMock) FindAllDepartments(page int, limit int, spec query.Spec) ([]*response.DepartmentResponse, error) {
+	ret := _m.Called(page, limit, spec)
 
 	if len(ret) == 0 {
 		panic("no return value specified for FindAllDepartments")
@@ -251,19 +264,19 @@ func (_m *DepartmentServiceMock) FindAllDepartments(page int, limit int) ([]*res
 
 	var r0 []*response.DepartmentResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int, int) ([]*response.DepartmentResponse, error)); ok {
-		return rf(page, limit)
+	if rf, ok := ret.Get(0).(func(int, int, query.Spec) ([]*response.DepartmentResponse, error)); ok {
+		return rf(page, limit, spec)
 	}
-	if rf, ok := ret.Get(0).(func(int, int) []*response.DepartmentResponse); ok {
-		r0 = rf(page, limit)
+	if rf, ok := ret.Get(0).(func(int, int, query.Spec) []*response.DepartmentResponse); ok {
+		r0 = rf(page, limit, spec)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*response.DepartmentResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(int, int) error); ok {
-		r1 = rf(page, limit)
+	if rf, ok := ret.Get(1).(func(int, int, query.Spec) error); ok {
+		r1 = rf(page, limit, spec)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -279,13 +292,14 @@ type DepartmentServiceMock_FindAllDepartments_Call struct {
 // FindAllDepartments is a helper method to define mock.On call
 //   - page int
 //   - limit int
-func (_e *DepartmentServiceMock_Expecter) FindAllDepartments(page interface{}, limit interface{}) *DepartmentServiceMock_FindAllDepartments_Call {
-	return &DepartmentServiceMock_FindAllDepartments_Call{Call: _e.mock.On("FindAllDepartments", page, limit)}
+//   - spec query.Spec
+func (_e *DepartmentServiceMock_Expecter) FindAllDepartments(page interface{}, limit interface{}, spec interface{}) *DepartmentServiceMock_FindAllDepartments_Call {
+	return &DepartmentServiceMock_FindAllDepartments_Call{Call: _e.mock.On("FindAllDepartments", page, limit, spec)}
 }
 
-func (_c *DepartmentServiceMock_FindAllDepartments_Call) Run(run func(page int, limit int)) *DepartmentServiceMock_FindAllDepartments_Call {
+func (_c *DepartmentServiceMock_FindAllDepartments_Call) Run(run func(page int, limit int, spec query.Spec)) *DepartmentServiceMock_FindAllDepartments_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(int), args[1].(int))
+		run(args[0].(int), args[1].(int), args[2].(query.Spec))
 	})
 	return _c
 }
@@ -295,7 +309,66 @@ func (_c *DepartmentServiceMock_FindAllDepartments_Call) Return(_a0 []*response.
 	return _c
 }
 
-func (_c *DepartmentServiceMock_FindAllDepartments_Call) RunAndReturn(run func(int, int) ([]*response.DepartmentResponse, error)) *DepartmentServiceMock_FindAllDepartments_Call {
+func (_c *DepartmentServiceMock_FindAllDepartments_Call) RunAndReturn(run func(int, int, query.Spec) ([]*response.DepartmentResponse, error)) *DepartmentServiceMock_FindAllDepartments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindAllDepartmentsByCursor provides a mock function with given fields: keyset, spec
+func (_m *DepartmentServiceMock) FindAllDepartmentsByCursor(keyset pagination.Keyset, spec query.Spec) (*pagination.CursorPage, error) {
+	ret := _m.Called(keyset, spec)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindAllDepartmentsByCursor")
+	}
+
+	var r0 *pagination.CursorPage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(pagination.Keyset, query.Spec) (*pagination.CursorPage, error)); ok {
+		return rf(keyset, spec)
+	}
+	if rf, ok := ret.Get(0).(func(pagination.Keyset, query.Spec) *pagination.CursorPage); ok {
+		r0 = rf(keyset, spec)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pagination.CursorPage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(pagination.Keyset, query.Spec) error); ok {
+		r1 = rf(keyset, spec)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DepartmentServiceMock_FindAllDepartmentsByCursor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindAllDepartmentsByCursor'
+type DepartmentServiceMock_FindAllDepartmentsByCursor_Call struct {
+	*mock.Call
+}
+
+// FindAllDepartmentsByCursor is a helper method to define mock.On call
+//   - keyset pagination.Keyset
+//   - spec query.Spec
+func (_e *DepartmentServiceMock_Expecter) FindAllDepartmentsByCursor(keyset interface{}, spec interface{}) *DepartmentServiceMock_FindAllDepartmentsByCursor_Call {
+	return &DepartmentServiceMock_FindAllDepartmentsByCursor_Call{Call: _e.mock.On("FindAllDepartmentsByCursor", keyset, spec)}
+}
+
+func (_c *DepartmentServiceMock_FindAllDepartmentsByCursor_Call) Run(run func(keyset pagination.Keyset, spec query.Spec)) *DepartmentServiceMock_FindAllDepartmentsByCursor_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(pagination.Keyset), args[1].(query.Spec))
+	})
+	return _c
+}
+
+func (_c *DepartmentServiceMock_FindAllDepartmentsByCursor_Call) Return(_a0 *pagination.CursorPage, _a1 error) *DepartmentServiceMock_FindAllDepartmentsByCursor_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DepartmentServiceMock_FindAllDepartmentsByCursor_Call) RunAndReturn(run func(pagination.Keyset, query.Spec) (*pagination.CursorPage, error)) *DepartmentServiceMock_FindAllDepartmentsByCursor_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -358,9 +431,68 @@ func (_c *DepartmentServiceMock_FindDepartmentById_Call) RunAndReturn(run func(u
 	return _c
 }
 
-// UpdateDepartment provides a mock function with given fields: id, input
-func (_m *DepartmentServiceMock) UpdateDepartment(id uint, input request.DepartmentRequest) (*response.DepartmentResponse, error) {
-	ret := _m.Called(id, input)
+// RestoreDepartment provides a mock function with given fields: id, meta
+func (_m *DepartmentServiceMock) RestoreDepartment(id uint, meta audit.Meta) (*response.DepartmentResponse, error) {
+	ret := _m.Called(id, meta)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestoreDepartment")
+	}
+
+	var r0 *response.DepartmentResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, audit.Meta) (*response.DepartmentResponse, error)); ok {
+		return rf(id, meta)
+	}
+	if rf, ok := ret.Get(0).(func(uint, audit.Meta) *response.DepartmentResponse); ok {
+		r0 = rf(id, meta)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*response.DepartmentResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, audit.Meta) error); ok {
+		r1 = rf(id, meta)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DepartmentServiceMock_RestoreDepartment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreDepartment'
+type DepartmentServiceMock_RestoreDepartment_Call struct {
+	*mock.Call
+}
+
+// RestoreDepartment is a helper method to define mock.On call
+//   - id uint
+//   - meta audit.Meta
+func (_e *DepartmentServiceMock_Expecter) RestoreDepartment(id interface{}, meta interface{}) *DepartmentServiceMock_RestoreDepartment_Call {
+	return &DepartmentServiceMock_RestoreDepartment_Call{Call: _e.mock.On("RestoreDepartment", id, meta)}
+}
+
+func (_c *DepartmentServiceMock_RestoreDepartment_Call) Run(run func(id uint, meta audit.Meta)) *DepartmentServiceMock_RestoreDepartment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uint), args[1].(audit.Meta))
+	})
+	return _c
+}
+
+func (_c *DepartmentServiceMock_RestoreDepartment_Call) Return(_a0 *response.DepartmentResponse, _a1 error) *DepartmentServiceMock_RestoreDepartment_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DepartmentServiceMock_RestoreDepartment_Call) RunAndReturn(run func(uint, audit.Meta) (*response.DepartmentResponse, error)) *DepartmentServiceMock_RestoreDepartment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateDepartment provides a mock function with given fields: id, version, meta, input, fields
+func (_m *DepartmentServiceMock) UpdateDepartment(id uint, version uint, meta audit.Meta, input request.DepartmentPatch, fields patch.Fields) (*response.DepartmentResponse, error) {
+	ret := _m.Called(id, version, meta, input, fields)
 
 	if len(ret) == 0 {
 		panic("no return value specified for UpdateDepartment")
@@ -368,19 +500,19 @@ func (_m *DepartmentServiceMock) UpdateDepartment(id uint, input request.Departm
 
 	var r0 *response.DepartmentResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(uint, request.DepartmentRequest) (*response.DepartmentResponse, error)); ok {
-		return rf(id, input)
+	if rf, ok := ret.Get(0).(func(uint, uint, audit.Meta, request.DepartmentPatch, patch.Fields) (*response.DepartmentResponse, error)); ok {
+		return rf(id, version, meta, input, fields)
 	}
-	if rf, ok := ret.Get(0).(func(uint, request.DepartmentRequest) *response.DepartmentResponse); ok {
-		r0 = rf(id, input)
+	if rf, ok := ret.Get(0).(func(uint, uint, audit.Meta, request.DepartmentPatch, patch.Fields) *response.DepartmentResponse); ok {
+		r0 = rf(id, version, meta, input, fields)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*response.DepartmentResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(uint, request.DepartmentRequest) error); ok {
-		r1 = rf(id, input)
+	if rf, ok := ret.Get(1).(func(uint, uint, audit.Meta, request.DepartmentPatch, patch.Fields) error); ok {
+		r1 = rf(id, version, meta, input, fields)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -395,14 +527,17 @@ type DepartmentServiceMock_UpdateDepartment_Call struct {
 
 // UpdateDepartment is a helper method to define mock.On call
 //   - id uint
-//   - input request.DepartmentRequest
-func (_e *DepartmentServiceMock_Expecter) UpdateDepartment(id interface{}, input interface{}) *DepartmentServiceMock_UpdateDepartment_Call {
-	return &DepartmentServiceMock_UpdateDepartment_Call{Call: _e.mock.On("UpdateDepartment", id, input)}
+//   - version uint
+//   - meta audit.Meta
+//   - input request.DepartmentPatch
+//   - fields patch.Fields
+func (_e *DepartmentServiceMock_Expecter) UpdateDepartment(id interface{}, version interface{}, meta interface{}, input interface{}, fields interface{}) *DepartmentServiceMock_UpdateDepartment_Call {
+	return &DepartmentServiceMock_UpdateDepartment_Call{Call: _e.mock.On("UpdateDepartment", id, version, meta, input, fields)}
 }
 
-func (_c *DepartmentServiceMock_UpdateDepartment_Call) Run(run func(id uint, input request.DepartmentRequest)) *DepartmentServiceMock_UpdateDepartment_Call {
+func (_c *DepartmentServiceMock_UpdateDepartment_Call) Run(run func(id uint, version uint, meta audit.Meta, input request.DepartmentPatch, fields patch.Fields)) *DepartmentServiceMock_UpdateDepartment_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(uint), args[1].(request.DepartmentRequest))
+		run(args[0].(uint), args[1].(uint), args[2].(audit.Meta), args[3].(request.DepartmentPatch), args[4].(patch.Fields))
 	})
 	return _c
 }
@@ -412,7 +547,7 @@ func (_c *DepartmentServiceMock_UpdateDepartment_Call) Return(_a0 *response.Depa
 	return _c
 }
 
-func (_c *DepartmentServiceMock_UpdateDepartment_Call) RunAndReturn(run func(uint, request.DepartmentRequest) (*response.DepartmentResponse, error)) *DepartmentServiceMock_UpdateDepartment_Call {
+func (_c *DepartmentServiceMock_UpdateDepartment_Call) RunAndReturn(run func(uint, uint, audit.Meta, request.DepartmentPatch, patch.Fields) (*response.DepartmentResponse, error)) *DepartmentServiceMock_UpdateDepartment_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -3,9 +3,14 @@
 package mocks
 
 import (
+	audit "student_go/internal/audit"
 	entity "student_go/internal/entity"
 
 	mock "github.com/stretchr/testify/mock"
+
+	pagination "student_go/pkg/pagination"
+
+	query "student_go/pkg/query"
 )
 
 // StudentRepository is an autogenerated mock type for the Repository type
@@ -21,9 +26,9 @@ func (_m *StudentRepository) EXPECT() *StudentRepository_Expecter {
 	return &StudentRepository_Expecter{mock: &_m.Mock}
 }
 
-// Count provides a mock function with no fields
-func (_m *StudentRepository) Count() (int, error) {
-	ret := _m.Called()
+// Count provides a mock function with given fields: cohortId, spec
+func (_m *StudentRepository) Count(cohortId uint, spec query.Spec) (int, error) {
+	ret := _m.Called(cohortId, spec)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Count")
@@ -31,17 +36,17 @@ func (_m *StudentRepository) Count() (int, error) {
 
 	var r0 int
 	var r1 error
-	if rf, ok := ret.Get(0).(func() (int, error)); ok {
-		return rf()
+	if rf, ok := ret.Get(0).(func(uint, query.Spec) (int, error)); ok {
+		return rf(cohortId, spec)
 	}
-	if rf, ok := ret.Get(0).(func() int); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(uint, query.Spec) int); ok {
+		r0 = rf(cohortId, spec)
 	} else {
 		r0 = ret.Get(0).(int)
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(uint, query.Spec) error); ok {
+		r1 = rf(cohortId, spec)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -55,13 +60,15 @@ type StudentRepository_Count_Call struct {
 }
 
 // Count is a helper method to define mock.On call
-func (_e *StudentRepository_Expecter) Count() *StudentRepository_Count_Call {
-	return &StudentRepository_Count_Call{Call: _e.mock.On("Count")}
+//   - cohortId uint
+//   - spec query.Spec
+func (_e *StudentRepository_Expecter) Count(cohortId interface{}, spec interface{}) *StudentRepository_Count_Call {
+	return &StudentRepository_Count_Call{Call: _e.mock.On("Count", cohortId, spec)}
 }
 
-func (_c *StudentRepository_Count_Call) Run(run func()) *StudentRepository_Count_Call {
+func (_c *StudentRepository_Count_Call) Run(run func(cohortId uint, spec query.Spec)) *StudentRepository_Count_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
+		run(args[0].(uint), args[1].(query.Spec))
 	})
 	return _c
 }
@@ -71,22 +78,22 @@ func (_c *StudentRepository_Count_Call) Return(_a0 int, _a1 error) *StudentRepos
 	return _c
 }
 
-func (_c *StudentRepository_Count_Call) RunAndReturn(run func() (int, error)) *StudentRepository_Count_Call {
+func (_c *StudentRepository_Count_Call) RunAndReturn(run func(uint, query.Spec) (int, error)) *StudentRepository_Count_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteById provides a mock function with given fields: id
-func (_m *StudentRepository) DeleteById(id uint) error {
-	ret := _m.Called(id)
+// DeleteById provides a mock function with given fields: id, version, meta
+func (_m *StudentRepository) DeleteById(id uint, version uint, meta audit.Meta) error {
+	ret := _m.Called(id, version, meta)
 
 	if len(ret) == 0 {
 		panic("no return value specified for DeleteById")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(uint) error); ok {
-		r0 = rf(id)
+	if rf, ok := ret.Get(0).(func(uint, uint, audit.Meta) error); ok {
+		r0 = rf(id, version, meta)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -101,13 +108,15 @@ type StudentRepository_DeleteById_Call struct {
 
 // DeleteById is a helper method to define mock.On call
 //   - id uint
-func (_e *StudentRepository_Expecter) DeleteById(id interface{}) *StudentRepository_DeleteById_Call {
-	return &StudentRepository_DeleteById_Call{Call: _e.mock.On("DeleteById", id)}
+//   - version uint
+//   - meta audit.Meta
+func (_e *StudentRepository_Expecter) DeleteById(id interface{}, version interface{}, meta interface{}) *StudentRepository_DeleteById_Call {
+	return &StudentRepository_DeleteById_Call{Call: _e.mock.On("DeleteById", id, version, meta)}
 }
 
-func (_c *StudentRepository_DeleteById_Call) Run(run func(id uint)) *StudentRepository_DeleteById_Call {
+func (_c *StudentRepository_DeleteById_Call) Run(run func(id uint, version uint, meta audit.Meta)) *StudentRepository_DeleteById_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(uint))
+		run(args[0].(uint), args[1].(uint), args[2].(audit.Meta))
 	})
 	return _c
 }
@@ -117,7 +126,7 @@ func (_c *StudentRepository_DeleteById_Call) Return(_a0 error) *StudentRepositor
 	return _c
 }
 
-func (_c *StudentRepository_DeleteById_Call) RunAndReturn(run func(uint) error) *StudentRepository_DeleteById_Call {
+func (_c *StudentRepository_DeleteById_Call) RunAndReturn(run func(uint, uint, audit.Meta) error) *StudentRepository_DeleteById_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -178,9 +187,9 @@ func (_c *StudentRepository_ExistsById_Call) RunAndReturn(run func(uint) (bool,
 	return _c
 }
 
-// FindAll provides a mock function with given fields: page, limit
-func (_m *StudentRepository) FindAll(page int, limit int) ([]entity.Student, error) {
-	ret := _m.Called(page, limit)
+// FindAll provides a mock function with given fields: page, limit, cohortId, spec
+func (_m *StudentRepository) FindAll(page int, limit int, cohortId uint, spec query.Spec) ([]entity.Student, error) {
+	ret := _m.Called(page, limit, cohortId, spec)
 
 	if len(ret) == 0 {
 		panic("no return value specified for FindAll")
@@ -188,19 +197,19 @@ func (_m *StudentRepository) FindAll(page int, limit int) ([]entity.Student, err
 
 	var r0 []entity.Student
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int, int) ([]entity.Student, error)); ok {
-		return rf(page, limit)
+	if rf, ok := ret.Get(0).(func(int, int, uint, query.Spec) ([]entity.Student, error)); ok {
+		return rf(page, limit, cohortId, spec)
 	}
-	if rf, ok := ret.Get(0).(func(int, int) []entity.Student); ok {
-		r0 = rf(page, limit)
+	if rf, ok := ret.Get(0).(func(int, int, uint, query.Spec) []entity.Student); ok {
+		r0 = rf(page, limit, cohortId, spec)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]entity.Student)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(int, int) error); ok {
-		r1 = rf(page, limit)
+	if rf, ok := ret.Get(1).(func(int, int, uint, query.Spec) error); ok {
+		r1 = rf(page, limit, cohortId, spec)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -216,13 +225,15 @@ type StudentRepository_FindAll_Call struct {
 // FindAll is a helper method to define mock.On call
 //   - page int
 //   - limit int
-func (_e *StudentRepository_Expecter) FindAll(page interface{}, limit interface{}) *StudentRepository_FindAll_Call {
-	return &StudentRepository_FindAll_Call{Call: _e.mock.On("FindAll", page, limit)}
+//   - cohortId uint
+//   - spec query.Spec
+func (_e *StudentRepository_Expecter) FindAll(page interface{}, limit interface{}, cohortId interface{}, spec interface{}) *StudentRepository_FindAll_Call {
+	return &StudentRepository_FindAll_Call{Call: _e.mock.On("FindAll", page, limit, cohortId, spec)}
 }
 
-func (_c *StudentRepository_FindAll_Call) Run(run func(page int, limit int)) *StudentRepository_FindAll_Call {
+func (_c *StudentRepository_FindAll_Call) Run(run func(page int, limit int, cohortId uint, spec query.Spec)) *StudentRepository_FindAll_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(int), args[1].(int))
+		run(args[0].(int), args[1].(int), args[2].(uint), args[3].(query.Spec))
 	})
 	return _c
 }
@@ -232,7 +243,67 @@ func (_c *StudentRepository_FindAll_Call) Return(_a0 []entity.Student, _a1 error
 	return _c
 }
 
-func (_c *StudentRepository_FindAll_Call) RunAndReturn(run func(int, int) ([]entity.Student, error)) *StudentRepository_FindAll_Call {
+func (_c *StudentRepository_FindAll_Call) RunAndReturn(run func(int, int, uint, query.Spec) ([]entity.Student, error)) *StudentRepository_FindAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindAllByCursor provides a mock function with given fields: keyset, cohortId, spec
+func (_m *StudentRepository) FindAllByCursor(keyset pagination.Keyset, cohortId uint, spec query.Spec) ([]entity.Student, error) {
+	ret := _m.Called(keyset, cohortId, spec)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindAllByCursor")
+	}
+
+	var r0 []entity.Student
+	var r1 error
+	if rf, ok := ret.Get(0).(func(pagination.Keyset, uint, query.Spec) ([]entity.Student, error)); ok {
+		return rf(keyset, cohortId, spec)
+	}
+	if rf, ok := ret.Get(0).(func(pagination.Keyset, uint, query.Spec) []entity.Student); ok {
+		r0 = rf(keyset, cohortId, spec)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Student)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(pagination.Keyset, uint, query.Spec) error); ok {
+		r1 = rf(keyset, cohortId, spec)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StudentRepository_FindAllByCursor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindAllByCursor'
+type StudentRepository_FindAllByCursor_Call struct {
+	*mock.Call
+}
+
+// FindAllByCursor is a helper method to define mock.On call
+//   - keyset pagination.Keyset
+//   - cohortId uint
+//   - spec query.Spec
+func (_e *StudentRepository_Expecter) FindAllByCursor(keyset interface{}, cohortId interface{}, spec interface{}) *StudentRepository_FindAllByCursor_Call {
+	return &StudentRepository_FindAllByCursor_Call{Call: _e.mock.On("FindAllByCursor", keyset, cohortId, spec)}
+}
+
+func (_c *StudentRepository_FindAllByCursor_Call) Run(run func(keyset pagination.Keyset, cohortId uint, spec query.Spec)) *StudentRepository_FindAllByCursor_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(pagination.Keyset), args[1].(uint), args[2].(query.Spec))
+	})
+	return _c
+}
+
+func (_c *StudentRepository_FindAllByCursor_Call) Return(_a0 []entity.Student, _a1 error) *StudentRepository_FindAllByCursor_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StudentRepository_FindAllByCursor_Call) RunAndReturn(run func(pagination.Keyset, uint, query.Spec) ([]entity.Student, error)) *StudentRepository_FindAllByCursor_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -295,9 +366,400 @@ func (_c *StudentRepository_FindById_Call) RunAndReturn(run func(uint) (*entity.
 	return _c
 }
 
-// Save provides a mock function with given fields: _a0
-func (_m *StudentRepository) Save(_a0 *entity.Student) (*entity.Student, error) {
-	ret := _m.Called(_a0)
+// FindByNumber provides a mock function with given fields: number
+func (_m *StudentRepository) FindByNumber(number string) (*entity.Student, error) {
+	ret := _m.Called(number)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByNumber")
+	}
+
+	var r0 *entity.Student
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*entity.Student, error)); ok {
+		return rf(number)
+	}
+	if rf, ok := ret.Get(0).(func(string) *entity.Student); ok {
+		r0 = rf(number)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Student)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(number)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StudentRepository_FindByNumber_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByNumber'
+type StudentRepository_FindByNumber_Call struct {
+	*mock.Call
+}
+
+// FindByNumber is a helper method to define mock.On call
+//   - number string
+func (_e *StudentRepository_Expecter) FindByNumber(number interface{}) *StudentRepository_FindByNumber_Call {
+	return &StudentRepository_FindByNumber_Call{Call: _e.mock.On("FindByNumber", number)}
+}
+
+func (_c *StudentRepository_FindByNumber_Call) Run(run func(number string)) *StudentRepository_FindByNumber_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *StudentRepository_FindByNumber_Call) Return(_a0 *entity.Student, _a1 error) *StudentRepository_FindByNumber_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StudentRepository_FindByNumber_Call) RunAndReturn(run func(string) (*entity.Student, error)) *StudentRepository_FindByNumber_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindDuplicates provides a mock function with given fields: id, minSimilarity, limit
+func (_m *StudentRepository) FindDuplicates(id uint, minSimilarity float64, limit int) ([]entity.StudentDuplicate, error) {
+	ret := _m.Called(id, minSimilarity, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindDuplicates")
+	}
+
+	var r0 []entity.StudentDuplicate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, float64, int) ([]entity.StudentDuplicate, error)); ok {
+		return rf(id, minSimilarity, limit)
+	}
+	if rf, ok := ret.Get(0).(func(uint, float64, int) []entity.StudentDuplicate); ok {
+		r0 = rf(id, minSimilarity, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.StudentDuplicate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, float64, int) error); ok {
+		r1 = rf(id, minSimilarity, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StudentRepository_FindDuplicates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindDuplicates'
+type StudentRepository_FindDuplicates_Call struct {
+	*mock.Call
+}
+
+// FindDuplicates is a helper method to define mock.On call
+//   - id uint
+//   - minSimilarity float64
+//   - limit int
+func (_e *StudentRepository_Expecter) FindDuplicates(id interface{}, minSimilarity interface{}, limit interface{}) *StudentRepository_FindDuplicates_Call {
+	return &StudentRepository_FindDuplicates_Call{Call: _e.mock.On("FindDuplicates", id, minSimilarity, limit)}
+}
+
+func (_c *StudentRepository_FindDuplicates_Call) Run(run func(id uint, minSimilarity float64, limit int)) *StudentRepository_FindDuplicates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uint), args[1].(float64), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *StudentRepository_FindDuplicates_Call) Return(_a0 []entity.StudentDuplicate, _a1 error) *StudentRepository_FindDuplicates_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StudentRepository_FindDuplicates_Call) RunAndReturn(run func(uint, float64, int) ([]entity.StudentDuplicate, error)) *StudentRepository_FindDuplicates_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindSurvivorId provides a mock function with given fields: mergedId
+func (_m *StudentRepository) FindSurvivorId(mergedId uint) (uint, error) {
+	ret := _m.Called(mergedId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindSurvivorId")
+	}
+
+	var r0 uint
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint) (uint, error)); ok {
+		return rf(mergedId)
+	}
+	if rf, ok := ret.Get(0).(func(uint) uint); ok {
+		r0 = rf(mergedId)
+	} else {
+		r0 = ret.Get(0).(uint)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(mergedId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StudentRepository_FindSurvivorId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindSurvivorId'
+type StudentRepository_FindSurvivorId_Call struct {
+	*mock.Call
+}
+
+// FindSurvivorId is a helper method to define mock.On call
+//   - mergedId uint
+func (_e *StudentRepository_Expecter) FindSurvivorId(mergedId interface{}) *StudentRepository_FindSurvivorId_Call {
+	return &StudentRepository_FindSurvivorId_Call{Call: _e.mock.On("FindSurvivorId", mergedId)}
+}
+
+func (_c *StudentRepository_FindSurvivorId_Call) Run(run func(mergedId uint)) *StudentRepository_FindSurvivorId_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uint))
+	})
+	return _c
+}
+
+func (_c *StudentRepository_FindSurvivorId_Call) Return(_a0 uint, _a1 error) *StudentRepository_FindSurvivorId_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StudentRepository_FindSurvivorId_Call) RunAndReturn(run func(uint) (uint, error)) *StudentRepository_FindSurvivorId_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// HasGuardian provides a mock function with given fields: studentId
+func (_m *StudentRepository) HasGuardian(studentId uint) (bool, error) {
+	ret := _m.Called(studentId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HasGuardian")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint) (bool, error)); ok {
+		return rf(studentId)
+	}
+	if rf, ok := ret.Get(0).(func(uint) bool); ok {
+		r0 = rf(studentId)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(studentId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StudentRepository_HasGuardian_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HasGuardian'
+type StudentRepository_HasGuardian_Call struct {
+	*mock.Call
+}
+
+// HasGuardian is a helper method to define mock.On call
+//   - studentId uint
+func (_e *StudentRepository_Expecter) HasGuardian(studentId interface{}) *StudentRepository_HasGuardian_Call {
+	return &StudentRepository_HasGuardian_Call{Call: _e.mock.On("HasGuardian", studentId)}
+}
+
+func (_c *StudentRepository_HasGuardian_Call) Run(run func(studentId uint)) *StudentRepository_HasGuardian_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uint))
+	})
+	return _c
+}
+
+func (_c *StudentRepository_HasGuardian_Call) Return(_a0 bool, _a1 error) *StudentRepository_HasGuardian_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StudentRepository_HasGuardian_Call) RunAndReturn(run func(uint) (bool, error)) *StudentRepository_HasGuardian_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Merge provides a mock function with given fields: survivorId, duplicateId, meta
+func (_m *StudentRepository) Merge(survivorId uint, duplicateId uint, meta audit.Meta) error {
+	ret := _m.Called(survivorId, duplicateId, meta)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Merge")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint, uint, audit.Meta) error); ok {
+		r0 = rf(survivorId, duplicateId, meta)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StudentRepository_Merge_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Merge'
+type StudentRepository_Merge_Call struct {
+	*mock.Call
+}
+
+// Merge is a helper method to define mock.On call
+//   - survivorId uint
+//   - duplicateId uint
+//   - meta audit.Meta
+func (_e *StudentRepository_Expecter) Merge(survivorId interface{}, duplicateId interface{}, meta interface{}) *StudentRepository_Merge_Call {
+	return &StudentRepository_Merge_Call{Call: _e.mock.On("Merge", survivorId, duplicateId, meta)}
+}
+
+func (_c *StudentRepository_Merge_Call) Run(run func(survivorId uint, duplicateId uint, meta audit.Meta)) *StudentRepository_Merge_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uint), args[1].(uint), args[2].(audit.Meta))
+	})
+	return _c
+}
+
+func (_c *StudentRepository_Merge_Call) Return(_a0 error) *StudentRepository_Merge_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *StudentRepository_Merge_Call) RunAndReturn(run func(uint, uint, audit.Meta) error) *StudentRepository_Merge_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NextNumberSeq provides a mock function with given fields: scope
+func (_m *StudentRepository) NextNumberSeq(scope string) (int, error) {
+	ret := _m.Called(scope)
+
+	if len(ret) == 0 {
+		panic("no return value specified for NextNumberSeq")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (int, error)); ok {
+		return rf(scope)
+	}
+	if rf, ok := ret.Get(0).(func(string) int); ok {
+		r0 = rf(scope)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(scope)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StudentRepository_NextNumberSeq_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'NextNumberSeq'
+type StudentRepository_NextNumberSeq_Call struct {
+	*mock.Call
+}
+
+// NextNumberSeq is a helper method to define mock.On call
+//   - scope string
+func (_e *StudentRepository_Expecter) NextNumberSeq(scope interface{}) *StudentRepository_NextNumberSeq_Call {
+	return &StudentRepository_NextNumberSeq_Call{Call: _e.mock.On("NextNumberSeq", scope)}
+}
+
+func (_c *StudentRepository_NextNumberSeq_Call) Run(run func(scope string)) *StudentRepository_NextNumberSeq_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *StudentRepository_NextNumberSeq_Call) Return(_a0 int, _a1 error) *StudentRepository_NextNumberSeq_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StudentRepository_NextNumberSeq_Call) RunAndReturn(run func(string) (int, error)) *StudentRepository_NextNumberSeq_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Restore provides a mock function with given fields: id, meta
+func (_m *StudentRepository) Restore(id uint, meta audit.Meta) (bool, error) {
+	ret := _m.Called(id, meta)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Restore")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, audit.Meta) (bool, error)); ok {
+		return rf(id, meta)
+	}
+	if rf, ok := ret.Get(0).(func(uint, audit.Meta) bool); ok {
+		r0 = rf(id, meta)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, audit.Meta) error); ok {
+		r1 = rf(id, meta)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StudentRepository_Restore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Restore'
+type StudentRepository_Restore_Call struct {
+	*mock.Call
+}
+
+// Restore is a helper method to define mock.On call
+//   - id uint
+//   - meta audit.Meta
+func (_e *StudentRepository_Expecter) Restore(id interface{}, meta interface{}) *StudentRepository_Restore_Call {
+	return &StudentRepository_Restore_Call{Call: _e.mock.On("Restore", id, meta)}
+}
+
+func (_c *StudentRepository_Restore_Call) Run(run func(id uint, meta audit.Meta)) *StudentRepository_Restore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uint), args[1].(audit.Meta))
+	})
+	return _c
+}
+
+func (_c *StudentRepository_Restore_Call) Return(_a0 bool, _a1 error) *StudentRepository_Restore_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StudentRepository_Restore_Call) RunAndReturn(run func(uint, audit.Meta) (bool, error)) *StudentRepository_Restore_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Save provides a mock function with given fields: _a0, meta
+func (_m *StudentRepository) Save(_a0 *entity.Student, meta audit.Meta) (*entity.Student, error) {
+	ret := _m.Called(_a0, meta)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Save")
@@ -305,19 +767,19 @@ func (_m *StudentRepository) Save(_a0 *entity.Student) (*entity.Student, error)
 
 	var r0 *entity.Student
 	var r1 error
-	if rf, ok := ret.Get(0).(func(*entity.Student) (*entity.Student, error)); ok {
-		return rf(_a0)
+	if rf, ok := ret.Get(0).(func(*entity.Student, audit.Meta) (*entity.Student, error)); ok {
+		return rf(_a0, meta)
 	}
-	if rf, ok := ret.Get(0).(func(*entity.Student) *entity.Student); ok {
-		r0 = rf(_a0)
+	if rf, ok := ret.Get(0).(func(*entity.Student, audit.Meta) *entity.Student); ok {
+		r0 = rf(_a0, meta)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*entity.Student)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(*entity.Student) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(*entity.Student, audit.Meta) error); ok {
+		r1 = rf(_a0, meta)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -332,13 +794,14 @@ type StudentRepository_Save_Call struct {
 
 // Save is a helper method to define mock.On call
 //   - _a0 *entity.Student
-func (_e *StudentRepository_Expecter) Save(_a0 interface{}) *StudentRepository_Save_Call {
-	return &StudentRepository_Save_Call{Call: _e.mock.On("Save", _a0)}
+//   - meta audit.Meta
+func (_e *StudentRepository_Expecter) Save(_a0 interface{}, meta interface{}) *StudentRepository_Save_Call {
+	return &StudentRepository_Save_Call{Call: _e.mock.On("Save", _a0, meta)}
 }
 
-func (_c *StudentRepository_Save_Call) Run(run func(_a0 *entity.Student)) *StudentRepository_Save_Call {
+func (_c *StudentRepository_Save_Call) Run(run func(_a0 *entity.Student, meta audit.Meta)) *StudentRepository_Save_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(*entity.Student))
+		run(args[0].(*entity.Student), args[1].(audit.Meta))
 	})
 	return _c
 }
@@ -348,14 +811,14 @@ func (_c *StudentRepository_Save_Call) Return(_a0 *entity.Student, _a1 error) *S
 	return _c
 }
 
-func (_c *StudentRepository_Save_Call) RunAndReturn(run func(*entity.Student) (*entity.Student, error)) *StudentRepository_Save_Call {
+func (_c *StudentRepository_Save_Call) RunAndReturn(run func(*entity.Student, audit.Meta) (*entity.Student, error)) *StudentRepository_Save_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Update provides a mock function with given fields: _a0
-func (_m *StudentRepository) Update(_a0 *entity.Student) (*entity.Student, error) {
-	ret := _m.Called(_a0)
+// Update provides a mock function with given fields: id, version, changes, addresses, meta
+func (_m *StudentRepository) Update(id uint, version uint, changes map[string]interface{}, addresses []entity.StudentAddress, meta audit.Meta) (*entity.Student, error) {
+	ret := _m.Called(id, version, changes, addresses, meta)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Update")
@@ -363,19 +826,19 @@ func (_m *StudentRepository) Update(_a0 *entity.Student) (*entity.Student, error
 
 	var r0 *entity.Student
 	var r1 error
-	if rf, ok := ret.Get(0).(func(*entity.Student) (*entity.Student, error)); ok {
-		return rf(_a0)
+	if rf, ok := ret.Get(0).(func(uint, uint, map[string]interface{}, []entity.StudentAddress, audit.Meta) (*entity.Student, error)); ok {
+		return rf(id, version, changes, addresses, meta)
 	}
-	if rf, ok := ret.Get(0).(func(*entity.Student) *entity.Student); ok {
-		r0 = rf(_a0)
+	if rf, ok := ret.Get(0).(func(uint, uint, map[string]interface{}, []entity.StudentAddress, audit.Meta) *entity.Student); ok {
+		r0 = rf(id, version, changes, addresses, meta)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*entity.Student)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(*entity.Student) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(uint, uint, map[string]interface{}, []entity.StudentAddress, audit.Meta) error); ok {
+		r1 = rf(id, version, changes, addresses, meta)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -389,14 +852,18 @@ type StudentRepository_Update_Call struct {
 }
 
 // Update is a helper method to define mock.On call
-//   - _a0 *entity.Student
-func (_e *StudentRepository_Expecter) Update(_a0 interface{}) *StudentRepository_Update_Call {
-	return &StudentRepository_Update_Call{Call: _e.mock.On("Update", _a0)}
+//   - id uint
+//   - version uint
+//   - changes map[string]interface{}
+//   - addresses []entity.StudentAddress
+//   - meta audit.Meta
+func (_e *StudentRepository_Expecter) Update(id interface{}, version interface{}, changes interface{}, addresses interface{}, meta interface{}) *StudentRepository_Update_Call {
+	return &StudentRepository_Update_Call{Call: _e.mock.On("Update", id, version, changes, addresses, meta)}
 }
 
-func (_c *StudentRepository_Update_Call) Run(run func(_a0 *entity.Student)) *StudentRepository_Update_Call {
+func (_c *StudentRepository_Update_Call) Run(run func(id uint, version uint, changes map[string]interface{}, addresses []entity.StudentAddress, meta audit.Meta)) *StudentRepository_Update_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(*entity.Student))
+		run(args[0].(uint), args[1].(uint), args[2].(map[string]interface{}), args[3].([]entity.StudentAddress), args[4].(audit.Meta))
 	})
 	return _c
 }
@@ -406,7 +873,7 @@ func (_c *StudentRepository_Update_Call) Return(_a0 *entity.Student, _a1 error)
 	return _c
 }
 
-func (_c *StudentRepository_Update_Call) RunAndReturn(run func(*entity.Student) (*entity.Student, error)) *StudentRepository_Update_Call {
+func (_c *StudentRepository_Update_Call) RunAndReturn(run func(uint, uint, map[string]interface{}, []entity.StudentAddress, audit.Meta) (*entity.Student, error)) *StudentRepository_Update_Call {
 	_c.Call.Return(run)
 	return _c
 }
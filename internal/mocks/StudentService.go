@@ -3,10 +3,18 @@
 package mocks
 
 import (
-	request "student_go/internal/dto/request"
+	audit "student_go/internal/audit"
 
 	mock "github.com/stretchr/testify/mock"
 
+	pagination "student_go/pkg/pagination"
+
+	patch "student_go/pkg/patch"
+
+	query "student_go/pkg/query"
+
+	request "student_go/internal/dto/request"
+
 	response "student_go/internal/dto/response"
 )
 
@@ -23,9 +31,9 @@ func (_m *StudentServiceMock) EXPECT() *StudentServiceMock_Expecter {
 	return &StudentServiceMock_Expecter{mock: &_m.Mock}
 }
 
-// AddCourseToStudent provides a mock function with given fields: studentId, courseId
-func (_m *StudentServiceMock) AddCourseToStudent(studentId uint, courseId uint) (*response.StudentResponse, error) {
-	ret := _m.Called(studentId, courseId)
+// AddCourseToStudent provides a mock function with given fields: studentId, courseId, meta
+func (_m *StudentServiceMock) AddCourseToStudent(studentId uint, courseId uint, meta audit.Meta) (*response.StudentResponse, error) {
+	ret := _m.Called(studentId, courseId, meta)
 
 	if len(ret) == 0 {
 		panic("no return value specified for AddCourseToStudent")
@@ -33,19 +41,19 @@ func (_m *StudentServiceMock) AddCourseToStudent(studentId uint, courseId uint)
 
 	var r0 *response.StudentResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(uint, uint) (*response.StudentResponse, error)); ok {
-		return rf(studentId, courseId)
+	if rf, ok := ret.Get(0).(func(uint, uint, audit.Meta) (*response.StudentResponse, error)); ok {
+		return rf(studentId, courseId, meta)
 	}
-	if rf, ok := ret.Get(0).(func(uint, uint) *response.StudentResponse); ok {
-		r0 = rf(studentId, courseId)
+	if rf, ok := ret.Get(0).(func(uint, uint, audit.Meta) *response.StudentResponse); ok {
+		r0 = rf(studentId, courseId, meta)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*response.StudentResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(uint, uint) error); ok {
-		r1 = rf(studentId, courseId)
+	if rf, ok := ret.Get(1).(func(uint, uint, audit.Meta) error); ok {
+		r1 = rf(studentId, courseId, meta)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -61,13 +69,14 @@ type StudentServiceMock_AddCourseToStudent_Call struct {
 // AddCourseToStudent is a helper method to define mock.On call
 //   - studentId uint
 //   - courseId uint
-func (_e *StudentServiceMock_Expecter) AddCourseToStudent(studentId interface{}, courseId interface{}) *StudentServiceMock_AddCourseToStudent_Call {
-	return &StudentServiceMock_AddCourseToStudent_Call{Call: _e.mock.On("AddCourseToStudent", studentId, courseId)}
+//   - meta audit.Meta
+func (_e *StudentServiceMock_Expecter) AddCourseToStudent(studentId interface{}, courseId interface{}, meta interface{}) *StudentServiceMock_AddCourseToStudent_Call {
+	return &StudentServiceMock_AddCourseToStudent_Call{Call: _e.mock.On("AddCourseToStudent", studentId, courseId, meta)}
 }
 
-func (_c *StudentServiceMock_AddCourseToStudent_Call) Run(run func(studentId uint, courseId uint)) *StudentServiceMock_AddCourseToStudent_Call {
+func (_c *StudentServiceMock_AddCourseToStudent_Call) Run(run func(studentId uint, courseId uint, meta audit.Meta)) *StudentServiceMock_AddCourseToStudent_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(uint), args[1].(uint))
+		run(args[0].(uint), args[1].(uint), args[2].(audit.Meta))
 	})
 	return _c
 }
@@ -77,14 +86,14 @@ func (_c *StudentServiceMock_AddCourseToStudent_Call) Return(_a0 *response.Stude
 	return _c
 }
 
-func (_c *StudentServiceMock_AddCourseToStudent_Call) RunAndReturn(run func(uint, uint) (*response.StudentResponse, error)) *StudentServiceMock_AddCourseToStudent_Call {
+func (_c *StudentServiceMock_AddCourseToStudent_Call) RunAndReturn(run func(uint, uint, audit.Meta) (*response.StudentResponse, error)) *StudentServiceMock_AddCourseToStudent_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Count provides a mock function with no fields
-func (_m *StudentServiceMock) Count() (int, error) {
-	ret := _m.Called()
+// Count provides a mock function with given fields: cohortId, spec
+func (_m *StudentServiceMock) Count(cohortId uint, spec query.Spec) (int, error) {
+	ret := _m.Called(cohortId, spec)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Count")
@@ -92,17 +101,17 @@ func (_m *StudentServiceMock) Count() (int, error) {
 
 	var r0 int
 	var r1 error
-	if rf, ok := ret.Get(0).(func() (int, error)); ok {
-		return rf()
+	if rf, ok := ret.Get(0).(func(uint, query.Spec) (int, error)); ok {
+		return rf(cohortId, spec)
 	}
-	if rf, ok := ret.Get(0).(func() int); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(uint, query.Spec) int); ok {
+		r0 = rf(cohortId, spec)
 	} else {
 		r0 = ret.Get(0).(int)
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(uint, query.Spec) error); ok {
+		r1 = rf(cohortId, spec)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -116,13 +125,15 @@ type StudentServiceMock_Count_Call struct {
 }
 
 // Count is a helper method to define mock.On call
-func (_e *StudentServiceMock_Expecter) Count() *StudentServiceMock_Count_Call {
-	return &StudentServiceMock_Count_Call{Call: _e.mock.On("Count")}
+//   - cohortId uint
+//   - spec query.Spec
+func (_e *StudentServiceMock_Expecter) Count(cohortId interface{}, spec interface{}) *StudentServiceMock_Count_Call {
+	return &StudentServiceMock_Count_Call{Call: _e.mock.On("Count", cohortId, spec)}
 }
 
-func (_c *StudentServiceMock_Count_Call) Run(run func()) *StudentServiceMock_Count_Call {
+func (_c *StudentServiceMock_Count_Call) Run(run func(cohortId uint, spec query.Spec)) *StudentServiceMock_Count_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
+		run(args[0].(uint), args[1].(query.Spec))
 	})
 	return _c
 }
@@ -132,14 +143,14 @@ func (_c *StudentServiceMock_Count_Call) Return(_a0 int, _a1 error) *StudentServ
 	return _c
 }
 
-func (_c *StudentServiceMock_Count_Call) RunAndReturn(run func() (int, error)) *StudentServiceMock_Count_Call {
+func (_c *StudentServiceMock_Count_Call) RunAndReturn(run func(uint, query.Spec) (int, error)) *StudentServiceMock_Count_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateStudent provides a mock function with given fields: input
-func (_m *StudentServiceMock) CreateStudent(input request.StudentRequest) (*response.StudentResponse, error) {
-	ret := _m.Called(input)
+// CreateStudent provides a mock function with given fields: meta, input
+func (_m *StudentServiceMock) CreateStudent(meta audit.Meta, input request.StudentRequest) (*response.StudentResponse, error) {
+	ret := _m.Called(meta, input)
 
 	if len(ret) == 0 {
 		panic("no return value specified for CreateStudent")
@@ -147,19 +158,19 @@ func (_m *StudentServiceMock) CreateStudent(input request.StudentRequest) (*resp
 
 	var r0 *response.StudentResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(request.StudentRequest) (*response.StudentResponse, error)); ok {
-		return rf(input)
+	if rf, ok := ret.Get(0).(func(audit.Meta, request.StudentRequest) (*response.StudentResponse, error)); ok {
+		return rf(meta, input)
 	}
-	if rf, ok := ret.Get(0).(func(request.StudentRequest) *response.StudentResponse); ok {
-		r0 = rf(input)
+	if rf, ok := ret.Get(0).(func(audit.Meta, request.StudentRequest) *response.StudentResponse); ok {
+		r0 = rf(meta, input)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*response.StudentResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(request.StudentRequest) error); ok {
-		r1 = rf(input)
+	if rf, ok := ret.Get(1).(func(audit.Meta, request.StudentRequest) error); ok {
+		r1 = rf(meta, input)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -173,14 +184,15 @@ type StudentServiceMock_CreateStudent_Call struct {
 }
 
 // CreateStudent is a helper method to define mock.On call
+//   - meta audit.Meta
 //   - input request.StudentRequest
-func (_e *StudentServiceMock_Expecter) CreateStudent(input interface{}) *StudentServiceMock_CreateStudent_Call {
-	return &StudentServiceMock_CreateStudent_Call{Call: _e.mock.On("CreateStudent", input)}
+func (_e *StudentServiceMock_Expecter) CreateStudent(meta interface{}, input interface{}) *StudentServiceMock_CreateStudent_Call {
+	return &StudentServiceMock_CreateStudent_Call{Call: _e.mock.On("CreateStudent", meta, input)}
 }
 
-func (_c *StudentServiceMock_CreateStudent_Call) Run(run func(input request.StudentRequest)) *StudentServiceMock_CreateStudent_Call {
+func (_c *StudentServiceMock_CreateStudent_Call) Run(run func(meta audit.Meta, input request.StudentRequest)) *StudentServiceMock_CreateStudent_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(request.StudentRequest))
+		run(args[0].(audit.Meta), args[1].(request.StudentRequest))
 	})
 	return _c
 }
@@ -190,22 +202,22 @@ func (_c *StudentServiceMock_CreateStudent_Call) Return(_a0 *response.StudentRes
 	return _c
 }
 
-func (_c *StudentServiceMock_CreateStudent_Call) RunAndReturn(run func(request.StudentRequest) (*response.StudentResponse, error)) *StudentServiceMock_CreateStudent_Call {
+func (_c *StudentServiceMock_CreateStudent_Call) RunAndReturn(run func(audit.Meta, request.StudentRequest) (*response.StudentResponse, error)) *StudentServiceMock_CreateStudent_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteStudentById provides a mock function with given fields: id
-func (_m *StudentServiceMock) DeleteStudentById(id uint) error {
-	ret := _m.Called(id)
+// DeleteStudentById provides a mock function with given fields: id, version, meta
+func (_m *StudentServiceMock) DeleteStudentById(id uint, version uint, meta audit.Meta) error {
+	ret := _m.Called(id, version, meta)
 
 	if len(ret) == 0 {
 		panic("no return value specified for DeleteStudentById")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(uint) error); ok {
-		r0 = rf(id)
+	if rf, ok := ret.Get(0).(func(uint, uint, audit.Meta) error); ok {
+		r0 = rf(id, version, meta)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -220,13 +232,15 @@ type StudentServiceMock_DeleteStudentById_Call struct {
 
 // DeleteStudentById is a helper method to define mock.On call
 //   - id uint
-func (_e *StudentServiceMock_Expecter) DeleteStudentById(id interface{}) *StudentServiceMock_DeleteStudentById_Call {
-	return &StudentServiceMock_DeleteStudentById_Call{Call: _e.mock.On("DeleteStudentById", id)}
+//   - version uint
+//   - meta audit.Meta
+func (_e *StudentServiceMock_Expecter) DeleteStudentById(id interface{}, version interface{}, meta interface{}) *StudentServiceMock_DeleteStudentById_Call {
+	return &StudentServiceMock_DeleteStudentById_Call{Call: _e.mock.On("DeleteStudentById", id, version, meta)}
 }
 
-func (_c *StudentServiceMock_DeleteStudentById_Call) Run(run func(id uint)) *StudentServiceMock_DeleteStudentById_Call {
+func (_c *StudentServiceMock_DeleteStudentById_Call) Run(run func(id uint, version uint, meta audit.Meta)) *StudentServiceMock_DeleteStudentById_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(uint))
+		run(args[0].(uint), args[1].(uint), args[2].(audit.Meta))
 	})
 	return _c
 }
@@ -236,14 +250,14 @@ func (_c *StudentServiceMock_DeleteStudentById_Call) Return(_a0 error) *StudentS
 	return _c
 }
 
-func (_c *StudentServiceMock_DeleteStudentById_Call) RunAndReturn(run func(uint) error) *StudentServiceMock_DeleteStudentById_Call {
+func (_c *StudentServiceMock_DeleteStudentById_Call) RunAndReturn(run func(uint, uint, audit.Meta) error) *StudentServiceMock_DeleteStudentById_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// FindAllStudent provides a mock function with given fields: page, limit
-func (_m *StudentServiceMock) FindAllStudent(page int, limit int) ([]*response.StudentResponse, error) {
-	ret := _m.Called(page, limit)
+// FindAllStudent provides a mock function with given fields: page, limit, cohortId, spec
+func (_m *StudentServiceMock) FindAllStudent(page int, limit int, cohortId uint, spec query.Spec) ([]*response.StudentResponse, error) {
+	ret := _m.Called(page, limit, cohortId, spec)
 
 	if len(ret) == 0 {
 		panic("no return value specified for FindAllStudent")
@@ -251,19 +265,19 @@ func (_m *StudentServiceMock) FindAllStudent(page int, limit int) ([]*response.S
 
 	var r0 []*response.StudentResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int, int) ([]*response.StudentResponse, error)); ok {
-		return rf(page, limit)
+	if rf, ok := ret.Get(0).(func(int, int, uint, query.Spec) ([]*response.StudentResponse, error)); ok {
+		return rf(page, limit, cohortId, spec)
 	}
-	if rf, ok := ret.Get(0).(func(int, int) []*response.StudentResponse); ok {
-		r0 = rf(page, limit)
+	if rf, ok := ret.Get(0).(func(int, int, uint, query.Spec) []*response.StudentResponse); ok {
+		r0 = rf(page, limit, cohortId, spec)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*response.StudentResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(int, int) error); ok {
-		r1 = rf(page, limit)
+	if rf, ok := ret.Get(1).(func(int, int, uint, query.Spec) error); ok {
+		r1 = rf(page, limit, cohortId, spec)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -279,13 +293,15 @@ type StudentServiceMock_FindAllStudent_Call struct {
 // FindAllStudent is a helper method to define mock.On call
 //   - page int
 //   - limit int
-func (_e *StudentServiceMock_Expecter) FindAllStudent(page interface{}, limit interface{}) *StudentServiceMock_FindAllStudent_Call {
-	return &StudentServiceMock_FindAllStudent_Call{Call: _e.mock.On("FindAllStudent", page, limit)}
+//   - cohortId uint
+//   - spec query.Spec
+func (_e *StudentServiceMock_Expecter) FindAllStudent(page interface{}, limit interface{}, cohortId interface{}, spec interface{}) *StudentServiceMock_FindAllStudent_Call {
+	return &StudentServiceMock_FindAllStudent_Call{Call: _e.mock.On("FindAllStudent", page, limit, cohortId, spec)}
 }
 
-func (_c *StudentServiceMock_FindAllStudent_Call) Run(run func(page int, limit int)) *StudentServiceMock_FindAllStudent_Call {
+func (_c *StudentServiceMock_FindAllStudent_Call) Run(run func(page int, limit int, cohortId uint, spec query.Spec)) *StudentServiceMock_FindAllStudent_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(int), args[1].(int))
+		run(args[0].(int), args[1].(int), args[2].(uint), args[3].(query.Spec))
 	})
 	return _c
 }
@@ -295,7 +311,125 @@ func (_c *StudentServiceMock_FindAllStudent_Call) Return(_a0 []*response.Student
 	return _c
 }
 
-func (_c *StudentServiceMock_FindAllStudent_Call) RunAndReturn(run func(int, int) ([]*response.StudentResponse, error)) *StudentServiceMock_FindAllStudent_Call {
+func (_c *StudentServiceMock_FindAllStudent_Call) RunAndReturn(run func(int, int, uint, query.Spec) ([]*response.StudentResponse, error)) *StudentServiceMock_FindAllStudent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindAllStudentByCursor provides a mock function with given fields: keyset, cohortId, spec
+func (_m *StudentServiceMock) FindAllStudentByCursor(keyset pagination.Keyset, cohortId uint, spec query.Spec) (*pagination.CursorPage, error) {
+	ret := _m.Called(keyset, cohortId, spec)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindAllStudentByCursor")
+	}
+
+	var r0 *pagination.CursorPage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(pagination.Keyset, uint, query.Spec) (*pagination.CursorPage, error)); ok {
+		return rf(keyset, cohortId, spec)
+	}
+	if rf, ok := ret.Get(0).(func(pagination.Keyset, uint, query.Spec) *pagination.CursorPage); ok {
+		r0 = rf(keyset, cohortId, spec)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pagination.CursorPage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(pagination.Keyset, uint, query.Spec) error); ok {
+		r1 = rf(keyset, cohortId, spec)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StudentServiceMock_FindAllStudentByCursor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindAllStudentByCursor'
+type StudentServiceMock_FindAllStudentByCursor_Call struct {
+	*mock.Call
+}
+
+// FindAllStudentByCursor is a helper method to define mock.On call
+//   - keyset pagination.Keyset
+//   - cohortId uint
+//   - spec query.Spec
+func (_e *StudentServiceMock_Expecter) FindAllStudentByCursor(keyset interface{}, cohortId interface{}, spec interface{}) *StudentServiceMock_FindAllStudentByCursor_Call {
+	return &StudentServiceMock_FindAllStudentByCursor_Call{Call: _e.mock.On("FindAllStudentByCursor", keyset, cohortId, spec)}
+}
+
+func (_c *StudentServiceMock_FindAllStudentByCursor_Call) Run(run func(keyset pagination.Keyset, cohortId uint, spec query.Spec)) *StudentServiceMock_FindAllStudentByCursor_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(pagination.Keyset), args[1].(uint), args[2].(query.Spec))
+	})
+	return _c
+}
+
+func (_c *StudentServiceMock_FindAllStudentByCursor_Call) Return(_a0 *pagination.CursorPage, _a1 error) *StudentServiceMock_FindAllStudentByCursor_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StudentServiceMock_FindAllStudentByCursor_Call) RunAndReturn(run func(pagination.Keyset, uint, query.Spec) (*pagination.CursorPage, error)) *StudentServiceMock_FindAllStudentByCursor_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindDuplicates provides a mock function with given fields: id
+func (_m *StudentServiceMock) FindDuplicates(id uint) ([]*response.StudentDuplicateResponse, error) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindDuplicates")
+	}
+
+	var r0 []*response.StudentDuplicateResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint) ([]*response.StudentDuplicateResponse, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(uint) []*response.StudentDuplicateResponse); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*response.StudentDuplicateResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StudentServiceMock_FindDuplicates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindDuplicates'
+type StudentServiceMock_FindDuplicates_Call struct {
+	*mock.Call
+}
+
+// FindDuplicates is a helper method to define mock.On call
+//   - id uint
+func (_e *StudentServiceMock_Expecter) FindDuplicates(id interface{}) *StudentServiceMock_FindDuplicates_Call {
+	return &StudentServiceMock_FindDuplicates_Call{Call: _e.mock.On("FindDuplicates", id)}
+}
+
+func (_c *StudentServiceMock_FindDuplicates_Call) Run(run func(id uint)) *StudentServiceMock_FindDuplicates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uint))
+	})
+	return _c
+}
+
+func (_c *StudentServiceMock_FindDuplicates_Call) Return(_a0 []*response.StudentDuplicateResponse, _a1 error) *StudentServiceMock_FindDuplicates_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StudentServiceMock_FindDuplicates_Call) RunAndReturn(run func(uint) ([]*response.StudentDuplicateResponse, error)) *StudentServiceMock_FindDuplicates_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -358,9 +492,186 @@ func (_c *StudentServiceMock_FindStudentById_Call) RunAndReturn(run func(uint) (
 	return _c
 }
 
-// UpdateStudent provides a mock function with given fields: id, input
-func (_m *StudentServiceMock) UpdateStudent(id uint, input request.StudentRequest) (*response.StudentResponse, error) {
-	ret := _m.Called(id, input)
+// FindStudentByNumber provides a mock function with given fields: number
+func (_m *StudentServiceMock) FindStudentByNumber(number string) (*response.StudentResponse, error) {
+	ret := _m.Called(number)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindStudentByNumber")
+	}
+
+	var r0 *response.StudentResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*response.StudentResponse, error)); ok {
+		return rf(number)
+	}
+	if rf, ok := ret.Get(0).(func(string) *response.StudentResponse); ok {
+		r0 = rf(number)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*response.StudentResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(number)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StudentServiceMock_FindStudentByNumber_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindStudentByNumber'
+type StudentServiceMock_FindStudentByNumber_Call struct {
+	*mock.Call
+}
+
+// FindStudentByNumber is a helper method to define mock.On call
+//   - number string
+func (_e *StudentServiceMock_Expecter) FindStudentByNumber(number interface{}) *StudentServiceMock_FindStudentByNumber_Call {
+	return &StudentServiceMock_FindStudentByNumber_Call{Call: _e.mock.On("FindStudentByNumber", number)}
+}
+
+func (_c *StudentServiceMock_FindStudentByNumber_Call) Run(run func(number string)) *StudentServiceMock_FindStudentByNumber_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *StudentServiceMock_FindStudentByNumber_Call) Return(_a0 *response.StudentResponse, _a1 error) *StudentServiceMock_FindStudentByNumber_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StudentServiceMock_FindStudentByNumber_Call) RunAndReturn(run func(string) (*response.StudentResponse, error)) *StudentServiceMock_FindStudentByNumber_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MergeStudent provides a mock function with given fields: survivorId, duplicateId, meta
+func (_m *StudentServiceMock) MergeStudent(survivorId uint, duplicateId uint, meta audit.Meta) (*response.StudentResponse, error) {
+	ret := _m.Called(survivorId, duplicateId, meta)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MergeStudent")
+	}
+
+	var r0 *response.StudentResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, uint, audit.Meta) (*response.StudentResponse, error)); ok {
+		return rf(survivorId, duplicateId, meta)
+	}
+	if rf, ok := ret.Get(0).(func(uint, uint, audit.Meta) *response.StudentResponse); ok {
+		r0 = rf(survivorId, duplicateId, meta)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*response.StudentResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, uint, audit.Meta) error); ok {
+		r1 = rf(survivorId, duplicateId, meta)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StudentServiceMock_MergeStudent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MergeStudent'
+type StudentServiceMock_MergeStudent_Call struct {
+	*mock.Call
+}
+
+// MergeStudent is a helper method to define mock.On call
+//   - survivorId uint
+//   - duplicateId uint
+//   - meta audit.Meta
+func (_e *StudentServiceMock_Expecter) MergeStudent(survivorId interface{}, duplicateId interface{}, meta interface{}) *StudentServiceMock_MergeStudent_Call {
+	return &StudentServiceMock_MergeStudent_Call{Call: _e.mock.On("MergeStudent", survivorId, duplicateId, meta)}
+}
+
+func (_c *StudentServiceMock_MergeStudent_Call) Run(run func(survivorId uint, duplicateId uint, meta audit.Meta)) *StudentServiceMock_MergeStudent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uint), args[1].(uint), args[2].(audit.Meta))
+	})
+	return _c
+}
+
+func (_c *StudentServiceMock_MergeStudent_Call) Return(_a0 *response.StudentResponse, _a1 error) *StudentServiceMock_MergeStudent_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StudentServiceMock_MergeStudent_Call) RunAndReturn(run func(uint, uint, audit.Meta) (*response.StudentResponse, error)) *StudentServiceMock_MergeStudent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RestoreStudent provides a mock function with given fields: id, meta
+func (_m *StudentServiceMock) RestoreStudent(id uint, meta audit.Meta) (*response.StudentResponse, error) {
+	ret := _m.Called(id, meta)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestoreStudent")
+	}
+
+	var r0 *response.StudentResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, audit.Meta) (*response.StudentResponse, error)); ok {
+		return rf(id, meta)
+	}
+	if rf, ok := ret.Get(0).(func(uint, audit.Meta) *response.StudentResponse); ok {
+		r0 = rf(id, meta)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*response.StudentResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, audit.Meta) error); ok {
+		r1 = rf(id, meta)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StudentServiceMock_RestoreStudent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreStudent'
+type StudentServiceMock_RestoreStudent_Call struct {
+	*mock.Call
+}
+
+// RestoreStudent is a helper method to define mock.On call
+//   - id uint
+//   - meta audit.Meta
+func (_e *StudentServiceMock_Expecter) RestoreStudent(id interface{}, meta interface{}) *StudentServiceMock_RestoreStudent_Call {
+	return &StudentServiceMock_RestoreStudent_Call{Call: _e.mock.On("RestoreStudent", id, meta)}
+}
+
+func (_c *StudentServiceMock_RestoreStudent_Call) Run(run func(id uint, meta audit.Meta)) *StudentServiceMock_RestoreStudent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uint), args[1].(audit.Meta))
+	})
+	return _c
+}
+
+func (_c *StudentServiceMock_RestoreStudent_Call) Return(_a0 *response.StudentResponse, _a1 error) *StudentServiceMock_RestoreStudent_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StudentServiceMock_RestoreStudent_Call) RunAndReturn(run func(uint, audit.Meta) (*response.StudentResponse, error)) *StudentServiceMock_RestoreStudent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateStudent provides a mock function with given fields: id, version, meta, input, fields
+func (_m *StudentServiceMock) UpdateStudent(id uint, version uint, meta audit.Meta, input request.StudentPatch, fields patch.Fields) (*response.StudentResponse, error) {
+	ret := _m.Called(id, version, meta, input, fields)
 
 	if len(ret) == 0 {
 		panic("no return value specified for UpdateStudent")
@@ -368,19 +679,19 @@ func (_m *StudentServiceMock) UpdateStudent(id uint, input request.StudentReques
 
 	var r0 *response.StudentResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(uint, request.StudentRequest) (*response.StudentResponse, error)); ok {
-		return rf(id, input)
+	if rf, ok := ret.Get(0).(func(uint, uint, audit.Meta, request.StudentPatch, patch.Fields) (*response.StudentResponse, error)); ok {
+		return rf(id, version, meta, input, fields)
 	}
-	if rf, ok := ret.Get(0).(func(uint, request.StudentRequest) *response.StudentResponse); ok {
-		r0 = rf(id, input)
+	if rf, ok := ret.Get(0).(func(uint, uint, audit.Meta, request.StudentPatch, patch.Fields) *response.StudentResponse); ok {
+		r0 = rf(id, version, meta, input, fields)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*response.StudentResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(uint, request.StudentRequest) error); ok {
-		r1 = rf(id, input)
+	if rf, ok := ret.Get(1).(func(uint, uint, audit.Meta, request.StudentPatch, patch.Fields) error); ok {
+		r1 = rf(id, version, meta, input, fields)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -395,14 +706,17 @@ type StudentServiceMock_UpdateStudent_Call struct {
 
 // UpdateStudent is a helper method to define mock.On call
 //   - id uint
-//   - input request.StudentRequest
-func (_e *StudentServiceMock_Expecter) UpdateStudent(id interface{}, input interface{}) *StudentServiceMock_UpdateStudent_Call {
-	return &StudentServiceMock_UpdateStudent_Call{Call: _e.mock.On("UpdateStudent", id, input)}
+//   - version uint
+//   - meta audit.Meta
+//   - input request.StudentPatch
+//   - fields patch.Fields
+func (_e *StudentServiceMock_Expecter) UpdateStudent(id interface{}, version interface{}, meta interface{}, input interface{}, fields interface{}) *StudentServiceMock_UpdateStudent_Call {
+	return &StudentServiceMock_UpdateStudent_Call{Call: _e.mock.On("UpdateStudent", id, version, meta, input, fields)}
 }
 
-func (_c *StudentServiceMock_UpdateStudent_Call) Run(run func(id uint, input request.StudentRequest)) *StudentServiceMock_UpdateStudent_Call {
+func (_c *StudentServiceMock_UpdateStudent_Call) Run(run func(id uint, version uint, meta audit.Meta, input request.StudentPatch, fields patch.Fields)) *StudentServiceMock_UpdateStudent_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(uint), args[1].(request.StudentRequest))
+		run(args[0].(uint), args[1].(uint), args[2].(audit.Meta), args[3].(request.StudentPatch), args[4].(patch.Fields))
 	})
 	return _c
 }
@@ -412,7 +726,7 @@ func (_c *StudentServiceMock_UpdateStudent_Call) Return(_a0 *response.StudentRes
 	return _c
 }
 
-func (_c *StudentServiceMock_UpdateStudent_Call) RunAndReturn(run func(uint, request.StudentRequest) (*response.StudentResponse, error)) *StudentServiceMock_UpdateStudent_Call {
+func (_c *StudentServiceMock_UpdateStudent_Call) RunAndReturn(run func(uint, uint, audit.Meta, request.StudentPatch, patch.Fields) (*response.StudentResponse, error)) *StudentServiceMock_UpdateStudent_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -3,9 +3,14 @@
 package mocks
 
 import (
+	audit "student_go/internal/audit"
 	entity "student_go/internal/entity"
 
 	mock "github.com/stretchr/testify/mock"
+
+	pagination "student_go/pkg/pagination"
+
+	query "student_go/pkg/query"
 )
 
 // TeacherRepository is an autogenerated mock type for the Repository type
@@ -21,9 +26,9 @@ func (_m *TeacherRepository) EXPECT() *TeacherRepository_Expecter {
 	return &TeacherRepository_Expecter{mock: &_m.Mock}
 }
 
-// Count provides a mock function with no fields
-func (_m *TeacherRepository) Count() (int, error) {
-	ret := _m.Called()
+// Count provides a mock function with given fields: spec
+func (_m *TeacherRepository) Count(spec query.Spec) (int, error) {
+	ret := _m.Called(spec)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Count")
@@ -31,17 +36,17 @@ func (_m *TeacherRepository) Count() (int, error) {
 
 	var r0 int
 	var r1 error
-	if rf, ok := ret.Get(0).(func() (int, error)); ok {
-		return rf()
+	if rf, ok := ret.Get(0).(func(query.Spec) (int, error)); ok {
+		return rf(spec)
 	}
-	if rf, ok := ret.Get(0).(func() int); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(query.Spec) int); ok {
+		r0 = rf(spec)
 	} else {
 		r0 = ret.Get(0).(int)
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(query.Spec) error); ok {
+		r1 = rf(spec)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -55,13 +60,14 @@ type TeacherRepository_Count_Call struct {
 }
 
 // Count is a helper method to define mock.On call
-func (_e *TeacherRepository_Expecter) Count() *TeacherRepository_Count_Call {
-	return &TeacherRepository_Count_Call{Call: _e.mock.On("Count")}
+//   - spec query.Spec
+func (_e *TeacherRepository_Expecter) Count(spec interface{}) *TeacherRepository_Count_Call {
+	return &TeacherRepository_Count_Call{Call: _e.mock.On("Count", spec)}
 }
 
-func (_c *TeacherRepository_Count_Call) Run(run func()) *TeacherRepository_Count_Call {
+func (_c *TeacherRepository_Count_Call) Run(run func(spec query.Spec)) *TeacherRepository_Count_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
+		run(args[0].(query.Spec))
 	})
 	return _c
 }
@@ -71,22 +77,22 @@ func (_c *TeacherRepository_Count_Call) Return(_a0 int, _a1 error) *TeacherRepos
 	return _c
 }
 
-func (_c *TeacherRepository_Count_Call) RunAndReturn(run func() (int, error)) *TeacherRepository_Count_Call {
+func (_c *TeacherRepository_Count_Call) RunAndReturn(run func(query.Spec) (int, error)) *TeacherRepository_Count_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteById provides a mock function with given fields: id
-func (_m *TeacherRepository) DeleteById(id uint) error {
-	ret := _m.Called(id)
+// DeleteById provides a mock function with given fields: id, version, meta
+func (_m *TeacherRepository) DeleteById(id uint, version uint, meta audit.Meta) error {
+	ret := _m.Called(id, version, meta)
 
 	if len(ret) == 0 {
 		panic("no return value specified for DeleteById")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(uint) error); ok {
-		r0 = rf(id)
+	if rf, ok := ret.Get(0).(func(uint, uint, audit.Meta) error); ok {
+		r0 = rf(id, version, meta)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -101,13 +107,15 @@ type TeacherRepository_DeleteById_Call struct {
 
 // DeleteById is a helper method to define mock.On call
 //   - id uint
-func (_e *TeacherRepository_Expecter) DeleteById(id interface{}) *TeacherRepository_DeleteById_Call {
-	return &TeacherRepository_DeleteById_Call{Call: _e.mock.On("DeleteById", id)}
+//   - version uint
+//   - meta audit.Meta
+func (_e *TeacherRepository_Expecter) DeleteById(id interface{}, version interface{}, meta interface{}) *TeacherRepository_DeleteById_Call {
+	return &TeacherRepository_DeleteById_Call{Call: _e.mock.On("DeleteById", id, version, meta)}
 }
 
-func (_c *TeacherRepository_DeleteById_Call) Run(run func(id uint)) *TeacherRepository_DeleteById_Call {
+func (_c *TeacherRepository_DeleteById_Call) Run(run func(id uint, version uint, meta audit.Meta)) *TeacherRepository_DeleteById_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(uint))
+		run(args[0].(uint), args[1].(uint), args[2].(audit.Meta))
 	})
 	return _c
 }
@@ -117,7 +125,7 @@ func (_c *TeacherRepository_DeleteById_Call) Return(_a0 error) *TeacherRepositor
 	return _c
 }
 
-func (_c *TeacherRepository_DeleteById_Call) RunAndReturn(run func(uint) error) *TeacherRepository_DeleteById_Call {
+func (_c *TeacherRepository_DeleteById_Call) RunAndReturn(run func(uint, uint, audit.Meta) error) *TeacherRepository_DeleteById_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -178,9 +186,9 @@ func (_c *TeacherRepository_ExistsById_Call) RunAndReturn(run func(uint) (bool,
 	return _c
 }
 
-// FindAll provides a mock function with given fields: page, limit
-func (_m *TeacherRepository) FindAll(page int, limit int) ([]entity.Teacher, error) {
-	ret := _m.Called(page, limit)
+// FindAll provides a mock function with given fields: page, limit, spec
+func (_m *TeacherRepository) FindAll(page int, limit int, spec query.Spec) ([]entity.Teacher, error) {
+	ret := _m.Called(page, limit, spec)
 
 	if len(ret) == 0 {
 		panic("no return value specified for FindAll")
@@ -188,19 +196,19 @@ func (_m *TeacherRepository) FindAll(page int, limit int) ([]entity.Teacher, err
 
 	var r0 []entity.Teacher
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int, int) ([]entity.Teacher, error)); ok {
-		return rf(page, limit)
+	if rf, ok := ret.Get(0).(func(int, int, query.Spec) ([]entity.Teacher, error)); ok {
+		return rf(page, limit, spec)
 	}
-	if rf, ok := ret.Get(0).(func(int, int) []entity.Teacher); ok {
-		r0 = rf(page, limit)
+	if rf, ok := ret.Get(0).(func(int, int, query.Spec) []entity.Teacher); ok {
+		r0 = rf(page, limit, spec)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]entity.Teacher)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(int, int) error); ok {
-		r1 = rf(page, limit)
+	if rf, ok := ret.Get(1).(func(int, int, query.Spec) error); ok {
+		r1 = rf(page, limit, spec)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -216,13 +224,14 @@ type TeacherRepository_FindAll_Call struct {
 // FindAll is a helper method to define mock.On call
 //   - page int
 //   - limit int
-func (_e *TeacherRepository_Expecter) FindAll(page interface{}, limit interface{}) *TeacherRepository_FindAll_Call {
-	return &TeacherRepository_FindAll_Call{Call: _e.mock.On("FindAll", page, limit)}
+//   - spec query.Spec
+func (_e *TeacherRepository_Expecter) FindAll(page interface{}, limit interface{}, spec interface{}) *TeacherRepository_FindAll_Call {
+	return &TeacherRepository_FindAll_Call{Call: _e.mock.On("FindAll", page, limit, spec)}
 }
 
-func (_c *TeacherRepository_FindAll_Call) Run(run func(page int, limit int)) *TeacherRepository_FindAll_Call {
+func (_c *TeacherRepository_FindAll_Call) Run(run func(page int, limit int, spec query.Spec)) *TeacherRepository_FindAll_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(int), args[1].(int))
+		run(args[0].(int), args[1].(int), args[2].(query.Spec))
 	})
 	return _c
 }
@@ -232,7 +241,66 @@ func (_c *TeacherRepository_FindAll_Call) Return(_a0 []entity.Teacher, _a1 error
 	return _c
 }
 
-func (_c *TeacherRepository_FindAll_Call) RunAndReturn(run func(int, int) ([]entity.Teacher, error)) *TeacherRepository_FindAll_Call {
+func (_c *TeacherRepository_FindAll_Call) RunAndReturn(run func(int, int, query.Spec) ([]entity.Teacher, error)) *TeacherRepository_FindAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindAllByCursor provides a mock function with given fields: keyset, spec
+func (_m *TeacherRepository) FindAllByCursor(keyset pagination.Keyset, spec query.Spec) ([]entity.Teacher, error) {
+	ret := _m.Called(keyset, spec)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindAllByCursor")
+	}
+
+	var r0 []entity.Teacher
+	var r1 error
+	if rf, ok := ret.Get(0).(func(pagination.Keyset, query.Spec) ([]entity.Teacher, error)); ok {
+		return rf(keyset, spec)
+	}
+	if rf, ok := ret.Get(0).(func(pagination.Keyset, query.Spec) []entity.Teacher); ok {
+		r0 = rf(keyset, spec)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Teacher)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(pagination.Keyset, query.Spec) error); ok {
+		r1 = rf(keyset, spec)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TeacherRepository_FindAllByCursor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindAllByCursor'
+type TeacherRepository_FindAllByCursor_Call struct {
+	*mock.Call
+}
+
+// FindAllByCursor is a helper method to define mock.On call
+//   - keyset pagination.Keyset
+//   - spec query.Spec
+func (_e *TeacherRepository_Expecter) FindAllByCursor(keyset interface{}, spec interface{}) *TeacherRepository_FindAllByCursor_Call {
+	return &TeacherRepository_FindAllByCursor_Call{Call: _e.mock.On("FindAllByCursor", keyset, spec)}
+}
+
+func (_c *TeacherRepository_FindAllByCursor_Call) Run(run func(keyset pagination.Keyset, spec query.Spec)) *TeacherRepository_FindAllByCursor_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(pagination.Keyset), args[1].(query.Spec))
+	})
+	return _c
+}
+
+func (_c *TeacherRepository_FindAllByCursor_Call) Return(_a0 []entity.Teacher, _a1 error) *TeacherRepository_FindAllByCursor_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TeacherRepository_FindAllByCursor_Call) RunAndReturn(run func(pagination.Keyset, query.Spec) ([]entity.Teacher, error)) *TeacherRepository_FindAllByCursor_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -295,9 +363,66 @@ func (_c *TeacherRepository_FindById_Call) RunAndReturn(run func(uint) (*entity.
 	return _c
 }
 
-// Save provides a mock function with given fields: _a0
-func (_m *TeacherRepository) Save(_a0 *entity.Teacher) (*entity.Teacher, error) {
-	ret := _m.Called(_a0)
+// Restore provides a mock function with given fields: id, meta
+func (_m *TeacherRepository) Restore(id uint, meta audit.Meta) (bool, error) {
+	ret := _m.Called(id, meta)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Restore")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, audit.Meta) (bool, error)); ok {
+		return rf(id, meta)
+	}
+	if rf, ok := ret.Get(0).(func(uint, audit.Meta) bool); ok {
+		r0 = rf(id, meta)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, audit.Meta) error); ok {
+		r1 = rf(id, meta)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TeacherRepository_Restore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Restore'
+type TeacherRepository_Restore_Call struct {
+	*mock.Call
+}
+
+// Restore is a helper method to define mock.On call
+//   - id uint
+//   - meta audit.Meta
+func (_e *TeacherRepository_Expecter) Restore(id interface{}, meta interface{}) *TeacherRepository_Restore_Call {
+	return &TeacherRepository_Restore_Call{Call: _e.mock.On("Restore", id, meta)}
+}
+
+func (_c *TeacherRepository_Restore_Call) Run(run func(id uint, meta audit.Meta)) *TeacherRepository_Restore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uint), args[1].(audit.Meta))
+	})
+	return _c
+}
+
+func (_c *TeacherRepository_Restore_Call) Return(_a0 bool, _a1 error) *TeacherRepository_Restore_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TeacherRepository_Restore_Call) RunAndReturn(run func(uint, audit.Meta) (bool, error)) *TeacherRepository_Restore_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Save provides a mock function with given fields: _a0, meta
+func (_m *TeacherRepository) Save(_a0 *entity.Teacher, meta audit.Meta) (*entity.Teacher, error) {
+	ret := _m.Called(_a0, meta)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Save")
@@ -305,19 +430,19 @@ func (_m *TeacherRepository) Save(_a0 *entity.Teacher) (*entity.Teacher, error)
 
 	var r0 *entity.Teacher
 	var r1 error
-	if rf, ok := ret.Get(0).(func(*entity.Teacher) (*entity.Teacher, error)); ok {
-		return rf(_a0)
+	if rf, ok := ret.Get(0).(func(*entity.Teacher, audit.Meta) (*entity.Teacher, error)); ok {
+		return rf(_a0, meta)
 	}
-	if rf, ok := ret.Get(0).(func(*entity.Teacher) *entity.Teacher); ok {
-		r0 = rf(_a0)
+	if rf, ok := ret.Get(0).(func(*entity.Teacher, audit.Meta) *entity.Teacher); ok {
+		r0 = rf(_a0, meta)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*entity.Teacher)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(*entity.Teacher) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(*entity.Teacher, audit.Meta) error); ok {
+		r1 = rf(_a0, meta)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -332,13 +457,14 @@ type TeacherRepository_Save_Call struct {
 
 // Save is a helper method to define mock.On call
 //   - _a0 *entity.Teacher
-func (_e *TeacherRepository_Expecter) Save(_a0 interface{}) *TeacherRepository_Save_Call {
-	return &TeacherRepository_Save_Call{Call: _e.mock.On("Save", _a0)}
+//   - meta audit.Meta
+func (_e *TeacherRepository_Expecter) Save(_a0 interface{}, meta interface{}) *TeacherRepository_Save_Call {
+	return &TeacherRepository_Save_Call{Call: _e.mock.On("Save", _a0, meta)}
 }
 
-func (_c *TeacherRepository_Save_Call) Run(run func(_a0 *entity.Teacher)) *TeacherRepository_Save_Call {
+func (_c *TeacherRepository_Save_Call) Run(run func(_a0 *entity.Teacher, meta audit.Meta)) *TeacherRepository_Save_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(*entity.Teacher))
+		run(args[0].(*entity.Teacher), args[1].(audit.Meta))
 	})
 	return _c
 }
@@ -348,14 +474,14 @@ func (_c *TeacherRepository_Save_Call) Return(_a0 *entity.Teacher, _a1 error) *T
 	return _c
 }
 
-func (_c *TeacherRepository_Save_Call) RunAndReturn(run func(*entity.Teacher) (*entity.Teacher, error)) *TeacherRepository_Save_Call {
+func (_c *TeacherRepository_Save_Call) RunAndReturn(run func(*entity.Teacher, audit.Meta) (*entity.Teacher, error)) *TeacherRepository_Save_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Update provides a mock function with given fields: _a0
-func (_m *TeacherRepository) Update(_a0 *entity.Teacher) (*entity.Teacher, error) {
-	ret := _m.Called(_a0)
+// Update provides a mock function with given fields: id, version, changes, meta
+func (_m *TeacherRepository) Update(id uint, version uint, changes map[string]interface{}, meta audit.Meta) (*entity.Teacher, error) {
+	ret := _m.Called(id, version, changes, meta)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Update")
@@ -363,19 +489,19 @@ func (_m *TeacherRepository) Update(_a0 *entity.Teacher) (*entity.Teacher, error
 
 	var r0 *entity.Teacher
 	var r1 error
-	if rf, ok := ret.Get(0).(func(*entity.Teacher) (*entity.Teacher, error)); ok {
-		return rf(_a0)
+	if rf, ok := ret.Get(0).(func(uint, uint, map[string]interface{}, audit.Meta) (*entity.Teacher, error)); ok {
+		return rf(id, version, changes, meta)
 	}
-	if rf, ok := ret.Get(0).(func(*entity.Teacher) *entity.Teacher); ok {
-		r0 = rf(_a0)
+	if rf, ok := ret.Get(0).(func(uint, uint, map[string]interface{}, audit.Meta) *entity.Teacher); ok {
+		r0 = rf(id, version, changes, meta)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*entity.Teacher)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(*entity.Teacher) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(uint, uint, map[string]interface{}, audit.Meta) error); ok {
+		r1 = rf(id, version, changes, meta)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -389,14 +515,17 @@ type TeacherRepository_Update_Call struct {
 }
 
 // Update is a helper method to define mock.On call
-//   - _a0 *entity.Teacher
-func (_e *TeacherRepository_Expecter) Update(_a0 interface{}) *TeacherRepository_Update_Call {
-	return &TeacherRepository_Update_Call{Call: _e.mock.On("Update", _a0)}
+//   - id uint
+//   - version uint
+//   - changes map[string]interface{}
+//   - meta audit.Meta
+func (_e *TeacherRepository_Expecter) Update(id interface{}, version interface{}, changes interface{}, meta interface{}) *TeacherRepository_Update_Call {
+	return &TeacherRepository_Update_Call{Call: _e.mock.On("Update", id, version, changes, meta)}
 }
 
-func (_c *TeacherRepository_Update_Call) Run(run func(_a0 *entity.Teacher)) *TeacherRepository_Update_Call {
+func (_c *TeacherRepository_Update_Call) Run(run func(id uint, version uint, changes map[string]interface{}, meta audit.Meta)) *TeacherRepository_Update_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(*entity.Teacher))
+		run(args[0].(uint), args[1].(uint), args[2].(map[string]interface{}), args[3].(audit.Meta))
 	})
 	return _c
 }
@@ -406,7 +535,7 @@ func (_c *TeacherRepository_Update_Call) Return(_a0 *entity.Teacher, _a1 error)
 	return _c
 }
 
-func (_c *TeacherRepository_Update_Call) RunAndReturn(run func(*entity.Teacher) (*entity.Teacher, error)) *TeacherRepository_Update_Call {
+func (_c *TeacherRepository_Update_Call) RunAndReturn(run func(uint, uint, map[string]interface{}, audit.Meta) (*entity.Teacher, error)) *TeacherRepository_Update_Call {
 	_c.Call.Return(run)
 	return _c
 }
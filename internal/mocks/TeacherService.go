@@ -3,10 +3,18 @@
 package mocks
 
 import (
-	request "student_go/internal/dto/request"
+	audit "student_go/internal/audit"
 
 	mock "github.com/stretchr/testify/mock"
 
+	pagination "student_go/pkg/pagination"
+
+	patch "student_go/pkg/patch"
+
+	query "student_go/pkg/query"
+
+	request "student_go/internal/dto/request"
+
 	response "student_go/internal/dto/response"
 )
 
@@ -23,9 +31,9 @@ func (_m *TeacherServiceMock) EXPECT() *TeacherServiceMock_Expecter {
 	return &TeacherServiceMock_Expecter{mock: &_m.Mock}
 }
 
-// Count provides a mock function with no fields
-func (_m *TeacherServiceMock) Count() (int, error) {
-	ret := _m.Called()
+// Count provides a mock function with given fields: spec
+func (_m *TeacherServiceMock) Count(spec query.Spec) (int, error) {
+	ret := _m.Called(spec)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Count")
@@ -33,17 +41,17 @@ func (_m *TeacherServiceMock) Count() (int, error) {
 
 	var r0 int
 	var r1 error
-	if rf, ok := ret.Get(0).(func() (int, error)); ok {
-		return rf()
+	if rf, ok := ret.Get(0).(func(query.Spec) (int, error)); ok {
+		return rf(spec)
 	}
-	if rf, ok := ret.Get(0).(func() int); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(query.Spec) int); ok {
+		r0 = rf(spec)
 	} else {
 		r0 = ret.Get(0).(int)
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(query.Spec) error); ok {
+		r1 = rf(spec)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -57,13 +65,14 @@ type TeacherServiceMock_Count_Call struct {
 }
 
 // Count is a helper method to define mock.On call
-func (_e *TeacherServiceMock_Expecter) Count() *TeacherServiceMock_Count_Call {
-	return &TeacherServiceMock_Count_Call{Call: _e.mock.On("Count")}
+//   - spec query.Spec
+func (_e *TeacherServiceMock_Expecter) Count(spec interface{}) *TeacherServiceMock_Count_Call {
+	return &TeacherServiceMock_Count_Call{Call: _e.mock.On("Count", spec)}
 }
 
-func (_c *TeacherServiceMock_Count_Call) Run(run func()) *TeacherServiceMock_Count_Call {
+func (_c *TeacherServiceMock_Count_Call) Run(run func(spec query.Spec)) *TeacherServiceMock_Count_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
+		run(args[0].(query.Spec))
 	})
 	return _c
 }
@@ -73,14 +82,14 @@ func (_c *TeacherServiceMock_Count_Call) Return(_a0 int, _a1 error) *TeacherServ
 	return _c
 }
 
-func (_c *TeacherServiceMock_Count_Call) RunAndReturn(run func() (int, error)) *TeacherServiceMock_Count_Call {
+func (_c *TeacherServiceMock_Count_Call) RunAndReturn(run func(query.Spec) (int, error)) *TeacherServiceMock_Count_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateTeacher provides a mock function with given fields: input
-func (_m *TeacherServiceMock) CreateTeacher(input request.TeacherRequest) (*response.TeacherResponse, error) {
-	ret := _m.Called(input)
+// CreateTeacher provides a mock function with given fields: meta, input
+func (_m *TeacherServiceMock) CreateTeacher(meta audit.Meta, input request.TeacherRequest) (*response.TeacherResponse, error) {
+	ret := _m.Called(meta, input)
 
 	if len(ret) == 0 {
 		panic("no return value specified for CreateTeacher")
@@ -88,19 +97,19 @@ func (_m *TeacherServiceMock) CreateTeacher(input request.TeacherRequest) (*resp
 
 	var r0 *response.TeacherResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(request.TeacherRequest) (*response.TeacherResponse, error)); ok {
-		return rf(input)
+	if rf, ok := ret.Get(0).(func(audit.Meta, request.TeacherRequest) (*response.TeacherResponse, error)); ok {
+		return rf(meta, input)
 	}
-	if rf, ok := ret.Get(0).(func(request.TeacherRequest) *response.TeacherResponse); ok {
-		r0 = rf(input)
+	if rf, ok := ret.Get(0).(func(audit.Meta, request.TeacherRequest) *response.TeacherResponse); ok {
+		r0 = rf(meta, input)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*response.TeacherResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(request.TeacherRequest) error); ok {
-		r1 = rf(input)
+	if rf, ok := ret.Get(1).(func(audit.Meta, request.TeacherRequest) error); ok {
+		r1 = rf(meta, input)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -114,14 +123,15 @@ type TeacherServiceMock_CreateTeacher_Call struct {
 }
 
 // CreateTeacher is a helper method to define mock.On call
+//   - meta audit.Meta
 //   - input request.TeacherRequest
-func (_e *TeacherServiceMock_Expecter) CreateTeacher(input interface{}) *TeacherServiceMock_CreateTeacher_Call {
-	return &TeacherServiceMock_CreateTeacher_Call{Call: _e.mock.On("CreateTeacher", input)}
+func (_e *TeacherServiceMock_Expecter) CreateTeacher(meta interface{}, input interface{}) *TeacherServiceMock_CreateTeacher_Call {
+	return &TeacherServiceMock_CreateTeacher_Call{Call: _e.mock.On("CreateTeacher", meta, input)}
 }
 
-func (_c *TeacherServiceMock_CreateTeacher_Call) Run(run func(input request.TeacherRequest)) *TeacherServiceMock_CreateTeacher_Call {
+func (_c *TeacherServiceMock_CreateTeacher_Call) Run(run func(meta audit.Meta, input request.TeacherRequest)) *TeacherServiceMock_CreateTeacher_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(request.TeacherRequest))
+		run(args[0].(audit.Meta), args[1].(request.TeacherRequest))
 	})
 	return _c
 }
@@ -131,22 +141,22 @@ func (_c *TeacherServiceMock_CreateTeacher_Call) Return(_a0 *response.TeacherRes
 	return _c
 }
 
-func (_c *TeacherServiceMock_CreateTeacher_Call) RunAndReturn(run func(request.TeacherRequest) (*response.TeacherResponse, error)) *TeacherServiceMock_CreateTeacher_Call {
+func (_c *TeacherServiceMock_CreateTeacher_Call) RunAndReturn(run func(audit.Meta, request.TeacherRequest) (*response.TeacherResponse, error)) *TeacherServiceMock_CreateTeacher_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteTeacherById provides a mock function with given fields: id
-func (_m *TeacherServiceMock) DeleteTeacherById(id uint) error {
-	ret := _m.Called(id)
+// DeleteTeacherById provides a mock function with given fields: id, version, meta
+func (_m *TeacherServiceMock) DeleteTeacherById(id uint, version uint, meta audit.Meta) error {
+	ret := _m.Called(id, version, meta)
 
 	if len(ret) == 0 {
 		panic("no return value specified for DeleteTeacherById")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(uint) error); ok {
-		r0 = rf(id)
+	if rf, ok := ret.Get(0).(func(uint, uint, audit.Meta) error); ok {
+		r0 = rf(id, version, meta)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -161,13 +171,15 @@ type TeacherServiceMock_DeleteTeacherById_Call struct {
 
 // DeleteTeacherById is a helper method to define mock.On call
 //   - id uint
-func (_e *TeacherServiceMock_Expecter) DeleteTeacherById(id interface{}) *TeacherServiceMock_DeleteTeacherById_Call {
-	return &TeacherServiceMock_DeleteTeacherById_Call{Call: _e.mock.On("DeleteTeacherById", id)}
+//   - version uint
+//   - meta audit.Meta
+func (_e *TeacherServiceMock_Expecter) DeleteTeacherById(id interface{}, version interface{}, meta interface{}) *TeacherServiceMock_DeleteTeacherById_Call {
+	return &TeacherServiceMock_DeleteTeacherById_Call{Call: _e.mock.On("DeleteTeacherById", id, version, meta)}
 }
 
-func (_c *TeacherServiceMock_DeleteTeacherById_Call) Run(run func(id uint)) *TeacherServiceMock_DeleteTeacherById_Call {
+func (_c *TeacherServiceMock_DeleteTeacherById_Call) Run(run func(id uint, version uint, meta audit.Meta)) *TeacherServiceMock_DeleteTeacherById_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(uint))
+		run(args[0].(uint), args[1].(uint), args[2].(audit.Meta))
 	})
 	return _c
 }
@@ -177,14 +189,14 @@ func (_c *TeacherServiceMock_DeleteTeacherById_Call) Return(_a0 error) *TeacherS
 	return _c
 }
 
-func (_c *TeacherServiceMock_DeleteTeacherById_Call) RunAndReturn(run func(uint) error) *TeacherServiceMock_DeleteTeacherById_Call {
+func (_c *TeacherServiceMock_DeleteTeacherById_Call) RunAndReturn(run func(uint, uint, audit.Meta) error) *TeacherServiceMock_DeleteTeacherById_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// FindAllTeachers provides a mock function with given fields: page, limit
-func (_m *TeacherServiceMock) FindAllTeachers(page int, limit int) ([]*response.TeacherResponse, error) {
-	ret := _m.Called(page, limit)
+// FindAllTeachers provides a mock function with given fields: page, limit, spec
+func (_m *TeacherServiceMock) FindAllTeachers(page int, limit int, spec query.Spec) ([]*response.TeacherResponse, error) {
+	ret := _m.Called(page, limit, spec)
 
 	if len(ret) == 0 {
 		panic("no return value specified for FindAllTeachers")
@@ -192,19 +204,19 @@ func (_m *TeacherServiceMock) FindAllTeachers(page int, limit int) ([]*response.
 
 	var r0 []*response.TeacherResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int, int) ([]*response.TeacherResponse, error)); ok {
-		return rf(page, limit)
+	if rf, ok := ret.Get(0).(func(int, int, query.Spec) ([]*response.TeacherResponse, error)); ok {
+		return rf(page, limit, spec)
 	}
-	if rf, ok := ret.Get(0).(func(int, int) []*response.TeacherResponse); ok {
-		r0 = rf(page, limit)
+	if rf, ok := ret.Get(0).(func(int, int, query.Spec) []*response.TeacherResponse); ok {
+		r0 = rf(page, limit, spec)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*response.TeacherResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(int, int) error); ok {
-		r1 = rf(page, limit)
+	if rf, ok := ret.Get(1).(func(int, int, query.Spec) error); ok {
+		r1 = rf(page, limit, spec)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -220,13 +232,14 @@ type TeacherServiceMock_FindAllTeachers_Call struct {
 // FindAllTeachers is a helper method to define mock.On call
 //   - page int
 //   - limit int
-func (_e *TeacherServiceMock_Expecter) FindAllTeachers(page interface{}, limit interface{}) *TeacherServiceMock_FindAllTeachers_Call {
-	return &TeacherServiceMock_FindAllTeachers_Call{Call: _e.mock.On("FindAllTeachers", page, limit)}
+//   - spec query.Spec
+func (_e *TeacherServiceMock_Expecter) FindAllTeachers(page interface{}, limit interface{}, spec interface{}) *TeacherServiceMock_FindAllTeachers_Call {
+	return &TeacherServiceMock_FindAllTeachers_Call{Call: _e.mock.On("FindAllTeachers", page, limit, spec)}
 }
 
-func (_c *TeacherServiceMock_FindAllTeachers_Call) Run(run func(page int, limit int)) *TeacherServiceMock_FindAllTeachers_Call {
+func (_c *TeacherServiceMock_FindAllTeachers_Call) Run(run func(page int, limit int, spec query.Spec)) *TeacherServiceMock_FindAllTeachers_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(int), args[1].(int))
+		run(args[0].(int), args[1].(int), args[2].(query.Spec))
 	})
 	return _c
 }
@@ -236,7 +249,66 @@ func (_c *TeacherServiceMock_FindAllTeachers_Call) Return(_a0 []*response.Teache
 	return _c
 }
 
-func (_c *TeacherServiceMock_FindAllTeachers_Call) RunAndReturn(run func(int, int) ([]*response.TeacherResponse, error)) *TeacherServiceMock_FindAllTeachers_Call {
+func (_c *TeacherServiceMock_FindAllTeachers_Call) RunAndReturn(run func(int, int, query.Spec) ([]*response.TeacherResponse, error)) *TeacherServiceMock_FindAllTeachers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindAllTeachersByCursor provides a mock function with given fields: keyset, spec
+func (_m *TeacherServiceMock) FindAllTeachersByCursor(keyset pagination.Keyset, spec query.Spec) (*pagination.CursorPage, error) {
+	ret := _m.Called(keyset, spec)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindAllTeachersByCursor")
+	}
+
+	var r0 *pagination.CursorPage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(pagination.Keyset, query.Spec) (*pagination.CursorPage, error)); ok {
+		return rf(keyset, spec)
+	}
+	if rf, ok := ret.Get(0).(func(pagination.Keyset, query.Spec) *pagination.CursorPage); ok {
+		r0 = rf(keyset, spec)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pagination.CursorPage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(pagination.Keyset, query.Spec) error); ok {
+		r1 = rf(keyset, spec)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TeacherServiceMock_FindAllTeachersByCursor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindAllTeachersByCursor'
+type TeacherServiceMock_FindAllTeachersByCursor_Call struct {
+	*mock.Call
+}
+
+// FindAllTeachersByCursor is a helper method to define mock.On call
+//   - keyset pagination.Keyset
+//   - spec query.Spec
+func (_e *TeacherServiceMock_Expecter) FindAllTeachersByCursor(keyset interface{}, spec interface{}) *TeacherServiceMock_FindAllTeachersByCursor_Call {
+	return &TeacherServiceMock_FindAllTeachersByCursor_Call{Call: _e.mock.On("FindAllTeachersByCursor", keyset, spec)}
+}
+
+func (_c *TeacherServiceMock_FindAllTeachersByCursor_Call) Run(run func(keyset pagination.Keyset, spec query.Spec)) *TeacherServiceMock_FindAllTeachersByCursor_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(pagination.Keyset), args[1].(query.Spec))
+	})
+	return _c
+}
+
+func (_c *TeacherServiceMock_FindAllTeachersByCursor_Call) Return(_a0 *pagination.CursorPage, _a1 error) *TeacherServiceMock_FindAllTeachersByCursor_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TeacherServiceMock_FindAllTeachersByCursor_Call) RunAndReturn(run func(pagination.Keyset, query.Spec) (*pagination.CursorPage, error)) *TeacherServiceMock_FindAllTeachersByCursor_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -299,9 +371,68 @@ func (_c *TeacherServiceMock_FindTeacherById_Call) RunAndReturn(run func(uint) (
 	return _c
 }
 
-// UpdateTeacher provides a mock function with given fields: id, input
-func (_m *TeacherServiceMock) UpdateTeacher(id uint, input request.TeacherRequest) (*response.TeacherResponse, error) {
-	ret := _m.Called(id, input)
+// RestoreTeacher provides a mock function with given fields: id, meta
+func (_m *TeacherServiceMock) RestoreTeacher(id uint, meta audit.Meta) (*response.TeacherResponse, error) {
+	ret := _m.Called(id, meta)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestoreTeacher")
+	}
+
+	var r0 *response.TeacherResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, audit.Meta) (*response.TeacherResponse, error)); ok {
+		return rf(id, meta)
+	}
+	if rf, ok := ret.Get(0).(func(uint, audit.Meta) *response.TeacherResponse); ok {
+		r0 = rf(id, meta)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*response.TeacherResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, audit.Meta) error); ok {
+		r1 = rf(id, meta)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TeacherServiceMock_RestoreTeacher_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreTeacher'
+type TeacherServiceMock_RestoreTeacher_Call struct {
+	*mock.Call
+}
+
+// RestoreTeacher is a helper method to define mock.On call
+//   - id uint
+//   - meta audit.Meta
+func (_e *TeacherServiceMock_Expecter) RestoreTeacher(id interface{}, meta interface{}) *TeacherServiceMock_RestoreTeacher_Call {
+	return &TeacherServiceMock_RestoreTeacher_Call{Call: _e.mock.On("RestoreTeacher", id, meta)}
+}
+
+func (_c *TeacherServiceMock_RestoreTeacher_Call) Run(run func(id uint, meta audit.Meta)) *TeacherServiceMock_RestoreTeacher_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uint), args[1].(audit.Meta))
+	})
+	return _c
+}
+
+func (_c *TeacherServiceMock_RestoreTeacher_Call) Return(_a0 *response.TeacherResponse, _a1 error) *TeacherServiceMock_RestoreTeacher_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TeacherServiceMock_RestoreTeacher_Call) RunAndReturn(run func(uint, audit.Meta) (*response.TeacherResponse, error)) *TeacherServiceMock_RestoreTeacher_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateTeacher provides a mock function with given fields: id, version, meta, input, fields
+func (_m *TeacherServiceMock) UpdateTeacher(id uint, version uint, meta audit.Meta, input request.TeacherPatch, fields patch.Fields) (*response.TeacherResponse, error) {
+	ret := _m.Called(id, version, meta, input, fields)
 
 	if len(ret) == 0 {
 		panic("no return value specified for UpdateTeacher")
@@ -309,19 +440,19 @@ func (_m *TeacherServiceMock) UpdateTeacher(id uint, input request.TeacherReques
 
 	var r0 *response.TeacherResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(uint, request.TeacherRequest) (*response.TeacherResponse, error)); ok {
-		return rf(id, input)
+	if rf, ok := ret.Get(0).(func(uint, uint, audit.Meta, request.TeacherPatch, patch.Fields) (*response.TeacherResponse, error)); ok {
+		return rf(id, version, meta, input, fields)
 	}
-	if rf, ok := ret.Get(0).(func(uint, request.TeacherRequest) *response.TeacherResponse); ok {
-		r0 = rf(id, input)
+	if rf, ok := ret.Get(0).(func(uint, uint, audit.Meta, request.TeacherPatch, patch.Fields) *response.TeacherResponse); ok {
+		r0 = rf(id, version, meta, input, fields)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*response.TeacherResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(uint, request.TeacherRequest) error); ok {
-		r1 = rf(id, input)
+	if rf, ok := ret.Get(1).(func(uint, uint, audit.Meta, request.TeacherPatch, patch.Fields) error); ok {
+		r1 = rf(id, version, meta, input, fields)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -336,14 +467,17 @@ type TeacherServiceMock_UpdateTeacher_Call struct {
 
 // UpdateTeacher is a helper method to define mock.On call
 //   - id uint
-//   - input request.TeacherRequest
-func (_e *TeacherServiceMock_Expecter) UpdateTeacher(id interface{}, input interface{}) *TeacherServiceMock_UpdateTeacher_Call {
-	return &TeacherServiceMock_UpdateTeacher_Call{Call: _e.mock.On("UpdateTeacher", id, input)}
+//   - version uint
+//   - meta audit.Meta
+//   - input request.TeacherPatch
+//   - fields patch.Fields
+func (_e *TeacherServiceMock_Expecter) UpdateTeacher(id interface{}, version interface{}, meta interface{}, input interface{}, fields interface{}) *TeacherServiceMock_UpdateTeacher_Call {
+	return &TeacherServiceMock_UpdateTeacher_Call{Call: _e.mock.On("UpdateTeacher", id, version, meta, input, fields)}
 }
 
-func (_c *TeacherServiceMock_UpdateTeacher_Call) Run(run func(id uint, input request.TeacherRequest)) *TeacherServiceMock_UpdateTeacher_Call {
+func (_c *TeacherServiceMock_UpdateTeacher_Call) Run(run func(id uint, version uint, meta audit.Meta, input request.TeacherPatch, fields patch.Fields)) *TeacherServiceMock_UpdateTeacher_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(uint), args[1].(request.TeacherRequest))
+		run(args[0].(uint), args[1].(uint), args[2].(audit.Meta), args[3].(request.TeacherPatch), args[4].(patch.Fields))
 	})
 	return _c
 }
@@ -353,7 +487,7 @@ func (_c *TeacherServiceMock_UpdateTeacher_Call) Return(_a0 *response.TeacherRes
 	return _c
 }
 
-func (_c *TeacherServiceMock_UpdateTeacher_Call) RunAndReturn(run func(uint, request.TeacherRequest) (*response.TeacherResponse, error)) *TeacherServiceMock_UpdateTeacher_Call {
+func (_c *TeacherServiceMock_UpdateTeacher_Call) RunAndReturn(run func(uint, uint, audit.Meta, request.TeacherPatch, patch.Fields) (*response.TeacherResponse, error)) *TeacherServiceMock_UpdateTeacher_Call {
 	_c.Call.Return(run)
 	return _c
 }
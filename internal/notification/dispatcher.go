@@ -0,0 +1,135 @@
+package notification
+
+import (
+	"context"
+	"go.uber.org/zap"
+	"student_go/internal/config"
+	"student_go/pkg/log"
+	"time"
+)
+
+// Dispatcher periodically sends due notifications from the outbox and
+// reschedules failed ones with exponential backoff.
+type Dispatcher struct {
+	repo         Repository
+	sender       Sender
+	from         string
+	pollInterval time.Duration
+	batchSize    int
+	maxAttempts  int
+	baseBackoff  time.Duration
+	maxBackoff   time.Duration
+	now          func() time.Time
+}
+
+func NewDispatcher(repo Repository, sender Sender) *Dispatcher {
+	conf := config.Config.Notification
+
+	d := &Dispatcher{
+		repo:         repo,
+		sender:       sender,
+		from:         conf.From,
+		pollInterval: conf.PollInterval,
+		batchSize:    conf.BatchSize,
+		maxAttempts:  conf.MaxAttempts,
+		baseBackoff:  conf.BaseBackoff,
+		maxBackoff:   conf.MaxBackoff,
+		now:          time.Now,
+	}
+	if d.pollInterval <= 0 {
+		d.pollInterval = 5 * time.Second
+	}
+	if d.batchSize <= 0 {
+		d.batchSize = 20
+	}
+	if d.maxAttempts <= 0 {
+		d.maxAttempts = 8
+	}
+	if d.baseBackoff <= 0 {
+		d.baseBackoff = 30 * time.Second
+	}
+	if d.maxBackoff <= 0 {
+		d.maxBackoff = time.Hour
+	}
+
+	return d
+}
+
+// Run dispatches notifications until ctx is cancelled.
+func (d *Dispatcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(d.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := d.DispatchDue(); err != nil {
+			log.Log.Error("Failed to dispatch notifications", zap.Error(err))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// DispatchDue sends one batch of due notifications and returns how many
+// were delivered.
+func (d *Dispatcher) DispatchDue() (int, error) {
+	now := d.now()
+
+	// The lease only has to outlive one batch of sends.
+	notifications, err := d.repo.ClaimDue(now, d.maxBackoff, d.batchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	sent := 0
+	for _, n := range notifications {
+		err := d.sender.Send(Message{
+			ID:      n.ID,
+			From:    d.from,
+			To:      n.Recipient,
+			Subject: n.Subject,
+			Body:    n.Body,
+		})
+		if err == nil {
+			sent++
+			if err := d.repo.MarkSent(n.ID, d.now()); err != nil {
+				log.Log.Error("Failed to mark notification as sent", zap.Uint("id", n.ID), zap.Error(err))
+			}
+			continue
+		}
+
+		log.Log.Warn("Failed to send notification",
+			zap.Uint("id", n.ID),
+			zap.Int("attempts", n.Attempts),
+			zap.Error(err),
+		)
+
+		if n.Attempts >= d.maxAttempts {
+			err = d.repo.MarkFailed(n.ID, err.Error())
+		} else {
+			err = d.repo.MarkRetry(n.ID, d.now().Add(d.Backoff(n.Attempts)), err.Error())
+		}
+		if err != nil {
+			log.Log.Error("Failed to reschedule notification", zap.Uint("id", n.ID), zap.Error(err))
+		}
+	}
+
+	return sent, nil
+}
+
+// Backoff returns the delay before the next attempt after the given number
+// of failed attempts: base, 2*base, 4*base, ... capped at the max backoff.
+func (d *Dispatcher) Backoff(attempts int) time.Duration {
+	delay := d.baseBackoff
+	for i := 1; i < attempts; i++ {
+		delay *= 2
+		if delay >= d.maxBackoff {
+			return d.maxBackoff
+		}
+	}
+
+	return delay
+}
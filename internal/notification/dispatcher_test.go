@@ -0,0 +1,135 @@
+package notification
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+	"student_go/internal/entity"
+)
+
+// fakeRepository records what the dispatcher does with claimed
+// notifications.
+type fakeRepository struct {
+	saved   []entity.Notification
+	due     []entity.Notification
+	claimed []time.Time
+	sent    map[uint]time.Time
+	retry   map[uint]time.Time
+	failed  map[uint]string
+	err     error
+}
+
+func newFakeRepository(due ...entity.Notification) *fakeRepository {
+	return &fakeRepository{
+		due:    due,
+		sent:   map[uint]time.Time{},
+		retry:  map[uint]time.Time{},
+		failed: map[uint]string{},
+	}
+}
+
+func (f *fakeRepository) Save(tx *gorm.DB, n *entity.Notification) (*entity.Notification, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	f.saved = append(f.saved, *n)
+	return n, nil
+}
+
+func (f *fakeRepository) ClaimDue(now time.Time, lease time.Duration, limit int) ([]entity.Notification, error) {
+	f.claimed = append(f.claimed, now.Add(lease))
+	return f.due, f.err
+}
+
+func (f *fakeRepository) MarkSent(id uint, sentAt time.Time) error {
+	f.sent[id] = sentAt
+	return nil
+}
+
+func (f *fakeRepository) MarkRetry(id uint, nextAttemptAt time.Time, lastError string) error {
+	f.retry[id] = nextAttemptAt
+	return nil
+}
+
+func (f *fakeRepository) MarkFailed(id uint, lastError string) error {
+	f.failed[id] = lastError
+	return nil
+}
+
+type fakeSender struct {
+	sent []Message
+	err  error
+}
+
+func (f *fakeSender) Send(msg Message) error {
+	if f.err != nil {
+		return f.err
+	}
+	f.sent = append(f.sent, msg)
+	return nil
+}
+
+func newTestDispatcher(repo Repository, sender Sender, now time.Time) *Dispatcher {
+	return &Dispatcher{
+		repo:        repo,
+		sender:      sender,
+		from:        "noreply@example.com",
+		batchSize:   10,
+		maxAttempts: 3,
+		baseBackoff: time.Minute,
+		maxBackoff:  time.Hour,
+		now:         func() time.Time { return now },
+	}
+}
+
+func TestDispatchDue_Sent(t *testing.T) {
+	now := time.Date(2025, 9, 1, 12, 0, 0, 0, time.UTC)
+	repo := newFakeRepository(entity.Notification{ID: 1, Recipient: "bob@example.com", Subject: "Hi", Body: "Hello", Attempts: 1})
+	sender := &fakeSender{}
+
+	sent, err := newTestDispatcher(repo, sender, now).DispatchDue()
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, sent)
+	assert.Equal(t, []time.Time{now.Add(time.Hour)}, repo.claimed)
+	assert.Len(t, sender.sent, 1)
+	assert.Equal(t, "noreply@example.com", sender.sent[0].From)
+	assert.Equal(t, now, repo.sent[1])
+}
+
+func TestDispatchDue_Retry(t *testing.T) {
+	now := time.Date(2025, 9, 1, 12, 0, 0, 0, time.UTC)
+	repo := newFakeRepository(entity.Notification{ID: 2, Recipient: "bob@example.com", Attempts: 2})
+	sender := &fakeSender{err: errors.New("connection refused")}
+
+	sent, err := newTestDispatcher(repo, sender, now).DispatchDue()
+
+	assert.NoError(t, err)
+	assert.Equal(t, 0, sent)
+	assert.Equal(t, now.Add(2*time.Minute), repo.retry[2])
+	assert.Empty(t, repo.failed)
+}
+
+func TestDispatchDue_GivesUp(t *testing.T) {
+	now := time.Date(2025, 9, 1, 12, 0, 0, 0, time.UTC)
+	repo := newFakeRepository(entity.Notification{ID: 3, Recipient: "bob@example.com", Attempts: 3})
+	sender := &fakeSender{err: errors.New("mailbox unavailable")}
+
+	_, err := newTestDispatcher(repo, sender, now).DispatchDue()
+
+	assert.NoError(t, err)
+	assert.Equal(t, "mailbox unavailable", repo.failed[3])
+	assert.Empty(t, repo.retry)
+}
+
+func TestBackoff(t *testing.T) {
+	d := newTestDispatcher(nil, nil, time.Now())
+
+	assert.Equal(t, time.Minute, d.Backoff(1))
+	assert.Equal(t, 2*time.Minute, d.Backoff(2))
+	assert.Equal(t, 4*time.Minute, d.Backoff(3))
+	assert.Equal(t, time.Hour, d.Backoff(10))
+}
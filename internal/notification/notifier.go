@@ -0,0 +1,60 @@
+package notification
+
+import (
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"student_go/internal/entity"
+	"student_go/pkg/log"
+	"time"
+)
+
+type Event string
+
+const (
+	EventEnrollment      Event = "enrollment"
+	EventTeacherAssigned Event = "teacher_assigned"
+)
+
+type Recipient struct {
+	Email  string
+	Locale string
+}
+
+// Notifier renders a message for an event and stores it in the outbox in
+// the transaction of the change; the Dispatcher delivers it later.
+type Notifier interface {
+	Notify(tx *gorm.DB, event Event, to Recipient, data map[string]interface{}) error
+}
+
+type notifier struct {
+	repo      Repository
+	templates *Templates
+}
+
+func NewNotifier(repo Repository, templates *Templates) Notifier {
+	return &notifier{
+		repo:      repo,
+		templates: templates,
+	}
+}
+
+func (n *notifier) Notify(tx *gorm.DB, event Event, to Recipient, data map[string]interface{}) error {
+	log.Log.Info("Notify called", zap.String("event", string(event)), zap.String("recipient", to.Email))
+
+	rendered, err := n.templates.Render(event, to.Locale, data)
+	if err != nil {
+		return err
+	}
+
+	_, err = n.repo.Save(tx, &entity.Notification{
+		Event:         string(event),
+		Recipient:     to.Email,
+		Locale:        rendered.Locale,
+		Subject:       rendered.Subject,
+		Body:          rendered.Body,
+		Status:        entity.NotificationPending,
+		NextAttemptAt: time.Now(),
+	})
+
+	return err
+}
@@ -0,0 +1,52 @@
+package notification
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"student_go/internal/entity"
+	"student_go/pkg/log"
+)
+
+func init() {
+	logger, _ := zap.NewDevelopment()
+	log.Log = logger
+}
+
+func TestNotify(t *testing.T) {
+	templates, err := LoadTemplates("en")
+	require.NoError(t, err)
+
+	repo := newFakeRepository()
+
+	notifier := NewNotifier(repo, templates)
+	err = notifier.Notify(nil, EventTeacherAssigned,
+		Recipient{Email: "euler@example.com", Locale: "ru"},
+		map[string]interface{}{"TeacherName": "Euler", "CourseTitle": "Math"},
+	)
+
+	require.NoError(t, err)
+	require.Len(t, repo.saved, 1)
+	saved := repo.saved[0]
+	assert.Equal(t, "teacher_assigned", saved.Event)
+	assert.Equal(t, "euler@example.com", saved.Recipient)
+	assert.Equal(t, "ru", saved.Locale)
+	assert.Equal(t, entity.NotificationPending, saved.Status)
+	assert.Equal(t, "Вы назначены преподавателем курса Math", saved.Subject)
+}
+
+func TestNotify_SaveError(t *testing.T) {
+	templates, err := LoadTemplates("en")
+	require.NoError(t, err)
+
+	repo := newFakeRepository()
+	repo.err = errors.New("db error")
+
+	notifier := NewNotifier(repo, templates)
+	err = notifier.Notify(nil, EventEnrollment, Recipient{Email: "bob@example.com"}, nil)
+
+	assert.EqualError(t, err, "db error")
+}
@@ -0,0 +1,85 @@
+package notification
+
+import (
+	"gorm.io/gorm"
+	"student_go/internal/entity"
+	"student_go/pkg/dbcontext"
+	"time"
+)
+
+type Repository interface {
+	// Save inserts the notification in tx, so that it is only queued when
+	// the change it announces commits.
+	Save(tx *gorm.DB, notification *entity.Notification) (*entity.Notification, error)
+	ClaimDue(now time.Time, lease time.Duration, limit int) ([]entity.Notification, error)
+	MarkSent(id uint, sentAt time.Time) error
+	MarkRetry(id uint, nextAttemptAt time.Time, lastError string) error
+	MarkFailed(id uint, lastError string) error
+}
+
+type repository struct{}
+
+func NewNotificationRepository() Repository {
+	return &repository{}
+}
+
+func (r *repository) Save(tx *gorm.DB, notification *entity.Notification) (*entity.Notification, error) {
+	err := tx.Create(notification).Error
+	return notification, err
+}
+
+// ClaimDue picks up to limit pending notifications whose time has come and
+// pushes their next attempt forward by lease, so that concurrent
+// dispatchers skip them. A dispatcher that dies mid-send leaves the
+// notification to be picked up again once the lease expires.
+func (r *repository) ClaimDue(now time.Time, lease time.Duration, limit int) ([]entity.Notification, error) {
+	var notifications []entity.Notification
+
+	err := dbcontext.DB.Raw(`
+		UPDATE notifications
+		SET next_attempt_at = ?, attempts = attempts + 1
+		WHERE id IN (
+			SELECT id FROM notifications
+			WHERE status = ? AND next_attempt_at <= ?
+			ORDER BY next_attempt_at
+			LIMIT ?
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING *`,
+		now.Add(lease), entity.NotificationPending, now, limit,
+	).Scan(&notifications).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return notifications, nil
+}
+
+func (r *repository) MarkSent(id uint, sentAt time.Time) error {
+	return dbcontext.DB.Model(&entity.Notification{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"status":     entity.NotificationSent,
+			"sent_at":    sentAt,
+			"last_error": "",
+		}).Error
+}
+
+func (r *repository) MarkRetry(id uint, nextAttemptAt time.Time, lastError string) error {
+	return dbcontext.DB.Model(&entity.Notification{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"next_attempt_at": nextAttemptAt,
+			"last_error":      lastError,
+		}).Error
+}
+
+func (r *repository) MarkFailed(id uint, lastError string) error {
+	return dbcontext.DB.Model(&entity.Notification{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"status":     entity.NotificationFailed,
+			"last_error": lastError,
+		}).Error
+}
@@ -0,0 +1,72 @@
+package notification
+
+import (
+	"database/sql"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+
+	"student_go/pkg/dbcontext"
+)
+
+func setupTestDB(t *testing.T) (*sql.DB, sqlmock.Sqlmock, *gorm.DB) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+
+	dialector := postgres.New(postgres.Config{
+		Conn:                 db,
+		PreferSimpleProtocol: true,
+	})
+
+	gormDB, err := gorm.Open(dialector, &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	assert.NoError(t, err)
+
+	dbcontext.DB = gormDB
+	return db, mock, gormDB
+}
+
+func TestNotificationClaimDue(t *testing.T) {
+	db, mock, _ := setupTestDB(t)
+	defer db.Close()
+
+	now := time.Date(2025, 9, 1, 12, 0, 0, 0, time.UTC)
+
+	mock.ExpectQuery(`UPDATE notifications\s+SET next_attempt_at = \$1, attempts = attempts \+ 1\s+WHERE id IN \(.*FOR UPDATE SKIP LOCKED\s*\)\s+RETURNING \*`).
+		WithArgs(now.Add(time.Hour), "pending", now, 10).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "recipient", "attempts"}).
+			AddRow(1, "bob@example.com", 1))
+
+	repo := NewNotificationRepository()
+	notifications, err := repo.ClaimDue(now, time.Hour, 10)
+
+	require.NoError(t, err)
+	require.Len(t, notifications, 1)
+	assert.Equal(t, "bob@example.com", notifications[0].Recipient)
+	assert.Equal(t, 1, notifications[0].Attempts)
+}
+
+func TestNotificationMarkFailed(t *testing.T) {
+	db, mock, _ := setupTestDB(t)
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE "notifications" SET "last_error"=$1,"status"=$2 WHERE id = $3`)).
+		WithArgs("mailbox unavailable", "failed", 3).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	repo := NewNotificationRepository()
+	err := repo.MarkFailed(3, "mailbox unavailable")
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,104 @@
+package notification
+
+import (
+	"bytes"
+	"fmt"
+	"mime"
+	"net/smtp"
+	"os"
+	"path/filepath"
+	"student_go/internal/config"
+	"student_go/pkg/log"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+type Message struct {
+	ID      uint
+	From    string
+	To      string
+	Subject string
+	Body    string
+}
+
+// Sender delivers a single message. Returning an error makes the dispatcher
+// retry the message later.
+type Sender interface {
+	Send(msg Message) error
+}
+
+// NewSender builds the sender selected in the notification config.
+func NewSender() (Sender, error) {
+	conf := config.Config.Notification
+
+	switch conf.Sender {
+	case "smtp":
+		return &SMTPSender{
+			Host:     conf.SMTP.Host,
+			Port:     conf.SMTP.Port,
+			Username: conf.SMTP.Username,
+			Password: conf.SMTP.Password,
+		}, nil
+	case "file", "":
+		return &FileSender{Dir: conf.FileDir}, nil
+	}
+
+	return nil, fmt.Errorf("unknown notification sender %q", conf.Sender)
+}
+
+type SMTPSender struct {
+	Host     string
+	Port     int
+	Username string
+	Password string
+}
+
+func (s *SMTPSender) Send(msg Message) error {
+	var auth smtp.Auth
+	if s.Username != "" {
+		auth = smtp.PlainAuth("", s.Username, s.Password, s.Host)
+	}
+
+	addr := fmt.Sprintf("%s:%d", s.Host, s.Port)
+	return smtp.SendMail(addr, auth, msg.From, []string{msg.To}, buildMIME(msg))
+}
+
+// FileSender writes every message as an .eml file into Dir, or only logs it
+// when Dir is empty. It is meant for local development and tests.
+type FileSender struct {
+	Dir string
+}
+
+func (s *FileSender) Send(msg Message) error {
+	log.Log.Info("Notification sent",
+		zap.Uint("id", msg.ID),
+		zap.String("to", msg.To),
+		zap.String("subject", msg.Subject),
+	)
+
+	if s.Dir == "" {
+		return nil
+	}
+
+	if err := os.MkdirAll(s.Dir, 0o755); err != nil {
+		return err
+	}
+
+	name := fmt.Sprintf("%d-%d.eml", time.Now().UnixNano(), msg.ID)
+	return os.WriteFile(filepath.Join(s.Dir, name), buildMIME(msg), 0o644)
+}
+
+func buildMIME(msg Message) []byte {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "From: %s\r\n", msg.From)
+	fmt.Fprintf(&b, "To: %s\r\n", msg.To)
+	fmt.Fprintf(&b, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", msg.Subject))
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
+	b.WriteString("Content-Transfer-Encoding: 8bit\r\n")
+	b.WriteString("\r\n")
+	b.WriteString(msg.Body)
+
+	return b.Bytes()
+}
@@ -0,0 +1,104 @@
+package notification
+
+import (
+	"bufio"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileSender(t *testing.T) {
+	dir := t.TempDir()
+	sender := &FileSender{Dir: dir}
+
+	err := sender.Send(Message{ID: 7, From: "noreply@example.com", To: "bob@example.com", Subject: "Привет", Body: "Hello\n"})
+	require.NoError(t, err)
+
+	files, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+
+	content, err := os.ReadFile(filepath.Join(dir, files[0].Name()))
+	require.NoError(t, err)
+	assert.Contains(t, string(content), "To: bob@example.com\r\n")
+	assert.Contains(t, string(content), "Subject: =?utf-8?q?")
+	assert.True(t, strings.HasSuffix(string(content), "\r\n\r\nHello\n"))
+}
+
+// fakeSMTPServer accepts a single session and returns the DATA it received.
+func fakeSMTPServer(t *testing.T) (string, <-chan string) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	received := make(chan string, 1)
+	go func() {
+		defer ln.Close()
+		conn, err := ln.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		r := bufio.NewReader(conn)
+		write := func(s string) { conn.Write([]byte(s + "\r\n")) }
+		write("220 localhost ESMTP")
+
+		var data strings.Builder
+		inData := false
+		for {
+			line, err := r.ReadString('\n')
+			if err != nil {
+				return
+			}
+			if inData {
+				if line == ".\r\n" {
+					inData = false
+					received <- data.String()
+					write("250 OK")
+					continue
+				}
+				data.WriteString(line)
+				continue
+			}
+
+			switch cmd := strings.ToUpper(strings.TrimSpace(line)); {
+			case strings.HasPrefix(cmd, "EHLO"), strings.HasPrefix(cmd, "HELO"):
+				write("250 localhost")
+			case cmd == "DATA":
+				inData = true
+				write("354 Go ahead")
+			case cmd == "QUIT":
+				write("221 Bye")
+				return
+			default:
+				write("250 OK")
+			}
+		}
+	}()
+
+	return ln.Addr().String(), received
+}
+
+func TestSMTPSender(t *testing.T) {
+	addr, received := fakeSMTPServer(t)
+	host, port, err := net.SplitHostPort(addr)
+	require.NoError(t, err)
+
+	portNum, err := strconv.Atoi(port)
+	require.NoError(t, err)
+
+	sender := &SMTPSender{Host: host, Port: portNum}
+	err = sender.Send(Message{From: "noreply@example.com", To: "bob@example.com", Subject: "Hi", Body: "Hello\r\n"})
+	require.NoError(t, err)
+
+	data := <-received
+	assert.Contains(t, data, "To: bob@example.com")
+	assert.Contains(t, data, "Subject: Hi")
+	assert.Contains(t, data, "Hello")
+}
@@ -0,0 +1,107 @@
+package notification
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	"strings"
+	"text/template"
+)
+
+//go:embed templates
+var templateFS embed.FS
+
+// Templates renders messages from templates/<locale>/<event>.tmpl. Every
+// template defines a "subject" and a "body" block.
+type Templates struct {
+	defaultLocale string
+	byLocale      map[string]*template.Template
+}
+
+func LoadTemplates(defaultLocale string) (*Templates, error) {
+	locales, err := templateFS.ReadDir("templates")
+	if err != nil {
+		return nil, fmt.Errorf("read templates: %w", err)
+	}
+
+	t := &Templates{
+		defaultLocale: defaultLocale,
+		byLocale:      make(map[string]*template.Template),
+	}
+	for _, locale := range locales {
+		if !locale.IsDir() {
+			continue
+		}
+
+		files, err := templateFS.ReadDir("templates/" + locale.Name())
+		if err != nil {
+			return nil, fmt.Errorf("read %s templates: %w", locale.Name(), err)
+		}
+
+		root := template.New(locale.Name())
+		for _, file := range files {
+			event := strings.TrimSuffix(file.Name(), ".tmpl")
+			content, err := templateFS.ReadFile("templates/" + locale.Name() + "/" + file.Name())
+			if err != nil {
+				return nil, err
+			}
+			// Prefix the block names with the event so that all templates
+			// of a locale can share one namespace.
+			text := strings.NewReplacer(
+				`{{define "subject"}}`, `{{define "`+event+`.subject"}}`,
+				`{{define "body"}}`, `{{define "`+event+`.body"}}`,
+			).Replace(string(content))
+			if _, err := root.New(event).Parse(text); err != nil {
+				return nil, fmt.Errorf("parse %s/%s: %w", locale.Name(), file.Name(), err)
+			}
+		}
+		t.byLocale[locale.Name()] = root
+	}
+
+	if _, ok := t.byLocale[defaultLocale]; !ok {
+		return nil, fmt.Errorf("no templates for default locale %q", defaultLocale)
+	}
+
+	return t, nil
+}
+
+type Rendered struct {
+	Locale  string
+	Subject string
+	Body    string
+}
+
+// Render returns the subject and body of the event in the given locale,
+// falling back to the default locale when the event is not translated.
+func (t *Templates) Render(event Event, locale string, data interface{}) (*Rendered, error) {
+	if locale == "" || t.lookup(event, locale) == nil {
+		locale = t.defaultLocale
+	}
+	root := t.lookup(event, locale)
+	if root == nil {
+		return nil, fmt.Errorf("no template for event %q", event)
+	}
+
+	var subject, body bytes.Buffer
+	if err := root.ExecuteTemplate(&subject, string(event)+".subject", data); err != nil {
+		return nil, err
+	}
+	if err := root.ExecuteTemplate(&body, string(event)+".body", data); err != nil {
+		return nil, err
+	}
+
+	return &Rendered{
+		Locale:  locale,
+		Subject: strings.TrimSpace(subject.String()),
+		Body:    strings.TrimSpace(body.String()) + "\n",
+	}, nil
+}
+
+func (t *Templates) lookup(event Event, locale string) *template.Template {
+	root, ok := t.byLocale[locale]
+	if !ok || root.Lookup(string(event)+".subject") == nil {
+		return nil
+	}
+
+	return root
+}
@@ -0,0 +1,49 @@
+package notification
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadTemplates_UnknownDefaultLocale(t *testing.T) {
+	_, err := LoadTemplates("de")
+	assert.Error(t, err)
+}
+
+func TestRender(t *testing.T) {
+	templates, err := LoadTemplates("en")
+	require.NoError(t, err)
+
+	data := map[string]interface{}{"StudentName": "Анна", "CourseTitle": "Physics"}
+
+	tests := []struct {
+		name        string
+		locale      string
+		wantLocale  string
+		wantSubject string
+	}{
+		{"default locale", "", "en", "You are enrolled in Physics"},
+		{"russian", "ru", "ru", "Вы записаны на курс Physics"},
+		{"unknown locale falls back", "fr", "en", "You are enrolled in Physics"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rendered, err := templates.Render(EventEnrollment, tt.locale, data)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantLocale, rendered.Locale)
+			assert.Equal(t, tt.wantSubject, rendered.Subject)
+			assert.Contains(t, rendered.Body, "Анна")
+		})
+	}
+}
+
+func TestRender_UnknownEvent(t *testing.T) {
+	templates, err := LoadTemplates("en")
+	require.NoError(t, err)
+
+	_, err = templates.Render(Event("grade_posted"), "en", nil)
+	assert.Error(t, err)
+}
@@ -0,0 +1,351 @@
+package officehour
+
+import (
+	"errors"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+	"net/http"
+	"strconv"
+	"student_go/internal/actor"
+	"student_go/internal/calendar"
+	"student_go/internal/course"
+	"student_go/internal/dto/request"
+	"student_go/internal/student"
+	"student_go/internal/teacher"
+	"student_go/pkg/ical"
+	"student_go/pkg/log"
+	"student_go/pkg/pagination"
+	"time"
+)
+
+type Handler struct {
+	Service Service
+}
+
+func NewOfficeHourHandler() *Handler {
+	return &Handler{
+		Service: NewOfficeHourService(
+			NewOfficeHourRepository(),
+			course.NewCourseRepository(),
+			teacher.NewTeacherRepository(),
+			student.NewStudentRepository(),
+			calendar.NewCalendarRepository(),
+		),
+	}
+}
+
+func (h *Handler) CreateOfficeHour(c *gin.Context) {
+	teacherId, ok := parseID(c, "id", "invalid teacher ID")
+	if !ok {
+		return
+	}
+
+	a, err := actor.FromContext(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+
+	var req request.OfficeHourRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		log.Log.Warn("Invalid request in CreateOfficeHour", zap.Error(err))
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	log.Log.Info("CreateOfficeHour called", zap.Uint("teacher_id", teacherId))
+
+	officeHourResp, err := h.Service.CreateOfficeHour(teacherId, a, req)
+	if err != nil {
+		writeError(c, err, "failed to save office hour")
+		return
+	}
+
+	c.JSON(http.StatusCreated, officeHourResp)
+}
+
+func (h *Handler) FindOfficeHours(c *gin.Context) {
+	teacherId, ok := parseID(c, "id", "invalid teacher ID")
+	if !ok {
+		return
+	}
+
+	log.Log.Info("FindOfficeHours called", zap.Uint("teacher_id", teacherId))
+
+	officeHours, err := h.Service.FindOfficeHours(teacherId)
+	if err != nil {
+		writeError(c, err, "failed to get office hours")
+		return
+	}
+
+	c.JSON(http.StatusOK, officeHours)
+}
+
+func (h *Handler) DeleteOfficeHourById(c *gin.Context) {
+	teacherId, ok := parseID(c, "id", "invalid teacher ID")
+	if !ok {
+		return
+	}
+	id, ok := parseID(c, "officeHourId", "invalid office hour ID")
+	if !ok {
+		return
+	}
+
+	a, err := actor.FromContext(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+
+	log.Log.Info("DeleteOfficeHourById called", zap.Uint("teacher_id", teacherId), zap.Uint("id", id))
+
+	if err := h.Service.DeleteOfficeHourById(teacherId, id, a); err != nil {
+		writeError(c, err, "failed to delete office hour")
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// FindSlots lists free slots between the "from" and "to" query parameters
+// (RFC 3339). Without them it returns the next seven days.
+func (h *Handler) FindSlots(c *gin.Context) {
+	teacherId, ok := parseID(c, "id", "invalid teacher ID")
+	if !ok {
+		return
+	}
+
+	from := time.Now()
+	if value := c.Query("from"); value != "" {
+		parsed, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid from, expected RFC 3339"})
+			return
+		}
+		from = parsed
+	}
+	to := from.AddDate(0, 0, 7)
+	if value := c.Query("to"); value != "" {
+		parsed, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid to, expected RFC 3339"})
+			return
+		}
+		to = parsed
+	}
+
+	log.Log.Info("FindSlots called", zap.Uint("teacher_id", teacherId), zap.Time("from", from), zap.Time("to", to))
+
+	slots, err := h.Service.FindSlots(teacherId, from, to)
+	if err != nil {
+		writeError(c, err, "failed to get slots")
+		return
+	}
+
+	c.JSON(http.StatusOK, slots)
+}
+
+func (h *Handler) BookAppointment(c *gin.Context) {
+	teacherId, ok := parseID(c, "id", "invalid teacher ID")
+	if !ok {
+		return
+	}
+
+	a, err := actor.FromContext(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+
+	var req request.AppointmentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		log.Log.Warn("Invalid request in BookAppointment", zap.Error(err))
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	log.Log.Info("BookAppointment called", zap.Uint("teacher_id", teacherId), zap.Time("starts_at", req.StartsAt))
+
+	appointmentResp, err := h.Service.BookAppointment(teacherId, a, req)
+	if err != nil {
+		writeError(c, err, "failed to book appointment")
+		return
+	}
+
+	c.JSON(http.StatusCreated, appointmentResp)
+}
+
+func (h *Handler) CancelAppointment(c *gin.Context) {
+	id, ok := parseID(c, "id", "invalid appointment ID")
+	if !ok {
+		return
+	}
+
+	a, err := actor.FromContext(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+
+	log.Log.Info("CancelAppointment called", zap.Uint("id", id))
+
+	appointmentResp, err := h.Service.CancelAppointment(id, a)
+	if err != nil {
+		writeError(c, err, "failed to cancel appointment")
+		return
+	}
+
+	c.JSON(http.StatusOK, appointmentResp)
+}
+
+func (h *Handler) FindTeacherAppointments(c *gin.Context) {
+	teacherId, ok := parseID(c, "id", "invalid teacher ID")
+	if !ok {
+		return
+	}
+
+	a, err := actor.FromContext(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+
+	count, err := h.Service.CountTeacherAppointments(teacherId, a)
+	if err != nil {
+		log.Log.Error("Failed to count appointments", zap.Error(err))
+		writeError(c, err, "failed to count appointments")
+		return
+	}
+
+	pages := pagination.NewFromRequest(c.Request, count)
+
+	log.Log.Info("FindTeacherAppointments called",
+		zap.Uint("teacher_id", teacherId),
+		zap.Int("page", pages.Page),
+		zap.Int("per_page", pages.PerPage),
+		zap.Int("total_count", pages.TotalCount),
+	)
+
+	appointments, err := h.Service.FindTeacherAppointments(teacherId, a, pages.Page, pages.PerPage)
+	if err != nil {
+		writeError(c, err, "failed to get appointments")
+		return
+	}
+
+	pages.Items = appointments
+	c.JSON(http.StatusOK, pages)
+}
+
+func (h *Handler) FindStudentAppointments(c *gin.Context) {
+	studentId, ok := parseID(c, "id", "invalid student ID")
+	if !ok {
+		return
+	}
+
+	a, err := actor.FromContext(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+
+	count, err := h.Service.CountStudentAppointments(studentId, a)
+	if err != nil {
+		log.Log.Error("Failed to count appointments", zap.Error(err))
+		writeError(c, err, "failed to count appointments")
+		return
+	}
+
+	pages := pagination.NewFromRequest(c.Request, count)
+
+	log.Log.Info("FindStudentAppointments called",
+		zap.Uint("student_id", studentId),
+		zap.Int("page", pages.Page),
+		zap.Int("per_page", pages.PerPage),
+		zap.Int("total_count", pages.TotalCount),
+	)
+
+	appointments, err := h.Service.FindStudentAppointments(studentId, a, pages.Page, pages.PerPage)
+	if err != nil {
+		writeError(c, err, "failed to get appointments")
+		return
+	}
+
+	pages.Items = appointments
+	c.JSON(http.StatusOK, pages)
+}
+
+func (h *Handler) TeacherCalendar(c *gin.Context) {
+	teacherId, ok := parseID(c, "id", "invalid teacher ID")
+	if !ok {
+		return
+	}
+
+	a, err := actor.FromContext(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+
+	log.Log.Info("TeacherCalendar called", zap.Uint("teacher_id", teacherId))
+
+	calendar, err := h.Service.TeacherCalendar(teacherId, a)
+	if err != nil {
+		writeError(c, err, "failed to build calendar")
+		return
+	}
+
+	c.Data(http.StatusOK, ical.ContentType, calendar)
+}
+
+func (h *Handler) StudentCalendar(c *gin.Context) {
+	studentId, ok := parseID(c, "id", "invalid student ID")
+	if !ok {
+		return
+	}
+
+	a, err := actor.FromContext(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+
+	log.Log.Info("StudentCalendar called", zap.Uint("student_id", studentId))
+
+	calendar, err := h.Service.StudentCalendar(studentId, a)
+	if err != nil {
+		writeError(c, err, "failed to build calendar")
+		return
+	}
+
+	c.Data(http.StatusOK, ical.ContentType, calendar)
+}
+
+func parseID(c *gin.Context, name string, message string) (uint, bool) {
+	param := c.Param(name)
+	parsed, err := strconv.ParseUint(param, 10, 32)
+	if err != nil {
+		log.Log.Warn("Invalid path parameter", zap.String(name, param), zap.Error(err))
+		c.JSON(http.StatusBadRequest, gin.H{"error": message})
+		return 0, false
+	}
+
+	return uint(parsed), true
+}
+
+func writeError(c *gin.Context, err error, fallback string) {
+	switch {
+	case errors.Is(err, ErrTeacherNotFound), errors.Is(err, ErrStudentNotFound),
+		errors.Is(err, ErrOfficeHourNotFound), errors.Is(err, ErrAppointmentNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	case errors.Is(err, ErrForbidden), errors.Is(err, ErrNotEligible):
+		c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+	case errors.Is(err, ErrInvalidInput):
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	case errors.Is(err, ErrSlotTaken), errors.Is(err, ErrBookingLimit),
+		errors.Is(err, ErrHasBookings), errors.Is(err, ErrAlreadyCancelled),
+		errors.Is(err, ErrBlackout):
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": fallback})
+	}
+}
@@ -0,0 +1,138 @@
+package officehour
+
+import (
+	"bytes"
+	"encoding/json"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"net/http"
+	"net/http/httptest"
+	"student_go/internal/actor"
+	"student_go/internal/dto/request"
+	"student_go/internal/dto/response"
+	"student_go/internal/mocks"
+	"testing"
+	"time"
+)
+
+func setupHandlerTest() (*gin.Engine, *mocks.OfficeHourServiceMock, *Handler) {
+	gin.SetMode(gin.TestMode)
+	mockService := new(mocks.OfficeHourServiceMock)
+	handler := &Handler{Service: mockService}
+	r := gin.Default()
+	return r, mockService, handler
+}
+
+func setActor(req *http.Request, id string, role string) {
+	req.Header.Set(actor.HeaderUserID, id)
+	req.Header.Set(actor.HeaderUserRole, role)
+}
+
+func TestCreateOfficeHourHandler(t *testing.T) {
+	r, mockService, handler := setupHandlerTest()
+	input := request.OfficeHourRequest{Weekday: intPtr(2), StartTime: "14:00", EndTime: "15:00", SlotMinutes: 30, ValidFrom: "2025-01-01"}
+	mockService.On("CreateOfficeHour", uint(10), officeTeacher, input).Return(&response.OfficeHourResponse{ID: 1}, nil)
+
+	r.POST("/teachers/:id/office-hours", handler.CreateOfficeHour)
+	body, _ := json.Marshal(input)
+	req := httptest.NewRequest(http.MethodPost, "/teachers/10/office-hours", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	setActor(req, "10", "teacher")
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusCreated, resp.Code)
+	mockService.AssertExpectations(t)
+}
+
+func TestCreateOfficeHourHandler_MissingWeekday(t *testing.T) {
+	r, mockService, handler := setupHandlerTest()
+
+	r.POST("/teachers/:id/office-hours", handler.CreateOfficeHour)
+	req := httptest.NewRequest(http.MethodPost, "/teachers/10/office-hours",
+		bytes.NewBufferString(`{"startTime":"14:00","endTime":"15:00","slotMinutes":30,"validFrom":"2025-01-01"}`))
+	req.Header.Set("Content-Type", "application/json")
+	setActor(req, "10", "teacher")
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	mockService.AssertNotCalled(t, "CreateOfficeHour", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestFindSlotsHandler(t *testing.T) {
+	r, mockService, handler := setupHandlerTest()
+	from := time.Date(2025, 3, 3, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC)
+	mockService.On("FindSlots", uint(10), from, to).Return([]*response.SlotResponse{{OfficeHourID: 1}}, nil)
+
+	r.GET("/teachers/:id/slots", handler.FindSlots)
+	req := httptest.NewRequest(http.MethodGet, "/teachers/10/slots?from=2025-03-03T00:00:00Z&to=2025-03-10T00:00:00Z", nil)
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	mockService.AssertExpectations(t)
+}
+
+func TestBookAppointmentHandler_SlotTaken(t *testing.T) {
+	r, mockService, handler := setupHandlerTest()
+	input := request.AppointmentRequest{StartsAt: time.Date(2025, 3, 4, 14, 0, 0, 0, time.UTC)}
+	mockService.On("BookAppointment", uint(10), taughtStudent, input).Return(nil, ErrSlotTaken)
+
+	r.POST("/teachers/:id/appointments", handler.BookAppointment)
+	body, _ := json.Marshal(input)
+	req := httptest.NewRequest(http.MethodPost, "/teachers/10/appointments", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	setActor(req, "20", "student")
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusConflict, resp.Code)
+	mockService.AssertExpectations(t)
+}
+
+func TestCancelAppointmentHandler_Forbidden(t *testing.T) {
+	r, mockService, handler := setupHandlerTest()
+	mockService.On("CancelAppointment", uint(5), taughtStudent).Return(nil, ErrForbidden)
+
+	r.POST("/appointments/:id/cancel", handler.CancelAppointment)
+	req := httptest.NewRequest(http.MethodPost, "/appointments/5/cancel", nil)
+	setActor(req, "20", "student")
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusForbidden, resp.Code)
+}
+
+func TestFindStudentAppointmentsHandler(t *testing.T) {
+	r, mockService, handler := setupHandlerTest()
+	mockService.On("CountStudentAppointments", uint(20), taughtStudent).Return(1, nil)
+	mockService.On("FindStudentAppointments", uint(20), taughtStudent, 1, 10).
+		Return([]*response.AppointmentResponse{{ID: 5}}, nil)
+
+	r.GET("/students/:id/appointments", handler.FindStudentAppointments)
+	req := httptest.NewRequest(http.MethodGet, "/students/20/appointments?page=1&per_page=10", nil)
+	setActor(req, "20", "student")
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	mockService.AssertExpectations(t)
+}
+
+func TestTeacherCalendarHandler(t *testing.T) {
+	r, mockService, handler := setupHandlerTest()
+	mockService.On("TeacherCalendar", uint(10), officeTeacher).Return([]byte("BEGIN:VCALENDAR\r\nEND:VCALENDAR\r\n"), nil)
+
+	r.GET("/teachers/:id/appointments.ics", handler.TeacherCalendar)
+	req := httptest.NewRequest(http.MethodGet, "/teachers/10/appointments.ics", nil)
+	setActor(req, "10", "teacher")
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "text/calendar; charset=utf-8", resp.Header().Get("Content-Type"))
+	assert.Contains(t, resp.Body.String(), "BEGIN:VCALENDAR")
+}
@@ -0,0 +1,236 @@
+package officehour
+
+import (
+	"errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"student_go/internal/entity"
+	"student_go/pkg/dbcontext"
+	"time"
+)
+
+// exclusionViolation is the PostgreSQL error code raised when a booking
+// overlaps another booked appointment of the same teacher or student.
+const exclusionViolation = "23P01"
+
+type Repository interface {
+	SaveOfficeHour(officeHour *entity.OfficeHour) (*entity.OfficeHour, error)
+	FindOfficeHourById(teacherId uint, id uint) (*entity.OfficeHour, error)
+	FindOfficeHours(teacherId uint) ([]entity.OfficeHour, error)
+	DeleteOfficeHourById(id uint) error
+	CountUpcomingAppointments(officeHourId uint, now time.Time) (int, error)
+	BookAppointment(appointment *entity.Appointment, maxActive int, now time.Time) (*entity.Appointment, error)
+	FindAppointmentById(id uint) (*entity.Appointment, error)
+	CancelAppointment(id uint, cancelledAt time.Time) error
+	FindBookedAppointments(teacherId uint, from, to time.Time) ([]entity.Appointment, error)
+	FindTeacherAppointments(teacherId uint, page, limit int) ([]entity.Appointment, error)
+	CountTeacherAppointments(teacherId uint) (int, error)
+	FindStudentAppointments(studentId uint, page, limit int) ([]entity.Appointment, error)
+	CountStudentAppointments(studentId uint) (int, error)
+	FindTeacherAppointmentsSince(teacherId uint, since time.Time) ([]entity.Appointment, error)
+	FindStudentAppointmentsSince(studentId uint, since time.Time) ([]entity.Appointment, error)
+}
+
+type repository struct{}
+
+func NewOfficeHourRepository() Repository {
+	return &repository{}
+}
+
+func (r *repository) SaveOfficeHour(officeHour *entity.OfficeHour) (*entity.OfficeHour, error) {
+	err := dbcontext.DB.Create(officeHour).Error
+	return officeHour, err
+}
+
+func (r *repository) FindOfficeHourById(teacherId uint, id uint) (*entity.OfficeHour, error) {
+	var officeHour entity.OfficeHour
+	result := dbcontext.DB.
+		Where("teacher_id = ?", teacherId).
+		First(&officeHour, id)
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return &officeHour, nil
+}
+
+func (r *repository) FindOfficeHours(teacherId uint) ([]entity.OfficeHour, error) {
+	var officeHours []entity.OfficeHour
+
+	result := dbcontext.DB.
+		Where("teacher_id = ?", teacherId).
+		Order("weekday").
+		Order("start_minute").
+		Find(&officeHours)
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return officeHours, nil
+}
+
+func (r *repository) DeleteOfficeHourById(id uint) error {
+	result := dbcontext.DB.Delete(&entity.OfficeHour{}, id)
+
+	return result.Error
+}
+
+func (r *repository) CountUpcomingAppointments(officeHourId uint, now time.Time) (int, error) {
+	var count int64
+	err := dbcontext.DB.Model(&entity.Appointment{}).
+		Where("office_hour_id = ? AND status = ? AND starts_at > ?", officeHourId, entity.AppointmentBooked, now).
+		Count(&count).Error
+	return int(count), err
+}
+
+// BookAppointment inserts a booked appointment unless the student already
+// holds maxActive upcoming bookings with the same teacher. The student row
+// is locked so that concurrent bookings cannot both pass the limit check;
+// overlapping slots are rejected by the exclusion constraints on the table.
+// A maxActive of zero disables the limit.
+func (r *repository) BookAppointment(appointment *entity.Appointment, maxActive int, now time.Time) (*entity.Appointment, error) {
+	err := dbcontext.DB.Transaction(func(tx *gorm.DB) error {
+		var student entity.Student
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Select("id").
+			First(&student, appointment.StudentID).Error; err != nil {
+			return err
+		}
+
+		if maxActive > 0 {
+			var active int64
+			err := tx.Model(&entity.Appointment{}).
+				Where("teacher_id = ? AND student_id = ? AND status = ? AND starts_at > ?",
+					appointment.TeacherID, appointment.StudentID, entity.AppointmentBooked, now).
+				Count(&active).Error
+			if err != nil {
+				return err
+			}
+			if int(active) >= maxActive {
+				return ErrBookingLimit
+			}
+		}
+
+		return tx.Create(appointment).Error
+	})
+
+	var state interface{ SQLState() string }
+	if errors.As(err, &state) && state.SQLState() == exclusionViolation {
+		return nil, ErrSlotTaken
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return appointment, nil
+}
+
+func (r *repository) FindAppointmentById(id uint) (*entity.Appointment, error) {
+	var appointment entity.Appointment
+	result := dbcontext.DB.First(&appointment, id)
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return &appointment, nil
+}
+
+func (r *repository) CancelAppointment(id uint, cancelledAt time.Time) error {
+	return dbcontext.DB.Model(&entity.Appointment{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"status":       entity.AppointmentCancelled,
+			"cancelled_at": cancelledAt,
+		}).Error
+}
+
+func (r *repository) FindBookedAppointments(teacherId uint, from, to time.Time) ([]entity.Appointment, error) {
+	var appointments []entity.Appointment
+
+	result := dbcontext.DB.
+		Where("teacher_id = ? AND status = ? AND starts_at < ? AND ends_at > ?",
+			teacherId, entity.AppointmentBooked, to, from).
+		Order("starts_at").
+		Find(&appointments)
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return appointments, nil
+}
+
+func (r *repository) FindTeacherAppointments(teacherId uint, page, limit int) ([]entity.Appointment, error) {
+	return r.findAppointments("teacher_id", teacherId, page, limit)
+}
+
+func (r *repository) CountTeacherAppointments(teacherId uint) (int, error) {
+	return r.countAppointments("teacher_id", teacherId)
+}
+
+func (r *repository) FindStudentAppointments(studentId uint, page, limit int) ([]entity.Appointment, error) {
+	return r.findAppointments("student_id", studentId, page, limit)
+}
+
+func (r *repository) CountStudentAppointments(studentId uint) (int, error) {
+	return r.countAppointments("student_id", studentId)
+}
+
+func (r *repository) FindTeacherAppointmentsSince(teacherId uint, since time.Time) ([]entity.Appointment, error) {
+	return r.findAppointmentsSince("teacher_id", "Student", teacherId, since)
+}
+
+func (r *repository) FindStudentAppointmentsSince(studentId uint, since time.Time) ([]entity.Appointment, error) {
+	return r.findAppointmentsSince("student_id", "Teacher", studentId, since)
+}
+
+func (r *repository) findAppointments(column string, id uint, page, limit int) ([]entity.Appointment, error) {
+	var appointments []entity.Appointment
+
+	offset := (page - 1) * limit
+
+	result := dbcontext.DB.
+		Where(column+" = ?", id).
+		Order("starts_at DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&appointments)
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return appointments, nil
+}
+
+func (r *repository) countAppointments(column string, id uint) (int, error) {
+	var count int64
+	err := dbcontext.DB.Model(&entity.Appointment{}).
+		Where(column+" = ?", id).
+		Count(&count).Error
+	return int(count), err
+}
+
+// findAppointmentsSince loads the name of the other party with each
+// appointment, including a soft-deleted one, whose past appointments
+// still belong in the calendar.
+func (r *repository) findAppointmentsSince(column string, counterpart string, id uint, since time.Time) ([]entity.Appointment, error) {
+	var appointments []entity.Appointment
+
+	result := dbcontext.DB.
+		Preload(counterpart, func(db *gorm.DB) *gorm.DB {
+			return db.Unscoped().Select("id", "name")
+		}).
+		Where(column+" = ? AND starts_at >= ?", id, since).
+		Order("starts_at").
+		Find(&appointments)
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return appointments, nil
+}
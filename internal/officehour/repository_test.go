@@ -0,0 +1,162 @@
+package officehour
+
+import (
+	"database/sql"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"regexp"
+	"testing"
+	"time"
+
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+
+	"student_go/internal/entity"
+	"student_go/pkg/dbcontext"
+)
+
+func setupTestDB(t *testing.T) (*sql.DB, sqlmock.Sqlmock, *gorm.DB) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+
+	dialector := postgres.New(postgres.Config{
+		Conn:                 db,
+		PreferSimpleProtocol: true,
+	})
+
+	gormDB, err := gorm.Open(dialector, &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	assert.NoError(t, err)
+
+	dbcontext.DB = gormDB
+	return db, mock, gormDB
+}
+
+func newBooking() *entity.Appointment {
+	return &entity.Appointment{
+		OfficeHourID: 1,
+		TeacherID:    10,
+		StudentID:    20,
+		StartsAt:     time.Date(2025, 3, 4, 14, 0, 0, 0, time.UTC),
+		EndsAt:       time.Date(2025, 3, 4, 14, 30, 0, 0, time.UTC),
+		Status:       entity.AppointmentBooked,
+	}
+}
+
+func expectBookingChecks(mock sqlmock.Sqlmock, active int) {
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT "id" FROM "students" WHERE "students"."id" = $1 AND "students"."deleted_at" IS NULL ORDER BY "students"."id" LIMIT $2 FOR UPDATE`)).
+		WithArgs(20, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(20))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "appointments" WHERE teacher_id = $1 AND student_id = $2 AND status = $3 AND starts_at > $4`)).
+		WithArgs(10, 20, entity.AppointmentBooked, sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(active))
+}
+
+func TestAppointmentBook(t *testing.T) {
+	db, mock, _ := setupTestDB(t)
+	defer db.Close()
+
+	expectBookingChecks(mock, 1)
+	mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "appointments"`)).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(7))
+	mock.ExpectCommit()
+
+	repo := NewOfficeHourRepository()
+	appointment, err := repo.BookAppointment(newBooking(), 2, time.Now())
+
+	require.NoError(t, err)
+	assert.Equal(t, uint(7), appointment.ID)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestAppointmentBook_LimitReached(t *testing.T) {
+	db, mock, _ := setupTestDB(t)
+	defer db.Close()
+
+	expectBookingChecks(mock, 2)
+	mock.ExpectRollback()
+
+	repo := NewOfficeHourRepository()
+	appointment, err := repo.BookAppointment(newBooking(), 2, time.Now())
+
+	assert.Nil(t, appointment)
+	assert.ErrorIs(t, err, ErrBookingLimit)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestAppointmentBook_Overlap(t *testing.T) {
+	db, mock, _ := setupTestDB(t)
+	defer db.Close()
+
+	expectBookingChecks(mock, 0)
+	mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "appointments"`)).
+		WillReturnError(&pgconn.PgError{Code: exclusionViolation})
+	mock.ExpectRollback()
+
+	repo := NewOfficeHourRepository()
+	appointment, err := repo.BookAppointment(newBooking(), 2, time.Now())
+
+	assert.Nil(t, appointment)
+	assert.ErrorIs(t, err, ErrSlotTaken)
+}
+
+func TestAppointmentFindBooked(t *testing.T) {
+	db, mock, _ := setupTestDB(t)
+	defer db.Close()
+
+	from := time.Date(2025, 3, 3, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 0, 7)
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "appointments" WHERE teacher_id = $1 AND status = $2 AND starts_at < $3 AND ends_at > $4 ORDER BY starts_at`)).
+		WithArgs(10, entity.AppointmentBooked, to, from).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "teacher_id", "status"}).AddRow(1, 10, "booked"))
+
+	repo := NewOfficeHourRepository()
+	appointments, err := repo.FindBookedAppointments(10, from, to)
+
+	require.NoError(t, err)
+	assert.Len(t, appointments, 1)
+}
+
+func TestAppointmentFindSince_DeletedStudent(t *testing.T) {
+	db, mock, _ := setupTestDB(t)
+	defer db.Close()
+
+	since := time.Date(2025, 3, 3, 0, 0, 0, 0, time.UTC)
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "appointments" WHERE teacher_id = $1 AND starts_at >= $2 ORDER BY starts_at`)).
+		WithArgs(10, since).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "teacher_id", "student_id"}).AddRow(1, 10, 20).AddRow(2, 10, 20))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT "id","name" FROM "students" WHERE "students"."id" = $1`) + "$").
+		WithArgs(20).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(20, "Petrov"))
+
+	repo := NewOfficeHourRepository()
+	appointments, err := repo.FindTeacherAppointmentsSince(10, since)
+
+	require.NoError(t, err)
+	require.Len(t, appointments, 2)
+	assert.Equal(t, "Petrov", appointments[1].Student.Name)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestAppointmentCancel(t *testing.T) {
+	db, mock, _ := setupTestDB(t)
+	defer db.Close()
+
+	cancelledAt := time.Date(2025, 3, 3, 9, 0, 0, 0, time.UTC)
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE "appointments" SET "cancelled_at"=$1,"status"=$2 WHERE id = $3`)).
+		WithArgs(cancelledAt, entity.AppointmentCancelled, 5).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	repo := NewOfficeHourRepository()
+	err := repo.CancelAppointment(5, cancelledAt)
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
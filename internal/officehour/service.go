@@ -0,0 +1,600 @@
+package officehour
+
+import (
+	"errors"
+	"fmt"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"student_go/internal/actor"
+	"student_go/internal/calendar"
+	"student_go/internal/config"
+	"student_go/internal/course"
+	"student_go/internal/dto/request"
+	"student_go/internal/dto/response"
+	"student_go/internal/entity"
+	"student_go/internal/student"
+	"student_go/internal/teacher"
+	"student_go/pkg/ical"
+	"student_go/pkg/log"
+	"time"
+)
+
+const (
+	dateLayout = "2006-01-02"
+	timeLayout = "15:04"
+
+	// maxSlotRange bounds how far FindSlots expands recurring windows.
+	maxSlotRange = 31 * 24 * time.Hour
+	// calendarHistory is how far back the .ics feeds include appointments.
+	calendarHistory = 30 * 24 * time.Hour
+)
+
+var (
+	ErrTeacherNotFound     = errors.New("teacher not found")
+	ErrStudentNotFound     = errors.New("student not found")
+	ErrOfficeHourNotFound  = errors.New("office hour not found")
+	ErrAppointmentNotFound = errors.New("appointment not found")
+	ErrForbidden           = errors.New("forbidden")
+	ErrNotEligible         = errors.New("student is not taught by this teacher")
+	ErrInvalidInput        = errors.New("invalid input")
+	ErrSlotTaken           = errors.New("slot is already booked")
+	ErrBookingLimit        = errors.New("active booking limit reached")
+	ErrHasBookings         = errors.New("office hour has upcoming appointments")
+	ErrAlreadyCancelled    = errors.New("appointment is already cancelled")
+	ErrBlackout            = errors.New("office hours are closed on this day")
+)
+
+type Service interface {
+	CreateOfficeHour(teacherId uint, a actor.Actor, input request.OfficeHourRequest) (*response.OfficeHourResponse, error)
+	FindOfficeHours(teacherId uint) ([]*response.OfficeHourResponse, error)
+	DeleteOfficeHourById(teacherId uint, id uint, a actor.Actor) error
+	FindSlots(teacherId uint, from, to time.Time) ([]*response.SlotResponse, error)
+	BookAppointment(teacherId uint, a actor.Actor, input request.AppointmentRequest) (*response.AppointmentResponse, error)
+	CancelAppointment(id uint, a actor.Actor) (*response.AppointmentResponse, error)
+	FindTeacherAppointments(teacherId uint, a actor.Actor, page, limit int) ([]*response.AppointmentResponse, error)
+	CountTeacherAppointments(teacherId uint, a actor.Actor) (int, error)
+	FindStudentAppointments(studentId uint, a actor.Actor, page, limit int) ([]*response.AppointmentResponse, error)
+	CountStudentAppointments(studentId uint, a actor.Actor) (int, error)
+	TeacherCalendar(teacherId uint, a actor.Actor) ([]byte, error)
+	StudentCalendar(studentId uint, a actor.Actor) ([]byte, error)
+}
+
+type service struct {
+	officeHourRepository Repository
+	courseRepository     course.Repository
+	teacherRepository    teacher.Repository
+	studentRepository    student.Repository
+	calendarRepository   calendar.Repository
+	location             *time.Location
+	maxActiveBookings    int
+	now                  func() time.Time
+}
+
+func NewOfficeHourService(
+	officeHourRepository Repository,
+	courseRepository course.Repository,
+	teacherRepository teacher.Repository,
+	studentRepository student.Repository,
+	calendarRepository calendar.Repository,
+) Service {
+	conf := config.Config.OfficeHours
+
+	location := time.UTC
+	if conf.Timezone != "" {
+		loaded, err := time.LoadLocation(conf.Timezone)
+		if err != nil {
+			log.Log.Warn("Unknown office hours timezone, using UTC", zap.String("timezone", conf.Timezone), zap.Error(err))
+		} else {
+			location = loaded
+		}
+	}
+
+	return &service{
+		officeHourRepository: officeHourRepository,
+		courseRepository:     courseRepository,
+		teacherRepository:    teacherRepository,
+		studentRepository:    studentRepository,
+		calendarRepository:   calendarRepository,
+		location:             location,
+		maxActiveBookings:    conf.MaxActiveBookings,
+		now:                  time.Now,
+	}
+}
+
+func (s *service) CreateOfficeHour(teacherId uint, a actor.Actor, input request.OfficeHourRequest) (*response.OfficeHourResponse, error) {
+	log.Log.Info("CreateOfficeHour (service) called", zap.Uint("teacher_id", teacherId))
+
+	if err := s.checkTeacher(teacherId); err != nil {
+		return nil, err
+	}
+	if !a.IsAdmin() && !a.IsTeacher(teacherId) {
+		return nil, ErrForbidden
+	}
+
+	officeHour, err := parseOfficeHour(teacherId, input)
+	if err != nil {
+		return nil, err
+	}
+
+	saved, err := s.officeHourRepository.SaveOfficeHour(officeHour)
+	if err != nil {
+		return nil, err
+	}
+
+	return toOfficeHourResponse(saved), nil
+}
+
+func (s *service) FindOfficeHours(teacherId uint) ([]*response.OfficeHourResponse, error) {
+	log.Log.Info("FindOfficeHours (service) called", zap.Uint("teacher_id", teacherId))
+
+	if err := s.checkTeacher(teacherId); err != nil {
+		return nil, err
+	}
+
+	officeHours, err := s.officeHourRepository.FindOfficeHours(teacherId)
+	if err != nil {
+		return nil, err
+	}
+
+	officeHourResponses := make([]*response.OfficeHourResponse, 0, len(officeHours))
+	for i := range officeHours {
+		officeHourResponses = append(officeHourResponses, toOfficeHourResponse(&officeHours[i]))
+	}
+
+	return officeHourResponses, nil
+}
+
+func (s *service) DeleteOfficeHourById(teacherId uint, id uint, a actor.Actor) error {
+	log.Log.Info("DeleteOfficeHourById (service) called", zap.Uint("teacher_id", teacherId), zap.Uint("id", id))
+
+	if !a.IsAdmin() && !a.IsTeacher(teacherId) {
+		return ErrForbidden
+	}
+
+	if _, err := s.findOfficeHour(teacherId, id); err != nil {
+		return err
+	}
+
+	upcoming, err := s.officeHourRepository.CountUpcomingAppointments(id, s.now())
+	if err != nil {
+		return err
+	}
+	if upcoming > 0 {
+		return ErrHasBookings
+	}
+
+	return s.officeHourRepository.DeleteOfficeHourById(id)
+}
+
+func (s *service) FindSlots(teacherId uint, from, to time.Time) ([]*response.SlotResponse, error) {
+	log.Log.Info("FindSlots (service) called",
+		zap.Uint("teacher_id", teacherId),
+		zap.Time("from", from),
+		zap.Time("to", to),
+	)
+
+	if !from.Before(to) || to.Sub(from) > maxSlotRange {
+		return nil, fmt.Errorf("%w: range must be positive and at most %d days", ErrInvalidInput, int(maxSlotRange.Hours()/24))
+	}
+
+	if err := s.checkTeacher(teacherId); err != nil {
+		return nil, err
+	}
+
+	if now := s.now(); from.Before(now) {
+		from = now
+	}
+
+	slots, err := s.freeSlots(teacherId, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	slotResponses := make([]*response.SlotResponse, 0, len(slots))
+	for _, slot := range slots {
+		slotResponses = append(slotResponses, &response.SlotResponse{
+			OfficeHourID: slot.officeHour.ID,
+			StartsAt:     slot.start,
+			EndsAt:       slot.end,
+			Location:     slot.officeHour.Location,
+		})
+	}
+
+	return slotResponses, nil
+}
+
+func (s *service) BookAppointment(teacherId uint, a actor.Actor, input request.AppointmentRequest) (*response.AppointmentResponse, error) {
+	log.Log.Info("BookAppointment (service) called",
+		zap.Uint("teacher_id", teacherId),
+		zap.Uint("student_id", a.ID),
+		zap.Time("starts_at", input.StartsAt),
+	)
+
+	if a.Role != actor.RoleStudent {
+		return nil, ErrForbidden
+	}
+
+	if err := s.checkTeacher(teacherId); err != nil {
+		return nil, err
+	}
+
+	eligible, err := s.courseRepository.IsStudentTaughtBy(a.ID, teacherId)
+	if err != nil {
+		return nil, err
+	}
+	if !eligible {
+		return nil, ErrNotEligible
+	}
+
+	now := s.now()
+	if !input.StartsAt.After(now) {
+		return nil, fmt.Errorf("%w: slot is in the past", ErrInvalidInput)
+	}
+
+	// Expanding the whole day around the requested start finds the office
+	// hour window the slot belongs to without any extra bookkeeping.
+	day := input.StartsAt.In(s.location)
+	dayStart := time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, s.location)
+	officeHours, err := s.officeHourRepository.FindOfficeHours(teacherId)
+	if err != nil {
+		return nil, err
+	}
+
+	var match *slot
+	for _, candidate := range expandSlots(officeHours, dayStart, dayStart.AddDate(0, 0, 1), s.location) {
+		if candidate.start.Equal(input.StartsAt) {
+			match = &candidate
+			break
+		}
+	}
+	if match == nil {
+		return nil, fmt.Errorf("%w: no office hour slot starts at %s", ErrInvalidInput, input.StartsAt.Format(time.RFC3339))
+	}
+
+	blackouts, err := s.calendarRepository.FindBlackouts(dayStart, dayStart)
+	if err != nil {
+		return nil, err
+	}
+	if event, ok := calendar.IsBlackout(blackouts, dayStart); ok {
+		return nil, fmt.Errorf("%w: %s", ErrBlackout, event.Name)
+	}
+
+	appointment := entity.Appointment{
+		OfficeHourID: match.officeHour.ID,
+		TeacherID:    teacherId,
+		StudentID:    a.ID,
+		StartsAt:     match.start,
+		EndsAt:       match.end,
+		Status:       entity.AppointmentBooked,
+		Location:     match.officeHour.Location,
+		Note:         input.Note,
+	}
+	saved, err := s.officeHourRepository.BookAppointment(&appointment, s.maxActiveBookings, now)
+	if err != nil {
+		return nil, err
+	}
+
+	return toAppointmentResponse(saved), nil
+}
+
+func (s *service) CancelAppointment(id uint, a actor.Actor) (*response.AppointmentResponse, error) {
+	log.Log.Info("CancelAppointment (service) called", zap.Uint("id", id))
+
+	appointment, err := s.officeHourRepository.FindAppointmentById(id)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrAppointmentNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if !a.IsAdmin() && !a.IsTeacher(appointment.TeacherID) && !a.IsStudent(appointment.StudentID) {
+		return nil, ErrForbidden
+	}
+	if appointment.Status == entity.AppointmentCancelled {
+		return nil, ErrAlreadyCancelled
+	}
+
+	now := s.now()
+	if err := s.officeHourRepository.CancelAppointment(id, now); err != nil {
+		return nil, err
+	}
+	appointment.Status = entity.AppointmentCancelled
+	appointment.CancelledAt = &now
+
+	return toAppointmentResponse(appointment), nil
+}
+
+func (s *service) FindTeacherAppointments(teacherId uint, a actor.Actor, page, limit int) ([]*response.AppointmentResponse, error) {
+	log.Log.Info("FindTeacherAppointments (service) called",
+		zap.Uint("teacher_id", teacherId),
+		zap.Int("page", page),
+		zap.Int("limit", limit),
+	)
+
+	if err := s.authorizeTeacher(teacherId, a); err != nil {
+		return nil, err
+	}
+
+	appointments, err := s.officeHourRepository.FindTeacherAppointments(teacherId, page, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return toAppointmentResponses(appointments), nil
+}
+
+func (s *service) CountTeacherAppointments(teacherId uint, a actor.Actor) (int, error) {
+	if err := s.authorizeTeacher(teacherId, a); err != nil {
+		return 0, err
+	}
+
+	return s.officeHourRepository.CountTeacherAppointments(teacherId)
+}
+
+func (s *service) FindStudentAppointments(studentId uint, a actor.Actor, page, limit int) ([]*response.AppointmentResponse, error) {
+	log.Log.Info("FindStudentAppointments (service) called",
+		zap.Uint("student_id", studentId),
+		zap.Int("page", page),
+		zap.Int("limit", limit),
+	)
+
+	if err := s.authorizeStudent(studentId, a); err != nil {
+		return nil, err
+	}
+
+	appointments, err := s.officeHourRepository.FindStudentAppointments(studentId, page, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return toAppointmentResponses(appointments), nil
+}
+
+func (s *service) CountStudentAppointments(studentId uint, a actor.Actor) (int, error) {
+	if err := s.authorizeStudent(studentId, a); err != nil {
+		return 0, err
+	}
+
+	return s.officeHourRepository.CountStudentAppointments(studentId)
+}
+
+func (s *service) TeacherCalendar(teacherId uint, a actor.Actor) ([]byte, error) {
+	log.Log.Info("TeacherCalendar (service) called", zap.Uint("teacher_id", teacherId))
+
+	if err := s.authorizeTeacher(teacherId, a); err != nil {
+		return nil, err
+	}
+
+	now := s.now()
+	appointments, err := s.officeHourRepository.FindTeacherAppointmentsSince(teacherId, now.Add(-calendarHistory))
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]ical.Event, 0, len(appointments))
+	for _, appointment := range appointments {
+		events = append(events, toEvent(appointment, "Office hours with "+appointment.Student.Name))
+	}
+
+	return ical.Calendar{Name: "Office hours", Events: events}.Encode(now), nil
+}
+
+func (s *service) StudentCalendar(studentId uint, a actor.Actor) ([]byte, error) {
+	log.Log.Info("StudentCalendar (service) called", zap.Uint("student_id", studentId))
+
+	if err := s.authorizeStudent(studentId, a); err != nil {
+		return nil, err
+	}
+
+	now := s.now()
+	appointments, err := s.officeHourRepository.FindStudentAppointmentsSince(studentId, now.Add(-calendarHistory))
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]ical.Event, 0, len(appointments))
+	for _, appointment := range appointments {
+		events = append(events, toEvent(appointment, "Office hours with "+appointment.Teacher.Name))
+	}
+
+	return ical.Calendar{Name: "Office hours", Events: events}.Encode(now), nil
+}
+
+// freeSlots returns the slots between from and to that are not taken by a
+// booked appointment and do not fall on a blackout day.
+func (s *service) freeSlots(teacherId uint, from, to time.Time) ([]slot, error) {
+	officeHours, err := s.officeHourRepository.FindOfficeHours(teacherId)
+	if err != nil {
+		return nil, err
+	}
+
+	booked, err := s.officeHourRepository.FindBookedAppointments(teacherId, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	blackouts, err := s.calendarRepository.FindBlackouts(from.In(s.location), to.In(s.location))
+	if err != nil {
+		return nil, err
+	}
+
+	free := make([]slot, 0)
+	for _, candidate := range expandSlots(officeHours, from, to, s.location) {
+		if _, ok := calendar.IsBlackout(blackouts, candidate.start.In(s.location)); ok {
+			continue
+		}
+
+		taken := false
+		for _, appointment := range booked {
+			if candidate.start.Before(appointment.EndsAt) && appointment.StartsAt.Before(candidate.end) {
+				taken = true
+				break
+			}
+		}
+		if !taken {
+			free = append(free, candidate)
+		}
+	}
+
+	return free, nil
+}
+
+func (s *service) checkTeacher(teacherId uint) error {
+	exists, err := s.teacherRepository.ExistsById(teacherId)
+	if err != nil || !exists {
+		return ErrTeacherNotFound
+	}
+
+	return nil
+}
+
+func (s *service) authorizeTeacher(teacherId uint, a actor.Actor) error {
+	if err := s.checkTeacher(teacherId); err != nil {
+		return err
+	}
+	if !a.IsAdmin() && !a.IsTeacher(teacherId) {
+		return ErrForbidden
+	}
+
+	return nil
+}
+
+func (s *service) authorizeStudent(studentId uint, a actor.Actor) error {
+	exists, err := s.studentRepository.ExistsById(studentId)
+	if err != nil || !exists {
+		return ErrStudentNotFound
+	}
+	if !a.IsAdmin() && !a.IsStudent(studentId) {
+		return ErrForbidden
+	}
+
+	return nil
+}
+
+func (s *service) findOfficeHour(teacherId uint, id uint) (*entity.OfficeHour, error) {
+	officeHour, err := s.officeHourRepository.FindOfficeHourById(teacherId, id)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrOfficeHourNotFound
+	}
+
+	return officeHour, err
+}
+
+func parseOfficeHour(teacherId uint, input request.OfficeHourRequest) (*entity.OfficeHour, error) {
+	start, err := parseMinute(input.StartTime)
+	if err != nil {
+		return nil, err
+	}
+	end, err := parseMinute(input.EndTime)
+	if err != nil {
+		return nil, err
+	}
+	if end-start < input.SlotMinutes {
+		return nil, fmt.Errorf("%w: window must fit at least one slot", ErrInvalidInput)
+	}
+
+	validFrom, err := time.Parse(dateLayout, input.ValidFrom)
+	if err != nil {
+		return nil, fmt.Errorf("%w: validFrom must be a date (YYYY-MM-DD)", ErrInvalidInput)
+	}
+
+	var validUntil *time.Time
+	if input.ValidUntil != nil {
+		parsed, err := time.Parse(dateLayout, *input.ValidUntil)
+		if err != nil {
+			return nil, fmt.Errorf("%w: validUntil must be a date (YYYY-MM-DD)", ErrInvalidInput)
+		}
+		if parsed.Before(validFrom) {
+			return nil, fmt.Errorf("%w: validUntil is before validFrom", ErrInvalidInput)
+		}
+		validUntil = &parsed
+	}
+
+	return &entity.OfficeHour{
+		TeacherID:   teacherId,
+		Weekday:     *input.Weekday,
+		StartMinute: start,
+		EndMinute:   end,
+		SlotMinutes: input.SlotMinutes,
+		Location:    input.Location,
+		ValidFrom:   validFrom,
+		ValidUntil:  validUntil,
+	}, nil
+}
+
+// parseMinute converts "HH:MM" into minutes since midnight. "24:00" is
+// accepted as the end of the day.
+func parseMinute(value string) (int, error) {
+	if value == "24:00" {
+		return 24 * 60, nil
+	}
+
+	parsed, err := time.Parse(timeLayout, value)
+	if err != nil {
+		return 0, fmt.Errorf("%w: time must be HH:MM, got %q", ErrInvalidInput, value)
+	}
+
+	return parsed.Hour()*60 + parsed.Minute(), nil
+}
+
+func formatMinute(minute int) string {
+	return fmt.Sprintf("%02d:%02d", minute/60, minute%60)
+}
+
+func toOfficeHourResponse(officeHour *entity.OfficeHour) *response.OfficeHourResponse {
+	var validUntil *string
+	if officeHour.ValidUntil != nil {
+		formatted := officeHour.ValidUntil.Format(dateLayout)
+		validUntil = &formatted
+	}
+
+	return &response.OfficeHourResponse{
+		ID:          officeHour.ID,
+		TeacherID:   officeHour.TeacherID,
+		Weekday:     officeHour.Weekday,
+		StartTime:   formatMinute(officeHour.StartMinute),
+		EndTime:     formatMinute(officeHour.EndMinute),
+		SlotMinutes: officeHour.SlotMinutes,
+		Location:    officeHour.Location,
+		ValidFrom:   officeHour.ValidFrom.Format(dateLayout),
+		ValidUntil:  validUntil,
+	}
+}
+
+func toAppointmentResponse(appointment *entity.Appointment) *response.AppointmentResponse {
+	return &response.AppointmentResponse{
+		ID:           appointment.ID,
+		OfficeHourID: appointment.OfficeHourID,
+		TeacherID:    appointment.TeacherID,
+		StudentID:    appointment.StudentID,
+		StartsAt:     appointment.StartsAt,
+		EndsAt:       appointment.EndsAt,
+		Status:       appointment.Status,
+		Location:     appointment.Location,
+		Note:         appointment.Note,
+		CancelledAt:  appointment.CancelledAt,
+		CreatedAt:    appointment.CreatedAt,
+	}
+}
+
+func toAppointmentResponses(appointments []entity.Appointment) []*response.AppointmentResponse {
+	appointmentResponses := make([]*response.AppointmentResponse, 0, len(appointments))
+	for i := range appointments {
+		appointmentResponses = append(appointmentResponses, toAppointmentResponse(&appointments[i]))
+	}
+
+	return appointmentResponses
+}
+
+func toEvent(appointment entity.Appointment, summary string) ical.Event {
+	return ical.Event{
+		UID:         fmt.Sprintf("appointment-%d@student_go", appointment.ID),
+		Start:       appointment.StartsAt,
+		End:         appointment.EndsAt,
+		Summary:     summary,
+		Description: appointment.Note,
+		Location:    appointment.Location,
+		Cancelled:   appointment.Status == entity.AppointmentCancelled,
+	}
+}
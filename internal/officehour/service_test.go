@@ -0,0 +1,328 @@
+package officehour
+
+import (
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"strings"
+	"student_go/internal/actor"
+	"student_go/internal/dto/request"
+	"student_go/internal/entity"
+	mocks2 "student_go/internal/mocks"
+	"student_go/pkg/log"
+	"testing"
+	"time"
+)
+
+func init() {
+	logger, _ := zap.NewDevelopment()
+	log.Log = logger
+}
+
+var (
+	// Monday, 2025-03-03 09:00 UTC.
+	testNow = time.Date(2025, 3, 3, 9, 0, 0, 0, time.UTC)
+
+	officeTeacher = actor.Actor{ID: 10, Role: actor.RoleTeacher}
+	taughtStudent = actor.Actor{ID: 20, Role: actor.RoleStudent}
+
+	// Tuesdays 14:00-15:00 in 30 minute slots.
+	tuesdayHours = entity.OfficeHour{
+		ID:          1,
+		TeacherID:   10,
+		Weekday:     int(time.Tuesday),
+		StartMinute: 14 * 60,
+		EndMinute:   15 * 60,
+		SlotMinutes: 30,
+		Location:    "Room 101",
+		ValidFrom:   time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+)
+
+type testDeps struct {
+	officeHourRepo *mocks2.OfficeHourRepository
+	courseRepo     *mocks2.CourseRepository
+	teacherRepo    *mocks2.TeacherRepository
+	studentRepo    *mocks2.StudentRepository
+	calendarRepo   *mocks2.CalendarRepository
+}
+
+func newTestOfficeHourService() (*service, testDeps) {
+	deps := testDeps{
+		officeHourRepo: new(mocks2.OfficeHourRepository),
+		courseRepo:     new(mocks2.CourseRepository),
+		teacherRepo:    new(mocks2.TeacherRepository),
+		studentRepo:    new(mocks2.StudentRepository),
+		calendarRepo:   new(mocks2.CalendarRepository),
+	}
+	deps.teacherRepo.On("ExistsById", uint(10)).Return(true, nil).Maybe()
+	deps.studentRepo.On("ExistsById", uint(20)).Return(true, nil).Maybe()
+	deps.courseRepo.On("IsStudentTaughtBy", uint(20), uint(10)).Return(true, nil).Maybe()
+	deps.calendarRepo.On("FindBlackouts", mock.Anything, mock.Anything).Return([]entity.CalendarEvent{}, nil).Maybe()
+
+	svc := &service{
+		officeHourRepository: deps.officeHourRepo,
+		courseRepository:     deps.courseRepo,
+		teacherRepository:    deps.teacherRepo,
+		studentRepository:    deps.studentRepo,
+		calendarRepository:   deps.calendarRepo,
+		location:             time.UTC,
+		maxActiveBookings:    2,
+		now:                  func() time.Time { return testNow },
+	}
+	return svc, deps
+}
+
+func intPtr(v int) *int {
+	return &v
+}
+
+func TestCreateOfficeHour(t *testing.T) {
+	svc, deps := newTestOfficeHourService()
+
+	input := request.OfficeHourRequest{
+		Weekday:     intPtr(2),
+		StartTime:   "14:00",
+		EndTime:     "15:00",
+		SlotMinutes: 30,
+		Location:    "Room 101",
+		ValidFrom:   "2025-01-01",
+	}
+	deps.officeHourRepo.On("SaveOfficeHour", mock.MatchedBy(func(o *entity.OfficeHour) bool {
+		return o.TeacherID == 10 && o.StartMinute == 840 && o.EndMinute == 900 && o.ValidUntil == nil
+	})).Return(&tuesdayHours, nil)
+
+	result, err := svc.CreateOfficeHour(10, officeTeacher, input)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "14:00", result.StartTime)
+	assert.Equal(t, "15:00", result.EndTime)
+	assert.Equal(t, "2025-01-01", result.ValidFrom)
+	deps.officeHourRepo.AssertExpectations(t)
+}
+
+func TestCreateOfficeHour_InvalidWindow(t *testing.T) {
+	svc, deps := newTestOfficeHourService()
+
+	input := request.OfficeHourRequest{
+		Weekday:     intPtr(2),
+		StartTime:   "14:00",
+		EndTime:     "14:20",
+		SlotMinutes: 30,
+		ValidFrom:   "2025-01-01",
+	}
+
+	result, err := svc.CreateOfficeHour(10, officeTeacher, input)
+
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, ErrInvalidInput)
+	deps.officeHourRepo.AssertNotCalled(t, "SaveOfficeHour", mock.Anything)
+}
+
+func TestCreateOfficeHour_OtherTeacherForbidden(t *testing.T) {
+	svc, _ := newTestOfficeHourService()
+
+	other := actor.Actor{ID: 11, Role: actor.RoleTeacher}
+	result, err := svc.CreateOfficeHour(10, other, request.OfficeHourRequest{Weekday: intPtr(2)})
+
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, ErrForbidden)
+}
+
+func TestFindSlots_SkipsBooked(t *testing.T) {
+	svc, deps := newTestOfficeHourService()
+
+	from := testNow
+	to := testNow.AddDate(0, 0, 7)
+	deps.officeHourRepo.On("FindOfficeHours", uint(10)).Return([]entity.OfficeHour{tuesdayHours}, nil)
+	deps.officeHourRepo.On("FindBookedAppointments", uint(10), from, to).Return([]entity.Appointment{
+		{
+			StartsAt: time.Date(2025, 3, 4, 14, 0, 0, 0, time.UTC),
+			EndsAt:   time.Date(2025, 3, 4, 14, 30, 0, 0, time.UTC),
+		},
+	}, nil)
+
+	result, err := svc.FindSlots(10, from, to)
+
+	assert.NoError(t, err)
+	assert.Len(t, result, 1)
+	assert.Equal(t, time.Date(2025, 3, 4, 14, 30, 0, 0, time.UTC), result[0].StartsAt)
+	assert.Equal(t, "Room 101", result[0].Location)
+}
+
+func TestFindSlots_SkipsBlackout(t *testing.T) {
+	svc, deps := newTestOfficeHourService()
+
+	from := testNow
+	to := testNow.AddDate(0, 0, 14)
+	deps.officeHourRepo.On("FindOfficeHours", uint(10)).Return([]entity.OfficeHour{tuesdayHours}, nil)
+	deps.officeHourRepo.On("FindBookedAppointments", uint(10), from, to).Return([]entity.Appointment{}, nil)
+	deps.calendarRepo.ExpectedCalls = nil
+	deps.calendarRepo.On("FindBlackouts", from, to).Return([]entity.CalendarEvent{{
+		Name:      "Spring holiday",
+		StartDate: time.Date(2025, 3, 4, 0, 0, 0, 0, time.UTC),
+		EndDate:   time.Date(2025, 3, 5, 0, 0, 0, 0, time.UTC),
+		Blackout:  true,
+	}}, nil)
+
+	result, err := svc.FindSlots(10, from, to)
+
+	assert.NoError(t, err)
+	assert.Len(t, result, 2)
+	assert.Equal(t, time.Date(2025, 3, 11, 14, 0, 0, 0, time.UTC), result[0].StartsAt)
+}
+
+func TestFindSlots_RangeTooLong(t *testing.T) {
+	svc, _ := newTestOfficeHourService()
+
+	result, err := svc.FindSlots(10, testNow, testNow.AddDate(0, 2, 0))
+
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, ErrInvalidInput)
+}
+
+func TestBookAppointment(t *testing.T) {
+	svc, deps := newTestOfficeHourService()
+
+	startsAt := time.Date(2025, 3, 4, 14, 30, 0, 0, time.UTC)
+	deps.officeHourRepo.On("FindOfficeHours", uint(10)).Return([]entity.OfficeHour{tuesdayHours}, nil)
+	deps.officeHourRepo.On("BookAppointment", mock.MatchedBy(func(a *entity.Appointment) bool {
+		return a.OfficeHourID == 1 && a.StudentID == 20 && a.StartsAt.Equal(startsAt) &&
+			a.EndsAt.Equal(startsAt.Add(30*time.Minute)) && a.Status == entity.AppointmentBooked
+	}), 2, testNow).Return(&entity.Appointment{ID: 5, TeacherID: 10, StudentID: 20, StartsAt: startsAt}, nil)
+
+	result, err := svc.BookAppointment(10, taughtStudent, request.AppointmentRequest{StartsAt: startsAt})
+
+	assert.NoError(t, err)
+	assert.Equal(t, uint(5), result.ID)
+	deps.officeHourRepo.AssertExpectations(t)
+}
+
+func TestBookAppointment_NotTaught(t *testing.T) {
+	svc, deps := newTestOfficeHourService()
+
+	stranger := actor.Actor{ID: 21, Role: actor.RoleStudent}
+	deps.courseRepo.On("IsStudentTaughtBy", uint(21), uint(10)).Return(false, nil)
+
+	result, err := svc.BookAppointment(10, stranger, request.AppointmentRequest{StartsAt: testNow.Add(time.Hour)})
+
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, ErrNotEligible)
+}
+
+func TestBookAppointment_NotASlot(t *testing.T) {
+	svc, deps := newTestOfficeHourService()
+
+	deps.officeHourRepo.On("FindOfficeHours", uint(10)).Return([]entity.OfficeHour{tuesdayHours}, nil)
+
+	startsAt := time.Date(2025, 3, 4, 14, 10, 0, 0, time.UTC)
+	result, err := svc.BookAppointment(10, taughtStudent, request.AppointmentRequest{StartsAt: startsAt})
+
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, ErrInvalidInput)
+	deps.officeHourRepo.AssertNotCalled(t, "BookAppointment", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestBookAppointment_Blackout(t *testing.T) {
+	svc, deps := newTestOfficeHourService()
+
+	day := time.Date(2025, 3, 4, 0, 0, 0, 0, time.UTC)
+	deps.officeHourRepo.On("FindOfficeHours", uint(10)).Return([]entity.OfficeHour{tuesdayHours}, nil)
+	deps.calendarRepo.ExpectedCalls = nil
+	deps.calendarRepo.On("FindBlackouts", day, day).Return([]entity.CalendarEvent{
+		{Name: "Spring holiday", StartDate: day, EndDate: day, Blackout: true},
+	}, nil)
+
+	startsAt := time.Date(2025, 3, 4, 14, 0, 0, 0, time.UTC)
+	result, err := svc.BookAppointment(10, taughtStudent, request.AppointmentRequest{StartsAt: startsAt})
+
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, ErrBlackout)
+	assert.EqualError(t, err, "office hours are closed on this day: Spring holiday")
+	deps.officeHourRepo.AssertNotCalled(t, "BookAppointment", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestBookAppointment_LimitReached(t *testing.T) {
+	svc, deps := newTestOfficeHourService()
+
+	startsAt := time.Date(2025, 3, 4, 14, 0, 0, 0, time.UTC)
+	deps.officeHourRepo.On("FindOfficeHours", uint(10)).Return([]entity.OfficeHour{tuesdayHours}, nil)
+	deps.officeHourRepo.On("BookAppointment", mock.Anything, 2, testNow).Return(nil, ErrBookingLimit)
+
+	result, err := svc.BookAppointment(10, taughtStudent, request.AppointmentRequest{StartsAt: startsAt})
+
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, ErrBookingLimit)
+}
+
+func TestCancelAppointment(t *testing.T) {
+	svc, deps := newTestOfficeHourService()
+
+	deps.officeHourRepo.On("FindAppointmentById", uint(5)).
+		Return(&entity.Appointment{ID: 5, TeacherID: 10, StudentID: 20, Status: entity.AppointmentBooked}, nil)
+	deps.officeHourRepo.On("CancelAppointment", uint(5), testNow).Return(nil)
+
+	result, err := svc.CancelAppointment(5, taughtStudent)
+
+	assert.NoError(t, err)
+	assert.Equal(t, entity.AppointmentCancelled, result.Status)
+	assert.Equal(t, testNow, *result.CancelledAt)
+}
+
+func TestCancelAppointment_Errors(t *testing.T) {
+	svc, deps := newTestOfficeHourService()
+
+	deps.officeHourRepo.On("FindAppointmentById", uint(5)).
+		Return(&entity.Appointment{ID: 5, TeacherID: 10, StudentID: 20, Status: entity.AppointmentCancelled}, nil)
+	deps.officeHourRepo.On("FindAppointmentById", uint(6)).Return(nil, gorm.ErrRecordNotFound)
+
+	_, err := svc.CancelAppointment(5, actor.Actor{ID: 21, Role: actor.RoleStudent})
+	assert.ErrorIs(t, err, ErrForbidden)
+
+	_, err = svc.CancelAppointment(5, officeTeacher)
+	assert.ErrorIs(t, err, ErrAlreadyCancelled)
+
+	_, err = svc.CancelAppointment(6, officeTeacher)
+	assert.ErrorIs(t, err, ErrAppointmentNotFound)
+}
+
+func TestDeleteOfficeHour_HasBookings(t *testing.T) {
+	svc, deps := newTestOfficeHourService()
+
+	deps.officeHourRepo.On("FindOfficeHourById", uint(10), uint(1)).Return(&tuesdayHours, nil)
+	deps.officeHourRepo.On("CountUpcomingAppointments", uint(1), testNow).Return(1, nil)
+
+	err := svc.DeleteOfficeHourById(10, 1, officeTeacher)
+
+	assert.ErrorIs(t, err, ErrHasBookings)
+	deps.officeHourRepo.AssertNotCalled(t, "DeleteOfficeHourById", mock.Anything)
+}
+
+func TestStudentCalendar(t *testing.T) {
+	svc, deps := newTestOfficeHourService()
+
+	teacher := &entity.Teacher{ID: 10, Name: "Ivanov"}
+	deps.officeHourRepo.On("FindStudentAppointmentsSince", uint(20), testNow.Add(-calendarHistory)).Return([]entity.Appointment{
+		{ID: 5, TeacherID: 10, Teacher: teacher, StartsAt: testNow.Add(time.Hour), EndsAt: testNow.Add(90 * time.Minute), Status: entity.AppointmentBooked},
+		{ID: 6, TeacherID: 10, Teacher: teacher, StartsAt: testNow.Add(2 * time.Hour), EndsAt: testNow.Add(150 * time.Minute), Status: entity.AppointmentCancelled},
+	}, nil)
+
+	calendar, err := svc.StudentCalendar(20, taughtStudent)
+
+	assert.NoError(t, err)
+	text := string(calendar)
+	assert.Contains(t, text, "UID:appointment-5@student_go\r\n")
+	assert.Contains(t, text, "SUMMARY:Office hours with Ivanov\r\n")
+	assert.Equal(t, 1, strings.Count(text, "STATUS:CANCELLED"))
+}
+
+func TestStudentCalendar_OtherStudentForbidden(t *testing.T) {
+	svc, _ := newTestOfficeHourService()
+
+	calendar, err := svc.StudentCalendar(20, actor.Actor{ID: 21, Role: actor.RoleStudent})
+
+	assert.Nil(t, calendar)
+	assert.True(t, errors.Is(err, ErrForbidden))
+}
@@ -0,0 +1,49 @@
+package officehour
+
+import (
+	"student_go/internal/entity"
+	"time"
+)
+
+type slot struct {
+	officeHour entity.OfficeHour
+	start      time.Time
+	end        time.Time
+}
+
+// expandSlots turns recurring office hours into concrete slots that lie
+// entirely within [from, to). Windows are interpreted as wall-clock times in
+// loc, so slots keep their local time across DST changes.
+func expandSlots(officeHours []entity.OfficeHour, from, to time.Time, loc *time.Location) []slot {
+	slots := make([]slot, 0)
+
+	first := from.In(loc)
+	day := time.Date(first.Year(), first.Month(), first.Day(), 0, 0, 0, 0, loc)
+	for ; day.Before(to); day = day.AddDate(0, 0, 1) {
+		date := day.Format(dateLayout)
+
+		for _, officeHour := range officeHours {
+			if time.Weekday(officeHour.Weekday) != day.Weekday() {
+				continue
+			}
+			if date < officeHour.ValidFrom.Format(dateLayout) {
+				continue
+			}
+			if officeHour.ValidUntil != nil && date > officeHour.ValidUntil.Format(dateLayout) {
+				continue
+			}
+
+			for minute := officeHour.StartMinute; minute+officeHour.SlotMinutes <= officeHour.EndMinute; minute += officeHour.SlotMinutes {
+				start := time.Date(day.Year(), day.Month(), day.Day(), 0, minute, 0, 0, loc)
+				end := time.Date(day.Year(), day.Month(), day.Day(), 0, minute+officeHour.SlotMinutes, 0, 0, loc)
+				if start.Before(from) || end.After(to) {
+					continue
+				}
+
+				slots = append(slots, slot{officeHour: officeHour, start: start, end: end})
+			}
+		}
+	}
+
+	return slots
+}
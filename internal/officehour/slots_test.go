@@ -0,0 +1,50 @@
+package officehour
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"student_go/internal/entity"
+	"testing"
+	"time"
+)
+
+func TestExpandSlots_KeepsWallClockAcrossDST(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	require.NoError(t, err)
+
+	mondays := entity.OfficeHour{
+		ID:          1,
+		Weekday:     int(time.Monday),
+		StartMinute: 10 * 60,
+		EndMinute:   11 * 60,
+		SlotMinutes: 60,
+		ValidFrom:   time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	from := time.Date(2025, 3, 24, 0, 0, 0, 0, berlin)
+	slots := expandSlots([]entity.OfficeHour{mondays}, from, from.AddDate(0, 0, 14), berlin)
+
+	require.Len(t, slots, 2)
+	assert.Equal(t, time.Date(2025, 3, 24, 9, 0, 0, 0, time.UTC), slots[0].start.UTC())
+	assert.Equal(t, time.Date(2025, 3, 31, 8, 0, 0, 0, time.UTC), slots[1].start.UTC())
+}
+
+func TestExpandSlots_RespectsValidity(t *testing.T) {
+	until := time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC)
+	mondays := entity.OfficeHour{
+		Weekday:     int(time.Monday),
+		StartMinute: 10 * 60,
+		EndMinute:   11 * 60,
+		SlotMinutes: 20,
+		ValidFrom:   time.Date(2025, 3, 5, 0, 0, 0, 0, time.UTC),
+		ValidUntil:  &until,
+	}
+
+	from := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	slots := expandSlots([]entity.OfficeHour{mondays}, from, from.AddDate(0, 0, 21), time.UTC)
+
+	require.Len(t, slots, 3)
+	for _, s := range slots {
+		assert.Equal(t, 10, s.start.Day())
+	}
+}
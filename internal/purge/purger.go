@@ -0,0 +1,74 @@
+package purge
+
+import (
+	"context"
+	"go.uber.org/zap"
+	"student_go/internal/config"
+	"student_go/pkg/log"
+	"time"
+)
+
+const (
+	defaultRetention     = 30 * 24 * time.Hour
+	defaultPurgeInterval = time.Hour
+)
+
+// Purger periodically removes soft-deleted rows once they are older than
+// the retention period and can no longer be restored.
+type Purger struct {
+	repo      Repository
+	retention time.Duration
+	interval  time.Duration
+	now       func() time.Time
+}
+
+func NewPurger(repo Repository) *Purger {
+	p := &Purger{
+		repo: repo,
+		now:  time.Now,
+	}
+	if config.Config != nil {
+		p.retention = config.Config.SoftDelete.Retention
+		p.interval = config.Config.SoftDelete.PurgeInterval
+	}
+	if p.retention <= 0 {
+		p.retention = defaultRetention
+	}
+	if p.interval <= 0 {
+		p.interval = defaultPurgeInterval
+	}
+
+	return p
+}
+
+// Run purges until ctx is cancelled.
+func (p *Purger) Run(ctx context.Context) {
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := p.PurgeExpired(); err != nil {
+			log.Log.Error("Failed to purge deleted records", zap.Error(err))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// PurgeExpired removes the rows deleted more than the retention period
+// ago and returns how many there were.
+func (p *Purger) PurgeExpired() (int64, error) {
+	purged, err := p.repo.PurgeDeleted(p.now().Add(-p.retention))
+	if err != nil {
+		return 0, err
+	}
+	if purged > 0 {
+		log.Log.Info("Purged deleted records", zap.Int64("count", purged))
+	}
+
+	return purged, nil
+}
@@ -0,0 +1,52 @@
+package purge
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+	mocks2 "student_go/internal/mocks"
+	"student_go/pkg/log"
+)
+
+func init() {
+	logger, _ := zap.NewDevelopment()
+	log.Log = logger
+}
+
+func TestNewPurger_Defaults(t *testing.T) {
+	p := NewPurger(new(mocks2.PurgeRepository))
+
+	assert.Equal(t, defaultRetention, p.retention)
+	assert.Equal(t, defaultPurgeInterval, p.interval)
+}
+
+func TestPurgeExpired(t *testing.T) {
+	mockRepo := new(mocks2.PurgeRepository)
+	now := time.Date(2024, 3, 31, 12, 0, 0, 0, time.UTC)
+	p := NewPurger(mockRepo)
+	p.now = func() time.Time { return now }
+
+	mockRepo.On("PurgeDeleted", now.Add(-defaultRetention)).Return(int64(3), nil)
+
+	purged, err := p.PurgeExpired()
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), purged)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestPurgeExpired_Error(t *testing.T) {
+	mockRepo := new(mocks2.PurgeRepository)
+	p := NewPurger(mockRepo)
+
+	mockRepo.On("PurgeDeleted", mock.AnythingOfType("time.Time")).Return(int64(0), errors.New("db down"))
+
+	purged, err := p.PurgeExpired()
+
+	assert.EqualError(t, err, "db down")
+	assert.Zero(t, purged)
+}
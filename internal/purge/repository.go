@@ -0,0 +1,48 @@
+package purge
+
+import (
+	"gorm.io/gorm"
+	"student_go/internal/entity"
+	"student_go/pkg/dbcontext"
+	"time"
+)
+
+type Repository interface {
+	// PurgeDeleted removes for good the students, teachers, courses and
+	// departments deleted before the cutoff and returns how many rows went.
+	PurgeDeleted(before time.Time) (int64, error)
+}
+
+// purged are the soft-deletable entities. Their dependent rows, like
+// enrollments, go with them through ON DELETE CASCADE.
+var purged = []interface{}{
+	&entity.Course{},
+	&entity.Student{},
+	&entity.Department{},
+	&entity.Teacher{},
+}
+
+type repository struct{}
+
+func NewPurgeRepository() Repository {
+	return &repository{}
+}
+
+func (r *repository) PurgeDeleted(before time.Time) (int64, error) {
+	var total int64
+	err := dbcontext.DB.Transaction(func(tx *gorm.DB) error {
+		for _, model := range purged {
+			result := tx.Unscoped().Where("deleted_at < ?", before).Delete(model)
+			if result.Error != nil {
+				return result.Error
+			}
+			total += result.RowsAffected
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return total, nil
+}
@@ -0,0 +1,74 @@
+package purge
+
+import (
+	"database/sql"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+
+	"student_go/pkg/dbcontext"
+)
+
+func setupTestDB(t *testing.T) (*sql.DB, sqlmock.Sqlmock, *gorm.DB) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+
+	dialector := postgres.New(postgres.Config{
+		Conn:                 db,
+		PreferSimpleProtocol: true,
+	})
+
+	gormDB, err := gorm.Open(dialector, &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	assert.NoError(t, err)
+
+	dbcontext.DB = gormDB
+	return db, mock, gormDB
+}
+
+func TestPurgeDeleted(t *testing.T) {
+	db, mock, _ := setupTestDB(t)
+	defer db.Close()
+
+	before := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	mock.ExpectBegin()
+	for i, table := range []string{"courses", "students", "departments", "teachers"} {
+		mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "` + table + `" WHERE deleted_at < $1`)).
+			WithArgs(before).
+			WillReturnResult(sqlmock.NewResult(0, int64(i)))
+	}
+	mock.ExpectCommit()
+
+	repo := NewPurgeRepository()
+	purged, err := repo.PurgeDeleted(before)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(6), purged)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPurgeDeleted_Error(t *testing.T) {
+	db, mock, _ := setupTestDB(t)
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "courses"`)).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "students"`)).
+		WillReturnError(errors.New("db down"))
+	mock.ExpectRollback()
+
+	repo := NewPurgeRepository()
+	purged, err := repo.PurgeDeleted(time.Now())
+
+	assert.EqualError(t, err, "db down")
+	assert.Zero(t, purged)
+}
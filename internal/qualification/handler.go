@@ -0,0 +1,121 @@
+package qualification
+
+import (
+	"errors"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+	"net/http"
+	"strconv"
+	"student_go/internal/actor"
+	"student_go/internal/dto/request"
+	"student_go/internal/teacher"
+	"student_go/pkg/log"
+)
+
+type Handler struct {
+	Service Service
+}
+
+func NewQualificationHandler() *Handler {
+	return &Handler{
+		Service: NewQualificationService(NewQualificationRepository(), teacher.NewTeacherRepository()),
+	}
+}
+
+func (h *Handler) CreateQualification(c *gin.Context) {
+	teacherId, ok := parseID(c, "id", "invalid teacher ID")
+	if !ok {
+		return
+	}
+
+	a, err := actor.FromContext(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+
+	var req request.QualificationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		log.Log.Warn("Invalid request in CreateQualification", zap.Error(err))
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	log.Log.Info("CreateQualification called", zap.Uint("teacher_id", teacherId), zap.String("subject", req.Subject))
+
+	qualificationResp, err := h.Service.CreateQualification(teacherId, a, req)
+	if err != nil {
+		writeError(c, err, "failed to save qualification")
+		return
+	}
+
+	c.JSON(http.StatusCreated, qualificationResp)
+}
+
+func (h *Handler) FindAllQualifications(c *gin.Context) {
+	teacherId, ok := parseID(c, "id", "invalid teacher ID")
+	if !ok {
+		return
+	}
+
+	log.Log.Info("FindAllQualifications called", zap.Uint("teacher_id", teacherId))
+
+	qualifications, err := h.Service.FindAllQualifications(teacherId)
+	if err != nil {
+		writeError(c, err, "failed to get qualifications")
+		return
+	}
+
+	c.JSON(http.StatusOK, qualifications)
+}
+
+func (h *Handler) DeleteQualificationById(c *gin.Context) {
+	teacherId, ok := parseID(c, "id", "invalid teacher ID")
+	if !ok {
+		return
+	}
+	id, ok := parseID(c, "qualificationId", "invalid qualification ID")
+	if !ok {
+		return
+	}
+
+	a, err := actor.FromContext(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+
+	log.Log.Info("DeleteQualificationById called", zap.Uint("teacher_id", teacherId), zap.Uint("id", id))
+
+	if err := h.Service.DeleteQualificationById(teacherId, id, a); err != nil {
+		writeError(c, err, "failed to delete qualification")
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func parseID(c *gin.Context, name string, message string) (uint, bool) {
+	param := c.Param(name)
+	parsed, err := strconv.ParseUint(param, 10, 32)
+	if err != nil {
+		log.Log.Warn("Invalid path parameter", zap.String(name, param), zap.Error(err))
+		c.JSON(http.StatusBadRequest, gin.H{"error": message})
+		return 0, false
+	}
+
+	return uint(parsed), true
+}
+
+func writeError(c *gin.Context, err error, fallback string) {
+	switch {
+	case errors.Is(err, ErrTeacherNotFound), errors.Is(err, ErrQualificationNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	case errors.Is(err, ErrForbidden):
+		c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+	case errors.Is(err, ErrDuplicate):
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": fallback})
+	}
+}
@@ -0,0 +1,90 @@
+package qualification
+
+import (
+	"bytes"
+	"encoding/json"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"net/http"
+	"net/http/httptest"
+	"student_go/internal/actor"
+	"student_go/internal/dto/request"
+	"student_go/internal/dto/response"
+	"student_go/internal/mocks"
+	"testing"
+)
+
+func setupHandlerTest() (*gin.Engine, *mocks.QualificationServiceMock, *Handler) {
+	gin.SetMode(gin.TestMode)
+	mockService := new(mocks.QualificationServiceMock)
+	handler := &Handler{Service: mockService}
+	r := gin.Default()
+	return r, mockService, handler
+}
+
+func setActor(req *http.Request, id string, role string) {
+	req.Header.Set(actor.HeaderUserID, id)
+	req.Header.Set(actor.HeaderUserRole, role)
+}
+
+func TestCreateQualificationHandler(t *testing.T) {
+	r, mockService, handler := setupHandlerTest()
+	input := request.QualificationRequest{Subject: "MATH"}
+	mockService.On("CreateQualification", uint(3), admin, input).Return(&response.QualificationResponse{ID: 1}, nil)
+
+	r.POST("/teachers/:id/qualifications", handler.CreateQualification)
+	body, _ := json.Marshal(input)
+	req := httptest.NewRequest(http.MethodPost, "/teachers/3/qualifications", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	setActor(req, "1", "admin")
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusCreated, resp.Code)
+	mockService.AssertExpectations(t)
+}
+
+func TestCreateQualificationHandler_InvalidDate(t *testing.T) {
+	r, mockService, handler := setupHandlerTest()
+
+	r.POST("/teachers/:id/qualifications", handler.CreateQualification)
+	req := httptest.NewRequest(http.MethodPost, "/teachers/3/qualifications",
+		bytes.NewBufferString(`{"subject":"MATH","certifiedUntil":"30.06.2027"}`))
+	req.Header.Set("Content-Type", "application/json")
+	setActor(req, "1", "admin")
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	mockService.AssertNotCalled(t, "CreateQualification", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestCreateQualificationHandler_Duplicate(t *testing.T) {
+	r, mockService, handler := setupHandlerTest()
+	input := request.QualificationRequest{Subject: "MATH"}
+	mockService.On("CreateQualification", uint(3), admin, input).Return(nil, ErrDuplicate)
+
+	r.POST("/teachers/:id/qualifications", handler.CreateQualification)
+	body, _ := json.Marshal(input)
+	req := httptest.NewRequest(http.MethodPost, "/teachers/3/qualifications", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	setActor(req, "1", "admin")
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusConflict, resp.Code)
+}
+
+func TestDeleteQualificationHandler_Forbidden(t *testing.T) {
+	r, mockService, handler := setupHandlerTest()
+	mockService.On("DeleteQualificationById", uint(3), uint(9), teacherActor).Return(ErrForbidden)
+
+	r.DELETE("/teachers/:id/qualifications/:qualificationId", handler.DeleteQualificationById)
+	req := httptest.NewRequest(http.MethodDelete, "/teachers/3/qualifications/9", nil)
+	setActor(req, "3", "teacher")
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusForbidden, resp.Code)
+}
@@ -0,0 +1,104 @@
+package qualification
+
+import (
+	"errors"
+	"gorm.io/gorm"
+	"student_go/internal/entity"
+	"student_go/pkg/dbcontext"
+	"time"
+)
+
+// uniqueViolation is the Postgres SQLSTATE for a unique constraint error.
+const uniqueViolation = "23505"
+
+type Repository interface {
+	Save(qualification *entity.TeacherQualification) (*entity.TeacherQualification, error)
+	FindAll(teacherId uint) ([]entity.TeacherQualification, error)
+	DeleteById(teacherId, id uint) (bool, error)
+	IsQualified(teacherId uint, subject string, on time.Time) (bool, error)
+	FindQualifiedTeachers(subject string, on time.Time) ([]entity.Teacher, error)
+}
+
+type repository struct{}
+
+func NewQualificationRepository() Repository {
+	return &repository{}
+}
+
+func (r *repository) Save(qualification *entity.TeacherQualification) (*entity.TeacherQualification, error) {
+	err := dbcontext.DB.Create(qualification).Error
+
+	var state interface{ SQLState() string }
+	if errors.As(err, &state) && state.SQLState() == uniqueViolation {
+		return nil, ErrDuplicate
+	}
+
+	return qualification, err
+}
+
+func (r *repository) FindAll(teacherId uint) ([]entity.TeacherQualification, error) {
+	var qualifications []entity.TeacherQualification
+	result := dbcontext.DB.
+		Where("teacher_id = ?", teacherId).
+		Order("subject").
+		Find(&qualifications)
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return qualifications, nil
+}
+
+// DeleteById reports whether the teacher had the qualification.
+func (r *repository) DeleteById(teacherId, id uint) (bool, error) {
+	result := dbcontext.DB.
+		Where("teacher_id = ?", teacherId).
+		Delete(&entity.TeacherQualification{}, id)
+
+	return result.RowsAffected > 0, result.Error
+}
+
+// IsQualified reports whether the teacher holds a qualification for the
+// subject that is still valid on the given day.
+func (r *repository) IsQualified(teacherId uint, subject string, on time.Time) (bool, error) {
+	var exists bool
+	err := validOn(dbcontext.DB.Model(&entity.TeacherQualification{}), on).
+		Select("count(*) > 0").
+		Where("teacher_id = ? AND subject = ?", teacherId, subject).
+		Find(&exists).
+		Error
+
+	return exists, err
+}
+
+// FindQualifiedTeachers returns the teachers with a valid qualification for
+// the subject, or all teachers when subject is empty. Courses are preloaded
+// so that callers can weigh the teachers' workload; Qualifications holds
+// only the matching qualification.
+func (r *repository) FindQualifiedTeachers(subject string, on time.Time) ([]entity.Teacher, error) {
+	var teachers []entity.Teacher
+
+	db := dbcontext.DB.Preload("Courses")
+	if subject != "" {
+		db = db.
+			Preload("Qualifications", func(db *gorm.DB) *gorm.DB {
+				return validOn(db, on).Where("subject = ?", subject)
+			}).
+			Where("id IN (?)", validOn(dbcontext.DB.Model(&entity.TeacherQualification{}), on).
+				Select("teacher_id").
+				Where("subject = ?", subject))
+	}
+
+	result := db.Order("name").Find(&teachers)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return teachers, nil
+}
+
+// validOn keeps qualifications that have not expired before the given day.
+func validOn(db *gorm.DB, on time.Time) *gorm.DB {
+	return db.Where("certified_until IS NULL OR certified_until >= ?", on.Format(dateLayout))
+}
@@ -0,0 +1,74 @@
+package qualification
+
+import (
+	"database/sql"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"regexp"
+	"testing"
+	"time"
+
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+
+	"student_go/pkg/dbcontext"
+)
+
+func setupTestDB(t *testing.T) (*sql.DB, sqlmock.Sqlmock, *gorm.DB) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+
+	dialector := postgres.New(postgres.Config{
+		Conn:                 db,
+		PreferSimpleProtocol: true,
+	})
+
+	gormDB, err := gorm.Open(dialector, &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	assert.NoError(t, err)
+
+	dbcontext.DB = gormDB
+	return db, mock, gormDB
+}
+
+func TestQualificationIsQualified(t *testing.T) {
+	db, mock, _ := setupTestDB(t)
+	defer db.Close()
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) > 0 FROM "teacher_qualifications" WHERE (certified_until IS NULL OR certified_until >= $1) AND (teacher_id = $2 AND subject = $3)`)).
+		WithArgs("2026-10-18", 3, "MATH").
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(true))
+
+	repo := NewQualificationRepository()
+	qualified, err := repo.IsQualified(3, "MATH", time.Date(2026, 10, 18, 23, 30, 0, 0, time.UTC))
+
+	require.NoError(t, err)
+	assert.True(t, qualified)
+}
+
+func TestQualificationFindQualifiedTeachers(t *testing.T) {
+	db, mock, _ := setupTestDB(t)
+	defer db.Close()
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "teachers" WHERE id IN (SELECT "teacher_id" FROM "teacher_qualifications" WHERE (certified_until IS NULL OR certified_until >= $1) AND subject = $2) AND "teachers"."deleted_at" IS NULL ORDER BY name`)).
+		WithArgs("2026-10-18", "MATH").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(3, "Anna"))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "courses" WHERE "courses"."teacher_id" = $1`)).
+		WithArgs(3).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "teacher_id"}).AddRow(5, 3))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "teacher_qualifications" WHERE (certified_until IS NULL OR certified_until >= $1) AND subject = $2 AND "teacher_qualifications"."teacher_id" = $3`)).
+		WithArgs("2026-10-18", "MATH", 3).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "teacher_id", "subject"}).AddRow(1, 3, "MATH"))
+
+	repo := NewQualificationRepository()
+	teachers, err := repo.FindQualifiedTeachers("MATH", time.Date(2026, 10, 18, 9, 0, 0, 0, time.UTC))
+
+	require.NoError(t, err)
+	require.Len(t, teachers, 1)
+	assert.Len(t, teachers[0].Courses, 1)
+	assert.Len(t, teachers[0].Qualifications, 1)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,149 @@
+package qualification
+
+import (
+	"errors"
+	"go.uber.org/zap"
+	"strings"
+	"student_go/internal/actor"
+	"student_go/internal/dto/request"
+	"student_go/internal/dto/response"
+	"student_go/internal/entity"
+	"student_go/internal/teacher"
+	"student_go/pkg/log"
+	"time"
+)
+
+const dateLayout = "2006-01-02"
+
+var (
+	ErrTeacherNotFound       = errors.New("teacher not found")
+	ErrQualificationNotFound = errors.New("qualification not found")
+	ErrForbidden             = errors.New("forbidden")
+	ErrDuplicate             = errors.New("teacher already has a qualification for this subject")
+)
+
+type Service interface {
+	CreateQualification(teacherId uint, a actor.Actor, input request.QualificationRequest) (*response.QualificationResponse, error)
+	FindAllQualifications(teacherId uint) ([]*response.QualificationResponse, error)
+	DeleteQualificationById(teacherId, id uint, a actor.Actor) error
+}
+
+type service struct {
+	qualificationRepository Repository
+	teacherRepository       teacher.Repository
+	now                     func() time.Time
+}
+
+func NewQualificationService(qualificationRepository Repository, teacherRepository teacher.Repository) Service {
+	return &service{
+		qualificationRepository: qualificationRepository,
+		teacherRepository:       teacherRepository,
+		now:                     time.Now,
+	}
+}
+
+// CreateQualification records a qualification. Only admins manage them.
+func (s *service) CreateQualification(teacherId uint, a actor.Actor, input request.QualificationRequest) (*response.QualificationResponse, error) {
+	log.Log.Info("CreateQualification (service) called", zap.Uint("teacher_id", teacherId), zap.String("subject", input.Subject))
+
+	if !a.IsAdmin() {
+		return nil, ErrForbidden
+	}
+	if err := s.checkTeacher(teacherId); err != nil {
+		return nil, err
+	}
+
+	qualification := entity.TeacherQualification{
+		TeacherID: teacherId,
+		Subject:   NormalizeSubject(input.Subject),
+	}
+	if input.CertifiedUntil != nil {
+		certifiedUntil, err := time.Parse(dateLayout, *input.CertifiedUntil)
+		if err != nil {
+			return nil, err
+		}
+		qualification.CertifiedUntil = &certifiedUntil
+	}
+
+	saved, err := s.qualificationRepository.Save(&qualification)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.toQualificationResponse(saved), nil
+}
+
+func (s *service) FindAllQualifications(teacherId uint) ([]*response.QualificationResponse, error) {
+	log.Log.Info("FindAllQualifications (service) called", zap.Uint("teacher_id", teacherId))
+
+	if err := s.checkTeacher(teacherId); err != nil {
+		return nil, err
+	}
+
+	qualifications, err := s.qualificationRepository.FindAll(teacherId)
+	if err != nil {
+		return nil, err
+	}
+
+	qualificationResponses := make([]*response.QualificationResponse, 0, len(qualifications))
+	for i := range qualifications {
+		qualificationResponses = append(qualificationResponses, s.toQualificationResponse(&qualifications[i]))
+	}
+
+	return qualificationResponses, nil
+}
+
+func (s *service) DeleteQualificationById(teacherId, id uint, a actor.Actor) error {
+	log.Log.Info("DeleteQualificationById (service) called", zap.Uint("teacher_id", teacherId), zap.Uint("id", id))
+
+	if !a.IsAdmin() {
+		return ErrForbidden
+	}
+
+	deleted, err := s.qualificationRepository.DeleteById(teacherId, id)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return ErrQualificationNotFound
+	}
+
+	return nil
+}
+
+func (s *service) checkTeacher(teacherId uint) error {
+	exists, err := s.teacherRepository.ExistsById(teacherId)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return ErrTeacherNotFound
+	}
+
+	return nil
+}
+
+func (s *service) toQualificationResponse(qualification *entity.TeacherQualification) *response.QualificationResponse {
+	return &response.QualificationResponse{
+		ID:             qualification.ID,
+		TeacherID:      qualification.TeacherID,
+		Subject:        qualification.Subject,
+		CertifiedUntil: FormatDate(qualification.CertifiedUntil),
+		Expired:        qualification.CertifiedUntil != nil && qualification.CertifiedUntil.Format(dateLayout) < s.now().Format(dateLayout),
+	}
+}
+
+// NormalizeSubject brings subject codes to one spelling so that "math"
+// and " MATH " match.
+func NormalizeSubject(subject string) string {
+	return strings.ToUpper(strings.TrimSpace(subject))
+}
+
+func FormatDate(value *time.Time) *string {
+	if value == nil {
+		return nil
+	}
+
+	formatted := value.Format(dateLayout)
+	return &formatted
+}
@@ -0,0 +1,106 @@
+package qualification
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"student_go/internal/actor"
+	"student_go/internal/dto/request"
+	"student_go/internal/entity"
+	mocks2 "student_go/internal/mocks"
+	"student_go/pkg/log"
+	"testing"
+	"time"
+)
+
+func init() {
+	logger, _ := zap.NewDevelopment()
+	log.Log = logger
+}
+
+var (
+	admin        = actor.Actor{ID: 1, Role: actor.RoleAdmin}
+	teacherActor = actor.Actor{ID: 3, Role: actor.RoleTeacher}
+)
+
+func newTestQualificationService() (*service, *mocks2.QualificationRepository, *mocks2.TeacherRepository) {
+	mockQualificationRepo := new(mocks2.QualificationRepository)
+	mockTeacherRepo := new(mocks2.TeacherRepository)
+
+	mockTeacherRepo.On("ExistsById", uint(3)).Return(true, nil).Maybe()
+
+	svc := NewQualificationService(mockQualificationRepo, mockTeacherRepo).(*service)
+	svc.now = func() time.Time { return time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC) }
+	return svc, mockQualificationRepo, mockTeacherRepo
+}
+
+func TestCreateQualification(t *testing.T) {
+	svc, mockQualificationRepo, _ := newTestQualificationService()
+	until := "2027-06-30"
+
+	mockQualificationRepo.On("Save", mock.MatchedBy(func(q *entity.TeacherQualification) bool {
+		return q.TeacherID == 3 && q.Subject == "MATH" && q.CertifiedUntil.Format(dateLayout) == until
+	})).Return(func(q *entity.TeacherQualification) *entity.TeacherQualification {
+		q.ID = 9
+		return q
+	}, nil)
+
+	result, err := svc.CreateQualification(3, admin, request.QualificationRequest{Subject: " math ", CertifiedUntil: &until})
+
+	require.NoError(t, err)
+	assert.Equal(t, uint(9), result.ID)
+	assert.Equal(t, "MATH", result.Subject)
+	assert.Equal(t, until, *result.CertifiedUntil)
+	assert.False(t, result.Expired)
+}
+
+func TestCreateQualification_NotAdmin(t *testing.T) {
+	svc, mockQualificationRepo, _ := newTestQualificationService()
+
+	result, err := svc.CreateQualification(3, teacherActor, request.QualificationRequest{Subject: "MATH"})
+
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, ErrForbidden)
+	mockQualificationRepo.AssertNotCalled(t, "Save", mock.Anything)
+}
+
+func TestFindAllQualifications_Expired(t *testing.T) {
+	svc, mockQualificationRepo, _ := newTestQualificationService()
+	lastDay := time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC)
+	expired := time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC)
+
+	mockQualificationRepo.On("FindAll", uint(3)).Return([]entity.TeacherQualification{
+		{ID: 1, TeacherID: 3, Subject: "MATH", CertifiedUntil: &lastDay},
+		{ID: 2, TeacherID: 3, Subject: "PHYS", CertifiedUntil: &expired},
+		{ID: 3, TeacherID: 3, Subject: "CS"},
+	}, nil)
+
+	result, err := svc.FindAllQualifications(3)
+
+	require.NoError(t, err)
+	require.Len(t, result, 3)
+	assert.False(t, result[0].Expired)
+	assert.True(t, result[1].Expired)
+	assert.False(t, result[2].Expired)
+	assert.Nil(t, result[2].CertifiedUntil)
+}
+
+func TestFindAllQualifications_TeacherNotFound(t *testing.T) {
+	svc, _, mockTeacherRepo := newTestQualificationService()
+	mockTeacherRepo.On("ExistsById", uint(4)).Return(false, nil)
+
+	result, err := svc.FindAllQualifications(4)
+
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, ErrTeacherNotFound)
+}
+
+func TestDeleteQualificationById_NotFound(t *testing.T) {
+	svc, mockQualificationRepo, _ := newTestQualificationService()
+	mockQualificationRepo.On("DeleteById", uint(3), uint(9)).Return(false, nil)
+
+	err := svc.DeleteQualificationById(3, 9, admin)
+
+	assert.ErrorIs(t, err, ErrQualificationNotFound)
+}
@@ -0,0 +1,61 @@
+package search
+
+import (
+	"errors"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+	"net/http"
+	"strconv"
+	"strings"
+	"student_go/pkg/log"
+)
+
+type Handler struct {
+	Service Service
+}
+
+func NewSearchHandler() *Handler {
+	return &Handler{
+		Service: NewSearchService(NewSearchRepository()),
+	}
+}
+
+// Search answers GET /search?q=ann&type=student,teacher&limit=10 with the
+// best matches across all entity types.
+func (h *Handler) Search(c *gin.Context) {
+	q := c.Query("q")
+
+	var types []string
+	if value := c.Query("type"); value != "" {
+		types = strings.Split(value, ",")
+	}
+
+	limit := 0
+	if value := c.Query("limit"); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed <= 0 {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid limit"})
+			return
+		}
+		limit = parsed
+	}
+
+	log.Log.Info("Search called", zap.String("q", q), zap.Strings("types", types))
+
+	hits, err := h.Service.Search(q, types, limit)
+	if err != nil {
+		writeError(c, err, "failed to search")
+		return
+	}
+
+	c.JSON(http.StatusOK, hits)
+}
+
+func writeError(c *gin.Context, err error, fallback string) {
+	switch {
+	case errors.Is(err, ErrEmptyQuery), errors.Is(err, ErrInvalidType):
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": fallback})
+	}
+}
@@ -0,0 +1,59 @@
+package search
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"net/http"
+	"net/http/httptest"
+	"student_go/internal/dto/response"
+	"student_go/internal/mocks"
+	"testing"
+)
+
+func setupHandlerTest() (*gin.Engine, *mocks.SearchServiceMock, *Handler) {
+	gin.SetMode(gin.TestMode)
+	mockService := new(mocks.SearchServiceMock)
+	handler := &Handler{Service: mockService}
+	r := gin.Default()
+	return r, mockService, handler
+}
+
+func TestSearchHandler(t *testing.T) {
+	r, mockService, handler := setupHandlerTest()
+	mockService.On("Search", "ann smith", []string{"student", "teacher"}, 5).
+		Return([]*response.SearchHitResponse{{Type: "student", ID: 3, Title: "Ann Smith", Snippet: "<mark>Ann</mark> <mark>Smith</mark>", Rank: 0.6}}, nil)
+
+	r.GET("/search", handler.Search)
+	req := httptest.NewRequest(http.MethodGet, "/search?q=ann+smith&type=student,teacher&limit=5", nil)
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Contains(t, resp.Body.String(), `"type":"student"`)
+	mockService.AssertExpectations(t)
+}
+
+func TestSearchHandler_EmptyQuery(t *testing.T) {
+	r, mockService, handler := setupHandlerTest()
+	mockService.On("Search", "", []string(nil), 0).Return(nil, ErrEmptyQuery)
+
+	r.GET("/search", handler.Search)
+	req := httptest.NewRequest(http.MethodGet, "/search", nil)
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+}
+
+func TestSearchHandler_InvalidLimit(t *testing.T) {
+	r, mockService, handler := setupHandlerTest()
+
+	r.GET("/search", handler.Search)
+	req := httptest.NewRequest(http.MethodGet, "/search?q=ann&limit=abc", nil)
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	mockService.AssertNotCalled(t, "Search", mock.Anything, mock.Anything, mock.Anything)
+}
@@ -0,0 +1,86 @@
+package search
+
+import (
+	"html"
+	"strings"
+	"student_go/internal/entity"
+	"student_go/pkg/dbcontext"
+)
+
+type Repository interface {
+	// Search runs the tsquery over the given entity types, best matches
+	// first.
+	Search(tsquery string, types []string, limit int) ([]entity.SearchHit, error)
+}
+
+// The matched words in snippets are first marked with private-use
+// characters, so that the user-supplied text can be HTML-escaped before
+// the markers become <mark> tags.
+const (
+	startSel        = "\uE000"
+	stopSel         = "\uE001"
+	headlineOptions = "StartSel=" + startSel + ", StopSel=" + stopSel + ", HighlightAll=true"
+)
+
+var highlighter = strings.NewReplacer(startSel, "<mark>", stopSel, "</mark>")
+
+// sources holds one SELECT per entity type. Snippets are built from the
+// original text, so words that only match once accents are removed are not
+// highlighted.
+var sources = map[string]string{
+	entity.SearchStudent: `SELECT 'student' AS type, s.id, s.name AS title,
+		ts_headline('simple', s.name || ' ' || s.email, q.query, @options) AS snippet,
+		ts_rank(s.search_vector, q.query) AS rank
+		FROM students s, q WHERE s.search_vector @@ q.query AND s.deleted_at IS NULL`,
+	entity.SearchTeacher: `SELECT 'teacher' AS type, t.id, t.name AS title,
+		ts_headline('simple', t.name || ' ' || t.email, q.query, @options) AS snippet,
+		ts_rank(t.search_vector, q.query) AS rank
+		FROM teachers t, q WHERE t.search_vector @@ q.query AND t.deleted_at IS NULL`,
+	entity.SearchCourse: `SELECT 'course' AS type, c.id, c.title AS title,
+		ts_headline('simple', c.title || ' ' || c.subject, q.query, @options) AS snippet,
+		ts_rank(c.search_vector, q.query) AS rank
+		FROM courses c, q WHERE c.search_vector @@ q.query AND c.deleted_at IS NULL`,
+	entity.SearchDepartment: `SELECT 'department' AS type, d.id, d.name AS title,
+		ts_headline('simple', d.name, q.query, @options) AS snippet,
+		ts_rank(d.search_vector, q.query) AS rank
+		FROM departments d, q WHERE d.search_vector @@ q.query AND d.deleted_at IS NULL`,
+}
+
+type repository struct{}
+
+func NewSearchRepository() Repository {
+	return &repository{}
+}
+
+func (r *repository) Search(tsquery string, types []string, limit int) ([]entity.SearchHit, error) {
+	parts := make([]string, 0, len(types))
+	for _, t := range types {
+		parts = append(parts, sources[t])
+	}
+
+	sql := "WITH q AS (SELECT to_tsquery('simple', search_unaccent(@query)) AS query) " +
+		strings.Join(parts, " UNION ALL ") +
+		" ORDER BY rank DESC, type, id LIMIT @limit"
+
+	var hits []entity.SearchHit
+	err := dbcontext.DB.Raw(sql, map[string]interface{}{
+		"query":   tsquery,
+		"options": headlineOptions,
+		"limit":   limit,
+	}).Scan(&hits).Error
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range hits {
+		hits[i].Snippet = highlight(hits[i].Snippet)
+	}
+
+	return hits, nil
+}
+
+// highlight escapes a snippet from ts_headline and turns its markers into
+// <mark> tags.
+func highlight(snippet string) string {
+	return highlighter.Replace(html.EscapeString(snippet))
+}
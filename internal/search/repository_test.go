@@ -0,0 +1,79 @@
+package search
+
+import (
+	"database/sql"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"regexp"
+	"student_go/internal/entity"
+	"testing"
+
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+
+	"student_go/pkg/dbcontext"
+)
+
+func setupTestDB(t *testing.T) (*sql.DB, sqlmock.Sqlmock, *gorm.DB) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+
+	dialector := postgres.New(postgres.Config{
+		Conn:                 db,
+		PreferSimpleProtocol: true,
+	})
+
+	gormDB, err := gorm.Open(dialector, &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	assert.NoError(t, err)
+
+	dbcontext.DB = gormDB
+	return db, mock, gormDB
+}
+
+func TestSearch(t *testing.T) {
+	db, mock, _ := setupTestDB(t)
+	defer db.Close()
+
+	mock.ExpectQuery(`^WITH q AS \(SELECT to_tsquery\('simple', search_unaccent\(\$1\)\) AS query\) `+
+		`SELECT 'student' AS type, .* FROM students s, q WHERE s.search_vector @@ q.query AND s.deleted_at IS NULL`+
+		` UNION ALL SELECT 'course' AS type, .* FROM courses c, q WHERE c.search_vector @@ q.query AND c.deleted_at IS NULL`+
+		regexp.QuoteMeta(` ORDER BY rank DESC, type, id LIMIT $4`)).
+		WithArgs("ann:*", headlineOptions, headlineOptions, 10).
+		WillReturnRows(sqlmock.NewRows([]string{"type", "id", "title", "snippet", "rank"}).
+			AddRow("student", 3, "Ann Smith", startSel+"Ann"+stopSel+" Smith ann@example.com", 0.6).
+			AddRow("course", 7, "Annual Review", startSel+"Annual"+stopSel+" Review History", 0.3))
+
+	repo := NewSearchRepository()
+	hits, err := repo.Search("ann:*", []string{entity.SearchStudent, entity.SearchCourse}, 10)
+
+	require.NoError(t, err)
+	assert.Equal(t, []entity.SearchHit{
+		{Type: "student", ID: 3, Title: "Ann Smith", Snippet: "<mark>Ann</mark> Smith ann@example.com", Rank: 0.6},
+		{Type: "course", ID: 7, Title: "Annual Review", Snippet: "<mark>Annual</mark> Review History", Rank: 0.3},
+	}, hits)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSearch_EscapesSnippet(t *testing.T) {
+	db, mock, _ := setupTestDB(t)
+	defer db.Close()
+
+	name := `<img src=x onerror=alert(1)>`
+	mock.ExpectQuery(`^WITH q AS`).
+		WithArgs("img:*", headlineOptions, 10).
+		WillReturnRows(sqlmock.NewRows([]string{"type", "id", "title", "snippet", "rank"}).
+			AddRow("student", 3, name, "<"+startSel+"img"+stopSel+" src=x onerror=alert(1)> x@example.com", 0.6))
+
+	repo := NewSearchRepository()
+	hits, err := repo.Search("img:*", []string{entity.SearchStudent}, 10)
+
+	require.NoError(t, err)
+	require.Len(t, hits, 1)
+	assert.Equal(t, "&lt;<mark>img</mark> src=x onerror=alert(1)&gt; x@example.com", hits[0].Snippet)
+	assert.Equal(t, name, hits[0].Title)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
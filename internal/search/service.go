@@ -0,0 +1,125 @@
+package search
+
+import (
+	"errors"
+	"go.uber.org/zap"
+	"strings"
+	"student_go/internal/dto/response"
+	"student_go/internal/entity"
+	"student_go/pkg/log"
+	"unicode"
+)
+
+const (
+	DefaultLimit = 20
+	MaxLimit     = 100
+)
+
+var (
+	ErrEmptyQuery  = errors.New("search query must contain a letter or digit")
+	ErrInvalidType = errors.New("invalid search type")
+)
+
+// allTypes is the order the types are searched in when none are given.
+var allTypes = []string{
+	entity.SearchStudent,
+	entity.SearchTeacher,
+	entity.SearchCourse,
+	entity.SearchDepartment,
+}
+
+type Service interface {
+	// Search finds students, teachers, courses and departments matching q,
+	// narrowed to types when any are given. Every word of q must match the
+	// start of a word in the entity, ignoring case and accents.
+	Search(q string, types []string, limit int) ([]*response.SearchHitResponse, error)
+}
+
+type service struct {
+	searchRepository Repository
+}
+
+func NewSearchService(searchRepository Repository) Service {
+	return &service{
+		searchRepository: searchRepository,
+	}
+}
+
+func (s *service) Search(q string, types []string, limit int) ([]*response.SearchHitResponse, error) {
+	log.Log.Info("Search (service) called", zap.String("q", q), zap.Strings("types", types))
+
+	tsquery := prefixQuery(q)
+	if tsquery == "" {
+		return nil, ErrEmptyQuery
+	}
+
+	types, err := searchTypes(types)
+	if err != nil {
+		return nil, err
+	}
+
+	if limit <= 0 {
+		limit = DefaultLimit
+	}
+	if limit > MaxLimit {
+		limit = MaxLimit
+	}
+
+	hits, err := s.searchRepository.Search(tsquery, types, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	hitResponses := make([]*response.SearchHitResponse, 0, len(hits))
+	for _, hit := range hits {
+		hitResponses = append(hitResponses, &response.SearchHitResponse{
+			Type:    hit.Type,
+			ID:      hit.ID,
+			Title:   hit.Title,
+			Snippet: hit.Snippet,
+			Rank:    hit.Rank,
+		})
+	}
+
+	return hitResponses, nil
+}
+
+// prefixQuery turns free text into a tsquery that matches every word as a
+// prefix, e.g. "Ann sm" becomes "ann:* & sm:*". Anything but letters and
+// digits separates words, so the result never contains tsquery operators.
+func prefixQuery(q string) string {
+	words := strings.FieldsFunc(q, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	terms := make([]string, 0, len(words))
+	for _, word := range words {
+		terms = append(terms, strings.ToLower(word)+":*")
+	}
+
+	return strings.Join(terms, " & ")
+}
+
+func searchTypes(types []string) ([]string, error) {
+	if len(types) == 0 {
+		return allTypes, nil
+	}
+
+	requested := make(map[string]bool, len(types))
+	for _, t := range types {
+		if _, ok := sources[t]; !ok {
+			return nil, ErrInvalidType
+		}
+		requested[t] = true
+	}
+
+	// Keep the query the same whatever order the types were given in.
+	result := make([]string, 0, len(requested))
+	for _, t := range allTypes {
+		if requested[t] {
+			result = append(result, t)
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,91 @@
+package search
+
+import (
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"student_go/internal/entity"
+	mocks2 "student_go/internal/mocks"
+	"student_go/pkg/log"
+	"testing"
+)
+
+func init() {
+	logger, _ := zap.NewDevelopment()
+	log.Log = logger
+}
+
+func TestPrefixQuery(t *testing.T) {
+	tests := []struct {
+		q    string
+		want string
+	}{
+		{"Ann", "ann:*"},
+		{"  ann  SMITH ", "ann:* & smith:*"},
+		{"Ирина Петрова", "ирина:* & петрова:*"},
+		{"José", "josé:*"},
+		{"o'brien", "o:* & brien:*"},
+		{"ann & !bob | (c:*)", "ann:* & bob:* & c:*"},
+		{"B000123", "b000123:*"},
+		{" &|!() ", ""},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, prefixQuery(tt.q), tt.q)
+	}
+}
+
+func TestSearch_AllTypesByDefault(t *testing.T) {
+	mockRepo := new(mocks2.SearchRepository)
+	svc := NewSearchService(mockRepo)
+
+	mockRepo.On("Search", "ирина:*", allTypes, DefaultLimit).
+		Return([]entity.SearchHit{{Type: "student", ID: 4, Title: "Ирина", Snippet: "<mark>Ирина</mark>", Rank: 0.5}}, nil)
+
+	hits, err := svc.Search("Ирина", nil, 0)
+
+	require.NoError(t, err)
+	require.Len(t, hits, 1)
+	assert.Equal(t, "student", hits[0].Type)
+	assert.Equal(t, uint(4), hits[0].ID)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestSearch_TypesAndLimit(t *testing.T) {
+	mockRepo := new(mocks2.SearchRepository)
+	svc := NewSearchService(mockRepo)
+
+	mockRepo.On("Search", "math:*", []string{entity.SearchTeacher, entity.SearchCourse}, MaxLimit).
+		Return([]entity.SearchHit{}, nil)
+
+	hits, err := svc.Search("math", []string{"course", "teacher", "course"}, 500)
+
+	require.NoError(t, err)
+	assert.Empty(t, hits)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestSearch_Invalid(t *testing.T) {
+	mockRepo := new(mocks2.SearchRepository)
+	svc := NewSearchService(mockRepo)
+
+	_, err := svc.Search("  ", nil, 0)
+	assert.ErrorIs(t, err, ErrEmptyQuery)
+
+	_, err = svc.Search("ann", []string{"password"}, 0)
+	assert.ErrorIs(t, err, ErrInvalidType)
+
+	mockRepo.AssertNotCalled(t, "Search")
+}
+
+func TestSearch_RepositoryError(t *testing.T) {
+	mockRepo := new(mocks2.SearchRepository)
+	svc := NewSearchService(mockRepo)
+
+	mockRepo.On("Search", "ann:*", allTypes, DefaultLimit).Return(nil, errors.New("db down"))
+
+	_, err := svc.Search("ann", nil, 0)
+
+	assert.EqualError(t, err, "db down")
+}
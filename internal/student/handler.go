@@ -7,19 +7,34 @@ import (
 	"gorm.io/gorm"
 	"net/http"
 	"strconv"
+	"strings"
+	"student_go/internal/actor"
+	"student_go/internal/audit"
 	"student_go/internal/course"
+	"student_go/internal/department"
 	"student_go/internal/dto/request"
+	"student_go/internal/dto/response"
+	"student_go/internal/hold"
+	"student_go/internal/notification"
+	"student_go/pkg/etag"
 	"student_go/pkg/log"
 	"student_go/pkg/pagination"
+	"student_go/pkg/patch"
+	"student_go/pkg/query"
+	"time"
 )
 
+// listAttributes are the fields students lists can be limited to.
+var listAttributes = query.AttributesOf(response.StudentResponse{})
+
 type StudentHandler struct {
 	Service Service
 }
 
-func NewStudentHandler() *StudentHandler {
+func NewStudentHandler(notifier notification.Notifier) *StudentHandler {
 	return &StudentHandler{
-		Service: NewStudentService(NewStudentRepository(), course.NewCourseRepository()),
+		Service: NewStudentService(NewStudentRepository(), course.NewCourseRepository(), hold.NewHoldRepository(),
+			department.NewDepartmentRepository(), notifier),
 	}
 }
 
@@ -37,9 +52,13 @@ func (h *StudentHandler) CreateStudent(c *gin.Context) {
 		zap.String("email", req.Email),
 	)
 
-	studentResp, err := h.Service.CreateStudent(req)
+	studentResp, err := h.Service.CreateStudent(audit.FromContext(c), req)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to save student"})
+		if errors.Is(err, ErrDepartmentRequired) || errors.Is(err, ErrDepartmentNotFound) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to save student"})
+		}
 		return
 	}
 
@@ -47,7 +66,7 @@ func (h *StudentHandler) CreateStudent(c *gin.Context) {
 }
 
 func (h *StudentHandler) UpdateStudent(c *gin.Context) {
-	var req request.StudentRequest
+	var req request.StudentPatch
 
 	idParam := c.Param("id")
 	parsedID, err := strconv.ParseUint(idParam, 10, 32)
@@ -59,23 +78,38 @@ func (h *StudentHandler) UpdateStudent(c *gin.Context) {
 
 	id := uint(parsedID)
 
-	if err := c.ShouldBindJSON(&req); err != nil {
+	version, err := etag.IfMatch(c.Request)
+	if err != nil {
+		c.JSON(http.StatusPreconditionFailed, gin.H{"error": err.Error()})
+		return
+	}
+
+	fields, err := patch.Bind(c.Request, &req)
+	if err != nil {
 		log.Log.Warn("Invalid request in UpdateStudent", zap.Error(err))
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		if errors.Is(err, patch.ErrUnsupportedMediaType) {
+			c.JSON(http.StatusUnsupportedMediaType, gin.H{"error": err.Error()})
+		} else {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		}
 		return
 	}
 
-	log.Log.Info("UpdateStudent called",
-		zap.String("name", req.Name),
-		zap.String("email", req.Email),
-	)
+	log.Log.Info("UpdateStudent called", zap.Uint("id", id), zap.Strings("fields", fields.Names()))
 
-	studentResp, err := h.Service.UpdateStudent(id, req)
+	studentResp, err := h.Service.UpdateStudent(id, version, audit.FromContext(c), req, fields)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to update student"})
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "student not found"})
+		} else if errors.Is(err, etag.ErrMismatch) {
+			c.JSON(http.StatusPreconditionFailed, gin.H{"error": err.Error()})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to update student"})
+		}
 		return
 	}
 
+	c.Header(etag.HeaderETag, etag.Format(studentResp.Version, studentResp))
 	c.JSON(http.StatusOK, studentResp)
 }
 
@@ -93,19 +127,87 @@ func (h *StudentHandler) FindStudentById(c *gin.Context) {
 
 	id := uint(parsedID)
 	studentResp, err := h.Service.FindStudentById(id)
+	if err != nil {
+		var mergedErr *MergedError
+		if errors.As(err, &mergedErr) {
+			redirectToSurvivor(c, idParam, mergedErr)
+		} else if errors.Is(err, gorm.ErrRecordNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "student not found"})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "something went wrong"})
+		}
+		return
+	}
+
+	tag := etag.Format(studentResp.Version, studentResp)
+	c.Header(etag.HeaderETag, tag)
+	if etag.NoneMatch(c.Request, tag) {
+		c.Status(http.StatusNotModified)
+		return
+	}
+
+	c.JSON(http.StatusOK, studentResp)
+}
+
+func (h *StudentHandler) FindStudentByNumber(c *gin.Context) {
+	number := c.Param("number")
+
+	log.Log.Info("FindStudentByNumber called", zap.String("student_number", number))
+
+	studentResp, err := h.Service.FindStudentByNumber(number)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			c.JSON(http.StatusNotFound, gin.H{"error": "student not found"})
 		} else {
 			c.JSON(http.StatusInternalServerError, gin.H{"error": "something went wrong"})
 		}
+		return
+	}
+
+	tag := etag.Format(studentResp.Version, studentResp)
+	c.Header(etag.HeaderETag, tag)
+	if etag.NoneMatch(c.Request, tag) {
+		c.Status(http.StatusNotModified)
+		return
 	}
 
 	c.JSON(http.StatusOK, studentResp)
 }
 
+// FindAllStudents lists students, optionally only the members of the
+// cohort given in the cohort_id query parameter. Filter and sort
+// parameters are described in pkg/query.
 func (h *StudentHandler) FindAllStudents(c *gin.Context) {
-	count, err := h.Service.Count()
+	var cohortId uint
+	if param := c.Query("cohort_id"); param != "" {
+		parsedID, err := strconv.ParseUint(param, 10, 32)
+		if err != nil {
+			log.Log.Warn("Invalid cohort ID in FindAllStudents", zap.String("cohort_id", param), zap.Error(err))
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid cohort ID"})
+			return
+		}
+		cohortId = uint(parsedID)
+	}
+
+	spec, err := query.NewFromRequest(c.Request, listFields, listRelations, listAttributes)
+	if err != nil {
+		log.Log.Warn("Invalid query in FindAllStudents", zap.Error(err))
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if spec.IncludeDeleted {
+		if a, err := actor.FromContext(c); err != nil || !a.IsAdmin() {
+			c.JSON(http.StatusForbidden, gin.H{"error": "only admins can list deleted students"})
+			return
+		}
+	}
+
+	if pagination.IsCursorRequest(c.Request) {
+		h.findAllStudentsByCursor(c, cohortId, spec)
+		return
+	}
+
+	count, err := h.Service.Count(cohortId, spec)
 	if err != nil {
 		log.Log.Error("Failed to count students", zap.Error(err))
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to count students"})
@@ -118,18 +220,64 @@ func (h *StudentHandler) FindAllStudents(c *gin.Context) {
 		zap.Int("page", pages.Page),
 		zap.Int("per_page", pages.PerPage),
 		zap.Int("total_count", pages.TotalCount),
+		zap.Uint("cohort_id", cohortId),
 	)
 
-	studentResp, err := h.Service.FindAllStudent(pages.Page, pages.PerPage)
+	studentResp, err := h.Service.FindAllStudent(pages.Page, pages.PerPage, cohortId, spec)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to get students"})
 		return
 	}
 
-	pages.Items = studentResp
+	items, err := spec.Render(studentResp, listRelations)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	pages.Items = items
 	c.JSON(http.StatusOK, pages)
 }
 
+// findAllStudentsByCursor serves FindAllStudents with cursor pagination. It only counts
+// the students when the client asks for include_total.
+func (h *StudentHandler) findAllStudentsByCursor(c *gin.Context, cohortId uint, spec query.Spec) {
+	keyset, err := pagination.NewKeysetFromRequest(c.Request)
+	if err != nil {
+		log.Log.Warn("Invalid cursor in FindAllStudents", zap.Error(err))
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if len(spec.Sorts) > 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "sort is not supported with cursor pagination"})
+		return
+	}
+
+	page, err := h.Service.FindAllStudentByCursor(keyset, cohortId, spec)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to get students"})
+		return
+	}
+
+	if c.Query(pagination.TotalVar) == "true" {
+		count, err := h.Service.Count(cohortId, spec)
+		if err != nil {
+			log.Log.Error("Failed to count students", zap.Error(err))
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to count students"})
+			return
+		}
+		page.TotalCount = &count
+	}
+
+	page.Items, err = spec.Render(page.Items, listRelations)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, page)
+}
+
 func (h *StudentHandler) FindAllCoursesByStudentId(c *gin.Context) {
 	idParam := c.Param("id")
 	parsedID, err := strconv.ParseUint(idParam, 10, 32)
@@ -161,21 +309,61 @@ func (h *StudentHandler) DeleteStudentById(c *gin.Context) {
 		return
 	}
 
-	log.Log.Info("DeleteStudentById called", zap.String("id", idParam))
+	version, err := etag.IfMatch(c.Request)
+	if err != nil {
+		c.JSON(http.StatusPreconditionFailed, gin.H{"error": err.Error()})
+		return
+	}
+
+	log.Log.Info("DeleteStudentById called", zap.String("id", idParam), zap.Uint("version", version))
 
 	id := uint(parsedID)
 
-	err = h.Service.DeleteStudentById(id)
+	err = h.Service.DeleteStudentById(id, version, audit.FromContext(c))
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		if errors.Is(err, etag.ErrMismatch) {
+			c.JSON(http.StatusPreconditionFailed, gin.H{"error": err.Error()})
+		} else if errors.Is(err, gorm.ErrRecordNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "student not found"})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
 		return
 	}
 
 	c.Status(http.StatusNoContent)
 }
 
+// RestoreStudent brings back a deleted student with its enrollments, as
+// long as the purge job has not removed it yet.
+func (h *StudentHandler) RestoreStudent(c *gin.Context) {
+	idParam := c.Param("id")
+	parsedID, err := strconv.ParseUint(idParam, 10, 32)
+	if err != nil {
+		log.Log.Warn("Invalid ID in RestoreStudent", zap.String("id", idParam), zap.Error(err))
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid student ID"})
+		return
+	}
+
+	log.Log.Info("RestoreStudent called", zap.String("id", idParam))
+
+	studentResp, err := h.Service.RestoreStudent(uint(parsedID), audit.FromContext(c))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "deleted student not found"})
+		} else if errors.Is(err, ErrNameTaken) {
+			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to restore student"})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, studentResp)
+}
+
 func (h *StudentHandler) StudentAddCourse(c *gin.Context) {
-	studentIdParam := c.Param("studentId")
+	studentIdParam := c.Param("id")
 	parsedStudentID, err := strconv.ParseUint(studentIdParam, 10, 32)
 	if err != nil {
 		log.Log.Warn("Invalid student ID",
@@ -205,10 +393,19 @@ func (h *StudentHandler) StudentAddCourse(c *gin.Context) {
 		zap.Uint("course_id", courseId),
 	)
 
-	studentResp, err := h.Service.AddCourseToStudent(studentId, courseId)
+	studentResp, err := h.Service.AddCourseToStudent(studentId, courseId, audit.FromContext(c))
 	if err != nil {
-		if err.Error() == "student not found" || err.Error() == "course not found" {
+		var holdErr *HoldError
+		if errors.As(err, &holdErr) {
+			holds := make([]*response.HoldResponse, 0, len(holdErr.Holds))
+			for i := range holdErr.Holds {
+				holds = append(holds, hold.ToHoldResponse(&holdErr.Holds[i], time.Now()))
+			}
+			c.JSON(http.StatusConflict, gin.H{"error": holdErr.Error(), "holds": holds})
+		} else if err.Error() == "student not found" || err.Error() == "course not found" {
 			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		} else if errors.Is(err, ErrGuardianRequired) {
+			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
 		} else {
 			c.JSON(http.StatusInternalServerError, gin.H{"error": "internal server error"})
 		}
@@ -217,3 +414,105 @@ func (h *StudentHandler) StudentAddCourse(c *gin.Context) {
 
 	c.JSON(http.StatusOK, studentResp)
 }
+
+// FindDuplicates lists the students that may be the same person as the
+// one in the path, for review before a merge.
+func (h *StudentHandler) FindDuplicates(c *gin.Context) {
+	idParam := c.Param("id")
+	parsedID, err := strconv.ParseUint(idParam, 10, 32)
+	if err != nil {
+		log.Log.Warn("Invalid ID in FindDuplicates", zap.String("id", idParam), zap.Error(err))
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid student ID"})
+		return
+	}
+
+	log.Log.Info("FindDuplicates called", zap.String("id", idParam))
+
+	duplicates, err := h.Service.FindDuplicates(uint(parsedID))
+	if err != nil {
+		var mergedErr *MergedError
+		if errors.As(err, &mergedErr) {
+			redirectToSurvivor(c, idParam, mergedErr)
+		} else if errors.Is(err, gorm.ErrRecordNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "student not found"})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to find duplicates"})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, duplicates)
+}
+
+// MergeStudent merges the student given as duplicateId in the body into
+// the student in the path. The duplicate is deleted for good, so only
+// admins can merge.
+func (h *StudentHandler) MergeStudent(c *gin.Context) {
+	var req request.StudentMergeRequest
+
+	a, err := actor.FromContext(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+	if !a.IsAdmin() {
+		c.JSON(http.StatusForbidden, gin.H{"error": "only admins can merge students"})
+		return
+	}
+
+	idParam := c.Param("id")
+	parsedID, err := strconv.ParseUint(idParam, 10, 32)
+	if err != nil {
+		log.Log.Warn("Invalid ID in MergeStudent", zap.String("id", idParam), zap.Error(err))
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid student ID"})
+		return
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		log.Log.Warn("Invalid request in MergeStudent", zap.Error(err))
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	log.Log.Info("MergeStudent called",
+		zap.String("id", idParam),
+		zap.Uint("duplicate_id", req.DuplicateID),
+	)
+
+	studentResp, err := h.Service.MergeStudent(uint(parsedID), req.DuplicateID, audit.FromContext(c))
+	if err != nil {
+		if errors.Is(err, ErrSelfMerge) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		} else if errors.Is(err, gorm.ErrRecordNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "student not found"})
+		} else if errors.Is(err, ErrMergeConflict) {
+			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to merge students"})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, studentResp)
+}
+
+// redirectToSurvivor answers a request for a merged student with a
+// permanent redirect to the same URL for the survivor.
+func redirectToSurvivor(c *gin.Context, idParam string, mergedErr *MergedError) {
+	survivorId := strconv.FormatUint(uint64(mergedErr.SurvivorID), 10)
+
+	segments := strings.Split(c.Request.URL.Path, "/")
+	for i, segment := range segments {
+		if segment == idParam {
+			segments[i] = survivorId
+			break
+		}
+	}
+	location := strings.Join(segments, "/")
+	if c.Request.URL.RawQuery != "" {
+		location += "?" + c.Request.URL.RawQuery
+	}
+
+	c.Header("Location", location)
+	c.JSON(http.StatusMovedPermanently, gin.H{"error": mergedErr.Error(), "survivorId": mergedErr.SurvivorID})
+}
@@ -5,13 +5,22 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"student_go/internal/actor"
+	"student_go/internal/audit"
 	"student_go/internal/dto/request"
 	"student_go/internal/dto/response"
+	"student_go/internal/entity"
 	"student_go/internal/mocks"
+	"student_go/pkg/etag"
+	"student_go/pkg/pagination"
+	"student_go/pkg/patch"
+	"student_go/pkg/query"
 	"testing"
 
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
 )
 
 func setupHandlerTest() (*gin.Engine, *mocks.StudentServiceMock, *StudentHandler) {
@@ -26,7 +35,7 @@ func TestCreateStudentHandler(t *testing.T) {
 	r, mockService, handler := setupHandlerTest()
 	input := request.StudentRequest{Name: "John", Email: "john@example.com"}
 	expected := &response.StudentResponse{ID: 1, Name: "John", Email: "john@example.com"}
-	mockService.On("CreateStudent", input).Return(expected, nil)
+	mockService.On("CreateStudent", audit.Meta{}, input).Return(expected, nil)
 
 	r.POST("/students", handler.CreateStudent)
 	body, _ := json.Marshal(input)
@@ -41,14 +50,14 @@ func TestCreateStudentHandler(t *testing.T) {
 
 func TestUpdateStudentHandler(t *testing.T) {
 	r, mockService, handler := setupHandlerTest()
-	input := request.StudentRequest{Name: "Updated", Email: "updated@example.com"}
-	expected := &response.StudentResponse{ID: 1, Name: "Updated", Email: "updated@example.com"}
-	mockService.On("UpdateStudent", uint(1), input).Return(expected, nil)
+	email := "updated@example.com"
+	expected := &response.StudentResponse{ID: 1, Name: "John", Email: email}
+	mockService.On("UpdateStudent", uint(1), uint(0), audit.Meta{}, request.StudentPatch{Email: &email}, patch.Fields{"email": true}).
+		Return(expected, nil)
 
 	r.PATCH("/students/:id", handler.UpdateStudent)
-	body, _ := json.Marshal(input)
-	req := httptest.NewRequest(http.MethodPatch, "/students/1", bytes.NewBuffer(body))
-	req.Header.Set("Content-Type", "application/json")
+	req := httptest.NewRequest(http.MethodPatch, "/students/1", bytes.NewBufferString(`{"email":"updated@example.com"}`))
+	req.Header.Set("Content-Type", patch.ContentType)
 	resp := httptest.NewRecorder()
 	r.ServeHTTP(resp, req)
 
@@ -56,6 +65,95 @@ func TestUpdateStudentHandler(t *testing.T) {
 	mockService.AssertExpectations(t)
 }
 
+func TestUpdateStudentHandler_InvalidPatch(t *testing.T) {
+	tests := []struct {
+		name        string
+		contentType string
+		body        string
+		expected    int
+	}{
+		{name: "invalid email", body: `{"email":"not-an-email"}`, expected: http.StatusBadRequest},
+		{name: "null name", body: `{"name":null}`, expected: http.StatusBadRequest},
+		{name: "invalid address", body: `{"addresses":[{"kind":"work"}]}`, expected: http.StatusBadRequest},
+		{name: "read-only field", body: `{"studentNumber":"202500001"}`, expected: http.StatusBadRequest},
+		{name: "not an object", body: `["email"]`, expected: http.StatusBadRequest},
+		{name: "media type", contentType: "text/plain", body: `{"email":"updated@example.com"}`, expected: http.StatusUnsupportedMediaType},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, mockService, handler := setupHandlerTest()
+
+			r.PATCH("/students/:id", handler.UpdateStudent)
+			req := httptest.NewRequest(http.MethodPatch, "/students/1", bytes.NewBufferString(tt.body))
+			if tt.contentType == "" {
+				tt.contentType = patch.ContentType
+			}
+			req.Header.Set("Content-Type", tt.contentType)
+			resp := httptest.NewRecorder()
+			r.ServeHTTP(resp, req)
+
+			assert.Equal(t, tt.expected, resp.Code)
+			mockService.AssertNotCalled(t, "UpdateStudent", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestUpdateStudentHandler_IfMatch(t *testing.T) {
+	tests := []struct {
+		name       string
+		ifMatch    string
+		serviceErr error
+		expected   int
+	}{
+		{name: "current version", ifMatch: `"4"`, expected: http.StatusOK},
+		{name: "stale version", ifMatch: `"4"`, serviceErr: etag.ErrMismatch, expected: http.StatusPreconditionFailed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, mockService, handler := setupHandlerTest()
+			name := "Updated"
+			input := request.StudentPatch{Name: &name}
+			fields := patch.Fields{"name": true}
+			updated := &response.StudentResponse{ID: 1, Name: "Updated", Version: 5}
+			if tt.serviceErr != nil {
+				mockService.On("UpdateStudent", uint(1), uint(4), audit.Meta{}, input, fields).Return(nil, tt.serviceErr)
+			} else {
+				mockService.On("UpdateStudent", uint(1), uint(4), audit.Meta{}, input, fields).
+					Return(updated, nil)
+			}
+
+			r.PATCH("/students/:id", handler.UpdateStudent)
+			req := httptest.NewRequest(http.MethodPatch, "/students/1", bytes.NewBufferString(`{"name":"Updated"}`))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("If-Match", tt.ifMatch)
+			resp := httptest.NewRecorder()
+			r.ServeHTTP(resp, req)
+
+			assert.Equal(t, tt.expected, resp.Code)
+			if tt.expected == http.StatusOK {
+				assert.Equal(t, etag.Format(5, updated), resp.Header().Get("ETag"))
+			}
+			mockService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestUpdateStudentHandler_MalformedIfMatch(t *testing.T) {
+	r, mockService, handler := setupHandlerTest()
+
+	r.PATCH("/students/:id", handler.UpdateStudent)
+	req := httptest.NewRequest(http.MethodPatch, "/students/1", bytes.NewBufferString(`{"name":"Updated","email":"updated@example.com"}`))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("If-Match", "4")
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusPreconditionFailed, resp.Code)
+	mockService.AssertNotCalled(t, "UpdateStudent", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
 func TestFindStudentByIdHandler(t *testing.T) {
 	r, mockService, handler := setupHandlerTest()
 	expected := &response.StudentResponse{ID: 2, Name: "Alice", Email: "alice@example.com"}
@@ -70,11 +168,72 @@ func TestFindStudentByIdHandler(t *testing.T) {
 	mockService.AssertExpectations(t)
 }
 
+func TestFindStudentByIdHandler_IfNoneMatch(t *testing.T) {
+	student := &response.StudentResponse{ID: 2, Name: "Alice", Version: 3}
+	current := etag.Format(3, student)
+
+	tests := []struct {
+		name        string
+		ifNoneMatch string
+		expected    int
+	}{
+		{name: "unchanged", ifNoneMatch: current, expected: http.StatusNotModified},
+		{name: "weak tag in list", ifNoneMatch: `"1", W/` + current, expected: http.StatusNotModified},
+		{name: "changed", ifNoneMatch: `"2"`, expected: http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, mockService, handler := setupHandlerTest()
+			mockService.On("FindStudentById", uint(2)).Return(student, nil)
+
+			r.GET("/students/:id", handler.FindStudentById)
+			req := httptest.NewRequest(http.MethodGet, "/students/2", nil)
+			req.Header.Set("If-None-Match", tt.ifNoneMatch)
+			resp := httptest.NewRecorder()
+			r.ServeHTTP(resp, req)
+
+			assert.Equal(t, tt.expected, resp.Code)
+			assert.Equal(t, current, resp.Header().Get("ETag"))
+		})
+	}
+}
+
+// Renaming a course does not bump the version of its students, so their
+// ETag has to change with the embedded course title instead.
+func TestFindStudentByIdHandler_CourseRenameChangesETag(t *testing.T) {
+	find := func(title, ifNoneMatch string) *httptest.ResponseRecorder {
+		r, mockService, handler := setupHandlerTest()
+		mockService.On("FindStudentById", uint(2)).Return(&response.StudentResponse{
+			ID:      2,
+			Name:    "Alice",
+			Courses: []response.CourseResponse{{ID: 5, Title: title}},
+			Version: 3,
+		}, nil)
+
+		r.GET("/students/:id", handler.FindStudentById)
+		req := httptest.NewRequest(http.MethodGet, "/students/2", nil)
+		if ifNoneMatch != "" {
+			req.Header.Set("If-None-Match", ifNoneMatch)
+		}
+		resp := httptest.NewRecorder()
+		r.ServeHTTP(resp, req)
+		return resp
+	}
+
+	before := find("Math", "")
+	after := find("Algebra", before.Header().Get("ETag"))
+
+	assert.NotEqual(t, before.Header().Get("ETag"), after.Header().Get("ETag"))
+	assert.Equal(t, http.StatusOK, after.Code)
+	assert.Contains(t, after.Body.String(), "Algebra")
+}
+
 func TestFindAllStudentsHandler(t *testing.T) {
 	r, mockService, handler := setupHandlerTest()
 	students := []*response.StudentResponse{{ID: 1, Name: "X", Email: "x@example.com"}}
-	mockService.On("Count").Return(1, nil)
-	mockService.On("FindAllStudent", 1, 10).Return(students, nil)
+	mockService.On("Count", uint(0), query.Spec{}).Return(1, nil)
+	mockService.On("FindAllStudent", 1, 10, uint(0), query.Spec{}).Return(students, nil)
 
 	r.GET("/students", handler.FindAllStudents)
 	req := httptest.NewRequest(http.MethodGet, "/students?page=1&per_page=10", nil)
@@ -85,9 +244,198 @@ func TestFindAllStudentsHandler(t *testing.T) {
 	mockService.AssertExpectations(t)
 }
 
+func TestFindAllStudentsHandler_ByCohort(t *testing.T) {
+	r, mockService, handler := setupHandlerTest()
+	students := []*response.StudentResponse{{ID: 1, Name: "X", Email: "x@example.com"}}
+	mockService.On("Count", uint(4), query.Spec{}).Return(1, nil)
+	mockService.On("FindAllStudent", 1, 10, uint(4), query.Spec{}).Return(students, nil)
+
+	r.GET("/students", handler.FindAllStudents)
+	req := httptest.NewRequest(http.MethodGet, "/students?page=1&per_page=10&cohort_id=4", nil)
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	mockService.AssertExpectations(t)
+}
+
+func TestFindAllStudentsHandler_FilterAndSort(t *testing.T) {
+	r, mockService, handler := setupHandlerTest()
+	spec := query.Spec{
+		Filters: []query.Filter{{Column: "name", Op: query.Contains, Value: "ann"}},
+		Sorts:   []query.Sort{{Column: "name", Desc: true}, {Column: "id"}},
+	}
+	mockService.On("Count", uint(0), spec).Return(1, nil)
+	mockService.On("FindAllStudent", 1, 10, uint(0), spec).Return([]*response.StudentResponse{{ID: 1, Name: "Ann"}}, nil)
+
+	r.GET("/students", handler.FindAllStudents)
+	req := httptest.NewRequest(http.MethodGet, "/students?per_page=10&filter[name][contains]=ann&sort=-name,id", nil)
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	mockService.AssertExpectations(t)
+}
+
+func TestFindAllStudentsHandler_IncludeAndFields(t *testing.T) {
+	r, mockService, handler := setupHandlerTest()
+	spec := query.Spec{Include: []string{"courses"}, Fields: []string{"id", "name"}}
+	students := []*response.StudentResponse{{
+		ID:        1,
+		Name:      "Ann",
+		Email:     "ann@example.com",
+		Addresses: []response.AddressResponse{{City: "Riga"}},
+		Courses:   []response.CourseResponse{{ID: 10, Title: "Biology"}},
+	}}
+	mockService.On("Count", uint(0), spec).Return(1, nil)
+	mockService.On("FindAllStudent", 1, 100, uint(0), spec).Return(students, nil)
+
+	r.GET("/students", handler.FindAllStudents)
+	req := httptest.NewRequest(http.MethodGet, "/students?include=courses&fields=id,name", nil)
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	var body struct {
+		Items []map[string]interface{} `json:"items"`
+	}
+	assert.NoError(t, json.Unmarshal(resp.Body.Bytes(), &body))
+	assert.Len(t, body.Items, 1)
+	assert.Equal(t, "Ann", body.Items[0]["name"])
+	assert.Contains(t, body.Items[0], "courses")
+	assert.NotContains(t, body.Items[0], "email")
+	assert.NotContains(t, body.Items[0], "addresses")
+}
+
+func TestFindAllStudentsHandler_UnknownInclude(t *testing.T) {
+	r, mockService, handler := setupHandlerTest()
+
+	r.GET("/students", handler.FindAllStudents)
+	req := httptest.NewRequest(http.MethodGet, "/students?include=grades", nil)
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	mockService.AssertNotCalled(t, "Count", mock.Anything, mock.Anything)
+}
+
+func TestFindAllStudentsHandler_UnknownField(t *testing.T) {
+	r, mockService, handler := setupHandlerTest()
+
+	r.GET("/students", handler.FindAllStudents)
+	req := httptest.NewRequest(http.MethodGet, "/students?fields=id,grade", nil)
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	mockService.AssertNotCalled(t, "Count", mock.Anything, mock.Anything)
+}
+
+func TestFindAllStudentsHandler_UnknownFilter(t *testing.T) {
+	r, mockService, handler := setupHandlerTest()
+
+	r.GET("/students", handler.FindAllStudents)
+	req := httptest.NewRequest(http.MethodGet, "/students?filter[phone]=123", nil)
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	mockService.AssertNotCalled(t, "Count", mock.Anything, mock.Anything)
+}
+
+func TestFindAllStudentsHandler_Cursor(t *testing.T) {
+	r, mockService, handler := setupHandlerTest()
+	keyset := pagination.Keyset{Cursor: &pagination.Cursor{ID: 5}, Limit: 10}
+	mockService.On("FindAllStudentByCursor", keyset, uint(0), query.Spec{}).
+		Return(&pagination.CursorPage{Limit: 10, Items: []*response.StudentResponse{}}, nil)
+
+	r.GET("/students", handler.FindAllStudents)
+	req := httptest.NewRequest(http.MethodGet, "/students?limit=10&cursor="+pagination.Cursor{ID: 5}.Encode(), nil)
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.NotContains(t, resp.Body.String(), "total_count")
+	mockService.AssertExpectations(t)
+	mockService.AssertNotCalled(t, "Count", mock.Anything, mock.Anything)
+}
+
+func TestFindAllStudentsHandler_CursorWithTotal(t *testing.T) {
+	r, mockService, handler := setupHandlerTest()
+	keyset := pagination.Keyset{Limit: 10}
+	mockService.On("FindAllStudentByCursor", keyset, uint(0), query.Spec{}).
+		Return(&pagination.CursorPage{Limit: 10, Items: []*response.StudentResponse{}}, nil)
+	mockService.On("Count", uint(0), query.Spec{}).Return(12, nil)
+
+	r.GET("/students", handler.FindAllStudents)
+	req := httptest.NewRequest(http.MethodGet, "/students?limit=10&include_total=true", nil)
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Contains(t, resp.Body.String(), `"total_count":12`)
+	mockService.AssertExpectations(t)
+}
+
+func TestFindAllStudentsHandler_CursorRejectsSort(t *testing.T) {
+	r, mockService, handler := setupHandlerTest()
+
+	r.GET("/students", handler.FindAllStudents)
+	req := httptest.NewRequest(http.MethodGet, "/students?limit=10&sort=name", nil)
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	mockService.AssertNotCalled(t, "FindAllStudentByCursor", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestFindAllStudentsHandler_IncludeDeletedRequiresAdmin(t *testing.T) {
+	r, mockService, handler := setupHandlerTest()
+
+	r.GET("/students", handler.FindAllStudents)
+	req := httptest.NewRequest(http.MethodGet, "/students?include_deleted=true", nil)
+	req.Header.Set(actor.HeaderUserID, "5")
+	req.Header.Set(actor.HeaderUserRole, string(actor.RoleTeacher))
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusForbidden, resp.Code)
+	mockService.AssertNotCalled(t, "Count", mock.Anything, mock.Anything)
+}
+
+func TestFindAllStudentsHandler_IncludeDeleted(t *testing.T) {
+	r, mockService, handler := setupHandlerTest()
+	spec := query.Spec{IncludeDeleted: true}
+	mockService.On("Count", uint(0), spec).Return(1, nil)
+	mockService.On("FindAllStudent", 1, 100, uint(0), spec).
+		Return([]*response.StudentResponse{{ID: 1, Name: "Alice"}}, nil)
+
+	r.GET("/students", handler.FindAllStudents)
+	req := httptest.NewRequest(http.MethodGet, "/students?include_deleted=true", nil)
+	req.Header.Set(actor.HeaderUserID, "1")
+	req.Header.Set(actor.HeaderUserRole, string(actor.RoleAdmin))
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	mockService.AssertExpectations(t)
+}
+
+func TestFindAllStudentsHandler_InvalidCohort(t *testing.T) {
+	r, mockService, handler := setupHandlerTest()
+
+	r.GET("/students", handler.FindAllStudents)
+	req := httptest.NewRequest(http.MethodGet, "/students?cohort_id=abc", nil)
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	mockService.AssertNotCalled(t, "Count", mock.Anything, mock.Anything)
+}
+
 func TestDeleteStudentHandler(t *testing.T) {
 	r, mockService, handler := setupHandlerTest()
-	mockService.On("DeleteStudentById", uint(3)).Return(nil)
+	mockService.On("DeleteStudentById", uint(3), uint(0), audit.Meta{}).Return(nil)
 
 	r.DELETE("/students/:id", handler.DeleteStudentById)
 	req := httptest.NewRequest(http.MethodDelete, "/students/3", nil)
@@ -98,6 +446,20 @@ func TestDeleteStudentHandler(t *testing.T) {
 	mockService.AssertExpectations(t)
 }
 
+func TestDeleteStudentHandler_StaleVersion(t *testing.T) {
+	r, mockService, handler := setupHandlerTest()
+	mockService.On("DeleteStudentById", uint(3), uint(2), audit.Meta{}).Return(etag.ErrMismatch)
+
+	r.DELETE("/students/:id", handler.DeleteStudentById)
+	req := httptest.NewRequest(http.MethodDelete, "/students/3", nil)
+	req.Header.Set("If-Match", `"2"`)
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusPreconditionFailed, resp.Code)
+	mockService.AssertExpectations(t)
+}
+
 func TestFindAllCoursesByStudentIdHandler(t *testing.T) {
 	r, mockService, handler := setupHandlerTest()
 	courses := []response.CourseResponse{{ID: 1, Title: "Math"}}
@@ -116,13 +478,229 @@ func TestFindAllCoursesByStudentIdHandler(t *testing.T) {
 func TestStudentAddCourseHandler(t *testing.T) {
 	r, mockService, handler := setupHandlerTest()
 	expected := &response.StudentResponse{ID: 1, Name: "John"}
-	mockService.On("AddCourseToStudent", uint(1), uint(2)).Return(expected, nil)
+	mockService.On("AddCourseToStudent", uint(1), uint(2), audit.Meta{}).Return(expected, nil)
+
+	r.POST("/students/:id/courses/:courseId", handler.StudentAddCourse)
+	req := httptest.NewRequest(http.MethodPost, "/students/1/courses/2", nil)
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	mockService.AssertExpectations(t)
+}
+
+func TestStudentAddCourseHandler_GuardianRequired(t *testing.T) {
+	r, mockService, handler := setupHandlerTest()
+	mockService.On("AddCourseToStudent", uint(1), uint(2), audit.Meta{}).Return(nil, ErrGuardianRequired)
+
+	r.POST("/students/:id/courses/:courseId", handler.StudentAddCourse)
+	req := httptest.NewRequest(http.MethodPost, "/students/1/courses/2", nil)
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusConflict, resp.Code)
+}
+
+func TestStudentAddCourseHandler_ActiveHolds(t *testing.T) {
+	r, mockService, handler := setupHandlerTest()
+	holdErr := &HoldError{Holds: []entity.StudentHold{{ID: 3, StudentID: 1, Type: entity.HoldFinancial, Reason: "unpaid fees"}}}
+	mockService.On("AddCourseToStudent", uint(1), uint(2), audit.Meta{}).Return(nil, holdErr)
 
-	r.POST("/students/:studentId/courses/:courseId", handler.StudentAddCourse)
+	r.POST("/students/:id/courses/:courseId", handler.StudentAddCourse)
 	req := httptest.NewRequest(http.MethodPost, "/students/1/courses/2", nil)
 	resp := httptest.NewRecorder()
 	r.ServeHTTP(resp, req)
 
+	assert.Equal(t, http.StatusConflict, resp.Code)
+	assert.Contains(t, resp.Body.String(), `"error":"student has active holds: financial"`)
+	assert.Contains(t, resp.Body.String(), `"reason":"unpaid fees"`)
+}
+
+func TestFindStudentByNumberHandler(t *testing.T) {
+	r, mockService, handler := setupHandlerTest()
+	expected := &response.StudentResponse{ID: 1, StudentNumber: "202500001", Name: "John"}
+	mockService.On("FindStudentByNumber", "202500001").Return(expected, nil)
+
+	r.GET("/students/by-number/:number", handler.FindStudentByNumber)
+	req := httptest.NewRequest(http.MethodGet, "/students/by-number/202500001", nil)
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, req)
+
 	assert.Equal(t, http.StatusOK, resp.Code)
 	mockService.AssertExpectations(t)
 }
+
+func TestFindStudentByNumberHandler_NotFound(t *testing.T) {
+	r, mockService, handler := setupHandlerTest()
+	mockService.On("FindStudentByNumber", "missing").Return(nil, gorm.ErrRecordNotFound)
+
+	r.GET("/students/by-number/:number", handler.FindStudentByNumber)
+	req := httptest.NewRequest(http.MethodGet, "/students/by-number/missing", nil)
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusNotFound, resp.Code)
+}
+
+func TestCreateStudentHandler_InvalidAddress(t *testing.T) {
+	r, mockService, handler := setupHandlerTest()
+
+	r.POST("/students", handler.CreateStudent)
+	req := httptest.NewRequest(http.MethodPost, "/students", bytes.NewBufferString(
+		`{"name":"John","email":"john@example.com","addresses":[{"kind":"home","line1":"x","city":"Moscow","postalCode":"1","country":"Russia"}]}`))
+	req.Header.Set("Content-Type", "application/json")
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	mockService.AssertNotCalled(t, "CreateStudent", mock.Anything)
+}
+
+func TestCreateStudentHandler_DepartmentRequired(t *testing.T) {
+	r, mockService, handler := setupHandlerTest()
+	input := request.StudentRequest{Name: "John", Email: "john@example.com"}
+	mockService.On("CreateStudent", audit.Meta{}, input).Return(nil, ErrDepartmentRequired)
+
+	r.POST("/students", handler.CreateStudent)
+	body, _ := json.Marshal(input)
+	req := httptest.NewRequest(http.MethodPost, "/students", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.Contains(t, resp.Body.String(), "departmentId")
+}
+
+func TestFindStudentByIdHandler_Merged(t *testing.T) {
+	r, mockService, handler := setupHandlerTest()
+	mockService.On("FindStudentById", uint(7)).Return(nil, &MergedError{SurvivorID: 1})
+
+	r.GET("/students/:id", handler.FindStudentById)
+	req := httptest.NewRequest(http.MethodGet, "/students/7?include=courses", nil)
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusMovedPermanently, resp.Code)
+	assert.Equal(t, "/students/1?include=courses", resp.Header().Get("Location"))
+}
+
+func TestFindDuplicatesHandler(t *testing.T) {
+	r, mockService, handler := setupHandlerTest()
+	mockService.On("FindDuplicates", uint(1)).
+		Return([]*response.StudentDuplicateResponse{{ID: 7, Name: "Jon Smith", NameSimilarity: 0.56}}, nil)
+
+	r.GET("/students/:id/duplicates", handler.FindDuplicates)
+	req := httptest.NewRequest(http.MethodGet, "/students/1/duplicates", nil)
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Contains(t, resp.Body.String(), `"nameSimilarity":0.56`)
+}
+
+var adminId = uint(1)
+
+var mergeMeta = audit.Meta{ActorID: &adminId, ActorRole: string(actor.RoleAdmin)}
+
+// mergeRequest asks to merge student 7 into student 1 as user 1 with the
+// given role.
+func mergeRequest(role actor.Role) *http.Request {
+	req := httptest.NewRequest(http.MethodPost, "/students/1/merge", bytes.NewBufferString(`{"duplicateId":7}`))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(actor.HeaderUserID, "1")
+	req.Header.Set(actor.HeaderUserRole, string(role))
+	return req
+}
+
+func TestMergeStudentHandler(t *testing.T) {
+	r, mockService, handler := setupHandlerTest()
+	mockService.On("MergeStudent", uint(1), uint(7), mergeMeta).Return(&response.StudentResponse{ID: 1}, nil)
+
+	r.POST("/students/:id/merge", handler.MergeStudent)
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, mergeRequest(actor.RoleAdmin))
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	mockService.AssertExpectations(t)
+}
+
+func TestMergeStudentHandler_NotAdmin(t *testing.T) {
+	tests := []struct {
+		name string
+		role actor.Role
+		code int
+	}{
+		{"anonymous", "", http.StatusUnauthorized},
+		{"teacher", actor.RoleTeacher, http.StatusForbidden},
+		{"student", actor.RoleStudent, http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, mockService, handler := setupHandlerTest()
+
+			r.POST("/students/:id/merge", handler.MergeStudent)
+			resp := httptest.NewRecorder()
+			r.ServeHTTP(resp, mergeRequest(tt.role))
+
+			assert.Equal(t, tt.code, resp.Code)
+			mockService.AssertNotCalled(t, "MergeStudent", mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestMergeStudentHandler_Errors(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		code int
+	}{
+		{"self", ErrSelfMerge, http.StatusBadRequest},
+		{"not found", gorm.ErrRecordNotFound, http.StatusNotFound},
+		{"overlapping appointments", ErrMergeConflict, http.StatusConflict},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, mockService, handler := setupHandlerTest()
+			mockService.On("MergeStudent", uint(1), uint(7), mergeMeta).Return(nil, tt.err)
+
+			r.POST("/students/:id/merge", handler.MergeStudent)
+			resp := httptest.NewRecorder()
+			r.ServeHTTP(resp, mergeRequest(actor.RoleAdmin))
+
+			assert.Equal(t, tt.code, resp.Code)
+		})
+	}
+}
+
+func TestRestoreStudentHandler(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		code int
+	}{
+		{"restored", nil, http.StatusOK},
+		{"not deleted", gorm.ErrRecordNotFound, http.StatusNotFound},
+		{"name taken", ErrNameTaken, http.StatusConflict},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, mockService, handler := setupHandlerTest()
+			var studentResp *response.StudentResponse
+			if tt.err == nil {
+				studentResp = &response.StudentResponse{ID: 1}
+			}
+			mockService.On("RestoreStudent", uint(1), audit.Meta{}).Return(studentResp, tt.err)
+
+			r.POST("/students/:id/restore", handler.RestoreStudent)
+			req := httptest.NewRequest(http.MethodPost, "/students/1/restore", nil)
+			resp := httptest.NewRecorder()
+			r.ServeHTTP(resp, req)
+
+			assert.Equal(t, tt.code, resp.Code)
+		})
+	}
+}
@@ -0,0 +1,97 @@
+package student
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+)
+
+const defaultNumberFormat = "{year}{seq:5}"
+
+var numberToken = regexp.MustCompile(`\{([a-z]+)(?::(\d+))?\}`)
+
+// NumberFormat renders student numbers from a template such as
+// "{year}{dept:3}{seq:5}". The sequence is counted separately for every
+// distinct rendering of the other tokens, so "{year}{seq}" restarts each
+// year and "{dept}{seq}" counts each department on its own.
+type NumberFormat string
+
+// ParseNumberFormat checks that the template contains exactly one {seq}
+// token and no unknown tokens. An empty template selects the default.
+func ParseNumberFormat(format string) (NumberFormat, error) {
+	if format == "" {
+		format = defaultNumberFormat
+	}
+
+	seqCount := 0
+	for _, match := range numberToken.FindAllStringSubmatch(format, -1) {
+		switch match[1] {
+		case "seq", "dept":
+			if match[1] == "seq" {
+				seqCount++
+			}
+		case "year", "yy":
+			if match[2] != "" {
+				return "", fmt.Errorf("student number token %q takes no width", match[0])
+			}
+		default:
+			return "", fmt.Errorf("unknown student number token %q", match[0])
+		}
+	}
+	if seqCount != 1 {
+		return "", fmt.Errorf("student number format %q must contain exactly one {seq} token", format)
+	}
+
+	return NumberFormat(format), nil
+}
+
+// UsesDepartment reports whether the template has a {dept} token, which
+// is rendered from the ID of the student's department.
+func (f NumberFormat) UsesDepartment() bool {
+	for _, match := range numberToken.FindAllStringSubmatch(string(f), -1) {
+		if match[1] == "dept" {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Scope returns the template with every token except {seq} rendered. It
+// identifies the counter that the sequence is drawn from.
+func (f NumberFormat) Scope(now time.Time, dept uint) string {
+	return f.render(now, dept, func(string) string { return "{seq}" })
+}
+
+func (f NumberFormat) Render(now time.Time, dept uint, seq int) string {
+	return f.render(now, dept, func(width string) string {
+		return pad(seq, width)
+	})
+}
+
+func (f NumberFormat) render(now time.Time, dept uint, seq func(width string) string) string {
+	return numberToken.ReplaceAllStringFunc(string(f), func(token string) string {
+		match := numberToken.FindStringSubmatch(token)
+		switch match[1] {
+		case "year":
+			return strconv.Itoa(now.Year())
+		case "yy":
+			return fmt.Sprintf("%02d", now.Year()%100)
+		case "dept":
+			return pad(int(dept), match[2])
+		case "seq":
+			return seq(match[2])
+		}
+		return token
+	})
+}
+
+// pad renders n zero-padded to width digits, or as is without a width.
+func pad(n int, width string) string {
+	if width == "" {
+		return strconv.Itoa(n)
+	}
+	w, _ := strconv.Atoi(width)
+	return fmt.Sprintf("%0*d", w, n)
+}
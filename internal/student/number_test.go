@@ -0,0 +1,45 @@
+package student
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestNumberFormat(t *testing.T) {
+	now := time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC)
+
+	format, err := ParseNumberFormat("S{yy}-{seq:4}")
+	require.NoError(t, err)
+
+	assert.Equal(t, "S25-{seq}", format.Scope(now, 0))
+	assert.Equal(t, "S25-0042", format.Render(now, 0, 42))
+	assert.Equal(t, "S25-12345", format.Render(now, 0, 12345))
+	assert.False(t, format.UsesDepartment())
+}
+
+func TestNumberFormat_Department(t *testing.T) {
+	now := time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC)
+
+	format, err := ParseNumberFormat("{year}{dept:3}{seq:4}")
+	require.NoError(t, err)
+
+	assert.True(t, format.UsesDepartment())
+	assert.Equal(t, "2025007{seq}", format.Scope(now, 7))
+	assert.Equal(t, "20250070042", format.Render(now, 7, 42))
+}
+
+func TestNumberFormat_Default(t *testing.T) {
+	format, err := ParseNumberFormat("")
+	require.NoError(t, err)
+
+	assert.Equal(t, "202500007", format.Render(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), 0, 7))
+}
+
+func TestParseNumberFormat_Invalid(t *testing.T) {
+	for _, format := range []string{"{year}", "{year}{seq}{seq}", "{dept}", "{faculty}{seq}", "{year:2}{seq}"} {
+		_, err := ParseNumberFormat(format)
+		assert.Error(t, err, format)
+	}
+}
@@ -1,18 +1,99 @@
 package student
 
 import (
+	"errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"student_go/internal/audit"
 	"student_go/internal/entity"
 	"student_go/pkg/dbcontext"
+	"student_go/pkg/etag"
+	"student_go/pkg/pagination"
+	"student_go/pkg/query"
 )
 
 type Repository interface {
 	ExistsById(id uint) (bool, error)
-	Save(student *entity.Student) (*entity.Student, error)
-	Update(student *entity.Student) (*entity.Student, error)
+	// Save, Update, DeleteById, Restore and Merge record the change in the
+	// audit log in the same transaction.
+	Save(student *entity.Student, meta audit.Meta) (*entity.Student, error)
+	// Update sets the given columns only and bumps the version. Addresses
+	// replace the stored ones unless nil. It fails with etag.ErrMismatch
+	// when version is not zero and the row has another one.
+	Update(id uint, version uint, changes map[string]interface{}, addresses []entity.StudentAddress, meta audit.Meta) (*entity.Student, error)
 	FindById(id uint) (*entity.Student, error)
-	FindAll(page, limit int) ([]entity.Student, error)
-	DeleteById(id uint) error
-	Count() (int, error)
+	FindByNumber(number string) (*entity.Student, error)
+	// FindAll and Count restrict the result to one cohort when cohortId is
+	// not zero, and to the rows matching spec.
+	FindAll(page, limit int, cohortId uint, spec query.Spec) ([]entity.Student, error)
+	// FindAllByCursor returns the rows selected by keyset.Apply; pass them
+	// through pagination.Slice to get the page.
+	FindAllByCursor(keyset pagination.Keyset, cohortId uint, spec query.Spec) ([]entity.Student, error)
+	// DeleteById soft-deletes the student; Restore undoes it until the
+	// purge job removes the row. A version that is not zero must match the
+	// row's.
+	DeleteById(id uint, version uint, meta audit.Meta) error
+	Restore(id uint, meta audit.Meta) (bool, error)
+	Count(cohortId uint, spec query.Spec) (int, error)
+	HasGuardian(studentId uint) (bool, error)
+	NextNumberSeq(scope string) (int, error)
+	// FindDuplicates returns the other students whose name is at least
+	// minSimilarity similar to the student's or whose normalized email is
+	// the same, the likeliest duplicates first.
+	FindDuplicates(id uint, minSimilarity float64, limit int) ([]entity.StudentDuplicate, error)
+	// Merge moves the enrollments, cohorts, contacts, holds, appointments
+	// and uploads of duplicateId to survivorId, deletes the duplicate and
+	// records the redirect, all in one transaction.
+	Merge(survivorId, duplicateId uint, meta audit.Meta) error
+	// FindSurvivorId returns the student a merged student ID redirects to,
+	// or zero when the ID was never merged.
+	FindSurvivorId(mergedId uint) (uint, error)
+}
+
+const (
+	// Postgres SQLSTATE codes. exclusionViolation is raised when merged
+	// students have overlapping booked appointments.
+	uniqueViolation    = "23505"
+	exclusionViolation = "23P01"
+)
+
+// mergeStatements run in order with the named arguments survivor and
+// duplicate. Rows the survivor already has, like an enrollment in the same
+// course, are dropped together with the duplicate. The duplicate's
+// addresses go as well: the survivor's profile is kept as it is.
+var mergeStatements = []string{
+	`INSERT INTO course_student (course_id, student_id)
+		SELECT course_id, @survivor FROM course_student WHERE student_id = @duplicate
+		ON CONFLICT DO NOTHING`,
+	`INSERT INTO cohort_student (cohort_id, student_id)
+		SELECT cohort_id, @survivor FROM cohort_student WHERE student_id = @duplicate
+		ON CONFLICT DO NOTHING`,
+	`UPDATE student_contacts SET student_id = @survivor WHERE student_id = @duplicate`,
+	`UPDATE student_holds SET student_id = @survivor WHERE student_id = @duplicate`,
+	`UPDATE appointments SET student_id = @survivor WHERE student_id = @duplicate`,
+	`UPDATE attachments SET owner_id = @survivor WHERE owner_role = 'student' AND owner_id = @duplicate`,
+	// Earlier merges into the duplicate now redirect straight to the survivor.
+	`UPDATE student_merges SET survivor_id = @survivor WHERE survivor_id = @duplicate`,
+	`INSERT INTO student_merges (merged_id, survivor_id) VALUES (@duplicate, @survivor)`,
+	// The survivor's courses changed, and with them its ETag.
+	`UPDATE students SET version = version + 1 WHERE id = @survivor`,
+	`DELETE FROM students WHERE id = @duplicate`,
+}
+
+// listFields are the fields students can be filtered and sorted by.
+var listFields = query.Schema{
+	"id":             {Column: "id", Type: query.Int},
+	"student_number": {Column: "student_number", Type: query.String},
+	"name":           {Column: "name", Type: query.String},
+	"preferred_name": {Column: "preferred_name", Type: query.String},
+	"email":          {Column: "email", Type: query.String},
+}
+
+// listRelations are the relations students lists can include.
+var listRelations = query.Relations{
+	"addresses":       "Addresses",
+	"courses":         "Courses",
+	"courses.teacher": "Courses.Teacher",
 }
 
 type repository struct{}
@@ -33,13 +114,45 @@ func (r *repository) ExistsById(id uint) (bool, error) {
 	return exists, err
 }
 
-func (r *repository) Save(student *entity.Student) (*entity.Student, error) {
-	err := dbcontext.DB.Create(student).Error
+func (r *repository) Save(student *entity.Student, meta audit.Meta) (*entity.Student, error) {
+	err := audit.Create(dbcontext.DB, meta, student)
 	return student, err
 }
 
-func (r *repository) Update(student *entity.Student) (*entity.Student, error) {
-	err := dbcontext.DB.Save(&student).Error
+// Update saves the profile without touching the student number. Addresses
+// are replaced only when student.Addresses is not nil.
+func (r *repository) Update(id uint, version uint, changes map[string]interface{}, addresses []entity.StudentAddress, meta audit.Meta) (*entity.Student, error) {
+	err := audit.Track(dbcontext.DB, meta, &entity.Student{}, id, entity.AuditUpdate, func(tx *gorm.DB) error {
+		current, err := etag.Check(tx, &entity.Student{}, id, version)
+		if err != nil {
+			return err
+		}
+
+		columns := make(map[string]interface{}, len(changes)+1)
+		for column, value := range changes {
+			columns[column] = value
+		}
+		columns["version"] = current + 1
+
+		if err := tx.Model(&entity.Student{}).Where("id = ?", id).Updates(columns).Error; err != nil {
+			return err
+		}
+
+		if addresses == nil {
+			return nil
+		}
+
+		if err := tx.Where("student_id = ?", id).Delete(&entity.StudentAddress{}).Error; err != nil {
+			return err
+		}
+		if len(addresses) == 0 {
+			return nil
+		}
+		for i := range addresses {
+			addresses[i].StudentID = id
+		}
+		return tx.Create(&addresses).Error
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -47,9 +160,10 @@ func (r *repository) Update(student *entity.Student) (*entity.Student, error) {
 	var updatedStudent *entity.Student
 
 	err = dbcontext.DB.
+		Preload("Addresses").
 		Preload("Courses").
 		Preload("Courses.Teacher").
-		First(&updatedStudent, student.ID).Error
+		First(&updatedStudent, id).Error
 
 	if err != nil {
 		return nil, err
@@ -60,6 +174,7 @@ func (r *repository) Update(student *entity.Student) (*entity.Student, error) {
 func (r *repository) FindById(id uint) (*entity.Student, error) {
 	var student entity.Student
 	result := dbcontext.DB.
+		Preload("Addresses").
 		Preload("Courses").
 		Preload("Courses.Teacher").
 		First(&student, id)
@@ -71,14 +186,29 @@ func (r *repository) FindById(id uint) (*entity.Student, error) {
 	return &student, nil
 }
 
-func (r *repository) FindAll(page, limit int) ([]entity.Student, error) {
+func (r *repository) FindByNumber(number string) (*entity.Student, error) {
+	var student entity.Student
+	result := dbcontext.DB.
+		Preload("Addresses").
+		Preload("Courses").
+		Preload("Courses.Teacher").
+		Where("student_number = ?", number).
+		First(&student)
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return &student, nil
+}
+
+func (r *repository) FindAll(page, limit int, cohortId uint, spec query.Spec) ([]entity.Student, error) {
 	var students []entity.Student
 
 	offset := (page - 1) * limit
 
-	result := dbcontext.DB.
-		Preload("Courses").
-		Preload("Courses.Teacher").
+	db := spec.Apply(inCohort(dbcontext.DB, cohortId))
+	result := spec.Preload(db, listRelations).
 		Limit(limit).
 		Offset(offset).
 		Find(&students)
@@ -90,14 +220,173 @@ func (r *repository) FindAll(page, limit int) ([]entity.Student, error) {
 	return students, nil
 }
 
-func (r *repository) DeleteById(id uint) error {
-	result := dbcontext.DB.Delete(&entity.Student{}, id)
+func (r *repository) FindAllByCursor(keyset pagination.Keyset, cohortId uint, spec query.Spec) ([]entity.Student, error) {
+	var students []entity.Student
+
+	db := keyset.Apply(spec.Where(inCohort(dbcontext.DB, cohortId)))
+	result := spec.Preload(db, listRelations).
+		Find(&students)
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return students, nil
+}
+
+func (r *repository) DeleteById(id uint, version uint, meta audit.Meta) error {
+	return audit.Track(dbcontext.DB, meta, &entity.Student{}, id, entity.AuditDelete, func(tx *gorm.DB) error {
+		if version != 0 {
+			if _, err := etag.Check(tx, &entity.Student{}, id, version); err != nil {
+				return err
+			}
+		}
+		return tx.Delete(&entity.Student{}, id).Error
+	})
+}
+
+// Restore reports false when no deleted student has the ID.
+func (r *repository) Restore(id uint, meta audit.Meta) (bool, error) {
+	restored := false
+	err := audit.Track(dbcontext.DB, meta, &entity.Student{}, id, entity.AuditRestore, func(tx *gorm.DB) error {
+		result := tx.
+			Unscoped().
+			Model(&entity.Student{}).
+			Where("id = ? AND deleted_at IS NOT NULL", id).
+			Update("deleted_at", nil)
+		restored = result.RowsAffected > 0
+		return result.Error
+	})
+
+	if isViolation(err, uniqueViolation) {
+		return false, ErrNameTaken
+	}
 
-	return result.Error
+	return restored, err
 }
 
-func (r *repository) Count() (int, error) {
+func (r *repository) Count(cohortId uint, spec query.Spec) (int, error) {
 	var count int64
-	err := dbcontext.DB.Model(&entity.Student{}).Count(&count).Error
+	err := spec.Where(inCohort(dbcontext.DB.Model(&entity.Student{}), cohortId)).Count(&count).Error
 	return int(count), err
 }
+
+func inCohort(db *gorm.DB, cohortId uint) *gorm.DB {
+	if cohortId == 0 {
+		return db
+	}
+
+	return db.Where("id IN (SELECT student_id FROM cohort_student WHERE cohort_id = ?)", cohortId)
+}
+
+func (r *repository) HasGuardian(studentId uint) (bool, error) {
+	var exists bool
+	err := dbcontext.DB.
+		Model(&entity.StudentContact{}).
+		Select("count(*) > 0").
+		Where("student_id = ? AND is_guardian", studentId).
+		Find(&exists).
+		Error
+
+	return exists, err
+}
+
+// NextNumberSeq increments and returns the student number counter for
+// scope, starting at 1.
+func (r *repository) NextNumberSeq(scope string) (int, error) {
+	var value int
+	err := dbcontext.DB.Raw(`
+		INSERT INTO student_number_counters (scope, value) VALUES (?, 1)
+		ON CONFLICT (scope) DO UPDATE SET value = student_number_counters.value + 1
+		RETURNING value`,
+		scope,
+	).Scan(&value).Error
+
+	return value, err
+}
+
+func (r *repository) FindDuplicates(id uint, minSimilarity float64, limit int) ([]entity.StudentDuplicate, error) {
+	var duplicates []entity.StudentDuplicate
+	// name % name lets the trigram index narrow the candidates before the
+	// exact threshold is applied.
+	err := dbcontext.DB.Raw(`
+		SELECT s.id, s.student_number, s.name, s.email,
+			similarity(s.name, t.name) AS name_similarity,
+			normalize_email(s.email) = normalize_email(t.email) AS email_match
+		FROM students s JOIN students t ON t.id = ?
+		WHERE s.id <> t.id AND s.deleted_at IS NULL
+			AND ((s.name % t.name AND similarity(s.name, t.name) >= ?)
+				OR normalize_email(s.email) = normalize_email(t.email))
+		ORDER BY email_match DESC, name_similarity DESC, s.id
+		LIMIT ?`,
+		id, minSimilarity, limit,
+	).Scan(&duplicates).Error
+
+	return duplicates, err
+}
+
+func (r *repository) Merge(survivorId, duplicateId uint, meta audit.Meta) error {
+	err := dbcontext.DB.Transaction(func(tx *gorm.DB) error {
+		// Lock both students so that enrollments and other merges wait for
+		// this one.
+		var ids []uint
+		err := tx.Model(&entity.Student{}).
+			Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id IN ?", []uint{survivorId, duplicateId}).
+			Order("id").
+			Pluck("id", &ids).
+			Error
+		if err != nil {
+			return err
+		}
+		if len(ids) != 2 {
+			return gorm.ErrRecordNotFound
+		}
+
+		duplicate, err := audit.Snapshot(tx, &entity.Student{}, duplicateId)
+		if err != nil {
+			return err
+		}
+
+		args := map[string]interface{}{"survivor": survivorId, "duplicate": duplicateId}
+		for _, statement := range mergeStatements {
+			if err := tx.Exec(statement, args).Error; err != nil {
+				return err
+			}
+		}
+
+		err = audit.Record(tx, meta, entity.AuditStudent, duplicateId, entity.AuditMerge,
+			duplicate, map[string]interface{}{"merged_into": survivorId})
+		if err != nil {
+			return err
+		}
+
+		return audit.Record(tx, meta, entity.AuditStudent, survivorId, entity.AuditMerge,
+			nil, map[string]interface{}{"merged_id": duplicateId})
+	})
+
+	if isViolation(err, exclusionViolation) {
+		return ErrMergeConflict
+	}
+
+	return err
+}
+
+func (r *repository) FindSurvivorId(mergedId uint) (uint, error) {
+	var survivorIds []uint
+	err := dbcontext.DB.
+		Table("student_merges").
+		Where("merged_id = ?", mergedId).
+		Pluck("survivor_id", &survivorIds).
+		Error
+	if err != nil || len(survivorIds) == 0 {
+		return 0, err
+	}
+
+	return survivorIds[0], nil
+}
+
+func isViolation(err error, code string) bool {
+	var state interface{ SQLState() string }
+	return errors.As(err, &state) && state.SQLState() == code
+}
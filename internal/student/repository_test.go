@@ -3,17 +3,22 @@ package student
 import (
 	"database/sql"
 	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"regexp"
+	"strings"
 	"testing"
+	"time"
 
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
 	"gorm.io/gorm/logger"
 
+	"student_go/internal/audit"
 	"student_go/internal/entity"
 	"student_go/pkg/dbcontext"
+	"student_go/pkg/query"
 )
 
 func setupTestDB(t *testing.T) (*sql.DB, sqlmock.Sqlmock, *gorm.DB) {
@@ -54,14 +59,17 @@ func TestStudentSave(t *testing.T) {
 	defer db.Close()
 
 	mock.ExpectBegin()
-	mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "students" ("name","email") VALUES ($1,$2) RETURNING "id"`)).
-		WithArgs("John", "john@example.com").
+	mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "students" ("student_number","name","preferred_name","pronouns","email","phone","locale","date_of_birth","version","deleted_at") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10) RETURNING "id"`)).
+		WithArgs("202500001", "John", "", "", "john@example.com", "", "", nil, 1, nil).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "audit_entries"`)).
+		WithArgs("student", 1, "create", nil, "", "", nil, `{"date_of_birth":null,"deleted_at":null,"email":"john@example.com","id":1,"locale":"","name":"John","phone":"","preferred_name":"","pronouns":"","student_number":"202500001","version":1}`, sqlmock.AnyArg()).
 		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
 	mock.ExpectCommit()
 
 	repo := NewStudentRepository()
-	student := &entity.Student{Name: "John", Email: "john@example.com"}
-	result, err := repo.Save(student)
+	student := &entity.Student{StudentNumber: "202500001", Name: "John", Email: "john@example.com"}
+	result, err := repo.Save(student, audit.Meta{})
 
 	assert.NoError(t, err)
 	assert.Equal(t, "John", result.Name)
@@ -72,11 +80,16 @@ func TestStudentFindById(t *testing.T) {
 	db, mock, _ := setupTestDB(t)
 	defer db.Close()
 
-	mock.ExpectQuery(`SELECT \* FROM "students" WHERE "students"\."id" = \$1 ORDER BY "students"\."id" LIMIT .*`).
+	mock.ExpectQuery(`SELECT \* FROM "students" WHERE "students"\."id" = \$1 AND "students"\."deleted_at" IS NULL ORDER BY "students"\."id" LIMIT .*`).
 		WithArgs(1, 1).
 		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "email"}).
 			AddRow(1, "Alice", "alice@example.com"))
 
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "student_addresses" WHERE "student_addresses"."student_id" = $1`)).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "student_id", "kind", "city"}).
+			AddRow(1, 1, "home", "Moscow"))
+
 	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "course_student" WHERE "course_student"."student_id" = $1`)).
 		WithArgs(1).
 		WillReturnRows(sqlmock.NewRows([]string{"student_id", "course_id"}).
@@ -102,6 +115,8 @@ func TestStudentFindById(t *testing.T) {
 	require.NotNil(t, student)
 	assert.Equal(t, "Alice", student.Name)
 	assert.Equal(t, "alice@example.com", student.Email)
+	require.Len(t, student.Addresses, 1)
+	assert.Equal(t, "Moscow", student.Addresses[0].City)
 	require.Len(t, student.Courses, 2)
 	assert.Equal(t, "Math", student.Courses[0].Title)
 	assert.Equal(t, "Physics", student.Courses[1].Title)
@@ -112,16 +127,32 @@ func TestStudentUpdate(t *testing.T) {
 	defer db.Close()
 
 	mock.ExpectBegin()
-	mock.ExpectExec(regexp.QuoteMeta(`UPDATE "students"`)).
-		WithArgs("UpdatedName", "", 1). // Name, Email, ID
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "students" WHERE "students"."id" = $1 LIMIT $2 FOR UPDATE`)).
+		WithArgs(1, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "version", "deleted_at"}).AddRow(1, "John", 2, nil))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT "version" FROM "students" WHERE id = $1 AND "students"."deleted_at" IS NULL`)).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"version"}).AddRow(2))
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE "students" SET "name"=$1,"version"=$2 WHERE id = $3 AND "students"."deleted_at" IS NULL`)).
+		WithArgs("UpdatedName", 3, 1).
 		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "students" WHERE "students"."id" = $1 LIMIT $2`)).
+		WithArgs(1, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "version", "deleted_at"}).AddRow(1, "UpdatedName", 3, nil))
+	mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "audit_entries"`)).
+		WithArgs("student", 1, "update", nil, "", "", `{"name":"John","version":2}`, `{"name":"UpdatedName","version":3}`, sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
 	mock.ExpectCommit()
 
-	mock.ExpectQuery(`SELECT \* FROM "students" WHERE "students"\."id" = \$1 ORDER BY "students"\."id" LIMIT .*`).
+	mock.ExpectQuery(`SELECT \* FROM "students" WHERE "students"\."id" = \$1 AND "students"\."deleted_at" IS NULL ORDER BY "students"\."id" LIMIT .*`).
 		WithArgs(1, 1).
 		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "email"}).
 			AddRow(1, "UpdatedName", ""))
 
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "student_addresses" WHERE "student_addresses"."student_id" = $1`)).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "student_id"}))
+
 	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "course_student" WHERE "course_student"."student_id" = $1`)).
 		WithArgs(1).
 		WillReturnRows(sqlmock.NewRows([]string{"student_id", "course_id"}).
@@ -141,8 +172,7 @@ func TestStudentUpdate(t *testing.T) {
 			AddRow(202, "Prof. Jane"))
 
 	repo := NewStudentRepository()
-	st := &entity.Student{ID: 1, Name: "UpdatedName"}
-	updated, err := repo.Update(st)
+	updated, err := repo.Update(1, 2, map[string]interface{}{"name": "UpdatedName"}, nil, audit.Meta{})
 
 	require.NoError(t, err)
 	require.NotNil(t, updated)
@@ -161,12 +191,16 @@ func TestStudentFindAll(t *testing.T) {
 	page := 1
 	limit := 2
 
-	mock.ExpectQuery(`SELECT \* FROM "students" LIMIT \$\d+`).
+	mock.ExpectQuery(`SELECT \* FROM "students" WHERE "students"\."deleted_at" IS NULL ORDER BY "students"\."id" LIMIT \$\d+`).
 		WithArgs(limit).
 		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "email"}).
 			AddRow(1, "Alice", "alice@example.com").
 			AddRow(2, "Bob", "bob@example.com"))
 
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "student_addresses" WHERE "student_addresses"."student_id" IN ($1,$2)`)).
+		WithArgs(1, 2).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "student_id"}))
+
 	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "course_student" WHERE "course_student"."student_id" IN ($1,$2)`)).
 		WithArgs(1, 2).
 		WillReturnRows(sqlmock.NewRows([]string{"student_id", "course_id"}).
@@ -186,7 +220,7 @@ func TestStudentFindAll(t *testing.T) {
 			AddRow(202, "Prof. Jane"))
 
 	repo := NewStudentRepository()
-	students, err := repo.FindAll(page, limit)
+	students, err := repo.FindAll(page, limit, 0, query.Spec{Include: []string{"addresses", "courses", "courses.teacher"}})
 
 	require.NoError(t, err)
 	require.Len(t, students, 2)
@@ -208,18 +242,73 @@ func TestStudentDeleteById(t *testing.T) {
 	db, mock, _ := setupTestDB(t)
 	defer db.Close()
 
+	deletedAt := time.Date(2024, 5, 1, 9, 0, 0, 0, time.UTC)
+
 	mock.ExpectBegin()
-	mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "students" WHERE "students"."id" = $1`)).
-		WithArgs(1).
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "students" WHERE "students"."id" = $1 LIMIT $2 FOR UPDATE`)).
+		WithArgs(1, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "deleted_at"}).AddRow(1, "John", nil))
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE "students" SET "deleted_at"=$1 WHERE "students"."id" = $2 AND "students"."deleted_at" IS NULL`)).
+		WithArgs(sqlmock.AnyArg(), 1).
 		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "students" WHERE "students"."id" = $1 LIMIT $2`)).
+		WithArgs(1, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "deleted_at"}).AddRow(1, "John", deletedAt))
+	mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "audit_entries"`)).
+		WithArgs("student", 1, "delete", nil, "", "", `{"deleted_at":null}`, `{"deleted_at":"2024-05-01T09:00:00Z"}`, sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
 	mock.ExpectCommit()
 
 	repo := NewStudentRepository()
-	err := repo.DeleteById(1)
+	err := repo.DeleteById(1, 0, audit.Meta{})
 
 	assert.NoError(t, err)
 }
 
+func TestStudentRestore(t *testing.T) {
+	db, mock, _ := setupTestDB(t)
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "students" WHERE "students"."id" = $1 LIMIT $2 FOR UPDATE`)).
+		WithArgs(1, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "deleted_at"}).AddRow(1, "John", nil))
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE "students" SET "deleted_at"=$1 WHERE id = $2 AND deleted_at IS NOT NULL`)).
+		WithArgs(nil, 1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "students" WHERE "students"."id" = $1 LIMIT $2`)).
+		WithArgs(1, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "deleted_at"}).AddRow(1, "John", nil))
+	// The row was not deleted, so there is nothing to record.
+	mock.ExpectCommit()
+
+	repo := NewStudentRepository()
+	restored, err := repo.Restore(1, audit.Meta{})
+
+	assert.NoError(t, err)
+	assert.True(t, restored)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestStudentRestore_NameTaken(t *testing.T) {
+	db, mock, _ := setupTestDB(t)
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "students" WHERE "students"."id" = $1 LIMIT $2 FOR UPDATE`)).
+		WithArgs(1, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "deleted_at"}).AddRow(1, "John", time.Now()))
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE "students" SET "deleted_at"=$1`)).
+		WillReturnError(&pgconn.PgError{Code: uniqueViolation})
+	mock.ExpectRollback()
+
+	repo := NewStudentRepository()
+	restored, err := repo.Restore(1, audit.Meta{})
+
+	assert.ErrorIs(t, err, ErrNameTaken)
+	assert.False(t, restored)
+}
+
 func TestStudentCount(t *testing.T) {
 	db, mock, _ := setupTestDB(t)
 	defer db.Close()
@@ -228,8 +317,246 @@ func TestStudentCount(t *testing.T) {
 		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(3))
 
 	repo := NewStudentRepository()
-	count, err := repo.Count()
+	count, err := repo.Count(0, query.Spec{})
 
 	assert.NoError(t, err)
 	assert.Equal(t, 3, count)
 }
+
+func TestStudentCountInCohort(t *testing.T) {
+	db, mock, _ := setupTestDB(t)
+	defer db.Close()
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "students" WHERE id IN (SELECT student_id FROM cohort_student WHERE cohort_id = $1)`)).
+		WithArgs(4).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(25))
+
+	repo := NewStudentRepository()
+	count, err := repo.Count(4, query.Spec{})
+
+	assert.NoError(t, err)
+	assert.Equal(t, 25, count)
+}
+
+func TestStudentCountFiltered(t *testing.T) {
+	db, mock, _ := setupTestDB(t)
+	defer db.Close()
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "students" WHERE id IN (SELECT student_id FROM cohort_student WHERE cohort_id = $1) AND "students"."name" ILIKE $2`)).
+		WithArgs(4, "%ann%").
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+
+	repo := NewStudentRepository()
+	spec := query.Spec{Filters: []query.Filter{{Column: "name", Op: query.Contains, Value: "ann"}}}
+	count, err := repo.Count(4, spec)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, count)
+}
+
+func TestStudentHasGuardian(t *testing.T) {
+	db, mock, _ := setupTestDB(t)
+	defer db.Close()
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) > 0 FROM "student_contacts" WHERE student_id = $1 AND is_guardian`)).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(true))
+
+	repo := NewStudentRepository()
+	ok, err := repo.HasGuardian(1)
+
+	assert.NoError(t, err)
+	assert.True(t, ok)
+}
+
+func TestStudentUpdate_ReplacesAddresses(t *testing.T) {
+	db, mock, _ := setupTestDB(t)
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "students" WHERE "students"."id" = $1 LIMIT $2 FOR UPDATE`)).
+		WithArgs(1, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "version"}).AddRow(1, "John", 1))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT "version" FROM "students"`)).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"version"}).AddRow(1))
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE "students" SET "version"=$1 WHERE id = $2`)).
+		WithArgs(2, 1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "student_addresses" WHERE student_id = $1`)).
+		WithArgs(1).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "student_addresses" ("student_id","kind","line1","line2","city","region","postal_code","country") VALUES ($1,$2,$3,$4,$5,$6,$7,$8) RETURNING "id"`)).
+		WithArgs(1, "home", "Tverskaya 1", "", "Moscow", "", "125009", "RU").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(3))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "students" WHERE "students"."id" = $1 LIMIT $2`)).
+		WithArgs(1, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "version"}).AddRow(1, "John", 2))
+	mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "audit_entries"`)).
+		WithArgs("student", 1, "update", nil, "", "", `{"version":1}`, `{"version":2}`, sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectCommit()
+
+	mock.ExpectQuery(`SELECT \* FROM "students"`).
+		WithArgs(1, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(1, "John"))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "student_addresses"`)).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "student_id", "city"}).AddRow(3, 1, "Moscow"))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "course_student"`)).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"student_id", "course_id"}))
+
+	repo := NewStudentRepository()
+	updated, err := repo.Update(1, 0, map[string]interface{}{}, []entity.StudentAddress{
+		{Kind: "home", Line1: "Tverskaya 1", City: "Moscow", PostalCode: "125009", Country: "RU"},
+	}, audit.Meta{})
+
+	require.NoError(t, err)
+	require.Len(t, updated.Addresses, 1)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestStudentFindByNumber(t *testing.T) {
+	db, mock, _ := setupTestDB(t)
+	defer db.Close()
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "students" WHERE student_number = $1 AND "students"."deleted_at" IS NULL ORDER BY "students"."id" LIMIT $2`)).
+		WithArgs("202500001", 1).
+		WillReturnError(gorm.ErrRecordNotFound)
+
+	repo := NewStudentRepository()
+	student, err := repo.FindByNumber("202500001")
+
+	assert.Nil(t, student)
+	assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+}
+
+func TestStudentNextNumberSeq(t *testing.T) {
+	db, mock, _ := setupTestDB(t)
+	defer db.Close()
+
+	mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO student_number_counters (scope, value) VALUES ($1, 1)`)).
+		WithArgs("2025{seq}").
+		WillReturnRows(sqlmock.NewRows([]string{"value"}).AddRow(42))
+
+	repo := NewStudentRepository()
+	seq, err := repo.NextNumberSeq("2025{seq}")
+
+	assert.NoError(t, err)
+	assert.Equal(t, 42, seq)
+}
+
+func TestStudentFindDuplicates(t *testing.T) {
+	db, mock, _ := setupTestDB(t)
+	defer db.Close()
+
+	mock.ExpectQuery(`SELECT s.id, s.student_number, s.name, s.email,.*FROM students s JOIN students t ON t.id = \$1.*similarity\(s.name, t.name\) >= \$2.*LIMIT \$3`).
+		WithArgs(1, 0.4, 20).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "student_number", "name", "email", "name_similarity", "email_match"}).
+			AddRow(7, "202500007", "Jon Smith", "John.Smith+old@example.com", 0.56, true))
+
+	repo := NewStudentRepository()
+	duplicates, err := repo.FindDuplicates(1, 0.4, 20)
+
+	require.NoError(t, err)
+	assert.Equal(t, []entity.StudentDuplicate{
+		{ID: 7, StudentNumber: "202500007", Name: "Jon Smith", Email: "John.Smith+old@example.com", NameSimilarity: 0.56, EmailMatch: true},
+	}, duplicates)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestStudentMerge(t *testing.T) {
+	db, mock, _ := setupTestDB(t)
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT "id" FROM "students" WHERE id IN ($1,$2) AND "students"."deleted_at" IS NULL ORDER BY id FOR UPDATE`)).
+		WithArgs(1, 7).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1).AddRow(7))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "students" WHERE "students"."id" = $1 LIMIT $2`)).
+		WithArgs(7, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(7, "Jon Smith"))
+	for _, statement := range mergeStatements {
+		mock.ExpectExec(regexp.QuoteMeta(strings.Fields(statement)[0])).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+	}
+	mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "audit_entries"`)).
+		WithArgs("student", 7, "merge", nil, "", "", sqlmock.AnyArg(), `{"merged_into":1}`, sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "audit_entries"`)).
+		WithArgs("student", 1, "merge", nil, "", "", nil, `{"merged_id":7}`, sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(2))
+	mock.ExpectCommit()
+
+	repo := NewStudentRepository()
+	err := repo.Merge(1, 7, audit.Meta{})
+
+	require.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestStudentMerge_NotFound(t *testing.T) {
+	db, mock, _ := setupTestDB(t)
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT "id" FROM "students" WHERE id IN ($1,$2) AND "students"."deleted_at" IS NULL ORDER BY id FOR UPDATE`)).
+		WithArgs(1, 7).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectRollback()
+
+	repo := NewStudentRepository()
+	err := repo.Merge(1, 7, audit.Meta{})
+
+	assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestStudentMerge_OverlappingAppointments(t *testing.T) {
+	db, mock, _ := setupTestDB(t)
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT "id" FROM "students"`)).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1).AddRow(7))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "students" WHERE "students"."id" = $1 LIMIT $2`)).
+		WithArgs(7, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(7, "Jon Smith"))
+	for _, statement := range mergeStatements {
+		expectation := mock.ExpectExec(regexp.QuoteMeta(strings.Fields(statement)[0]))
+		if strings.HasPrefix(statement, "UPDATE appointments") {
+			expectation.WillReturnError(&pgconn.PgError{Code: exclusionViolation})
+			break
+		}
+		expectation.WillReturnResult(sqlmock.NewResult(0, 1))
+	}
+	mock.ExpectRollback()
+
+	repo := NewStudentRepository()
+	err := repo.Merge(1, 7, audit.Meta{})
+
+	assert.ErrorIs(t, err, ErrMergeConflict)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestStudentFindSurvivorId(t *testing.T) {
+	db, mock, _ := setupTestDB(t)
+	defer db.Close()
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT "survivor_id" FROM "student_merges" WHERE merged_id = $1`)).
+		WithArgs(7).
+		WillReturnRows(sqlmock.NewRows([]string{"survivor_id"}).AddRow(1))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT "survivor_id" FROM "student_merges" WHERE merged_id = $1`)).
+		WithArgs(8).
+		WillReturnRows(sqlmock.NewRows([]string{"survivor_id"}))
+
+	repo := NewStudentRepository()
+	survivorId, err := repo.FindSurvivorId(7)
+	require.NoError(t, err)
+	assert.Equal(t, uint(1), survivorId)
+
+	survivorId, err = repo.FindSurvivorId(8)
+	require.NoError(t, err)
+	assert.Zero(t, survivorId)
+}
@@ -1,181 +1,341 @@
 package student
 
 import (
+	"errors"
 	"fmt"
 	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"strings"
+	"student_go/internal/audit"
+	"student_go/internal/config"
 	"student_go/internal/course"
+	"student_go/internal/department"
 	"student_go/internal/dto/request"
 	response3 "student_go/internal/dto/response"
 	"student_go/internal/entity"
+	"student_go/internal/hold"
+	"student_go/internal/notification"
 	"student_go/pkg/dbcontext"
+	"student_go/pkg/etag"
 	"student_go/pkg/log"
+	"student_go/pkg/pagination"
+	"student_go/pkg/patch"
+	"student_go/pkg/query"
+	"time"
 )
 
+const (
+	dateLayout = "2006-01-02"
+	// adultAge is the age from which a student may enroll without a guardian.
+	adultAge = 18
+	// defaultDuplicateThreshold is used when students.duplicate_threshold
+	// is not configured.
+	defaultDuplicateThreshold = 0.4
+	// maxDuplicates caps the candidates returned for one student.
+	maxDuplicates = 20
+)
+
+var (
+	ErrGuardianRequired = errors.New("minor student must have a guardian contact before enrolling")
+	ErrSelfMerge        = errors.New("a student cannot be merged into itself")
+	ErrMergeConflict    = errors.New("students have overlapping appointments")
+	ErrNameTaken        = errors.New("another student has the same name")
+	// ErrDepartmentRequired means the student number format has a {dept}
+	// token but the request names no department.
+	ErrDepartmentRequired = errors.New("the student number format requires a departmentId")
+	ErrDepartmentNotFound = errors.New("department not found")
+)
+
+// MergedError reports that the requested student was merged into
+// another one, which should be used instead.
+type MergedError struct {
+	SurvivorID uint
+}
+
+func (e *MergedError) Error() string {
+	return fmt.Sprintf("student was merged into student %d", e.SurvivorID)
+}
+
+// HoldError rejects an enrollment because the student has active holds
+// of a blocking type.
+type HoldError struct {
+	Holds []entity.StudentHold
+}
+
+func (e *HoldError) Error() string {
+	types := make([]string, 0, len(e.Holds))
+	for _, h := range e.Holds {
+		types = append(types, h.Type)
+	}
+
+	return "student has active holds: " + strings.Join(types, ", ")
+}
+
 type Service interface {
-	CreateStudent(input request.StudentRequest) (*response3.StudentResponse, error)
-	UpdateStudent(id uint, input request.StudentRequest) (*response3.StudentResponse, error)
+	CreateStudent(meta audit.Meta, input request.StudentRequest) (*response3.StudentResponse, error)
+	// UpdateStudent and DeleteStudentById fail with etag.ErrMismatch when
+	// version is not zero and the student has another one.
+	// UpdateStudent changes the members the patch sets only.
+	UpdateStudent(id uint, version uint, meta audit.Meta, input request.StudentPatch, fields patch.Fields) (*response3.StudentResponse, error)
 	FindStudentById(id uint) (*response3.StudentResponse, error)
-	FindAllStudent(page, limit int) ([]*response3.StudentResponse, error)
-	DeleteStudentById(id uint) error
-	AddCourseToStudent(studentId uint, courseId uint) (*response3.StudentResponse, error)
-	Count() (int, error)
+	FindStudentByNumber(number string) (*response3.StudentResponse, error)
+	FindAllStudent(page, limit int, cohortId uint, spec query.Spec) ([]*response3.StudentResponse, error)
+	FindAllStudentByCursor(keyset pagination.Keyset, cohortId uint, spec query.Spec) (*pagination.CursorPage, error)
+	DeleteStudentById(id uint, version uint, meta audit.Meta) error
+	RestoreStudent(id uint, meta audit.Meta) (*response3.StudentResponse, error)
+	AddCourseToStudent(studentId uint, courseId uint, meta audit.Meta) (*response3.StudentResponse, error)
+	Count(cohortId uint, spec query.Spec) (int, error)
+	FindDuplicates(id uint) ([]*response3.StudentDuplicateResponse, error)
+	MergeStudent(survivorId uint, duplicateId uint, meta audit.Meta) (*response3.StudentResponse, error)
 }
 
 type service struct {
-	studentRepository Repository
-	courseRepository  course.Repository
+	studentRepository    Repository
+	courseRepository     course.Repository
+	holdRepository       hold.Repository
+	departmentRepository department.Repository
+	notifier             notification.Notifier
+	numberFormat         NumberFormat
+	blockingHolds        []string
+	minSimilarity        float64
 }
 
 func NewStudentService(
 	studentRepository Repository,
-	courseRepository course.Repository) Service {
+	courseRepository course.Repository,
+	holdRepository hold.Repository,
+	departmentRepository department.Repository,
+	notifier notification.Notifier) Service {
+	var format string
+	var blockingHolds []string
+	minSimilarity := defaultDuplicateThreshold
+	if config.Config != nil {
+		format = config.Config.Students.NumberFormat
+		blockingHolds = config.Config.Students.BlockingHolds
+		if threshold := config.Config.Students.DuplicateThreshold; threshold > 0 {
+			minSimilarity = threshold
+		}
+	}
+
+	numberFormat, err := ParseNumberFormat(format)
+	if err != nil {
+		log.Log.Error("Invalid student number format, using default", zap.Error(err))
+		numberFormat = defaultNumberFormat
+	}
+
 	return &service{
-		studentRepository: studentRepository,
-		courseRepository:  courseRepository,
+		studentRepository:    studentRepository,
+		courseRepository:     courseRepository,
+		holdRepository:       holdRepository,
+		departmentRepository: departmentRepository,
+		notifier:             notifier,
+		numberFormat:         numberFormat,
+		blockingHolds:        blockingHolds,
+		minSimilarity:        minSimilarity,
 	}
 }
 
-func (s *service) CreateStudent(input request.StudentRequest) (*response3.StudentResponse, error) {
+func (s *service) CreateStudent(meta audit.Meta, input request.StudentRequest) (*response3.StudentResponse, error) {
 	log.Log.Info("CreateStudent (service) called", zap.String("name", input.Name), zap.String("email", input.Email))
 
-	student := entity.Student{
-		Name:  input.Name,
-		Email: input.Email,
+	student, err := toStudent(input)
+	if err != nil {
+		return nil, err
+	}
+
+	var dept uint
+	if s.numberFormat.UsesDepartment() {
+		if dept, err = s.checkDepartment(input.DepartmentID); err != nil {
+			return nil, err
+		}
 	}
-	savedStudent, err := s.studentRepository.Save(&student)
+
+	now := time.Now()
+	seq, err := s.studentRepository.NextNumberSeq(s.numberFormat.Scope(now, dept))
 	if err != nil {
 		return nil, err
 	}
+	student.StudentNumber = s.numberFormat.Render(now, dept, seq)
 
-	resp := &response3.StudentResponse{
-		ID:    savedStudent.ID,
-		Name:  savedStudent.Name,
-		Email: savedStudent.Email,
+	savedStudent, err := s.studentRepository.Save(student, meta)
+	if err != nil {
+		return nil, err
 	}
-	return resp, nil
-}
 
-func (s *service) UpdateStudent(id uint, input request.StudentRequest) (*response3.StudentResponse, error) {
-	log.Log.Info("UpdateStudent (service) called", zap.Uint("id", id), zap.String("name", input.Name), zap.String("email", input.Email))
+	return toStudentResponse(savedStudent), nil
+}
 
-	student := entity.Student{
-		ID:    id,
-		Name:  input.Name,
-		Email: input.Email,
+// checkDepartment returns the ID of the department for the {dept} token of
+// the student number.
+func (s *service) checkDepartment(id uint) (uint, error) {
+	if id == 0 {
+		return 0, ErrDepartmentRequired
 	}
-	updatedStudent, err := s.studentRepository.Update(&student)
+
+	exists, err := s.departmentRepository.ExistsById(id)
 	if err != nil {
-		return nil, err
+		return 0, err
+	}
+	if !exists {
+		return 0, ErrDepartmentNotFound
 	}
 
-	coursesResp := make([]response3.CourseResponse, 0, len(student.Courses))
-	for _, course := range updatedStudent.Courses {
-		var teacherResp *response3.TeacherResponse
-		if course.Teacher != nil {
-			teacherResp = &response3.TeacherResponse{
-				ID:   course.Teacher.ID,
-				Name: course.Teacher.Name,
-			}
+	return id, nil
+}
+
+// UpdateStudent applies a merge patch to the editable profile fields. The
+// student number is never taken from the request.
+func (s *service) UpdateStudent(id uint, version uint, meta audit.Meta, input request.StudentPatch, fields patch.Fields) (*response3.StudentResponse, error) {
+	log.Log.Info("UpdateStudent (service) called", zap.Uint("id", id), zap.Strings("fields", fields.Names()))
+
+	// An empty patch changes nothing, so the version is left alone.
+	if len(fields) == 0 {
+		student, err := s.studentRepository.FindById(id)
+		if err != nil {
+			return nil, err
+		}
+		if version != 0 && student.Version != version {
+			return nil, etag.ErrMismatch
 		}
+		return toStudentResponse(student), nil
+	}
 
-		courseResp := response3.CourseResponse{
-			ID:      course.ID,
-			Title:   course.Title,
-			Teacher: teacherResp,
+	changes := make(map[string]interface{})
+	if fields.Has("name") {
+		changes["name"] = patch.Value(input.Name)
+	}
+	if fields.Has("preferredName") {
+		changes["preferred_name"] = patch.Value(input.PreferredName)
+	}
+	if fields.Has("pronouns") {
+		changes["pronouns"] = patch.Value(input.Pronouns)
+	}
+	if fields.Has("email") {
+		changes["email"] = patch.Value(input.Email)
+	}
+	if fields.Has("phone") {
+		changes["phone"] = patch.Value(input.Phone)
+	}
+	if fields.Has("locale") {
+		changes["locale"] = patch.Value(input.Locale)
+	}
+	if fields.Has("dateOfBirth") {
+		dateOfBirth, err := parseDate(input.DateOfBirth)
+		if err != nil {
+			return nil, err
 		}
-		coursesResp = append(coursesResp, courseResp)
+		changes["date_of_birth"] = dateOfBirth
 	}
 
-	studentResp := &response3.StudentResponse{
-		ID:      student.ID,
-		Name:    student.Name,
-		Email:   student.Email,
-		Courses: coursesResp,
+	var addresses []entity.StudentAddress
+	if fields.Has("addresses") {
+		addresses = toAddresses(patch.Value(input.Addresses))
 	}
-	return studentResp, nil
+
+	updatedStudent, err := s.studentRepository.Update(id, version, changes, addresses, meta)
+	if err != nil {
+		return nil, err
+	}
+
+	return toStudentResponse(updatedStudent), nil
 }
 
 func (s *service) FindStudentById(id uint) (*response3.StudentResponse, error) {
 	log.Log.Info("FindStudentById (service) called", zap.Uint("id", id))
 
 	student, err := s.studentRepository.FindById(id)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, s.mergedOr(id, err)
+	}
 	if err != nil {
 		return nil, err
 	}
 
-	coursesResp := make([]response3.CourseResponse, 0, len(student.Courses))
-	for _, course := range student.Courses {
-		var teacherResp *response3.TeacherResponse
-		if course.Teacher != nil {
-			teacherResp = &response3.TeacherResponse{
-				ID:   course.Teacher.ID,
-				Name: course.Teacher.Name,
-			}
-		}
+	return toStudentResponse(student), nil
+}
 
-		courseResp := response3.CourseResponse{
-			ID:      course.ID,
-			Title:   course.Title,
-			Teacher: teacherResp,
-		}
-		coursesResp = append(coursesResp, courseResp)
+// mergedOr returns a MergedError when id belongs to a merged student, and
+// err otherwise.
+func (s *service) mergedOr(id uint, err error) error {
+	survivorId, findErr := s.studentRepository.FindSurvivorId(id)
+	if findErr != nil {
+		return findErr
+	}
+	if survivorId != 0 {
+		return &MergedError{SurvivorID: survivorId}
 	}
 
-	studentResp := &response3.StudentResponse{
-		ID:      student.ID,
-		Name:    student.Name,
-		Email:   student.Email,
-		Courses: coursesResp,
+	return err
+}
+
+func (s *service) FindStudentByNumber(number string) (*response3.StudentResponse, error) {
+	log.Log.Info("FindStudentByNumber (service) called", zap.String("student_number", number))
+
+	student, err := s.studentRepository.FindByNumber(number)
+	if err != nil {
+		return nil, err
 	}
-	return studentResp, nil
+
+	return toStudentResponse(student), nil
 }
 
-func (s *service) FindAllStudent(page, limit int) ([]*response3.StudentResponse, error) {
-	log.Log.Info("FindAllStudent (service) called", zap.Int("page", page), zap.Int("limit", limit))
+func (s *service) FindAllStudent(page, limit int, cohortId uint, spec query.Spec) ([]*response3.StudentResponse, error) {
+	log.Log.Info("FindAllStudent (service) called", zap.Int("page", page), zap.Int("limit", limit), zap.Uint("cohort_id", cohortId))
 
-	students, err := s.studentRepository.FindAll(page, limit)
+	students, err := s.studentRepository.FindAll(page, limit, cohortId, spec)
 	if err != nil {
 		return nil, err
 	}
 
 	var studentResponses []*response3.StudentResponse
-	for _, student := range students {
-		coursesResp := make([]response3.CourseResponse, 0, len(student.Courses))
-		for _, course := range student.Courses {
-			var teacherResp *response3.TeacherResponse
-			if course.Teacher != nil {
-				teacherResp = &response3.TeacherResponse{
-					ID:   course.Teacher.ID,
-					Name: course.Teacher.Name,
-				}
-			}
+	for i := range students {
+		studentResponses = append(studentResponses, toStudentResponse(&students[i]))
+	}
 
-			courseResp := response3.CourseResponse{
-				ID:      course.ID,
-				Title:   course.Title,
-				Teacher: teacherResp,
-			}
-			coursesResp = append(coursesResp, courseResp)
-		}
+	return studentResponses, nil
+}
 
-		studentResp := &response3.StudentResponse{
-			ID:      student.ID,
-			Name:    student.Name,
-			Email:   student.Email,
-			Courses: coursesResp,
-		}
-		studentResponses = append(studentResponses, studentResp)
+func (s *service) FindAllStudentByCursor(keyset pagination.Keyset, cohortId uint, spec query.Spec) (*pagination.CursorPage, error) {
+	log.Log.Info("FindAllStudentByCursor (service) called", zap.Int("limit", keyset.Limit), zap.Uint("cohort_id", cohortId))
+
+	students, err := s.studentRepository.FindAllByCursor(keyset, cohortId, spec)
+	if err != nil {
+		return nil, err
 	}
 
-	return studentResponses, nil
+	students, page := pagination.Slice(keyset, students, func(s entity.Student) uint { return s.ID })
+
+	items := make([]*response3.StudentResponse, 0, len(students))
+	for i := range students {
+		items = append(items, toStudentResponse(&students[i]))
+	}
+	page.Items = items
+
+	return page, nil
 }
 
-func (s *service) DeleteStudentById(id uint) error {
-	log.Log.Info("DeleteStudentById (service) called", zap.Uint("id", id))
-	return s.studentRepository.DeleteById(id)
+func (s *service) DeleteStudentById(id uint, version uint, meta audit.Meta) error {
+	log.Log.Info("DeleteStudentById (service) called", zap.Uint("id", id), zap.Uint("version", version))
+	return s.studentRepository.DeleteById(id, version, meta)
 }
 
-func (s *service) AddCourseToStudent(studentId uint, courseId uint) (*response3.StudentResponse, error) {
+// RestoreStudent undoes a soft delete. It fails with ErrNameTaken when
+// another student has taken the name in the meantime.
+func (s *service) RestoreStudent(id uint, meta audit.Meta) (*response3.StudentResponse, error) {
+	log.Log.Info("RestoreStudent (service) called", zap.Uint("id", id))
+
+	restored, err := s.studentRepository.Restore(id, meta)
+	if err != nil {
+		return nil, err
+	}
+	if !restored {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	return s.FindStudentById(id)
+}
+
+func (s *service) AddCourseToStudent(studentId uint, courseId uint, meta audit.Meta) (*response3.StudentResponse, error) {
 	log.Log.Info("AddCourseToStudent (service) called", zap.Uint("student_id", studentId), zap.Uint("course_id", courseId))
 
 	exists, err := s.studentRepository.ExistsById(studentId)
@@ -188,10 +348,45 @@ func (s *service) AddCourseToStudent(studentId uint, courseId uint) (*response3.
 		return nil, fmt.Errorf("course not found")
 	}
 
+	// Enrolling twice changes nothing, so it is not audited or announced.
+	enrolled, err := s.courseRepository.HasStudent(courseId, studentId)
+	if err != nil {
+		return nil, err
+	}
+	if enrolled {
+		return s.FindStudentById(studentId)
+	}
+
+	if err := s.checkGuardian(studentId); err != nil {
+		return nil, err
+	}
+
+	if err := s.checkHolds(studentId); err != nil {
+		return nil, err
+	}
+
 	student := entity.Student{ID: studentId}
-	course := entity.Course{ID: courseId}
 
-	err = dbcontext.DB.Model(&student).Association("Courses").Append(&course)
+	err = dbcontext.DB.Transaction(func(tx *gorm.DB) error {
+		// A concurrent request may have enrolled the student since the
+		// check above; the primary key then turns the insert into a no-op.
+		result := tx.Exec("INSERT INTO course_student (course_id, student_id) VALUES (?, ?) ON CONFLICT DO NOTHING", courseId, studentId)
+		if result.Error != nil || result.RowsAffected == 0 {
+			return result.Error
+		}
+
+		// The course list is part of the student, so its ETag changes.
+		if err := tx.Model(&student).UpdateColumn("version", gorm.Expr("version + 1")).Error; err != nil {
+			return err
+		}
+
+		if err := audit.Record(tx, meta, entity.AuditStudent, studentId, entity.AuditEnroll,
+			nil, map[string]interface{}{"course_id": courseId}); err != nil {
+			return err
+		}
+
+		return s.notifyEnrollment(tx, studentId, courseId)
+	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to add course to student: %w", err)
 	}
@@ -199,6 +394,244 @@ func (s *service) AddCourseToStudent(studentId uint, courseId uint) (*response3.
 	return s.FindStudentById(studentId)
 }
 
-func (s *service) Count() (int, error) {
-	return s.studentRepository.Count()
+// notifyEnrollment queues the enrollment email in tx, so that it is sent
+// if and only if the enrollment commits.
+func (s *service) notifyEnrollment(tx *gorm.DB, studentId uint, courseId uint) error {
+	var student entity.Student
+	if err := tx.Select("name", "email", "locale").First(&student, studentId).Error; err != nil {
+		return err
+	}
+
+	var course entity.Course
+	if err := tx.Select("title").First(&course, courseId).Error; err != nil {
+		return err
+	}
+
+	return s.notifier.Notify(tx, notification.EventEnrollment,
+		notification.Recipient{Email: student.Email, Locale: student.Locale},
+		map[string]interface{}{
+			"StudentName": student.Name,
+			"CourseTitle": course.Title,
+		},
+	)
+}
+
+// checkGuardian rejects enrollment of a minor who has no guardian on file.
+// Students without a date of birth are treated as adults.
+func (s *service) checkGuardian(studentId uint) error {
+	student, err := s.studentRepository.FindById(studentId)
+	if err != nil {
+		return err
+	}
+	if !isMinor(student.DateOfBirth, time.Now()) {
+		return nil
+	}
+
+	hasGuardian, err := s.studentRepository.HasGuardian(studentId)
+	if err != nil {
+		return err
+	}
+	if !hasGuardian {
+		return ErrGuardianRequired
+	}
+
+	return nil
+}
+
+// checkHolds rejects enrollment while the student has an active hold of a
+// blocking type.
+func (s *service) checkHolds(studentId uint) error {
+	holds, err := s.holdRepository.FindActive(studentId, s.blockingHolds, time.Now())
+	if err != nil {
+		return err
+	}
+	if len(holds) > 0 {
+		return &HoldError{Holds: holds}
+	}
+
+	return nil
+}
+
+func isMinor(dateOfBirth *time.Time, now time.Time) bool {
+	if dateOfBirth == nil {
+		return false
+	}
+
+	return now.Before(dateOfBirth.AddDate(adultAge, 0, 0))
+}
+
+// parseDate parses an optional YYYY-MM-DD value; the request binding has
+// already validated the format.
+func parseDate(value *string) (*time.Time, error) {
+	if value == nil {
+		return nil, nil
+	}
+
+	parsed, err := time.Parse(dateLayout, *value)
+	if err != nil {
+		return nil, err
+	}
+
+	return &parsed, nil
+}
+
+func toStudent(input request.StudentRequest) (*entity.Student, error) {
+	dateOfBirth, err := parseDate(input.DateOfBirth)
+	if err != nil {
+		return nil, err
+	}
+
+	var addresses []entity.StudentAddress
+	if input.Addresses != nil {
+		addresses = toAddresses(input.Addresses)
+	}
+
+	return &entity.Student{
+		Name:          input.Name,
+		PreferredName: input.PreferredName,
+		Pronouns:      input.Pronouns,
+		Email:         input.Email,
+		Phone:         input.Phone,
+		Locale:        input.Locale,
+		DateOfBirth:   dateOfBirth,
+		Addresses:     addresses,
+	}, nil
+}
+
+// toAddresses never returns nil, so that an empty list still replaces
+// the stored addresses.
+func toAddresses(input []request.AddressRequest) []entity.StudentAddress {
+	addresses := make([]entity.StudentAddress, 0, len(input))
+	for _, address := range input {
+		addresses = append(addresses, entity.StudentAddress{
+			Kind:       address.Kind,
+			Line1:      address.Line1,
+			Line2:      address.Line2,
+			City:       address.City,
+			Region:     address.Region,
+			PostalCode: address.PostalCode,
+			Country:    address.Country,
+		})
+	}
+
+	return addresses
+}
+
+func toStudentResponse(student *entity.Student) *response3.StudentResponse {
+	coursesResp := make([]response3.CourseResponse, 0, len(student.Courses))
+	for _, course := range student.Courses {
+		var teacherResp *response3.TeacherResponse
+		if course.Teacher != nil {
+			teacherResp = &response3.TeacherResponse{
+				ID:    course.Teacher.ID,
+				Name:  course.Teacher.Name,
+				Email: course.Teacher.Email,
+			}
+		}
+
+		courseResp := response3.CourseResponse{
+			ID:      course.ID,
+			Title:   course.Title,
+			Teacher: teacherResp,
+		}
+		coursesResp = append(coursesResp, courseResp)
+	}
+
+	var addressesResp []response3.AddressResponse
+	for _, address := range student.Addresses {
+		addressesResp = append(addressesResp, response3.AddressResponse{
+			Kind:       address.Kind,
+			Line1:      address.Line1,
+			Line2:      address.Line2,
+			City:       address.City,
+			Region:     address.Region,
+			PostalCode: address.PostalCode,
+			Country:    address.Country,
+		})
+	}
+
+	studentResp := &response3.StudentResponse{
+		ID:            student.ID,
+		StudentNumber: student.StudentNumber,
+		Name:          student.Name,
+		PreferredName: student.PreferredName,
+		Pronouns:      student.Pronouns,
+		Email:         student.Email,
+		Phone:         student.Phone,
+		Locale:        student.Locale,
+		DateOfBirth:   formatDate(student.DateOfBirth),
+		Addresses:     addressesResp,
+		Courses:       coursesResp,
+		Version:       student.Version,
+	}
+	if student.DeletedAt.Valid {
+		studentResp.DeletedAt = &student.DeletedAt.Time
+	}
+
+	return studentResp
+}
+
+func formatDate(value *time.Time) *string {
+	if value == nil {
+		return nil
+	}
+
+	formatted := value.Format(dateLayout)
+	return &formatted
+}
+
+func (s *service) Count(cohortId uint, spec query.Spec) (int, error) {
+	return s.studentRepository.Count(cohortId, spec)
+}
+
+func (s *service) FindDuplicates(id uint) ([]*response3.StudentDuplicateResponse, error) {
+	log.Log.Info("FindDuplicates (service) called", zap.Uint("id", id))
+
+	exists, err := s.studentRepository.ExistsById(id)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, s.mergedOr(id, gorm.ErrRecordNotFound)
+	}
+
+	duplicates, err := s.studentRepository.FindDuplicates(id, s.minSimilarity, maxDuplicates)
+	if err != nil {
+		return nil, err
+	}
+
+	duplicateResponses := make([]*response3.StudentDuplicateResponse, 0, len(duplicates))
+	for _, duplicate := range duplicates {
+		duplicateResponses = append(duplicateResponses, &response3.StudentDuplicateResponse{
+			ID:             duplicate.ID,
+			StudentNumber:  duplicate.StudentNumber,
+			Name:           duplicate.Name,
+			Email:          duplicate.Email,
+			NameSimilarity: duplicate.NameSimilarity,
+			EmailMatch:     duplicate.EmailMatch,
+		})
+	}
+
+	return duplicateResponses, nil
+}
+
+// MergeStudent folds duplicateId into survivorId and returns the survivor.
+// Afterwards duplicateId resolves to the survivor through MergedError.
+func (s *service) MergeStudent(survivorId uint, duplicateId uint, meta audit.Meta) (*response3.StudentResponse, error) {
+	log.Log.Info("MergeStudent (service) called", zap.Uint("survivor_id", survivorId), zap.Uint("duplicate_id", duplicateId))
+
+	if survivorId == duplicateId {
+		return nil, ErrSelfMerge
+	}
+
+	if err := s.studentRepository.Merge(survivorId, duplicateId, meta); err != nil {
+		return nil, err
+	}
+
+	survivor, err := s.studentRepository.FindById(survivorId)
+	if err != nil {
+		return nil, err
+	}
+
+	return toStudentResponse(survivor), nil
 }
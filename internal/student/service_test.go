@@ -2,14 +2,27 @@ package student
 
 import (
 	"errors"
+	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"regexp"
+	"strconv"
+	"student_go/internal/audit"
 	"student_go/internal/dto/request"
+	"student_go/internal/dto/response"
 	"student_go/internal/entity"
 	mocks2 "student_go/internal/mocks"
+	"student_go/internal/notification"
+	"student_go/pkg/etag"
 	"student_go/pkg/log"
+	"student_go/pkg/pagination"
+	"student_go/pkg/patch"
+	"student_go/pkg/query"
 	"testing"
+	"time"
 )
 
 func init() {
@@ -17,17 +30,20 @@ func init() {
 	log.Log = logger
 }
 
-func newTestStudentService() (Service, *mocks2.StudentRepository, *mocks2.CourseRepository) {
+func newTestStudentService() (Service, *mocks2.StudentRepository, *mocks2.CourseRepository, *mocks2.HoldRepository) {
 	mockStudentRepo := new(mocks2.StudentRepository)
 	mockCourseRepo := new(mocks2.CourseRepository)
+	mockHoldRepo := new(mocks2.HoldRepository)
 
-	svc := NewStudentService(mockStudentRepo, mockCourseRepo)
+	mockStudentRepo.On("NextNumberSeq", mock.Anything).Return(1, nil).Maybe()
 
-	return svc, mockStudentRepo, mockCourseRepo
+	svc := NewStudentService(mockStudentRepo, mockCourseRepo, mockHoldRepo, new(mocks2.DepartmentRepository), new(mocks2.Notifier))
+
+	return svc, mockStudentRepo, mockCourseRepo, mockHoldRepo
 }
 
 func TestCreateStudent(t *testing.T) {
-	studentSvc, mockStudentRepo, _ := newTestStudentService()
+	studentSvc, mockStudentRepo, _, _ := newTestStudentService()
 
 	input := request.StudentRequest{
 		Name:  "Bob",
@@ -40,9 +56,9 @@ func TestCreateStudent(t *testing.T) {
 		Email: input.Email,
 	}
 
-	mockStudentRepo.On("Save", mock.AnythingOfType("*entity.Student")).Return(savedStudent, nil)
+	mockStudentRepo.On("Save", mock.AnythingOfType("*entity.Student"), audit.Meta{}).Return(savedStudent, nil)
 
-	result, err := studentSvc.CreateStudent(input)
+	result, err := studentSvc.CreateStudent(audit.Meta{}, input)
 
 	assert.NoError(t, err)
 	assert.NotNil(t, result)
@@ -53,8 +69,41 @@ func TestCreateStudent(t *testing.T) {
 	mockStudentRepo.AssertExpectations(t)
 }
 
+func TestCreateStudent_DepartmentNumber(t *testing.T) {
+	studentSvc, mockStudentRepo, _, _ := newTestStudentService()
+	studentSvc.(*service).numberFormat = "D{dept:3}-{seq:4}"
+	mockDeptRepo := studentSvc.(*service).departmentRepository.(*mocks2.DepartmentRepository)
+
+	mockDeptRepo.On("ExistsById", uint(7)).Return(true, nil)
+	mockStudentRepo.On("Save", mock.MatchedBy(func(s *entity.Student) bool {
+		return s.StudentNumber == "D007-0001"
+	}), audit.Meta{}).Return(&entity.Student{ID: 2, StudentNumber: "D007-0001"}, nil)
+
+	result, err := studentSvc.CreateStudent(audit.Meta{}, request.StudentRequest{Name: "Bob", Email: "bob@example.com", DepartmentID: 7})
+
+	require.NoError(t, err)
+	assert.Equal(t, "D007-0001", result.StudentNumber)
+	mockStudentRepo.AssertCalled(t, "NextNumberSeq", "D007-{seq}")
+	mockDeptRepo.AssertExpectations(t)
+}
+
+func TestCreateStudent_DepartmentRequired(t *testing.T) {
+	studentSvc, mockStudentRepo, _, _ := newTestStudentService()
+	studentSvc.(*service).numberFormat = "{dept}{seq}"
+	mockDeptRepo := studentSvc.(*service).departmentRepository.(*mocks2.DepartmentRepository)
+	mockDeptRepo.On("ExistsById", uint(9)).Return(false, nil)
+
+	_, err := studentSvc.CreateStudent(audit.Meta{}, request.StudentRequest{Name: "Bob", Email: "bob@example.com"})
+	assert.ErrorIs(t, err, ErrDepartmentRequired)
+
+	_, err = studentSvc.CreateStudent(audit.Meta{}, request.StudentRequest{Name: "Bob", Email: "bob@example.com", DepartmentID: 9})
+	assert.ErrorIs(t, err, ErrDepartmentNotFound)
+
+	mockStudentRepo.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
+}
+
 func TestCreateStudent_Error(t *testing.T) {
-	studentSvc, mockStudentRepo, _ := newTestStudentService()
+	studentSvc, mockStudentRepo, _, _ := newTestStudentService()
 
 	input := request.StudentRequest{
 		Name:  "Charlie",
@@ -63,9 +112,9 @@ func TestCreateStudent_Error(t *testing.T) {
 
 	expectedErr := errors.New("db error")
 
-	mockStudentRepo.On("Save", mock.AnythingOfType("*entity.Student")).Return(nil, expectedErr)
+	mockStudentRepo.On("Save", mock.AnythingOfType("*entity.Student"), audit.Meta{}).Return(nil, expectedErr)
 
-	result, err := studentSvc.CreateStudent(input)
+	result, err := studentSvc.CreateStudent(audit.Meta{}, input)
 
 	assert.Nil(t, result)
 	assert.EqualError(t, err, "db error")
@@ -74,17 +123,15 @@ func TestCreateStudent_Error(t *testing.T) {
 }
 
 func TestUpdateStudent(t *testing.T) {
-	studentSvc, mockStudentRepo, _ := newTestStudentService()
+	studentSvc, mockStudentRepo, _, _ := newTestStudentService()
 
-	input := request.StudentRequest{
-		Name:  "Eve",
-		Email: "eve@example.com",
-	}
+	email := "eve@example.com"
+	input := request.StudentPatch{Email: &email}
 
 	updatedStudent := &entity.Student{
 		ID:    3,
-		Name:  input.Name,
-		Email: input.Email,
+		Name:  "Eve",
+		Email: email,
 		Courses: []entity.Course{
 			{
 				ID:    20,
@@ -97,9 +144,10 @@ func TestUpdateStudent(t *testing.T) {
 		},
 	}
 
-	mockStudentRepo.On("Update", mock.AnythingOfType("*entity.Student")).Return(updatedStudent, nil)
+	mockStudentRepo.On("Update", uint(3), uint(0), map[string]interface{}{"email": email}, []entity.StudentAddress(nil), audit.Meta{}).
+		Return(updatedStudent, nil)
 
-	result, err := studentSvc.UpdateStudent(3, input)
+	result, err := studentSvc.UpdateStudent(3, 0, audit.Meta{}, input, patch.Fields{"email": true})
 
 	assert.NoError(t, err)
 	assert.NotNil(t, result)
@@ -114,18 +162,16 @@ func TestUpdateStudent(t *testing.T) {
 }
 
 func TestUpdateStudent_Error(t *testing.T) {
-	studentSvc, mockStudentRepo, _ := newTestStudentService()
+	studentSvc, mockStudentRepo, _, _ := newTestStudentService()
 
-	input := request.StudentRequest{
-		Name:  "Error",
-		Email: "error@example.com",
-	}
+	name := "Error"
+	input := request.StudentPatch{Name: &name}
 
 	expectedErr := errors.New("update failed")
 
-	mockStudentRepo.On("Update", mock.AnythingOfType("*entity.Student")).Return(nil, expectedErr)
+	mockStudentRepo.On("Update", uint(5), uint(0), mock.Anything, mock.Anything, audit.Meta{}).Return(nil, expectedErr)
 
-	result, err := studentSvc.UpdateStudent(5, input)
+	result, err := studentSvc.UpdateStudent(5, 0, audit.Meta{}, input, patch.Fields{"name": true})
 
 	assert.Nil(t, result)
 	assert.EqualError(t, err, "update failed")
@@ -133,8 +179,31 @@ func TestUpdateStudent_Error(t *testing.T) {
 	mockStudentRepo.AssertExpectations(t)
 }
 
+func TestUpdateStudent_EmptyPatch(t *testing.T) {
+	studentSvc, mockStudentRepo, _, _ := newTestStudentService()
+	mockStudentRepo.On("FindById", uint(5)).Return(&entity.Student{ID: 5, Name: "Alice", Version: 2}, nil)
+
+	result, err := studentSvc.UpdateStudent(5, 2, audit.Meta{}, request.StudentPatch{}, patch.Fields{})
+
+	require.NoError(t, err)
+	assert.Equal(t, "Alice", result.Name)
+	assert.Equal(t, uint(2), result.Version)
+	mockStudentRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestUpdateStudent_EmptyPatchStaleVersion(t *testing.T) {
+	studentSvc, mockStudentRepo, _, _ := newTestStudentService()
+	mockStudentRepo.On("FindById", uint(5)).Return(&entity.Student{ID: 5, Name: "Alice", Version: 3}, nil)
+
+	result, err := studentSvc.UpdateStudent(5, 2, audit.Meta{}, request.StudentPatch{}, patch.Fields{})
+
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, etag.ErrMismatch)
+	mockStudentRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
 func TestFindStudentById(t *testing.T) {
-	studentSvc, mockStudentRepo, _ := newTestStudentService()
+	studentSvc, mockStudentRepo, _, _ := newTestStudentService()
 
 	mockStudent := &entity.Student{
 		ID:    1,
@@ -169,7 +238,7 @@ func TestFindStudentById(t *testing.T) {
 }
 
 func TestFindStudentById_Error(t *testing.T) {
-	studentSvc, mockStudentRepo, _ := newTestStudentService()
+	studentSvc, mockStudentRepo, _, _ := newTestStudentService()
 
 	expectedErr := errors.New("student not found")
 
@@ -184,7 +253,7 @@ func TestFindStudentById_Error(t *testing.T) {
 }
 
 func TestFindAllStudent(t *testing.T) {
-	studentSvc, mockStudentRepo, _ := newTestStudentService()
+	studentSvc, mockStudentRepo, _, _ := newTestStudentService()
 
 	mockStudents := []entity.Student{
 		{
@@ -204,9 +273,9 @@ func TestFindAllStudent(t *testing.T) {
 		},
 	}
 
-	mockStudentRepo.On("FindAll", 1, 5).Return(mockStudents, nil)
+	mockStudentRepo.On("FindAll", 1, 5, uint(0), query.Spec{}).Return(mockStudents, nil)
 
-	result, err := studentSvc.FindAllStudent(1, 5)
+	result, err := studentSvc.FindAllStudent(1, 5, 0, query.Spec{})
 
 	assert.NoError(t, err)
 	assert.NotNil(t, result)
@@ -218,14 +287,32 @@ func TestFindAllStudent(t *testing.T) {
 	mockStudentRepo.AssertExpectations(t)
 }
 
+func TestFindAllStudentByCursor(t *testing.T) {
+	studentSvc, mockStudentRepo, _, _ := newTestStudentService()
+
+	keyset := pagination.Keyset{Cursor: &pagination.Cursor{ID: 3}, Limit: 2}
+	mockStudentRepo.On("FindAllByCursor", keyset, uint(0), query.Spec{}).
+		Return([]entity.Student{{ID: 4, Name: "Ann"}, {ID: 7, Name: "Bob"}, {ID: 9, Name: "Cid"}}, nil)
+
+	page, err := studentSvc.FindAllStudentByCursor(keyset, 0, query.Spec{})
+
+	assert.NoError(t, err)
+	items := page.Items.([]*response.StudentResponse)
+	assert.Len(t, items, 2)
+	assert.Equal(t, "Bob", items[1].Name)
+	assert.Equal(t, pagination.Cursor{ID: 7}.Encode(), page.NextCursor)
+	assert.Equal(t, pagination.Cursor{ID: 4, Before: true}.Encode(), page.PrevCursor)
+	assert.Nil(t, page.TotalCount)
+}
+
 func TestFindAllStudent_Error(t *testing.T) {
-	studentSvc, mockStudentRepo, _ := newTestStudentService()
+	studentSvc, mockStudentRepo, _, _ := newTestStudentService()
 
 	expectedErr := errors.New("find all error")
 
-	mockStudentRepo.On("FindAll", 1, 5).Return(nil, expectedErr)
+	mockStudentRepo.On("FindAll", 1, 5, uint(0), query.Spec{}).Return(nil, expectedErr)
 
-	result, err := studentSvc.FindAllStudent(1, 5)
+	result, err := studentSvc.FindAllStudent(1, 5, 0, query.Spec{})
 
 	assert.Nil(t, result)
 	assert.EqualError(t, err, "find all error")
@@ -234,34 +321,59 @@ func TestFindAllStudent_Error(t *testing.T) {
 }
 
 func TestDeleteStudentById(t *testing.T) {
-	studentSvc, mockStudentRepo, _ := newTestStudentService()
+	studentSvc, mockStudentRepo, _, _ := newTestStudentService()
 
-	mockStudentRepo.On("DeleteById", uint(1)).Return(nil)
+	mockStudentRepo.On("DeleteById", uint(1), uint(0), audit.Meta{}).Return(nil)
 
-	err := studentSvc.DeleteStudentById(1)
+	err := studentSvc.DeleteStudentById(1, 0, audit.Meta{})
 
 	assert.NoError(t, err)
 	mockStudentRepo.AssertExpectations(t)
 }
 
 func TestDeleteStudentById_Error(t *testing.T) {
-	studentSvc, mockStudentRepo, _ := newTestStudentService()
+	studentSvc, mockStudentRepo, _, _ := newTestStudentService()
 
 	expectedErr := errors.New("delete failed")
-	mockStudentRepo.On("DeleteById", uint(999)).Return(expectedErr)
+	mockStudentRepo.On("DeleteById", uint(999), uint(0), audit.Meta{}).Return(expectedErr)
 
-	err := studentSvc.DeleteStudentById(999)
+	err := studentSvc.DeleteStudentById(999, 0, audit.Meta{})
 
 	assert.EqualError(t, err, "delete failed")
 	mockStudentRepo.AssertExpectations(t)
 }
 
+func TestRestoreStudent(t *testing.T) {
+	studentSvc, mockStudentRepo, _, _ := newTestStudentService()
+
+	mockStudentRepo.On("Restore", uint(1), audit.Meta{}).Return(true, nil)
+	mockStudentRepo.On("FindById", uint(1)).Return(&entity.Student{ID: 1, Name: "Alice"}, nil)
+
+	result, err := studentSvc.RestoreStudent(1, audit.Meta{})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Alice", result.Name)
+	mockStudentRepo.AssertExpectations(t)
+}
+
+func TestRestoreStudent_NotDeleted(t *testing.T) {
+	studentSvc, mockStudentRepo, _, _ := newTestStudentService()
+
+	mockStudentRepo.On("Restore", uint(1), audit.Meta{}).Return(false, nil)
+
+	result, err := studentSvc.RestoreStudent(1, audit.Meta{})
+
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+	mockStudentRepo.AssertNotCalled(t, "FindById", mock.Anything)
+}
+
 func TestAddCourseToStudent_StudentNotFound(t *testing.T) {
-	studentSvc, mockStudentRepo, _ := newTestStudentService()
+	studentSvc, mockStudentRepo, _, _ := newTestStudentService()
 
 	mockStudentRepo.On("ExistsById", uint(1)).Return(false, nil)
 
-	result, err := studentSvc.AddCourseToStudent(1, 10)
+	result, err := studentSvc.AddCourseToStudent(1, 10, audit.Meta{})
 
 	assert.Nil(t, result)
 	assert.EqualError(t, err, "student not found")
@@ -270,12 +382,12 @@ func TestAddCourseToStudent_StudentNotFound(t *testing.T) {
 }
 
 func TestAddCourseToStudent_CourseNotFound(t *testing.T) {
-	studentSvc, mockStudentRepo, mockCourseRepo := newTestStudentService()
+	studentSvc, mockStudentRepo, mockCourseRepo, _ := newTestStudentService()
 
 	mockStudentRepo.On("ExistsById", uint(1)).Return(true, nil)
 	mockCourseRepo.On("ExistsById", uint(10)).Return(false, nil)
 
-	result, err := studentSvc.AddCourseToStudent(1, 10)
+	result, err := studentSvc.AddCourseToStudent(1, 10, audit.Meta{})
 
 	assert.Nil(t, result)
 	assert.EqualError(t, err, "course not found")
@@ -283,3 +395,230 @@ func TestAddCourseToStudent_CourseNotFound(t *testing.T) {
 	mockStudentRepo.AssertExpectations(t)
 	mockCourseRepo.AssertExpectations(t)
 }
+
+func TestAddCourseToStudent_MinorWithoutGuardian(t *testing.T) {
+	studentSvc, mockStudentRepo, mockCourseRepo, _ := newTestStudentService()
+
+	dateOfBirth := time.Now().AddDate(-16, 0, 0)
+	mockStudentRepo.On("ExistsById", uint(1)).Return(true, nil)
+	mockCourseRepo.On("ExistsById", uint(10)).Return(true, nil)
+	mockCourseRepo.On("HasStudent", uint(10), uint(1)).Return(false, nil)
+	mockStudentRepo.On("FindById", uint(1)).Return(&entity.Student{ID: 1, DateOfBirth: &dateOfBirth}, nil)
+	mockStudentRepo.On("HasGuardian", uint(1)).Return(false, nil)
+
+	result, err := studentSvc.AddCourseToStudent(1, 10, audit.Meta{})
+
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, ErrGuardianRequired)
+
+	mockStudentRepo.AssertExpectations(t)
+}
+
+func TestAddCourseToStudent_ActiveHolds(t *testing.T) {
+	studentSvc, mockStudentRepo, mockCourseRepo, mockHoldRepo := newTestStudentService()
+	studentSvc.(*service).blockingHolds = []string{entity.HoldFinancial}
+
+	mockStudentRepo.On("ExistsById", uint(1)).Return(true, nil)
+	mockCourseRepo.On("ExistsById", uint(10)).Return(true, nil)
+	mockCourseRepo.On("HasStudent", uint(10), uint(1)).Return(false, nil)
+	mockStudentRepo.On("FindById", uint(1)).Return(&entity.Student{ID: 1}, nil)
+	mockHoldRepo.On("FindActive", uint(1), []string{entity.HoldFinancial}, mock.AnythingOfType("time.Time")).
+		Return([]entity.StudentHold{{ID: 3, StudentID: 1, Type: entity.HoldFinancial, Reason: "unpaid fees"}}, nil)
+
+	result, err := studentSvc.AddCourseToStudent(1, 10, audit.Meta{})
+
+	assert.Nil(t, result)
+	var holdErr *HoldError
+	require.ErrorAs(t, err, &holdErr)
+	assert.Len(t, holdErr.Holds, 1)
+	assert.EqualError(t, err, "student has active holds: financial")
+}
+
+func TestAddCourseToStudent_AlreadyEnrolled(t *testing.T) {
+	studentSvc, mockStudentRepo, mockCourseRepo, mockHoldRepo := newTestStudentService()
+
+	mockStudentRepo.On("ExistsById", uint(1)).Return(true, nil)
+	mockCourseRepo.On("ExistsById", uint(10)).Return(true, nil)
+	mockCourseRepo.On("HasStudent", uint(10), uint(1)).Return(true, nil)
+	mockStudentRepo.On("FindById", uint(1)).Return(&entity.Student{ID: 1, Courses: []entity.Course{{ID: 10, Title: "Math"}}}, nil)
+
+	result, err := studentSvc.AddCourseToStudent(1, 10, audit.Meta{})
+
+	require.NoError(t, err)
+	assert.Len(t, result.Courses, 1)
+	mockHoldRepo.AssertNotCalled(t, "FindActive", mock.Anything, mock.Anything, mock.Anything)
+	studentSvc.(*service).notifier.(*mocks2.Notifier).AssertNotCalled(t, "Notify", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestAddCourseToStudent_NotifyFailsRollsBack(t *testing.T) {
+	db, sqlMock, _ := setupTestDB(t)
+	defer db.Close()
+	studentSvc, mockStudentRepo, mockCourseRepo, mockHoldRepo := newTestStudentService()
+
+	mockStudentRepo.On("ExistsById", uint(1)).Return(true, nil)
+	mockCourseRepo.On("ExistsById", uint(10)).Return(true, nil)
+	mockCourseRepo.On("HasStudent", uint(10), uint(1)).Return(false, nil)
+	mockStudentRepo.On("FindById", uint(1)).Return(&entity.Student{ID: 1}, nil)
+	mockHoldRepo.On("FindActive", uint(1), []string(nil), mock.AnythingOfType("time.Time")).Return(nil, nil)
+
+	sqlMock.ExpectBegin()
+	sqlMock.ExpectExec(regexp.QuoteMeta(`INSERT INTO course_student (course_id, student_id) VALUES ($1, $2) ON CONFLICT DO NOTHING`)).
+		WithArgs(10, 1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	sqlMock.ExpectExec(regexp.QuoteMeta(`UPDATE "students" SET "version"=version + 1`)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	sqlMock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "audit_entries"`)).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	sqlMock.ExpectQuery(regexp.QuoteMeta(`SELECT "name","email","locale" FROM "students"`)).
+		WillReturnRows(sqlmock.NewRows([]string{"name", "email", "locale"}).AddRow("Bob", "bob@example.com", "ru"))
+	sqlMock.ExpectQuery(regexp.QuoteMeta(`SELECT "title" FROM "courses"`)).
+		WillReturnRows(sqlmock.NewRows([]string{"title"}).AddRow("Math"))
+	sqlMock.ExpectRollback()
+
+	notifier := studentSvc.(*service).notifier.(*mocks2.Notifier)
+	notifier.On("Notify", mock.Anything, notification.EventEnrollment, notification.Recipient{Email: "bob@example.com", Locale: "ru"},
+		map[string]interface{}{"StudentName": "Bob", "CourseTitle": "Math"}).Return(errors.New("outbox full"))
+
+	result, err := studentSvc.AddCourseToStudent(1, 10, audit.Meta{})
+
+	assert.Nil(t, result)
+	assert.EqualError(t, err, "failed to add course to student: outbox full")
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
+func TestIsMinor(t *testing.T) {
+	now := time.Date(2025, 6, 15, 12, 0, 0, 0, time.UTC)
+	turnsAdultTomorrow := time.Date(2007, 6, 16, 0, 0, 0, 0, time.UTC)
+	turnedAdultToday := time.Date(2007, 6, 15, 0, 0, 0, 0, time.UTC)
+
+	assert.True(t, isMinor(&turnsAdultTomorrow, now))
+	assert.False(t, isMinor(&turnedAdultToday, now))
+	assert.False(t, isMinor(nil, now))
+}
+
+func TestCreateStudent_WithDateOfBirth(t *testing.T) {
+	studentSvc, mockStudentRepo, _, _ := newTestStudentService()
+
+	dateOfBirth := "2008-02-29"
+	input := request.StudentRequest{Name: "John", Email: "john@example.com", DateOfBirth: &dateOfBirth}
+	mockStudentRepo.On("Save", mock.MatchedBy(func(s *entity.Student) bool {
+		return s.DateOfBirth != nil && s.DateOfBirth.Equal(time.Date(2008, 2, 29, 0, 0, 0, 0, time.UTC))
+	}), audit.Meta{}).Return(func(s *entity.Student, _ audit.Meta) *entity.Student {
+		s.ID = 1
+		return s
+	}, nil)
+
+	result, err := studentSvc.CreateStudent(audit.Meta{}, input)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "2008-02-29", *result.DateOfBirth)
+}
+
+func TestCreateStudent_GeneratesNumber(t *testing.T) {
+	studentSvc, mockStudentRepo, _, _ := newTestStudentService()
+
+	year := strconv.Itoa(time.Now().Year())
+	mockStudentRepo.On("Save", mock.MatchedBy(func(s *entity.Student) bool {
+		return s.StudentNumber == year+"00001"
+	}), audit.Meta{}).Return(func(s *entity.Student, _ audit.Meta) *entity.Student {
+		s.ID = 1
+		return s
+	}, nil)
+
+	result, err := studentSvc.CreateStudent(audit.Meta{}, request.StudentRequest{Name: "John", Email: "john@example.com"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, year+"00001", result.StudentNumber)
+	mockStudentRepo.AssertCalled(t, "NextNumberSeq", year+"{seq}")
+}
+
+func TestUpdateStudent_NullClears(t *testing.T) {
+	studentSvc, mockStudentRepo, _, _ := newTestStudentService()
+
+	changes := map[string]interface{}{
+		"phone":         "",
+		"date_of_birth": (*time.Time)(nil),
+	}
+	mockStudentRepo.On("Update", uint(3), uint(2), changes, []entity.StudentAddress{}, audit.Meta{}).
+		Return(&entity.Student{ID: 3, StudentNumber: "202500003", Name: "Eve"}, nil)
+
+	fields := patch.Fields{"phone": true, "dateOfBirth": true, "addresses": true}
+	result, err := studentSvc.UpdateStudent(3, 2, audit.Meta{}, request.StudentPatch{}, fields)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "202500003", result.StudentNumber)
+	mockStudentRepo.AssertExpectations(t)
+}
+
+func TestFindStudentById_Merged(t *testing.T) {
+	studentSvc, mockStudentRepo, _, _ := newTestStudentService()
+
+	mockStudentRepo.On("FindById", uint(7)).Return(nil, gorm.ErrRecordNotFound)
+	mockStudentRepo.On("FindSurvivorId", uint(7)).Return(uint(1), nil)
+
+	_, err := studentSvc.FindStudentById(7)
+
+	var mergedErr *MergedError
+	require.ErrorAs(t, err, &mergedErr)
+	assert.Equal(t, uint(1), mergedErr.SurvivorID)
+}
+
+func TestFindStudentById_NotFound(t *testing.T) {
+	studentSvc, mockStudentRepo, _, _ := newTestStudentService()
+
+	mockStudentRepo.On("FindById", uint(8)).Return(nil, gorm.ErrRecordNotFound)
+	mockStudentRepo.On("FindSurvivorId", uint(8)).Return(uint(0), nil)
+
+	_, err := studentSvc.FindStudentById(8)
+
+	assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+}
+
+func TestFindDuplicates(t *testing.T) {
+	studentSvc, mockStudentRepo, _, _ := newTestStudentService()
+
+	mockStudentRepo.On("ExistsById", uint(1)).Return(true, nil)
+	mockStudentRepo.On("FindDuplicates", uint(1), defaultDuplicateThreshold, maxDuplicates).
+		Return([]entity.StudentDuplicate{{ID: 7, Name: "Jon Smith", NameSimilarity: 0.56, EmailMatch: true}}, nil)
+
+	result, err := studentSvc.FindDuplicates(1)
+
+	require.NoError(t, err)
+	assert.Equal(t, []*response.StudentDuplicateResponse{
+		{ID: 7, Name: "Jon Smith", NameSimilarity: 0.56, EmailMatch: true},
+	}, result)
+}
+
+func TestFindDuplicates_NotFound(t *testing.T) {
+	studentSvc, mockStudentRepo, _, _ := newTestStudentService()
+
+	mockStudentRepo.On("ExistsById", uint(9)).Return(false, nil)
+	mockStudentRepo.On("FindSurvivorId", uint(9)).Return(uint(0), nil)
+
+	_, err := studentSvc.FindDuplicates(9)
+
+	assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+	mockStudentRepo.AssertNotCalled(t, "FindDuplicates", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestMergeStudent(t *testing.T) {
+	studentSvc, mockStudentRepo, _, _ := newTestStudentService()
+
+	mockStudentRepo.On("Merge", uint(1), uint(7), audit.Meta{}).Return(nil)
+	mockStudentRepo.On("FindById", uint(1)).Return(&entity.Student{ID: 1, Name: "John Smith"}, nil)
+
+	result, err := studentSvc.MergeStudent(1, 7, audit.Meta{})
+
+	require.NoError(t, err)
+	assert.Equal(t, uint(1), result.ID)
+	mockStudentRepo.AssertExpectations(t)
+}
+
+func TestMergeStudent_Self(t *testing.T) {
+	studentSvc, mockStudentRepo, _, _ := newTestStudentService()
+
+	_, err := studentSvc.MergeStudent(1, 1, audit.Meta{})
+
+	assert.ErrorIs(t, err, ErrSelfMerge)
+	mockStudentRepo.AssertNotCalled(t, "Merge", mock.Anything, mock.Anything)
+}
@@ -7,11 +7,20 @@ import (
 	"gorm.io/gorm"
 	"net/http"
 	"strconv"
+	"student_go/internal/actor"
+	"student_go/internal/audit"
 	"student_go/internal/dto/request"
+	"student_go/internal/dto/response"
+	"student_go/pkg/etag"
 	"student_go/pkg/log"
 	"student_go/pkg/pagination"
+	"student_go/pkg/patch"
+	"student_go/pkg/query"
 )
 
+// listAttributes are the fields teachers lists can be limited to.
+var listAttributes = query.AttributesOf(response.TeacherResponse{})
+
 type TeacherHandler struct {
 	Service Service
 }
@@ -33,7 +42,7 @@ func (h *TeacherHandler) CreateTeacher(c *gin.Context) {
 
 	log.Log.Info("CreateTeacher called", zap.String("name", req.Name))
 
-	teacherResp, err := h.Service.CreateTeacher(req)
+	teacherResp, err := h.Service.CreateTeacher(audit.FromContext(c), req)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to save teacher"})
 		return
@@ -42,7 +51,7 @@ func (h *TeacherHandler) CreateTeacher(c *gin.Context) {
 }
 
 func (h *TeacherHandler) UpdateTeacher(c *gin.Context) {
-	var req request.TeacherRequest
+	var req request.TeacherPatch
 
 	idParam := c.Param("id")
 	parsedID, err := strconv.ParseUint(idParam, 10, 32)
@@ -53,20 +62,38 @@ func (h *TeacherHandler) UpdateTeacher(c *gin.Context) {
 	}
 	id := uint(parsedID)
 
-	if err := c.ShouldBindJSON(&req); err != nil {
+	version, err := etag.IfMatch(c.Request)
+	if err != nil {
+		c.JSON(http.StatusPreconditionFailed, gin.H{"error": err.Error()})
+		return
+	}
+
+	fields, err := patch.Bind(c.Request, &req)
+	if err != nil {
 		log.Log.Warn("Invalid request in UpdateTeacher", zap.Error(err))
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		if errors.Is(err, patch.ErrUnsupportedMediaType) {
+			c.JSON(http.StatusUnsupportedMediaType, gin.H{"error": err.Error()})
+		} else {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		}
 		return
 	}
 
-	log.Log.Info("UpdateTeacher called", zap.Uint("id", id), zap.String("name", req.Name))
+	log.Log.Info("UpdateTeacher called", zap.Uint("id", id), zap.Strings("fields", fields.Names()))
 
-	teacherResp, err := h.Service.UpdateTeacher(id, req)
+	teacherResp, err := h.Service.UpdateTeacher(id, version, audit.FromContext(c), req, fields)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to update teacher"})
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "teacher not found"})
+		} else if errors.Is(err, etag.ErrMismatch) {
+			c.JSON(http.StatusPreconditionFailed, gin.H{"error": err.Error()})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to update teacher"})
+		}
 		return
 	}
 
+	c.Header(etag.HeaderETag, etag.Format(teacherResp.Version, teacherResp))
 	c.JSON(http.StatusOK, teacherResp)
 }
 
@@ -92,11 +119,36 @@ func (h *TeacherHandler) FindTeacherById(c *gin.Context) {
 		return
 	}
 
+	tag := etag.Format(teacherResp.Version, teacherResp)
+	c.Header(etag.HeaderETag, tag)
+	if etag.NoneMatch(c.Request, tag) {
+		c.Status(http.StatusNotModified)
+		return
+	}
+
 	c.JSON(http.StatusOK, teacherResp)
 }
 
 func (h *TeacherHandler) FindAllTeachers(c *gin.Context) {
-	count, err := h.Service.Count()
+	spec, err := query.NewFromRequest(c.Request, listFields, listRelations, listAttributes)
+	if err != nil {
+		log.Log.Warn("Invalid query in FindAllTeachers", zap.Error(err))
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if spec.IncludeDeleted {
+		if a, err := actor.FromContext(c); err != nil || !a.IsAdmin() {
+			c.JSON(http.StatusForbidden, gin.H{"error": "only admins can list deleted teachers"})
+			return
+		}
+	}
+
+	if pagination.IsCursorRequest(c.Request) {
+		h.findAllTeachersByCursor(c, spec)
+		return
+	}
+
+	count, err := h.Service.Count(spec)
 	if err != nil {
 		log.Log.Error("Failed to count teachers", zap.Error(err))
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to count teachers"})
@@ -111,15 +163,60 @@ func (h *TeacherHandler) FindAllTeachers(c *gin.Context) {
 		zap.Int("total_count", pages.TotalCount),
 	)
 
-	teachers, err := h.Service.FindAllTeachers(pages.Page, pages.PerPage)
+	teachers, err := h.Service.FindAllTeachers(pages.Page, pages.PerPage, spec)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to get all teachers"})
 		return
 	}
-	pages.Items = teachers
+	items, err := spec.Render(teachers, listRelations)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	pages.Items = items
 	c.JSON(http.StatusOK, pages)
 }
 
+// findAllTeachersByCursor serves FindAllTeachers with cursor pagination. It only counts
+// the teachers when the client asks for include_total.
+func (h *TeacherHandler) findAllTeachersByCursor(c *gin.Context, spec query.Spec) {
+	keyset, err := pagination.NewKeysetFromRequest(c.Request)
+	if err != nil {
+		log.Log.Warn("Invalid cursor in FindAllTeachers", zap.Error(err))
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if len(spec.Sorts) > 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "sort is not supported with cursor pagination"})
+		return
+	}
+
+	page, err := h.Service.FindAllTeachersByCursor(keyset, spec)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to get all teachers"})
+		return
+	}
+
+	if c.Query(pagination.TotalVar) == "true" {
+		count, err := h.Service.Count(spec)
+		if err != nil {
+			log.Log.Error("Failed to count teachers", zap.Error(err))
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to count teachers"})
+			return
+		}
+		page.TotalCount = &count
+	}
+
+	page.Items, err = spec.Render(page.Items, listRelations)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, page)
+}
+
 func (h *TeacherHandler) DeleteTeacherById(c *gin.Context) {
 	idParam := c.Param("id")
 	parsedID, err := strconv.ParseUint(idParam, 10, 32)
@@ -130,13 +227,52 @@ func (h *TeacherHandler) DeleteTeacherById(c *gin.Context) {
 	}
 	id := uint(parsedID)
 
-	log.Log.Info("DeleteTeacherById called", zap.Uint("id", id))
+	version, err := etag.IfMatch(c.Request)
+	if err != nil {
+		c.JSON(http.StatusPreconditionFailed, gin.H{"error": err.Error()})
+		return
+	}
+
+	log.Log.Info("DeleteTeacherById called", zap.Uint("id", id), zap.Uint("version", version))
 
-	err = h.Service.DeleteTeacherById(id)
+	err = h.Service.DeleteTeacherById(id, version, audit.FromContext(c))
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		if errors.Is(err, etag.ErrMismatch) {
+			c.JSON(http.StatusPreconditionFailed, gin.H{"error": err.Error()})
+		} else if errors.Is(err, gorm.ErrRecordNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "teacher not found"})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
 		return
 	}
 
 	c.Status(http.StatusNoContent)
 }
+
+// RestoreTeacher brings back a deleted teacher, as long as the purge job
+// has not removed them yet.
+func (h *TeacherHandler) RestoreTeacher(c *gin.Context) {
+	idParam := c.Param("id")
+	parsedID, err := strconv.ParseUint(idParam, 10, 32)
+	if err != nil {
+		log.Log.Warn("Invalid teacher ID in RestoreTeacher", zap.String("id", idParam), zap.Error(err))
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid teacher ID"})
+		return
+	}
+	id := uint(parsedID)
+
+	log.Log.Info("RestoreTeacher called", zap.Uint("id", id))
+
+	teacherResp, err := h.Service.RestoreTeacher(id, audit.FromContext(c))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "deleted teacher not found"})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to restore teacher"})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, teacherResp)
+}
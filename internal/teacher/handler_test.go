@@ -5,10 +5,13 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"student_go/internal/audit"
 	"student_go/internal/dto/request"
 	"student_go/internal/dto/response"
 	"student_go/internal/mocks"
 	"student_go/internal/teacher"
+	"student_go/pkg/patch"
+	"student_go/pkg/query"
 	"testing"
 
 	"github.com/gin-gonic/gin"
@@ -28,7 +31,7 @@ func TestCreateTeacherHandler(t *testing.T) {
 	r, mockService, handler := setupHandlerTest()
 	input := request.TeacherRequest{Name: "Alice"}
 	expected := &response.TeacherResponse{ID: 1, Name: "Alice"}
-	mockService.On("CreateTeacher", input).Return(expected, nil)
+	mockService.On("CreateTeacher", audit.Meta{}, input).Return(expected, nil)
 
 	r.POST("/teachers", handler.CreateTeacher)
 	body, _ := json.Marshal(input)
@@ -43,13 +46,12 @@ func TestCreateTeacherHandler(t *testing.T) {
 
 func TestUpdateTeacherHandler(t *testing.T) {
 	r, mockService, handler := setupHandlerTest()
-	input := request.TeacherRequest{Name: "Updated"}
-	expected := &response.TeacherResponse{ID: 1, Name: "Updated"}
-	mockService.On("UpdateTeacher", uint(1), input).Return(expected, nil)
+	expected := &response.TeacherResponse{ID: 1, Name: "Marie"}
+	mockService.On("UpdateTeacher", uint(1), uint(0), audit.Meta{}, request.TeacherPatch{}, patch.Fields{"departmentId": true}).
+		Return(expected, nil)
 
 	r.PATCH("/teachers/:id", handler.UpdateTeacher)
-	body, _ := json.Marshal(input)
-	req := httptest.NewRequest(http.MethodPatch, "/teachers/1", bytes.NewBuffer(body))
+	req := httptest.NewRequest(http.MethodPatch, "/teachers/1", bytes.NewBufferString(`{"departmentId":null}`))
 	req.Header.Set("Content-Type", "application/json")
 	resp := httptest.NewRecorder()
 	r.ServeHTTP(resp, req)
@@ -75,8 +77,8 @@ func TestFindTeacherByIdHandler(t *testing.T) {
 func TestFindAllTeachersHandler(t *testing.T) {
 	r, mockService, handler := setupHandlerTest()
 	teachers := []*response.TeacherResponse{{ID: 1, Name: "X"}}
-	mockService.On("Count").Return(1, nil)
-	mockService.On("FindAllTeachers", 1, 10).Return(teachers, nil)
+	mockService.On("Count", query.Spec{}).Return(1, nil)
+	mockService.On("FindAllTeachers", 1, 10, query.Spec{}).Return(teachers, nil)
 
 	r.GET("/teachers", handler.FindAllTeachers)
 	req := httptest.NewRequest(http.MethodGet, "/teachers?page=1&per_page=10", nil)
@@ -89,7 +91,7 @@ func TestFindAllTeachersHandler(t *testing.T) {
 
 func TestDeleteTeacherHandler(t *testing.T) {
 	r, mockService, handler := setupHandlerTest()
-	mockService.On("DeleteTeacherById", uint(3)).Return(nil)
+	mockService.On("DeleteTeacherById", uint(3), uint(0), audit.Meta{}).Return(nil)
 
 	r.DELETE("/teachers/:id", handler.DeleteTeacherById)
 	req := httptest.NewRequest(http.MethodDelete, "/teachers/3", nil)
@@ -99,3 +101,16 @@ func TestDeleteTeacherHandler(t *testing.T) {
 	assert.Equal(t, http.StatusNoContent, resp.Code)
 	mockService.AssertExpectations(t)
 }
+
+func TestRestoreTeacherHandler(t *testing.T) {
+	r, mockService, handler := setupHandlerTest()
+	mockService.On("RestoreTeacher", uint(3), audit.Meta{}).Return(&response.TeacherResponse{ID: 3, Name: "Jane"}, nil)
+
+	r.POST("/teachers/:id/restore", handler.RestoreTeacher)
+	req := httptest.NewRequest(http.MethodPost, "/teachers/3/restore", nil)
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	mockService.AssertExpectations(t)
+}
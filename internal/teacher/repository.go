@@ -1,18 +1,50 @@
 package teacher
 
 import (
+	"gorm.io/gorm"
+	"student_go/internal/audit"
 	"student_go/internal/entity"
 	"student_go/pkg/dbcontext"
+	"student_go/pkg/etag"
+	"student_go/pkg/pagination"
+	"student_go/pkg/query"
 )
 
 type Repository interface {
 	ExistsById(id uint) (bool, error)
-	Save(teacher *entity.Teacher) (*entity.Teacher, error)
-	Update(teacher *entity.Teacher) (*entity.Teacher, error)
+	// Save, Update, DeleteById and Restore record the change in the audit
+	// log in the same transaction.
+	Save(teacher *entity.Teacher, meta audit.Meta) (*entity.Teacher, error)
+	// Update sets the given columns only and bumps the version. It fails
+	// with etag.ErrMismatch when version is not zero and the row has
+	// another one.
+	Update(id uint, version uint, changes map[string]interface{}, meta audit.Meta) (*entity.Teacher, error)
 	FindById(id uint) (*entity.Teacher, error)
-	FindAll(page, limit int) ([]entity.Teacher, error)
-	DeleteById(id uint) error
-	Count() (int, error)
+	// FindAll and Count only return the rows matching spec.
+	FindAll(page, limit int, spec query.Spec) ([]entity.Teacher, error)
+	// FindAllByCursor returns the rows selected by keyset.Apply; pass them
+	// through pagination.Slice to get the page.
+	FindAllByCursor(keyset pagination.Keyset, spec query.Spec) ([]entity.Teacher, error)
+	// DeleteById soft-deletes the teacher; Restore undoes it until the
+	// purge job removes the row. A version that is not zero must match the
+	// row's.
+	DeleteById(id uint, version uint, meta audit.Meta) error
+	Restore(id uint, meta audit.Meta) (bool, error)
+	Count(spec query.Spec) (int, error)
+}
+
+// listFields are the fields teachers can be filtered and sorted by.
+var listFields = query.Schema{
+	"id":            {Column: "id", Type: query.Int},
+	"name":          {Column: "name", Type: query.String},
+	"email":         {Column: "email", Type: query.String},
+	"department_id": {Column: "department_id", Type: query.Int, Nullable: true},
+}
+
+// listRelations are the relations teachers lists can include.
+var listRelations = query.Relations{
+	"courses":     "Courses",
+	"departments": "Departments",
 }
 
 type repository struct{}
@@ -33,14 +65,26 @@ func (r *repository) ExistsById(id uint) (bool, error) {
 	return exists, err
 }
 
-func (r *repository) Save(teacher *entity.Teacher) (*entity.Teacher, error) {
-	err := dbcontext.DB.Create(teacher).Error
+func (r *repository) Save(teacher *entity.Teacher, meta audit.Meta) (*entity.Teacher, error) {
+	err := audit.Create(dbcontext.DB, meta, teacher)
 	return teacher, err
 }
 
-func (r *repository) Update(teacher *entity.Teacher) (*entity.Teacher, error) {
-	err := dbcontext.DB.Save(&teacher).Error
-
+func (r *repository) Update(id uint, version uint, changes map[string]interface{}, meta audit.Meta) (*entity.Teacher, error) {
+	err := audit.Track(dbcontext.DB, meta, &entity.Teacher{}, id, entity.AuditUpdate, func(tx *gorm.DB) error {
+		current, err := etag.Check(tx, &entity.Teacher{}, id, version)
+		if err != nil {
+			return err
+		}
+
+		columns := make(map[string]interface{}, len(changes)+1)
+		for column, value := range changes {
+			columns[column] = value
+		}
+		columns["version"] = current + 1
+
+		return tx.Model(&entity.Teacher{}).Where("id = ?", id).Updates(columns).Error
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -50,7 +94,7 @@ func (r *repository) Update(teacher *entity.Teacher) (*entity.Teacher, error) {
 	err = dbcontext.DB.
 		Preload("Courses").
 		Preload("Departments").
-		First(&updatedTeacher, teacher.ID).Error
+		First(&updatedTeacher, id).Error
 
 	return updatedTeacher, err
 }
@@ -69,14 +113,13 @@ func (r *repository) FindById(id uint) (*entity.Teacher, error) {
 	return &teacher, nil
 }
 
-func (r *repository) FindAll(page, limit int) ([]entity.Teacher, error) {
+func (r *repository) FindAll(page, limit int, spec query.Spec) ([]entity.Teacher, error) {
 	var teachers []entity.Teacher
 
 	offset := (page - 1) * limit
 
-	result := dbcontext.DB.
-		Preload("Courses").
-		Preload("Departments").
+	db := spec.Apply(dbcontext.DB)
+	result := spec.Preload(db, listRelations).
 		Offset(offset).
 		Find(&teachers)
 
@@ -87,14 +130,49 @@ func (r *repository) FindAll(page, limit int) ([]entity.Teacher, error) {
 	return teachers, nil
 }
 
-func (r *repository) DeleteById(id uint) error {
-	result := dbcontext.DB.Delete(&entity.Teacher{}, id)
+func (r *repository) FindAllByCursor(keyset pagination.Keyset, spec query.Spec) ([]entity.Teacher, error) {
+	var teachers []entity.Teacher
+
+	db := keyset.Apply(spec.Where(dbcontext.DB))
+	result := spec.Preload(db, listRelations).
+		Find(&teachers)
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return teachers, nil
+}
+
+func (r *repository) DeleteById(id uint, version uint, meta audit.Meta) error {
+	return audit.Track(dbcontext.DB, meta, &entity.Teacher{}, id, entity.AuditDelete, func(tx *gorm.DB) error {
+		if version != 0 {
+			if _, err := etag.Check(tx, &entity.Teacher{}, id, version); err != nil {
+				return err
+			}
+		}
+		return tx.Delete(&entity.Teacher{}, id).Error
+	})
+}
 
-	return result.Error
+// Restore reports false when no deleted teacher has the ID.
+func (r *repository) Restore(id uint, meta audit.Meta) (bool, error) {
+	restored := false
+	err := audit.Track(dbcontext.DB, meta, &entity.Teacher{}, id, entity.AuditRestore, func(tx *gorm.DB) error {
+		result := tx.
+			Unscoped().
+			Model(&entity.Teacher{}).
+			Where("id = ? AND deleted_at IS NOT NULL", id).
+			Update("deleted_at", nil)
+		restored = result.RowsAffected > 0
+		return result.Error
+	})
+
+	return restored, err
 }
 
-func (r *repository) Count() (int, error) {
+func (r *repository) Count(spec query.Spec) (int, error) {
 	var count int64
-	err := dbcontext.DB.Model(&entity.Teacher{}).Count(&count).Error
+	err := spec.Where(dbcontext.DB.Model(&entity.Teacher{})).Count(&count).Error
 	return int(count), err
 }
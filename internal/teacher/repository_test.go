@@ -5,14 +5,17 @@ import (
 	"github.com/stretchr/testify/require"
 	"regexp"
 	"testing"
+	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/stretchr/testify/assert"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
 	"gorm.io/gorm/logger"
+	"student_go/internal/audit"
 	"student_go/internal/entity"
 	"student_go/pkg/dbcontext"
+	"student_go/pkg/query"
 )
 
 func setupTestDB(t *testing.T) (*sql.DB, sqlmock.Sqlmock, *gorm.DB) {
@@ -53,14 +56,17 @@ func TestSave(t *testing.T) {
 	defer db.Close()
 
 	mock.ExpectBegin()
-	mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "teachers" ("name") VALUES ($1) RETURNING "id"`)).
-		WithArgs("John").
+	mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "teachers" ("name","email","locale","department_id","version","deleted_at") VALUES ($1,$2,$3,$4,$5,$6) RETURNING "id"`)).
+		WithArgs("John", "john@example.com", "", nil, 1, nil).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "audit_entries"`)).
+		WithArgs("teacher", 1, "create", nil, "", "", nil, `{"deleted_at":null,"department_id":null,"email":"john@example.com","id":1,"locale":"","name":"John","version":1}`, sqlmock.AnyArg()).
 		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
 	mock.ExpectCommit()
 
 	repo := NewTeacherRepository()
-	tch := &entity.Teacher{Name: "John"}
-	result, err := repo.Save(tch)
+	tch := &entity.Teacher{Name: "John", Email: "john@example.com"}
+	result, err := repo.Save(tch, audit.Meta{})
 
 	assert.NoError(t, err)
 	assert.Equal(t, "John", result.Name)
@@ -70,7 +76,7 @@ func TestFindById(t *testing.T) {
 	db, mock, _ := setupTestDB(t)
 	defer db.Close()
 
-	mock.ExpectQuery(`SELECT \* FROM "teachers" WHERE "teachers"\."id" = \$1 ORDER BY "teachers"\."id" LIMIT .*`).
+	mock.ExpectQuery(`SELECT \* FROM "teachers" WHERE "teachers"\."id" = \$1 AND "teachers"\."deleted_at" IS NULL ORDER BY "teachers"\."id" LIMIT .*`).
 		WithArgs(1, 1).
 		WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(1, "Alice"))
 
@@ -97,12 +103,24 @@ func TestUpdate(t *testing.T) {
 	defer db.Close()
 
 	mock.ExpectBegin()
-	mock.ExpectExec(regexp.QuoteMeta(`UPDATE "teachers"`)).
-		WithArgs("UpdatedName", 1).
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "teachers" WHERE "teachers"."id" = $1 LIMIT $2 FOR UPDATE`)).
+		WithArgs(1, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "version", "deleted_at"}).AddRow(1, "John", 1, nil))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT "version" FROM "teachers" WHERE id = $1 AND "teachers"."deleted_at" IS NULL`)).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"version"}).AddRow(1))
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE "teachers" SET "name"=$1,"version"=$2 WHERE id = $3 AND "teachers"."deleted_at" IS NULL`)).
+		WithArgs("UpdatedName", 2, 1).
 		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "teachers" WHERE "teachers"."id" = $1 LIMIT $2`)).
+		WithArgs(1, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "version", "deleted_at"}).AddRow(1, "UpdatedName", 2, nil))
+	mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "audit_entries"`)).
+		WithArgs("teacher", 1, "update", nil, "", "", `{"name":"John","version":1}`, `{"name":"UpdatedName","version":2}`, sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
 	mock.ExpectCommit()
 
-	mock.ExpectQuery(`SELECT \* FROM "teachers" WHERE "teachers"\."id" = \$1 ORDER BY "teachers"\."id" LIMIT .*`).
+	mock.ExpectQuery(`SELECT \* FROM "teachers" WHERE "teachers"\."id" = \$1 AND "teachers"\."deleted_at" IS NULL ORDER BY "teachers"\."id" LIMIT .*`).
 		WithArgs(1, 1).
 		WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).
 			AddRow(1, "UpdatedName"))
@@ -118,8 +136,7 @@ func TestUpdate(t *testing.T) {
 			AddRow(1, "Physics", 1))
 
 	repo := NewTeacherRepository()
-	tch := &entity.Teacher{ID: 1, Name: "UpdatedName"}
-	updated, err := repo.Update(tch)
+	updated, err := repo.Update(1, 0, map[string]interface{}{"name": "UpdatedName"}, audit.Meta{})
 
 	require.NoError(t, err)
 	require.NotNil(t, updated)
@@ -134,7 +151,7 @@ func TestFindAll(t *testing.T) {
 	limit := 2
 	offset := (page - 1) * limit
 
-	mock.ExpectQuery(`SELECT \* FROM "teachers" OFFSET \$\d+`).
+	mock.ExpectQuery(`SELECT \* FROM "teachers" WHERE "teachers"\."deleted_at" IS NULL ORDER BY "teachers"\."id" OFFSET \$\d+`).
 		WithArgs(offset).
 		WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).
 			AddRow(1, "Alice").
@@ -153,7 +170,7 @@ func TestFindAll(t *testing.T) {
 			AddRow(2, "CSEDept", 2))
 
 	repo := NewTeacherRepository()
-	teachers, err := repo.FindAll(page, limit)
+	teachers, err := repo.FindAll(page, limit, query.Spec{Include: []string{"courses", "departments"}})
 
 	require.NoError(t, err)
 	require.Len(t, teachers, 2)
@@ -165,17 +182,52 @@ func TestDeleteById(t *testing.T) {
 	db, mock, _ := setupTestDB(t)
 	defer db.Close()
 
+	deletedAt := time.Date(2024, 5, 1, 9, 0, 0, 0, time.UTC)
+
 	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "teachers" WHERE "teachers"."id" = $1 LIMIT $2 FOR UPDATE`)).
+		WithArgs(1, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "deleted_at"}).AddRow(1, "John", nil))
 	mock.ExpectExec(regexp.QuoteMeta(
-		`DELETE FROM "teachers" WHERE "teachers"."id" = $1`)).
-		WithArgs(1).
+		`UPDATE "teachers" SET "deleted_at"=$1 WHERE "teachers"."id" = $2 AND "teachers"."deleted_at" IS NULL`)).
+		WithArgs(sqlmock.AnyArg(), 1).
 		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "teachers" WHERE "teachers"."id" = $1 LIMIT $2`)).
+		WithArgs(1, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "deleted_at"}).AddRow(1, "John", deletedAt))
+	mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "audit_entries"`)).
+		WithArgs("teacher", 1, "delete", nil, "", "", `{"deleted_at":null}`, `{"deleted_at":"2024-05-01T09:00:00Z"}`, sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectCommit()
+
+	repo := NewTeacherRepository()
+	err := repo.DeleteById(1, 0, audit.Meta{})
+
+	assert.NoError(t, err)
+}
+
+func TestRestore(t *testing.T) {
+	db, mock, _ := setupTestDB(t)
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "teachers" WHERE "teachers"."id" = $1 LIMIT $2 FOR UPDATE`)).
+		WithArgs(1, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "deleted_at"}).AddRow(1, "John", nil))
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE "teachers" SET "deleted_at"=$1 WHERE id = $2 AND deleted_at IS NOT NULL`)).
+		WithArgs(nil, 1).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "teachers" WHERE "teachers"."id" = $1 LIMIT $2`)).
+		WithArgs(1, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "deleted_at"}).AddRow(1, "John", nil))
+	// The row was not deleted, so there is nothing to record.
 	mock.ExpectCommit()
 
 	repo := NewTeacherRepository()
-	err := repo.DeleteById(1)
+	restored, err := repo.Restore(1, audit.Meta{})
 
 	assert.NoError(t, err)
+	assert.False(t, restored)
 }
 
 func TestCount(t *testing.T) {
@@ -187,7 +239,7 @@ func TestCount(t *testing.T) {
 		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(3))
 
 	repo := NewTeacherRepository()
-	count, err := repo.Count()
+	count, err := repo.Count(query.Spec{})
 
 	assert.NoError(t, err)
 	assert.Equal(t, 3, count)
@@ -2,19 +2,30 @@ package teacher
 
 import (
 	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"student_go/internal/audit"
 	"student_go/internal/dto/request"
 	"student_go/internal/dto/response"
 	"student_go/internal/entity"
+	"student_go/pkg/etag"
 	"student_go/pkg/log"
+	"student_go/pkg/pagination"
+	"student_go/pkg/patch"
+	"student_go/pkg/query"
 )
 
 type Service interface {
-	CreateTeacher(input request.TeacherRequest) (*response.TeacherResponse, error)
-	UpdateTeacher(id uint, input request.TeacherRequest) (*response.TeacherResponse, error)
+	CreateTeacher(meta audit.Meta, input request.TeacherRequest) (*response.TeacherResponse, error)
+	// UpdateTeacher and DeleteTeacherById fail with etag.ErrMismatch when
+	// version is not zero and the teacher has another one.
+	// UpdateTeacher changes the members the patch sets only.
+	UpdateTeacher(id uint, version uint, meta audit.Meta, input request.TeacherPatch, fields patch.Fields) (*response.TeacherResponse, error)
 	FindTeacherById(id uint) (*response.TeacherResponse, error)
-	FindAllTeachers(page, limit int) ([]*response.TeacherResponse, error)
-	DeleteTeacherById(id uint) error
-	Count() (int, error)
+	FindAllTeachers(page, limit int, spec query.Spec) ([]*response.TeacherResponse, error)
+	FindAllTeachersByCursor(keyset pagination.Keyset, spec query.Spec) (*pagination.CursorPage, error)
+	DeleteTeacherById(id uint, version uint, meta audit.Meta) error
+	RestoreTeacher(id uint, meta audit.Meta) (*response.TeacherResponse, error)
+	Count(spec query.Spec) (int, error)
 }
 
 type service struct {
@@ -25,32 +36,60 @@ func NewTeacherService(repo Repository) Service {
 	return &service{repo: repo}
 }
 
-func (s *service) CreateTeacher(input request.TeacherRequest) (*response.TeacherResponse, error) {
+func (s *service) CreateTeacher(meta audit.Meta, input request.TeacherRequest) (*response.TeacherResponse, error) {
 	log.Log.Info("CreateTeacher (service) called", zap.String("name", input.Name))
 
 	teacher := entity.Teacher{
-		Name: input.Name,
+		Name:         input.Name,
+		Email:        input.Email,
+		Locale:       input.Locale,
+		DepartmentID: input.DepartmentID,
 	}
-	savedTeacher, err := s.repo.Save(&teacher)
+	savedTeacher, err := s.repo.Save(&teacher, meta)
 	if err != nil {
 		return nil, err
 	}
 
 	resp := &response.TeacherResponse{
-		ID:   savedTeacher.ID,
-		Name: savedTeacher.Name,
+		ID:           savedTeacher.ID,
+		Name:         savedTeacher.Name,
+		Email:        savedTeacher.Email,
+		Locale:       savedTeacher.Locale,
+		DepartmentID: savedTeacher.DepartmentID,
+		Version:      savedTeacher.Version,
 	}
 	return resp, nil
 }
 
-func (s *service) UpdateTeacher(id uint, input request.TeacherRequest) (*response.TeacherResponse, error) {
-	log.Log.Info("UpdateTeacher (service) called", zap.Uint("id", id), zap.String("name", input.Name))
+func (s *service) UpdateTeacher(id uint, version uint, meta audit.Meta, input request.TeacherPatch, fields patch.Fields) (*response.TeacherResponse, error) {
+	log.Log.Info("UpdateTeacher (service) called", zap.Uint("id", id), zap.Strings("fields", fields.Names()))
 
-	teacher := entity.Teacher{
-		ID:   id,
-		Name: input.Name,
+	// An empty patch changes nothing, so the version is left alone.
+	if len(fields) == 0 {
+		current, err := s.FindTeacherById(id)
+		if err != nil {
+			return nil, err
+		}
+		if version != 0 && current.Version != version {
+			return nil, etag.ErrMismatch
+		}
+		return current, nil
+	}
+
+	changes := make(map[string]interface{})
+	if fields.Has("name") {
+		changes["name"] = patch.Value(input.Name)
+	}
+	if fields.Has("email") {
+		changes["email"] = patch.Value(input.Email)
+	}
+	if fields.Has("locale") {
+		changes["locale"] = patch.Value(input.Locale)
 	}
-	updatedTeacher, err := s.repo.Update(&teacher)
+	if fields.Has("departmentId") {
+		changes["department_id"] = input.DepartmentID
+	}
+	updatedTeacher, err := s.repo.Update(id, version, changes, meta)
 	if err != nil {
 		return nil, err
 	}
@@ -74,10 +113,14 @@ func (s *service) UpdateTeacher(id uint, input request.TeacherRequest) (*respons
 	}
 
 	teacherResp := &response.TeacherResponse{
-		ID:          teacher.ID,
-		Name:        teacher.Name,
-		Courses:     coursesResp,
-		Departments: departmentsResp,
+		ID:           updatedTeacher.ID,
+		Name:         updatedTeacher.Name,
+		Email:        updatedTeacher.Email,
+		Locale:       updatedTeacher.Locale,
+		DepartmentID: updatedTeacher.DepartmentID,
+		Courses:      coursesResp,
+		Departments:  departmentsResp,
+		Version:      updatedTeacher.Version,
 	}
 	return teacherResp, nil
 }
@@ -109,59 +152,110 @@ func (s *service) FindTeacherById(id uint) (*response.TeacherResponse, error) {
 	}
 
 	teacherResp := &response.TeacherResponse{
-		ID:          teacher.ID,
-		Name:        teacher.Name,
-		Courses:     coursesResp,
-		Departments: departmentsResp,
+		ID:           teacher.ID,
+		Name:         teacher.Name,
+		Email:        teacher.Email,
+		Locale:       teacher.Locale,
+		DepartmentID: teacher.DepartmentID,
+		Courses:      coursesResp,
+		Departments:  departmentsResp,
+		Version:      teacher.Version,
 	}
 	return teacherResp, nil
 }
 
-func (s *service) FindAllTeachers(page, limit int) ([]*response.TeacherResponse, error) {
+func (s *service) FindAllTeachers(page, limit int, spec query.Spec) ([]*response.TeacherResponse, error) {
 	log.Log.Info("FindAllTeachers (service) called", zap.Int("page", page), zap.Int("limit", limit))
 
-	teachers, err := s.repo.FindAll(page, limit)
+	teachers, err := s.repo.FindAll(page, limit, spec)
 	if err != nil {
 		return nil, err
 	}
 
 	var teacherResponses []*response.TeacherResponse
-	for _, teacher := range teachers {
-		var coursesResp []response.CourseResponse
-		for _, course := range teacher.Courses {
-			courseResp := response.CourseResponse{
-				ID:    course.ID,
-				Title: course.Title,
-			}
-			coursesResp = append(coursesResp, courseResp)
-		}
+	for i := range teachers {
+		teacherResponses = append(teacherResponses, toTeacherResponse(&teachers[i]))
+	}
 
-		var departmentsResp []response.DepartmentResponse
-		for _, department := range teacher.Departments {
-			departmentResp := response.DepartmentResponse{
-				ID:   department.ID,
-				Name: department.Name,
-			}
-			departmentsResp = append(departmentsResp, departmentResp)
-		}
+	return teacherResponses, nil
+}
 
-		teacherResp := &response.TeacherResponse{
-			ID:          teacher.ID,
-			Name:        teacher.Name,
-			Courses:     coursesResp,
-			Departments: departmentsResp,
-		}
-		teacherResponses = append(teacherResponses, teacherResp)
+func (s *service) FindAllTeachersByCursor(keyset pagination.Keyset, spec query.Spec) (*pagination.CursorPage, error) {
+	log.Log.Info("FindAllTeachersByCursor (service) called", zap.Int("limit", keyset.Limit))
+
+	teachers, err := s.repo.FindAllByCursor(keyset, spec)
+	if err != nil {
+		return nil, err
 	}
 
-	return teacherResponses, nil
+	teachers, page := pagination.Slice(keyset, teachers, func(t entity.Teacher) uint { return t.ID })
+
+	items := make([]*response.TeacherResponse, 0, len(teachers))
+	for i := range teachers {
+		items = append(items, toTeacherResponse(&teachers[i]))
+	}
+	page.Items = items
+
+	return page, nil
 }
 
-func (s *service) DeleteTeacherById(id uint) error {
-	log.Log.Info("DeleteTeacherById (service) called", zap.Uint("id", id))
-	return s.repo.DeleteById(id)
+func (s *service) DeleteTeacherById(id uint, version uint, meta audit.Meta) error {
+	log.Log.Info("DeleteTeacherById (service) called", zap.Uint("id", id), zap.Uint("version", version))
+	return s.repo.DeleteById(id, version, meta)
 }
 
-func (s *service) Count() (int, error) {
-	return s.repo.Count()
+// RestoreTeacher undoes a soft delete. Courses and departments the teacher
+// had are theirs again unless they were reassigned in the meantime.
+func (s *service) RestoreTeacher(id uint, meta audit.Meta) (*response.TeacherResponse, error) {
+	log.Log.Info("RestoreTeacher (service) called", zap.Uint("id", id))
+
+	restored, err := s.repo.Restore(id, meta)
+	if err != nil {
+		return nil, err
+	}
+	if !restored {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	return s.FindTeacherById(id)
+}
+
+func (s *service) Count(spec query.Spec) (int, error) {
+	return s.repo.Count(spec)
+}
+
+func toTeacherResponse(teacher *entity.Teacher) *response.TeacherResponse {
+	var coursesResp []response.CourseResponse
+	for _, course := range teacher.Courses {
+		courseResp := response.CourseResponse{
+			ID:    course.ID,
+			Title: course.Title,
+		}
+		coursesResp = append(coursesResp, courseResp)
+	}
+
+	var departmentsResp []response.DepartmentResponse
+	for _, department := range teacher.Departments {
+		departmentResp := response.DepartmentResponse{
+			ID:   department.ID,
+			Name: department.Name,
+		}
+		departmentsResp = append(departmentsResp, departmentResp)
+	}
+
+	teacherResp := &response.TeacherResponse{
+		ID:           teacher.ID,
+		Name:         teacher.Name,
+		Email:        teacher.Email,
+		Locale:       teacher.Locale,
+		DepartmentID: teacher.DepartmentID,
+		Courses:      coursesResp,
+		Departments:  departmentsResp,
+		Version:      teacher.Version,
+	}
+	if teacher.DeletedAt.Valid {
+		teacherResp.DeletedAt = &teacher.DeletedAt.Time
+	}
+
+	return teacherResp
 }
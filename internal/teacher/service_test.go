@@ -5,10 +5,13 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"go.uber.org/zap"
+	"student_go/internal/audit"
 	"student_go/internal/dto/request"
 	"student_go/internal/entity"
 	"student_go/internal/mocks"
 	"student_go/pkg/log"
+	"student_go/pkg/patch"
+	"student_go/pkg/query"
 	"testing"
 )
 
@@ -29,9 +32,9 @@ func TestCreateTeacher(t *testing.T) {
 	input := request.TeacherRequest{Name: "Newton"}
 	saved := &entity.Teacher{ID: 1, Name: "Newton"}
 
-	mockRepo.On("Save", mock.AnythingOfType("*entity.Teacher")).Return(saved, nil)
+	mockRepo.On("Save", mock.AnythingOfType("*entity.Teacher"), audit.Meta{}).Return(saved, nil)
 
-	result, err := svc.CreateTeacher(input)
+	result, err := svc.CreateTeacher(audit.Meta{}, input)
 
 	assert.NoError(t, err)
 	assert.Equal(t, uint(1), result.ID)
@@ -43,9 +46,9 @@ func TestCreateTeacher(t *testing.T) {
 func TestCreateTeacher_Error(t *testing.T) {
 	svc, mockRepo := newTestTeacherService()
 
-	mockRepo.On("Save", mock.Anything).Return(nil, errors.New("db error"))
+	mockRepo.On("Save", mock.Anything, audit.Meta{}).Return(nil, errors.New("db error"))
 
-	result, err := svc.CreateTeacher(request.TeacherRequest{Name: "Fail"})
+	result, err := svc.CreateTeacher(audit.Meta{}, request.TeacherRequest{Name: "Fail"})
 
 	assert.Nil(t, result)
 	assert.EqualError(t, err, "db error")
@@ -56,7 +59,8 @@ func TestCreateTeacher_Error(t *testing.T) {
 func TestUpdateTeacher(t *testing.T) {
 	svc, mockRepo := newTestTeacherService()
 
-	input := request.TeacherRequest{Name: "Updated"}
+	name := "Updated"
+	input := request.TeacherPatch{Name: &name}
 	updated := &entity.Teacher{
 		ID:   2,
 		Name: "Updated",
@@ -68,9 +72,10 @@ func TestUpdateTeacher(t *testing.T) {
 		},
 	}
 
-	mockRepo.On("Update", mock.Anything).Return(updated, nil)
+	changes := map[string]interface{}{"name": "Updated", "department_id": (*uint)(nil)}
+	mockRepo.On("Update", uint(2), uint(0), changes, audit.Meta{}).Return(updated, nil)
 
-	result, err := svc.UpdateTeacher(2, input)
+	result, err := svc.UpdateTeacher(2, 0, audit.Meta{}, input, patch.Fields{"name": true, "departmentId": true})
 
 	assert.NoError(t, err)
 	assert.Equal(t, "Updated", result.Name)
@@ -85,9 +90,10 @@ func TestUpdateTeacher(t *testing.T) {
 func TestUpdateTeacher_Error(t *testing.T) {
 	svc, mockRepo := newTestTeacherService()
 
-	mockRepo.On("Update", mock.Anything).Return(nil, errors.New("update error"))
+	mockRepo.On("Update", uint(1), uint(0), mock.Anything, audit.Meta{}).Return(nil, errors.New("update error"))
 
-	result, err := svc.UpdateTeacher(1, request.TeacherRequest{Name: "Fail"})
+	name := "Faraday"
+	result, err := svc.UpdateTeacher(1, 0, audit.Meta{}, request.TeacherPatch{Name: &name}, patch.Fields{"name": true})
 
 	assert.Nil(t, result)
 	assert.EqualError(t, err, "update error")
@@ -150,9 +156,9 @@ func TestFindAllTeachers(t *testing.T) {
 		},
 	}
 
-	mockRepo.On("FindAll", 1, 5).Return(teachers, nil)
+	mockRepo.On("FindAll", 1, 5, query.Spec{}).Return(teachers, nil)
 
-	result, err := svc.FindAllTeachers(1, 5)
+	result, err := svc.FindAllTeachers(1, 5, query.Spec{})
 
 	assert.NoError(t, err)
 	assert.Len(t, result, 1)
@@ -166,9 +172,9 @@ func TestFindAllTeachers(t *testing.T) {
 func TestFindAllTeachers_Error(t *testing.T) {
 	svc, mockRepo := newTestTeacherService()
 
-	mockRepo.On("FindAll", 1, 5).Return(nil, errors.New("fail"))
+	mockRepo.On("FindAll", 1, 5, query.Spec{}).Return(nil, errors.New("fail"))
 
-	result, err := svc.FindAllTeachers(1, 5)
+	result, err := svc.FindAllTeachers(1, 5, query.Spec{})
 
 	assert.Nil(t, result)
 	assert.EqualError(t, err, "fail")
@@ -179,9 +185,9 @@ func TestFindAllTeachers_Error(t *testing.T) {
 func TestDeleteTeacherById(t *testing.T) {
 	svc, mockRepo := newTestTeacherService()
 
-	mockRepo.On("DeleteById", uint(1)).Return(nil)
+	mockRepo.On("DeleteById", uint(1), uint(0), audit.Meta{}).Return(nil)
 
-	err := svc.DeleteTeacherById(1)
+	err := svc.DeleteTeacherById(1, 0, audit.Meta{})
 
 	assert.NoError(t, err)
 	mockRepo.AssertExpectations(t)
@@ -190,9 +196,9 @@ func TestDeleteTeacherById(t *testing.T) {
 func TestDeleteTeacherById_Error(t *testing.T) {
 	svc, mockRepo := newTestTeacherService()
 
-	mockRepo.On("DeleteById", uint(2)).Return(errors.New("delete error"))
+	mockRepo.On("DeleteById", uint(2), uint(0), audit.Meta{}).Return(errors.New("delete error"))
 
-	err := svc.DeleteTeacherById(2)
+	err := svc.DeleteTeacherById(2, 0, audit.Meta{})
 
 	assert.EqualError(t, err, "delete error")
 	mockRepo.AssertExpectations(t)
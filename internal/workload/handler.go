@@ -0,0 +1,96 @@
+package workload
+
+import (
+	"errors"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+	"net/http"
+	"strconv"
+	"student_go/internal/actor"
+	"student_go/internal/department"
+	"student_go/internal/teacher"
+	"student_go/pkg/log"
+)
+
+type Handler struct {
+	Service Service
+}
+
+func NewWorkloadHandler() *Handler {
+	return &Handler{
+		Service: NewWorkloadService(
+			NewWorkloadRepository(),
+			teacher.NewTeacherRepository(),
+			department.NewDepartmentRepository(),
+		),
+	}
+}
+
+func (h *Handler) FindTeacherWorkload(c *gin.Context) {
+	teacherId, ok := parseID(c, "id", "invalid teacher ID")
+	if !ok {
+		return
+	}
+
+	a, err := actor.FromContext(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+
+	log.Log.Info("FindTeacherWorkload called", zap.Uint("teacher_id", teacherId))
+
+	workload, err := h.Service.FindTeacherWorkload(teacherId, a)
+	if err != nil {
+		writeError(c, err, "failed to get workload")
+		return
+	}
+
+	c.JSON(http.StatusOK, workload)
+}
+
+func (h *Handler) FindDepartmentWorkload(c *gin.Context) {
+	departmentId, ok := parseID(c, "id", "invalid department ID")
+	if !ok {
+		return
+	}
+
+	a, err := actor.FromContext(c)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+
+	log.Log.Info("FindDepartmentWorkload called", zap.Uint("department_id", departmentId))
+
+	report, err := h.Service.FindDepartmentWorkload(departmentId, a)
+	if err != nil {
+		writeError(c, err, "failed to get department workload")
+		return
+	}
+
+	c.JSON(http.StatusOK, report)
+}
+
+func parseID(c *gin.Context, name string, message string) (uint, bool) {
+	param := c.Param(name)
+	parsed, err := strconv.ParseUint(param, 10, 32)
+	if err != nil {
+		log.Log.Warn("Invalid path parameter", zap.String(name, param), zap.Error(err))
+		c.JSON(http.StatusBadRequest, gin.H{"error": message})
+		return 0, false
+	}
+
+	return uint(parsed), true
+}
+
+func writeError(c *gin.Context, err error, fallback string) {
+	switch {
+	case errors.Is(err, ErrTeacherNotFound), errors.Is(err, ErrDepartmentNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	case errors.Is(err, ErrForbidden):
+		c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": fallback})
+	}
+}
@@ -0,0 +1,81 @@
+package workload
+
+import (
+	"errors"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"net/http"
+	"net/http/httptest"
+	"student_go/internal/actor"
+	"student_go/internal/dto/response"
+	"student_go/internal/mocks"
+	"testing"
+)
+
+func setupHandlerTest() (*gin.Engine, *mocks.WorkloadServiceMock, *Handler) {
+	gin.SetMode(gin.TestMode)
+	mockService := new(mocks.WorkloadServiceMock)
+	handler := &Handler{Service: mockService}
+	r := gin.Default()
+	return r, mockService, handler
+}
+
+func setActor(req *http.Request, id string, role string) {
+	req.Header.Set(actor.HeaderUserID, id)
+	req.Header.Set(actor.HeaderUserRole, role)
+}
+
+func TestFindTeacherWorkloadHandler(t *testing.T) {
+	r, mockService, handler := setupHandlerTest()
+	mockService.On("FindTeacherWorkload", uint(3), teacherActor).
+		Return(&response.WorkloadResponse{TeacherID: 3, Courses: 2}, nil)
+
+	r.GET("/teachers/:id/workload", handler.FindTeacherWorkload)
+	req := httptest.NewRequest(http.MethodGet, "/teachers/3/workload", nil)
+	setActor(req, "3", "teacher")
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Contains(t, resp.Body.String(), `"courses":2`)
+}
+
+func TestFindTeacherWorkloadHandler_Unauthorized(t *testing.T) {
+	r, mockService, handler := setupHandlerTest()
+
+	r.GET("/teachers/:id/workload", handler.FindTeacherWorkload)
+	req := httptest.NewRequest(http.MethodGet, "/teachers/3/workload", nil)
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusUnauthorized, resp.Code)
+	mockService.AssertNotCalled(t, "FindTeacherWorkload", mock.Anything, mock.Anything)
+}
+
+func TestFindDepartmentWorkloadHandler_Forbidden(t *testing.T) {
+	r, mockService, handler := setupHandlerTest()
+	mockService.On("FindDepartmentWorkload", uint(2), teacherActor).Return(nil, ErrForbidden)
+
+	r.GET("/departments/:id/workload", handler.FindDepartmentWorkload)
+	req := httptest.NewRequest(http.MethodGet, "/departments/2/workload", nil)
+	setActor(req, "3", "teacher")
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusForbidden, resp.Code)
+}
+
+func TestFindDepartmentWorkloadHandler_Error(t *testing.T) {
+	r, mockService, handler := setupHandlerTest()
+	mockService.On("FindDepartmentWorkload", uint(2), admin).Return(nil, errors.New("db error"))
+
+	r.GET("/departments/:id/workload", handler.FindDepartmentWorkload)
+	req := httptest.NewRequest(http.MethodGet, "/departments/2/workload", nil)
+	setActor(req, "1", "admin")
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.Contains(t, resp.Body.String(), "failed to get department workload")
+}
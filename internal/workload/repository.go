@@ -0,0 +1,76 @@
+package workload
+
+import (
+	"student_go/internal/entity"
+	"student_go/pkg/dbcontext"
+)
+
+type Repository interface {
+	// CountCourses and CountEnrollments return the load per teacher ID;
+	// teachers without courses are missing from the map.
+	CountCourses(teacherIds []uint) (map[uint]int, error)
+	CountEnrollments(teacherIds []uint) (map[uint]int, error)
+	FindDepartmentTeachers(departmentId uint) ([]entity.Teacher, error)
+}
+
+type teacherCount struct {
+	TeacherID uint
+	Count     int
+}
+
+type repository struct{}
+
+func NewWorkloadRepository() Repository {
+	return &repository{}
+}
+
+func (r *repository) CountCourses(teacherIds []uint) (map[uint]int, error) {
+	var rows []teacherCount
+	err := dbcontext.DB.
+		Model(&entity.Course{}).
+		Select("teacher_id, count(*) AS count").
+		Where("teacher_id IN ?", teacherIds).
+		Group("teacher_id").
+		Scan(&rows).
+		Error
+
+	return toMap(rows), err
+}
+
+func (r *repository) CountEnrollments(teacherIds []uint) (map[uint]int, error) {
+	var rows []teacherCount
+	err := dbcontext.DB.
+		Table("course_student").
+		Select("courses.teacher_id, count(*) AS count").
+		Joins("JOIN courses ON courses.id = course_student.course_id").
+		Joins("JOIN students ON students.id = course_student.student_id").
+		Where("courses.teacher_id IN ? AND courses.deleted_at IS NULL AND students.deleted_at IS NULL", teacherIds).
+		Group("courses.teacher_id").
+		Scan(&rows).
+		Error
+
+	return toMap(rows), err
+}
+
+func (r *repository) FindDepartmentTeachers(departmentId uint) ([]entity.Teacher, error) {
+	var teachers []entity.Teacher
+	result := dbcontext.DB.
+		Where("department_id = ?", departmentId).
+		Order("name").
+		Find(&teachers)
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return teachers, nil
+}
+
+func toMap(rows []teacherCount) map[uint]int {
+	counts := make(map[uint]int, len(rows))
+	for _, row := range rows {
+		counts[row.TeacherID] = row.Count
+	}
+
+	return counts
+}
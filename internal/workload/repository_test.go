@@ -0,0 +1,82 @@
+package workload
+
+import (
+	"database/sql"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"regexp"
+	"testing"
+
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+
+	"student_go/pkg/dbcontext"
+)
+
+func setupTestDB(t *testing.T) (*sql.DB, sqlmock.Sqlmock, *gorm.DB) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+
+	dialector := postgres.New(postgres.Config{
+		Conn:                 db,
+		PreferSimpleProtocol: true,
+	})
+
+	gormDB, err := gorm.Open(dialector, &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	assert.NoError(t, err)
+
+	dbcontext.DB = gormDB
+	return db, mock, gormDB
+}
+
+func TestWorkloadCountCourses(t *testing.T) {
+	db, mock, _ := setupTestDB(t)
+	defer db.Close()
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT teacher_id, count(*) AS count FROM "courses" WHERE teacher_id IN ($1,$2) AND "courses"."deleted_at" IS NULL GROUP BY "teacher_id"`)).
+		WithArgs(3, 4).
+		WillReturnRows(sqlmock.NewRows([]string{"teacher_id", "count"}).AddRow(3, 2))
+
+	repo := NewWorkloadRepository()
+	counts, err := repo.CountCourses([]uint{3, 4})
+
+	require.NoError(t, err)
+	assert.Equal(t, map[uint]int{3: 2}, counts)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestWorkloadCountEnrollments(t *testing.T) {
+	db, mock, _ := setupTestDB(t)
+	defer db.Close()
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT courses.teacher_id, count(*) AS count FROM "course_student" JOIN courses ON courses.id = course_student.course_id JOIN students ON students.id = course_student.student_id WHERE courses.teacher_id IN ($1) AND courses.deleted_at IS NULL AND students.deleted_at IS NULL GROUP BY "courses"."teacher_id"`)).
+		WithArgs(3).
+		WillReturnRows(sqlmock.NewRows([]string{"teacher_id", "count"}).AddRow(3, 41))
+
+	repo := NewWorkloadRepository()
+	counts, err := repo.CountEnrollments([]uint{3})
+
+	require.NoError(t, err)
+	assert.Equal(t, 41, counts[3])
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestWorkloadFindDepartmentTeachers(t *testing.T) {
+	db, mock, _ := setupTestDB(t)
+	defer db.Close()
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "teachers" WHERE department_id = $1 AND "teachers"."deleted_at" IS NULL ORDER BY name`)).
+		WithArgs(2).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(3, "Anna").AddRow(4, "Boris"))
+
+	repo := NewWorkloadRepository()
+	teachers, err := repo.FindDepartmentTeachers(2)
+
+	require.NoError(t, err)
+	assert.Len(t, teachers, 2)
+	assert.Equal(t, "Boris", teachers[1].Name)
+}
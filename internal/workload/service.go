@@ -0,0 +1,199 @@
+package workload
+
+import (
+	"errors"
+	"fmt"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"student_go/internal/actor"
+	"student_go/internal/config"
+	"student_go/internal/department"
+	"student_go/internal/dto/response"
+	"student_go/internal/entity"
+	"student_go/internal/teacher"
+	"student_go/pkg/log"
+)
+
+var (
+	ErrTeacherNotFound    = errors.New("teacher not found")
+	ErrDepartmentNotFound = errors.New("department not found")
+	ErrForbidden          = errors.New("forbidden")
+	ErrLimitExceeded      = errors.New("teacher workload limit exceeded")
+)
+
+type Service interface {
+	FindTeacherWorkload(teacherId uint, a actor.Actor) (*response.WorkloadResponse, error)
+	FindDepartmentWorkload(departmentId uint, a actor.Actor) (*response.DepartmentWorkloadResponse, error)
+	// CheckAssignment returns ErrLimitExceeded if one more course with the
+	// given number of students would take the teacher over a limit.
+	CheckAssignment(teacherId uint, courseStudents int) error
+}
+
+type service struct {
+	workloadRepository   Repository
+	teacherRepository    teacher.Repository
+	departmentRepository department.Repository
+	maxCourses           int
+	maxStudents          int
+}
+
+func NewWorkloadService(
+	workloadRepository Repository,
+	teacherRepository teacher.Repository,
+	departmentRepository department.Repository,
+) Service {
+	s := &service{
+		workloadRepository:   workloadRepository,
+		teacherRepository:    teacherRepository,
+		departmentRepository: departmentRepository,
+	}
+	if config.Config != nil {
+		s.maxCourses = config.Config.Workload.MaxCourses
+		s.maxStudents = config.Config.Workload.MaxStudents
+	}
+
+	return s
+}
+
+// FindTeacherWorkload is visible to admins, the teacher and the head of the
+// teacher's department.
+func (s *service) FindTeacherWorkload(teacherId uint, a actor.Actor) (*response.WorkloadResponse, error) {
+	log.Log.Info("FindTeacherWorkload (service) called", zap.Uint("teacher_id", teacherId))
+
+	t, err := s.teacherRepository.FindById(teacherId)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrTeacherNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if !a.IsAdmin() && !a.IsTeacher(teacherId) {
+		isHead, err := s.isHeadOf(t.DepartmentID, a)
+		if err != nil {
+			return nil, err
+		}
+		if !isHead {
+			return nil, ErrForbidden
+		}
+	}
+
+	workloads, err := s.workloads([]entity.Teacher{*t})
+	if err != nil {
+		return nil, err
+	}
+
+	return &workloads[0], nil
+}
+
+// FindDepartmentWorkload reports the load of every teacher in the
+// department. It is visible to admins and the head of the department.
+func (s *service) FindDepartmentWorkload(departmentId uint, a actor.Actor) (*response.DepartmentWorkloadResponse, error) {
+	log.Log.Info("FindDepartmentWorkload (service) called", zap.Uint("department_id", departmentId))
+
+	dept, err := s.departmentRepository.FindById(departmentId)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrDepartmentNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	isHead := dept.HeadOfDepartmentID != nil && a.IsTeacher(*dept.HeadOfDepartmentID)
+	if !a.IsAdmin() && !isHead {
+		return nil, ErrForbidden
+	}
+
+	teachers, err := s.workloadRepository.FindDepartmentTeachers(departmentId)
+	if err != nil {
+		return nil, err
+	}
+
+	workloads, err := s.workloads(teachers)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &response.DepartmentWorkloadResponse{
+		DepartmentID: dept.ID,
+		Name:         dept.Name,
+		Teachers:     workloads,
+	}
+	for _, w := range workloads {
+		report.Courses += w.Courses
+		report.Students += w.Students
+	}
+
+	return report, nil
+}
+
+func (s *service) CheckAssignment(teacherId uint, courseStudents int) error {
+	ids := []uint{teacherId}
+
+	courses, err := s.workloadRepository.CountCourses(ids)
+	if err != nil {
+		return err
+	}
+	if s.maxCourses > 0 && courses[teacherId]+1 > s.maxCourses {
+		return fmt.Errorf("%w: %d courses, limit is %d", ErrLimitExceeded, courses[teacherId]+1, s.maxCourses)
+	}
+
+	students, err := s.workloadRepository.CountEnrollments(ids)
+	if err != nil {
+		return err
+	}
+	if s.maxStudents > 0 && students[teacherId]+courseStudents > s.maxStudents {
+		return fmt.Errorf("%w: %d students, limit is %d", ErrLimitExceeded, students[teacherId]+courseStudents, s.maxStudents)
+	}
+
+	return nil
+}
+
+func (s *service) isHeadOf(departmentId *uint, a actor.Actor) (bool, error) {
+	if departmentId == nil || a.Role != actor.RoleTeacher {
+		return false, nil
+	}
+
+	dept, err := s.departmentRepository.FindById(*departmentId)
+	if err != nil {
+		return false, err
+	}
+
+	return dept.HeadOfDepartmentID != nil && a.IsTeacher(*dept.HeadOfDepartmentID), nil
+}
+
+func (s *service) workloads(teachers []entity.Teacher) ([]response.WorkloadResponse, error) {
+	ids := make([]uint, 0, len(teachers))
+	for _, t := range teachers {
+		ids = append(ids, t.ID)
+	}
+	if len(ids) == 0 {
+		return []response.WorkloadResponse{}, nil
+	}
+
+	courses, err := s.workloadRepository.CountCourses(ids)
+	if err != nil {
+		return nil, err
+	}
+	students, err := s.workloadRepository.CountEnrollments(ids)
+	if err != nil {
+		return nil, err
+	}
+
+	workloads := make([]response.WorkloadResponse, 0, len(teachers))
+	for _, t := range teachers {
+		w := response.WorkloadResponse{
+			TeacherID:   t.ID,
+			Name:        t.Name,
+			Courses:     courses[t.ID],
+			Students:    students[t.ID],
+			MaxCourses:  s.maxCourses,
+			MaxStudents: s.maxStudents,
+		}
+		w.Overloaded = (s.maxCourses > 0 && w.Courses > s.maxCourses) ||
+			(s.maxStudents > 0 && w.Students > s.maxStudents)
+		workloads = append(workloads, w)
+	}
+
+	return workloads, nil
+}
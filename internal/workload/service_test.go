@@ -0,0 +1,161 @@
+package workload
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"student_go/internal/actor"
+	"student_go/internal/entity"
+	mocks2 "student_go/internal/mocks"
+	"student_go/pkg/log"
+	"testing"
+)
+
+func init() {
+	logger, _ := zap.NewDevelopment()
+	log.Log = logger
+}
+
+var (
+	admin        = actor.Actor{ID: 1, Role: actor.RoleAdmin}
+	headActor    = actor.Actor{ID: 5, Role: actor.RoleTeacher}
+	teacherActor = actor.Actor{ID: 3, Role: actor.RoleTeacher}
+	otherActor   = actor.Actor{ID: 4, Role: actor.RoleTeacher}
+)
+
+func newTestWorkloadService() (*service, *mocks2.WorkloadRepository, *mocks2.TeacherRepository, *mocks2.DepartmentRepository) {
+	mockWorkloadRepo := new(mocks2.WorkloadRepository)
+	mockTeacherRepo := new(mocks2.TeacherRepository)
+	mockDepartmentRepo := new(mocks2.DepartmentRepository)
+
+	svc := NewWorkloadService(mockWorkloadRepo, mockTeacherRepo, mockDepartmentRepo).(*service)
+	svc.maxCourses = 3
+	svc.maxStudents = 100
+	return svc, mockWorkloadRepo, mockTeacherRepo, mockDepartmentRepo
+}
+
+func headedDepartment() *entity.Department {
+	head := uint(5)
+	return &entity.Department{ID: 2, Name: "Science", HeadOfDepartmentID: &head}
+}
+
+func TestFindTeacherWorkload(t *testing.T) {
+	svc, mockWorkloadRepo, mockTeacherRepo, _ := newTestWorkloadService()
+
+	mockTeacherRepo.On("FindById", uint(3)).Return(&entity.Teacher{ID: 3, Name: "Anna"}, nil)
+	mockWorkloadRepo.On("CountCourses", []uint{3}).Return(map[uint]int{3: 4}, nil)
+	mockWorkloadRepo.On("CountEnrollments", []uint{3}).Return(map[uint]int{3: 80}, nil)
+
+	result, err := svc.FindTeacherWorkload(3, teacherActor)
+
+	require.NoError(t, err)
+	assert.Equal(t, "Anna", result.Name)
+	assert.Equal(t, 4, result.Courses)
+	assert.Equal(t, 80, result.Students)
+	assert.Equal(t, 3, result.MaxCourses)
+	assert.True(t, result.Overloaded)
+}
+
+func TestFindTeacherWorkload_HeadOfDepartment(t *testing.T) {
+	svc, mockWorkloadRepo, mockTeacherRepo, mockDepartmentRepo := newTestWorkloadService()
+	departmentId := uint(2)
+
+	mockTeacherRepo.On("FindById", uint(3)).Return(&entity.Teacher{ID: 3, DepartmentID: &departmentId}, nil)
+	mockDepartmentRepo.On("FindById", uint(2)).Return(headedDepartment(), nil)
+	mockWorkloadRepo.On("CountCourses", []uint{3}).Return(map[uint]int{}, nil)
+	mockWorkloadRepo.On("CountEnrollments", []uint{3}).Return(map[uint]int{}, nil)
+
+	result, err := svc.FindTeacherWorkload(3, headActor)
+
+	require.NoError(t, err)
+	assert.Equal(t, 0, result.Courses)
+	assert.False(t, result.Overloaded)
+}
+
+func TestFindTeacherWorkload_Forbidden(t *testing.T) {
+	svc, mockWorkloadRepo, mockTeacherRepo, _ := newTestWorkloadService()
+
+	mockTeacherRepo.On("FindById", uint(3)).Return(&entity.Teacher{ID: 3}, nil)
+
+	result, err := svc.FindTeacherWorkload(3, otherActor)
+
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, ErrForbidden)
+	mockWorkloadRepo.AssertNotCalled(t, "CountCourses")
+}
+
+func TestFindTeacherWorkload_NotFound(t *testing.T) {
+	svc, _, mockTeacherRepo, _ := newTestWorkloadService()
+
+	mockTeacherRepo.On("FindById", uint(3)).Return(nil, gorm.ErrRecordNotFound)
+
+	result, err := svc.FindTeacherWorkload(3, admin)
+
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, ErrTeacherNotFound)
+}
+
+func TestFindDepartmentWorkload(t *testing.T) {
+	svc, mockWorkloadRepo, _, mockDepartmentRepo := newTestWorkloadService()
+
+	mockDepartmentRepo.On("FindById", uint(2)).Return(headedDepartment(), nil)
+	mockWorkloadRepo.On("FindDepartmentTeachers", uint(2)).Return([]entity.Teacher{
+		{ID: 3, Name: "Anna"},
+		{ID: 5, Name: "Boris"},
+	}, nil)
+	mockWorkloadRepo.On("CountCourses", []uint{3, 5}).Return(map[uint]int{3: 2, 5: 1}, nil)
+	mockWorkloadRepo.On("CountEnrollments", []uint{3, 5}).Return(map[uint]int{3: 50, 5: 20}, nil)
+
+	result, err := svc.FindDepartmentWorkload(2, headActor)
+
+	require.NoError(t, err)
+	assert.Equal(t, "Science", result.Name)
+	assert.Equal(t, 3, result.Courses)
+	assert.Equal(t, 70, result.Students)
+	assert.Len(t, result.Teachers, 2)
+}
+
+func TestFindDepartmentWorkload_Forbidden(t *testing.T) {
+	svc, mockWorkloadRepo, _, mockDepartmentRepo := newTestWorkloadService()
+
+	mockDepartmentRepo.On("FindById", uint(2)).Return(headedDepartment(), nil)
+
+	result, err := svc.FindDepartmentWorkload(2, teacherActor)
+
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, ErrForbidden)
+	mockWorkloadRepo.AssertNotCalled(t, "FindDepartmentTeachers")
+}
+
+func TestCheckAssignment(t *testing.T) {
+	svc, mockWorkloadRepo, _, _ := newTestWorkloadService()
+
+	mockWorkloadRepo.On("CountCourses", []uint{3}).Return(map[uint]int{3: 2}, nil)
+	mockWorkloadRepo.On("CountEnrollments", []uint{3}).Return(map[uint]int{3: 70}, nil)
+
+	assert.NoError(t, svc.CheckAssignment(3, 30))
+	assert.ErrorIs(t, svc.CheckAssignment(3, 31), ErrLimitExceeded)
+}
+
+func TestCheckAssignment_TooManyCourses(t *testing.T) {
+	svc, mockWorkloadRepo, _, _ := newTestWorkloadService()
+
+	mockWorkloadRepo.On("CountCourses", []uint{3}).Return(map[uint]int{3: 3}, nil)
+
+	err := svc.CheckAssignment(3, 0)
+
+	assert.ErrorIs(t, err, ErrLimitExceeded)
+	assert.EqualError(t, err, "teacher workload limit exceeded: 4 courses, limit is 3")
+	mockWorkloadRepo.AssertNotCalled(t, "CountEnrollments")
+}
+
+func TestCheckAssignment_Unlimited(t *testing.T) {
+	svc, mockWorkloadRepo, _, _ := newTestWorkloadService()
+	svc.maxCourses, svc.maxStudents = 0, 0
+
+	mockWorkloadRepo.On("CountCourses", []uint{3}).Return(map[uint]int{3: 30}, nil)
+	mockWorkloadRepo.On("CountEnrollments", []uint{3}).Return(map[uint]int{3: 3000}, nil)
+
+	assert.NoError(t, svc.CheckAssignment(3, 100))
+}
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS announcement_revisions;
+DROP TABLE IF EXISTS announcements;
\ No newline at end of file
@@ -0,0 +1,26 @@
+CREATE TABLE IF NOT EXISTS announcements
+(
+    id          BIGSERIAL PRIMARY KEY,
+    course_id   BIGINT      NOT NULL REFERENCES courses (id) ON DELETE CASCADE,
+    author_role TEXT        NOT NULL,
+    author_id   BIGINT      NOT NULL,
+    title       TEXT        NOT NULL,
+    body        TEXT        NOT NULL,
+    pinned      BOOLEAN     NOT NULL DEFAULT FALSE,
+    edited_at   TIMESTAMPTZ,
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at  TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_announcements_course ON announcements (course_id, pinned DESC, created_at DESC);
+
+CREATE TABLE IF NOT EXISTS announcement_revisions
+(
+    id              BIGSERIAL PRIMARY KEY,
+    announcement_id BIGINT      NOT NULL REFERENCES announcements (id) ON DELETE CASCADE,
+    title           TEXT        NOT NULL,
+    body            TEXT        NOT NULL,
+    editor_role     TEXT        NOT NULL,
+    editor_id       BIGINT      NOT NULL,
+    created_at      TIMESTAMPTZ NOT NULL DEFAULT now()
+);
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS discussion_post_revisions;
+DROP TABLE IF EXISTS discussion_posts;
+DROP TABLE IF EXISTS discussion_threads;
\ No newline at end of file
@@ -0,0 +1,36 @@
+CREATE TABLE IF NOT EXISTS discussion_threads
+(
+    id          BIGSERIAL PRIMARY KEY,
+    course_id   BIGINT      NOT NULL REFERENCES courses (id) ON DELETE CASCADE,
+    author_role TEXT        NOT NULL,
+    author_id   BIGINT      NOT NULL,
+    title       TEXT        NOT NULL,
+    pinned      BOOLEAN     NOT NULL DEFAULT FALSE,
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at  TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_discussion_threads_course ON discussion_threads (course_id, pinned DESC, updated_at DESC);
+
+CREATE TABLE IF NOT EXISTS discussion_posts
+(
+    id          BIGSERIAL PRIMARY KEY,
+    thread_id   BIGINT      NOT NULL REFERENCES discussion_threads (id) ON DELETE CASCADE,
+    parent_id   BIGINT REFERENCES discussion_posts (id) ON DELETE CASCADE,
+    author_role TEXT        NOT NULL,
+    author_id   BIGINT      NOT NULL,
+    body        TEXT        NOT NULL,
+    edited_at   TIMESTAMPTZ,
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at  TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_discussion_posts_thread ON discussion_posts (thread_id, created_at);
+
+CREATE TABLE IF NOT EXISTS discussion_post_revisions
+(
+    id         BIGSERIAL PRIMARY KEY,
+    post_id    BIGINT      NOT NULL REFERENCES discussion_posts (id) ON DELETE CASCADE,
+    body       TEXT        NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
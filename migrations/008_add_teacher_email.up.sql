@@ -0,0 +1,2 @@
+ALTER TABLE teachers
+    ADD COLUMN IF NOT EXISTS email TEXT NOT NULL DEFAULT '';
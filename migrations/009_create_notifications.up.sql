@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS notifications
+(
+    id              BIGSERIAL PRIMARY KEY,
+    event           TEXT        NOT NULL,
+    recipient       TEXT        NOT NULL,
+    locale          TEXT        NOT NULL,
+    subject         TEXT        NOT NULL,
+    body            TEXT        NOT NULL,
+    status          TEXT        NOT NULL DEFAULT 'pending',
+    attempts        INT         NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    last_error      TEXT        NOT NULL DEFAULT '',
+    created_at      TIMESTAMPTZ NOT NULL DEFAULT now(),
+    sent_at         TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS idx_notifications_due ON notifications (next_attempt_at) WHERE status = 'pending';
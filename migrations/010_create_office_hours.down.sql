@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS appointments;
+DROP TABLE IF EXISTS office_hours;
\ No newline at end of file
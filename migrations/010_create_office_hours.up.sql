@@ -0,0 +1,39 @@
+CREATE EXTENSION IF NOT EXISTS btree_gist;
+
+CREATE TABLE IF NOT EXISTS office_hours
+(
+    id           BIGSERIAL PRIMARY KEY,
+    teacher_id   BIGINT      NOT NULL REFERENCES teachers (id) ON DELETE CASCADE,
+    weekday      SMALLINT    NOT NULL CHECK (weekday BETWEEN 0 AND 6),
+    start_minute INT         NOT NULL CHECK (start_minute BETWEEN 0 AND 1439),
+    end_minute   INT         NOT NULL CHECK (end_minute BETWEEN 1 AND 1440),
+    slot_minutes INT         NOT NULL CHECK (slot_minutes > 0),
+    location     TEXT        NOT NULL DEFAULT '',
+    valid_from   DATE        NOT NULL,
+    valid_until  DATE,
+    created_at   TIMESTAMPTZ NOT NULL DEFAULT now(),
+    CHECK (start_minute < end_minute)
+);
+
+CREATE INDEX IF NOT EXISTS idx_office_hours_teacher ON office_hours (teacher_id);
+
+CREATE TABLE IF NOT EXISTS appointments
+(
+    id             BIGSERIAL PRIMARY KEY,
+    office_hour_id BIGINT      NOT NULL REFERENCES office_hours (id) ON DELETE CASCADE,
+    teacher_id     BIGINT      NOT NULL REFERENCES teachers (id) ON DELETE CASCADE,
+    student_id     BIGINT      NOT NULL REFERENCES students (id) ON DELETE CASCADE,
+    starts_at      TIMESTAMPTZ NOT NULL,
+    ends_at        TIMESTAMPTZ NOT NULL,
+    status         TEXT        NOT NULL DEFAULT 'booked',
+    location       TEXT        NOT NULL DEFAULT '',
+    note           TEXT        NOT NULL DEFAULT '',
+    cancelled_at   TIMESTAMPTZ,
+    created_at     TIMESTAMPTZ NOT NULL DEFAULT now(),
+    CHECK (starts_at < ends_at),
+    -- Neither the teacher nor the student can be in two booked appointments at once.
+    EXCLUDE USING gist (teacher_id WITH =, tstzrange(starts_at, ends_at) WITH &&) WHERE (status = 'booked'),
+    EXCLUDE USING gist (student_id WITH =, tstzrange(starts_at, ends_at) WITH &&) WHERE (status = 'booked')
+);
+
+CREATE INDEX IF NOT EXISTS idx_appointments_student ON appointments (student_id, starts_at);
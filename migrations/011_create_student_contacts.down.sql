@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS student_contacts;
+
+ALTER TABLE students
+    DROP COLUMN IF EXISTS date_of_birth;
\ No newline at end of file
@@ -0,0 +1,20 @@
+ALTER TABLE students
+    ADD COLUMN IF NOT EXISTS date_of_birth DATE;
+
+CREATE TABLE IF NOT EXISTS student_contacts
+(
+    id                 BIGSERIAL PRIMARY KEY,
+    student_id         BIGINT      NOT NULL REFERENCES students (id) ON DELETE CASCADE,
+    name               TEXT        NOT NULL,
+    relationship       TEXT        NOT NULL,
+    phone              TEXT        NOT NULL,
+    email              TEXT        NOT NULL DEFAULT '',
+    address            TEXT        NOT NULL DEFAULT '',
+    is_guardian        BOOLEAN     NOT NULL DEFAULT FALSE,
+    is_emergency       BOOLEAN     NOT NULL DEFAULT FALSE,
+    can_receive_grades BOOLEAN     NOT NULL DEFAULT FALSE,
+    created_at         TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at         TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_student_contacts_student ON student_contacts (student_id);
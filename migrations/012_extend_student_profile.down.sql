@@ -0,0 +1,11 @@
+DROP TABLE IF EXISTS student_addresses;
+DROP TABLE IF EXISTS student_number_counters;
+
+DROP TRIGGER IF EXISTS students_student_number_immutable ON students;
+DROP FUNCTION IF EXISTS students_keep_student_number();
+
+ALTER TABLE students
+    DROP COLUMN IF EXISTS phone,
+    DROP COLUMN IF EXISTS pronouns,
+    DROP COLUMN IF EXISTS preferred_name,
+    DROP COLUMN IF EXISTS student_number;
@@ -0,0 +1,52 @@
+ALTER TABLE students
+    ADD COLUMN IF NOT EXISTS student_number TEXT,
+    ADD COLUMN IF NOT EXISTS preferred_name TEXT NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS pronouns       TEXT NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS phone          TEXT NOT NULL DEFAULT '';
+
+-- Existing students get a number derived from their ID.
+UPDATE students
+SET student_number = 'S' || lpad(id::text, 6, '0')
+WHERE student_number IS NULL;
+
+ALTER TABLE students
+    ALTER COLUMN student_number SET NOT NULL,
+    ADD CONSTRAINT students_student_number_key UNIQUE (student_number);
+
+CREATE OR REPLACE FUNCTION students_keep_student_number() RETURNS trigger AS
+$$
+BEGIN
+    IF NEW.student_number IS DISTINCT FROM OLD.student_number THEN
+        RAISE EXCEPTION 'student_number is immutable';
+    END IF;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER students_student_number_immutable
+    BEFORE UPDATE OF student_number
+    ON students
+    FOR EACH ROW
+EXECUTE FUNCTION students_keep_student_number();
+
+-- One counter per rendered prefix, e.g. per year for "{year}{seq:5}".
+CREATE TABLE IF NOT EXISTS student_number_counters
+(
+    scope TEXT PRIMARY KEY,
+    value BIGINT NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS student_addresses
+(
+    id          BIGSERIAL PRIMARY KEY,
+    student_id  BIGINT NOT NULL REFERENCES students (id) ON DELETE CASCADE,
+    kind        TEXT   NOT NULL,
+    line1       TEXT   NOT NULL,
+    line2       TEXT   NOT NULL DEFAULT '',
+    city        TEXT   NOT NULL,
+    region      TEXT   NOT NULL DEFAULT '',
+    postal_code TEXT   NOT NULL,
+    country     CHAR(2) NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_student_addresses_student ON student_addresses (student_id);
@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS attachments
+(
+    id           BIGSERIAL PRIMARY KEY,
+    owner_role   TEXT        NOT NULL,
+    owner_id     BIGINT      NOT NULL,
+    kind         TEXT        NOT NULL,
+    filename     TEXT        NOT NULL,
+    content_type TEXT        NOT NULL,
+    size         BIGINT      NOT NULL,
+    sha256       CHAR(64)    NOT NULL,
+    storage_key  TEXT        NOT NULL UNIQUE,
+    created_at   TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_attachments_owner ON attachments (owner_role, owner_id, created_at DESC);
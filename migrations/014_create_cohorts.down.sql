@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS cohort_student;
+DROP TABLE IF EXISTS cohorts;
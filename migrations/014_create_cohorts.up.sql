@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS cohorts
+(
+    id         BIGSERIAL PRIMARY KEY,
+    name       TEXT        NOT NULL UNIQUE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE TABLE IF NOT EXISTS cohort_student
+(
+    cohort_id  BIGINT NOT NULL REFERENCES cohorts (id) ON DELETE CASCADE,
+    student_id BIGINT NOT NULL REFERENCES students (id) ON DELETE CASCADE,
+    PRIMARY KEY (cohort_id, student_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_cohort_student_student ON cohort_student (student_id);
@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS teacher_qualifications;
+
+ALTER TABLE courses
+    DROP COLUMN IF EXISTS subject;
@@ -0,0 +1,14 @@
+ALTER TABLE courses
+    ADD COLUMN IF NOT EXISTS subject TEXT NOT NULL DEFAULT '';
+
+CREATE TABLE IF NOT EXISTS teacher_qualifications
+(
+    id              BIGSERIAL PRIMARY KEY,
+    teacher_id      BIGINT      NOT NULL REFERENCES teachers (id) ON DELETE CASCADE,
+    subject         TEXT        NOT NULL,
+    certified_until DATE,
+    created_at      TIMESTAMPTZ NOT NULL DEFAULT now(),
+    UNIQUE (teacher_id, subject)
+);
+
+CREATE INDEX IF NOT EXISTS idx_teacher_qualifications_subject ON teacher_qualifications (subject);
@@ -0,0 +1,4 @@
+ALTER TABLE teachers
+    ADD COLUMN IF NOT EXISTS department_id BIGINT REFERENCES departments (id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS idx_teachers_department ON teachers (department_id);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS substitutions;
+DROP TABLE IF EXISTS leave_requests;
@@ -0,0 +1,32 @@
+CREATE TABLE IF NOT EXISTS leave_requests
+(
+    id         BIGSERIAL PRIMARY KEY,
+    teacher_id BIGINT      NOT NULL REFERENCES teachers (id) ON DELETE CASCADE,
+    start_date DATE        NOT NULL,
+    end_date   DATE        NOT NULL,
+    reason     TEXT        NOT NULL DEFAULT '',
+    status     TEXT        NOT NULL DEFAULT 'pending',
+    decided_by BIGINT,
+    decided_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    CHECK (start_date <= end_date),
+    -- A teacher cannot have two open or approved leaves for the same day.
+    EXCLUDE USING gist (teacher_id WITH =, daterange(start_date, end_date, '[]') WITH &&)
+        WHERE (status IN ('pending', 'approved'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_leave_requests_teacher ON leave_requests (teacher_id, start_date);
+
+CREATE TABLE IF NOT EXISTS substitutions
+(
+    id               BIGSERIAL PRIMARY KEY,
+    leave_request_id BIGINT      NOT NULL REFERENCES leave_requests (id) ON DELETE CASCADE,
+    course_id        BIGINT      NOT NULL REFERENCES courses (id) ON DELETE CASCADE,
+    substitute_id    BIGINT      NOT NULL REFERENCES teachers (id) ON DELETE CASCADE,
+    start_date       DATE        NOT NULL,
+    end_date         DATE        NOT NULL,
+    created_at       TIMESTAMPTZ NOT NULL DEFAULT now(),
+    CHECK (start_date <= end_date),
+    -- A course has at most one substitute on any day.
+    EXCLUDE USING gist (course_id WITH =, daterange(start_date, end_date, '[]') WITH &&)
+);
@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS calendar_events
+(
+    id         BIGSERIAL PRIMARY KEY,
+    term       TEXT        NOT NULL DEFAULT '',
+    kind       TEXT        NOT NULL CHECK (kind IN ('holiday', 'exam_period', 'reading_week')),
+    name       TEXT        NOT NULL,
+    start_date DATE        NOT NULL,
+    end_date   DATE        NOT NULL,
+    blackout   BOOLEAN     NOT NULL DEFAULT false,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    CHECK (start_date <= end_date)
+);
+
+CREATE INDEX IF NOT EXISTS idx_calendar_events_dates ON calendar_events (start_date, end_date);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS student_hold_events;
+DROP TABLE IF EXISTS student_holds;
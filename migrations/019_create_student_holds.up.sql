@@ -0,0 +1,26 @@
+CREATE TABLE IF NOT EXISTS student_holds
+(
+    id           BIGSERIAL PRIMARY KEY,
+    student_id   BIGINT      NOT NULL REFERENCES students (id) ON DELETE CASCADE,
+    type         TEXT        NOT NULL CHECK (type IN ('financial', 'disciplinary', 'missing_documents')),
+    reason       TEXT        NOT NULL,
+    placed_by    BIGINT      NOT NULL,
+    release_date DATE,
+    released_at  TIMESTAMPTZ,
+    released_by  BIGINT,
+    created_at   TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_student_holds_student ON student_holds (student_id) WHERE released_at IS NULL;
+
+CREATE TABLE IF NOT EXISTS student_hold_events
+(
+    id         BIGSERIAL PRIMARY KEY,
+    hold_id    BIGINT      NOT NULL REFERENCES student_holds (id) ON DELETE CASCADE,
+    action     TEXT        NOT NULL,
+    actor_id   BIGINT      NOT NULL,
+    note       TEXT        NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_student_hold_events_hold ON student_hold_events (hold_id, created_at);
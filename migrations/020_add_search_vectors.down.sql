@@ -0,0 +1,11 @@
+DROP INDEX IF EXISTS idx_departments_search;
+DROP INDEX IF EXISTS idx_courses_search;
+DROP INDEX IF EXISTS idx_teachers_search;
+DROP INDEX IF EXISTS idx_students_search;
+
+ALTER TABLE departments DROP COLUMN IF EXISTS search_vector;
+ALTER TABLE courses DROP COLUMN IF EXISTS search_vector;
+ALTER TABLE teachers DROP COLUMN IF EXISTS search_vector;
+ALTER TABLE students DROP COLUMN IF EXISTS search_vector;
+
+DROP FUNCTION IF EXISTS search_unaccent(text);
@@ -0,0 +1,38 @@
+CREATE EXTENSION IF NOT EXISTS unaccent;
+
+-- unaccent() is only STABLE, which generated columns and index expressions
+-- do not accept. Pinning the dictionary makes the wrapper safe to mark
+-- IMMUTABLE.
+CREATE OR REPLACE FUNCTION search_unaccent(text) RETURNS text AS
+$$
+SELECT public.unaccent('public.unaccent'::regdictionary, $1)
+$$ LANGUAGE sql IMMUTABLE PARALLEL SAFE STRICT;
+
+-- The 'simple' configuration only lowercases, without stemming, which suits
+-- names in any language, Cyrillic included. concat_ws() is not immutable,
+-- so the columns are joined with || (all of them are NOT NULL).
+ALTER TABLE students
+    ADD COLUMN IF NOT EXISTS search_vector tsvector GENERATED ALWAYS AS (
+        to_tsvector('simple', search_unaccent(
+                name || ' ' || preferred_name || ' ' || student_number || ' ' || email))
+        ) STORED;
+
+ALTER TABLE teachers
+    ADD COLUMN IF NOT EXISTS search_vector tsvector GENERATED ALWAYS AS (
+        to_tsvector('simple', search_unaccent(name || ' ' || email))
+        ) STORED;
+
+ALTER TABLE courses
+    ADD COLUMN IF NOT EXISTS search_vector tsvector GENERATED ALWAYS AS (
+        to_tsvector('simple', search_unaccent(title || ' ' || subject))
+        ) STORED;
+
+ALTER TABLE departments
+    ADD COLUMN IF NOT EXISTS search_vector tsvector GENERATED ALWAYS AS (
+        to_tsvector('simple', search_unaccent(name))
+        ) STORED;
+
+CREATE INDEX IF NOT EXISTS idx_students_search ON students USING GIN (search_vector);
+CREATE INDEX IF NOT EXISTS idx_teachers_search ON teachers USING GIN (search_vector);
+CREATE INDEX IF NOT EXISTS idx_courses_search ON courses USING GIN (search_vector);
+CREATE INDEX IF NOT EXISTS idx_departments_search ON departments USING GIN (search_vector);
@@ -0,0 +1,6 @@
+DROP TABLE IF EXISTS student_merges;
+
+DROP INDEX IF EXISTS idx_students_normalized_email;
+DROP INDEX IF EXISTS idx_students_name_trgm;
+
+DROP FUNCTION IF EXISTS normalize_email(text);
@@ -0,0 +1,23 @@
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+-- normalize_email makes addresses that reach the same mailbox compare
+-- equal: case and surrounding spaces are ignored, and so is a "+tag" in
+-- the local part.
+CREATE OR REPLACE FUNCTION normalize_email(text) RETURNS text AS
+$$
+SELECT regexp_replace(lower(btrim($1)), '\+[^@]*@', '@')
+$$ LANGUAGE sql IMMUTABLE PARALLEL SAFE STRICT;
+
+CREATE INDEX IF NOT EXISTS idx_students_name_trgm ON students USING GIN (name gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS idx_students_normalized_email ON students (normalize_email(email));
+
+-- A merged student's row is deleted; its ID keeps resolving to the
+-- survivor through this table.
+CREATE TABLE IF NOT EXISTS student_merges
+(
+    merged_id   BIGINT PRIMARY KEY,
+    survivor_id BIGINT      NOT NULL REFERENCES students (id) ON DELETE CASCADE,
+    merged_at   TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_student_merges_survivor ON student_merges (survivor_id);
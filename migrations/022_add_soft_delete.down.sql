@@ -0,0 +1,17 @@
+-- Soft-deleted rows are removed for good: without deleted_at they would
+-- reappear.
+DELETE FROM courses WHERE deleted_at IS NOT NULL;
+DELETE FROM students WHERE deleted_at IS NOT NULL;
+DELETE FROM departments WHERE deleted_at IS NOT NULL;
+DELETE FROM teachers WHERE deleted_at IS NOT NULL;
+
+DROP INDEX IF EXISTS courses_title_key;
+ALTER TABLE courses ADD CONSTRAINT courses_title_key UNIQUE (title);
+
+DROP INDEX IF EXISTS students_name_key;
+ALTER TABLE students ADD CONSTRAINT students_name_key UNIQUE (name);
+
+ALTER TABLE departments DROP COLUMN IF EXISTS deleted_at;
+ALTER TABLE courses DROP COLUMN IF EXISTS deleted_at;
+ALTER TABLE teachers DROP COLUMN IF EXISTS deleted_at;
+ALTER TABLE students DROP COLUMN IF EXISTS deleted_at;
@@ -0,0 +1,18 @@
+ALTER TABLE students ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMPTZ;
+ALTER TABLE teachers ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMPTZ;
+ALTER TABLE courses ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMPTZ;
+ALTER TABLE departments ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMPTZ;
+
+-- Only the purge job looks for deleted rows.
+CREATE INDEX IF NOT EXISTS idx_students_deleted_at ON students (deleted_at) WHERE deleted_at IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_teachers_deleted_at ON teachers (deleted_at) WHERE deleted_at IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_courses_deleted_at ON courses (deleted_at) WHERE deleted_at IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_departments_deleted_at ON departments (deleted_at) WHERE deleted_at IS NOT NULL;
+
+-- A deleted student or course must not block its name for a new one.
+-- Restoring it fails while the name is taken again.
+ALTER TABLE students DROP CONSTRAINT IF EXISTS students_name_key;
+CREATE UNIQUE INDEX IF NOT EXISTS students_name_key ON students (name) WHERE deleted_at IS NULL;
+
+ALTER TABLE courses DROP CONSTRAINT IF EXISTS courses_title_key;
+CREATE UNIQUE INDEX IF NOT EXISTS courses_title_key ON courses (title) WHERE deleted_at IS NULL;
@@ -0,0 +1,30 @@
+-- audit_entries keeps one row per change to a student, teacher, course or
+-- department. before and after hold only the columns that changed.
+CREATE TABLE IF NOT EXISTS audit_entries
+(
+    id         BIGSERIAL PRIMARY KEY,
+    entity     TEXT        NOT NULL,
+    entity_id  BIGINT      NOT NULL,
+    action     TEXT        NOT NULL,
+    actor_id   BIGINT,
+    actor_role TEXT        NOT NULL DEFAULT '',
+    request_id TEXT        NOT NULL DEFAULT '',
+    before     JSONB,
+    after      JSONB,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_audit_entries_entity ON audit_entries (entity, entity_id, id);
+
+CREATE OR REPLACE FUNCTION audit_entries_append_only() RETURNS trigger AS
+$$
+BEGIN
+    RAISE EXCEPTION 'audit entries cannot be changed or removed';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER audit_entries_append_only
+    BEFORE UPDATE OR DELETE OR TRUNCATE
+    ON audit_entries
+    FOR EACH STATEMENT
+EXECUTE FUNCTION audit_entries_append_only();
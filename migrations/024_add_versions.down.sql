@@ -0,0 +1,4 @@
+ALTER TABLE departments DROP COLUMN IF EXISTS version;
+ALTER TABLE courses DROP COLUMN IF EXISTS version;
+ALTER TABLE teachers DROP COLUMN IF EXISTS version;
+ALTER TABLE students DROP COLUMN IF EXISTS version;
@@ -0,0 +1,7 @@
+-- version counts the updates to a row. Clients get it as the ETag and
+-- send it back in If-Match so that concurrent edits do not overwrite
+-- each other.
+ALTER TABLE students ADD COLUMN IF NOT EXISTS version INTEGER NOT NULL DEFAULT 1;
+ALTER TABLE teachers ADD COLUMN IF NOT EXISTS version INTEGER NOT NULL DEFAULT 1;
+ALTER TABLE courses ADD COLUMN IF NOT EXISTS version INTEGER NOT NULL DEFAULT 1;
+ALTER TABLE departments ADD COLUMN IF NOT EXISTS version INTEGER NOT NULL DEFAULT 1;
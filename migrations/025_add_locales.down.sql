@@ -0,0 +1,2 @@
+ALTER TABLE teachers DROP COLUMN IF EXISTS locale;
+ALTER TABLE students DROP COLUMN IF EXISTS locale;
@@ -0,0 +1,4 @@
+-- locale is the language tag notifications to the person are rendered in.
+-- An empty locale falls back to the configured default.
+ALTER TABLE students ADD COLUMN IF NOT EXISTS locale VARCHAR(35) NOT NULL DEFAULT '';
+ALTER TABLE teachers ADD COLUMN IF NOT EXISTS locale VARCHAR(35) NOT NULL DEFAULT '';
@@ -0,0 +1,109 @@
+// Package etag turns row versions into entity tags and evaluates the
+// If-Match and If-None-Match preconditions against them.
+//
+// A versioned entity has a Version column that starts at 1 and is bumped
+// by every update. Its ETag is that version followed by a hash of the
+// response body, because a response also embeds related rows, such as a
+// student's course titles, whose changes do not bump the version.
+// If-Match only compares the version; If-None-Match compares whole tags.
+package etag
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+const (
+	HeaderETag        = "ETag"
+	HeaderIfMatch     = "If-Match"
+	HeaderIfNoneMatch = "If-None-Match"
+)
+
+// ErrMismatch means the row changed since the client read it.
+var ErrMismatch = errors.New("the resource was changed by someone else; fetch it again")
+
+// Format renders version and a hash of the JSON encoding of body as a
+// strong entity tag. When body cannot be encoded the tag is the version
+// alone.
+func Format(version uint, body interface{}) string {
+	tag := strconv.FormatUint(uint64(version), 10)
+	if encoded, err := json.Marshal(body); err == nil {
+		sum := sha256.Sum256(encoded)
+		tag += "-" + hex.EncodeToString(sum[:8])
+	}
+
+	return `"` + tag + `"`
+}
+
+// IfMatch returns the version the If-Match header asks for, or zero when
+// the request has no such header or accepts any version with "*". A tag
+// that is not one of ours can never match and fails with ErrMismatch.
+func IfMatch(req *http.Request) (uint, error) {
+	header := strings.TrimSpace(req.Header.Get(HeaderIfMatch))
+	if header == "" || header == "*" {
+		return 0, nil
+	}
+
+	version, ok := parse(header)
+	if !ok {
+		return 0, ErrMismatch
+	}
+
+	return version, nil
+}
+
+// NoneMatch reports whether the If-None-Match header lists current, a
+// tag made by Format, so that a GET can answer 304 Not Modified. Weak
+// tags match as well.
+func NoneMatch(req *http.Request, current string) bool {
+	header := req.Header.Get(HeaderIfNoneMatch)
+	for _, tag := range strings.Split(header, ",") {
+		tag = strings.TrimSpace(tag)
+		if tag == "*" || strings.TrimPrefix(tag, "W/") == current {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Check returns the version of the live row of model's table with the
+// given ID. It fails with ErrMismatch when expected is not zero and
+// differs, and with gorm.ErrRecordNotFound when there is no such row.
+// Call it in the transaction that changes the row, after locking it.
+func Check(tx *gorm.DB, model interface{}, id uint, expected uint) (uint, error) {
+	var versions []uint
+	err := tx.Model(model).Where("id = ?", id).Pluck("version", &versions).Error
+	if err != nil {
+		return 0, err
+	}
+	if len(versions) == 0 {
+		return 0, gorm.ErrRecordNotFound
+	}
+	if expected != 0 && versions[0] != expected {
+		return 0, ErrMismatch
+	}
+
+	return versions[0], nil
+}
+
+func parse(tag string) (uint, bool) {
+	if len(tag) < 2 || tag[0] != '"' || tag[len(tag)-1] != '"' {
+		return 0, false
+	}
+
+	prefix, _, _ := strings.Cut(tag[1:len(tag)-1], "-")
+	version, err := strconv.ParseUint(prefix, 10, 32)
+	if err != nil || version == 0 {
+		return 0, false
+	}
+
+	return uint(version), true
+}
@@ -0,0 +1,118 @@
+package etag
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+type row struct {
+	ID      uint
+	Version uint
+}
+
+func (row) TableName() string {
+	return "rows"
+}
+
+func requestWith(header, value string) *http.Request {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	if value != "" {
+		req.Header.Set(header, value)
+	}
+	return req
+}
+
+func TestFormat(t *testing.T) {
+	tag := Format(7, map[string]string{"title": "Math"})
+
+	assert.Regexp(t, `^"7-[0-9a-f]{16}"$`, tag)
+	assert.Equal(t, tag, Format(7, map[string]string{"title": "Math"}))
+	assert.NotEqual(t, tag, Format(7, map[string]string{"title": "Algebra"}))
+	assert.Equal(t, `"7"`, Format(7, func() {}))
+}
+
+func TestIfMatch(t *testing.T) {
+	tests := []struct {
+		header   string
+		expected uint
+		err      error
+	}{
+		{header: "", expected: 0},
+		{header: "*", expected: 0},
+		{header: `"7"`, expected: 7},
+		{header: `"7-0123456789abcdef"`, expected: 7},
+		{header: `W/"7"`, err: ErrMismatch},
+		{header: "7", err: ErrMismatch},
+		{header: `"abc"`, err: ErrMismatch},
+	}
+
+	for _, tt := range tests {
+		version, err := IfMatch(requestWith(HeaderIfMatch, tt.header))
+
+		assert.ErrorIs(t, err, tt.err, tt.header)
+		assert.Equal(t, tt.expected, version, tt.header)
+	}
+}
+
+func TestNoneMatch(t *testing.T) {
+	current := `"7-0123456789abcdef"`
+
+	assert.True(t, NoneMatch(requestWith(HeaderIfNoneMatch, current), current))
+	assert.True(t, NoneMatch(requestWith(HeaderIfNoneMatch, `"6", W/`+current), current))
+	assert.True(t, NoneMatch(requestWith(HeaderIfNoneMatch, "*"), current))
+	assert.False(t, NoneMatch(requestWith(HeaderIfNoneMatch, `"7-fedcba9876543210"`), current))
+	assert.False(t, NoneMatch(requestWith(HeaderIfNoneMatch, `"7"`), current))
+	assert.False(t, NoneMatch(requestWith(HeaderIfNoneMatch, ""), current))
+}
+
+func setupTestDB(t *testing.T) (sqlmock.Sqlmock, *gorm.DB) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	gormDB, err := gorm.Open(postgres.New(postgres.Config{
+		Conn:                 db,
+		PreferSimpleProtocol: true,
+	}), &gorm.Config{Logger: logger.Default.LogMode(logger.Silent)})
+	require.NoError(t, err)
+
+	return mock, gormDB
+}
+
+func TestCheck(t *testing.T) {
+	tests := []struct {
+		name     string
+		rows     *sqlmock.Rows
+		expected uint
+		version  uint
+		err      error
+	}{
+		{name: "any version", rows: sqlmock.NewRows([]string{"version"}).AddRow(3), expected: 0, version: 3},
+		{name: "same version", rows: sqlmock.NewRows([]string{"version"}).AddRow(3), expected: 3, version: 3},
+		{name: "other version", rows: sqlmock.NewRows([]string{"version"}).AddRow(4), expected: 3, err: ErrMismatch},
+		{name: "no row", rows: sqlmock.NewRows([]string{"version"}), expected: 3, err: gorm.ErrRecordNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock, db := setupTestDB(t)
+			mock.ExpectQuery(regexp.QuoteMeta(`SELECT "version" FROM "rows" WHERE id = $1`)).
+				WithArgs(5).
+				WillReturnRows(tt.rows)
+
+			version, err := Check(db, &row{}, 5, tt.expected)
+
+			assert.ErrorIs(t, err, tt.err)
+			assert.Equal(t, tt.version, version)
+		})
+	}
+}
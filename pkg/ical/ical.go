@@ -0,0 +1,98 @@
+// Package ical writes iCalendar (RFC 5545) feeds.
+package ical
+
+import (
+	"bytes"
+	"strings"
+	"time"
+)
+
+const ContentType = "text/calendar; charset=utf-8"
+
+type Event struct {
+	UID   string
+	Start time.Time
+	End   time.Time
+	// AllDay events use DATE values; End is exclusive.
+	AllDay      bool
+	Summary     string
+	Description string
+	Location    string
+	Cancelled   bool
+}
+
+type Calendar struct {
+	Name   string
+	Events []Event
+}
+
+// Encode renders the calendar with CRLF line endings and folded lines.
+func (c Calendar) Encode(now time.Time) []byte {
+	var b bytes.Buffer
+	stamp := now.UTC().Format("20060102T150405Z")
+
+	writeLine(&b, "BEGIN:VCALENDAR")
+	writeLine(&b, "VERSION:2.0")
+	writeLine(&b, "PRODID:-//student_go//EN")
+	writeLine(&b, "CALSCALE:GREGORIAN")
+	if c.Name != "" {
+		writeLine(&b, "X-WR-CALNAME:"+escape(c.Name))
+	}
+
+	for _, e := range c.Events {
+		writeLine(&b, "BEGIN:VEVENT")
+		writeLine(&b, "UID:"+e.UID)
+		writeLine(&b, "DTSTAMP:"+stamp)
+		if e.AllDay {
+			writeLine(&b, "DTSTART;VALUE=DATE:"+e.Start.Format("20060102"))
+			writeLine(&b, "DTEND;VALUE=DATE:"+e.End.Format("20060102"))
+		} else {
+			writeLine(&b, "DTSTART:"+e.Start.UTC().Format("20060102T150405Z"))
+			writeLine(&b, "DTEND:"+e.End.UTC().Format("20060102T150405Z"))
+		}
+		writeLine(&b, "SUMMARY:"+escape(e.Summary))
+		if e.Description != "" {
+			writeLine(&b, "DESCRIPTION:"+escape(e.Description))
+		}
+		if e.Location != "" {
+			writeLine(&b, "LOCATION:"+escape(e.Location))
+		}
+		if e.Cancelled {
+			writeLine(&b, "STATUS:CANCELLED")
+		} else {
+			writeLine(&b, "STATUS:CONFIRMED")
+		}
+		writeLine(&b, "END:VEVENT")
+	}
+
+	writeLine(&b, "END:VCALENDAR")
+	return b.Bytes()
+}
+
+func escape(s string) string {
+	return strings.NewReplacer(
+		`\`, `\\`,
+		";", `\;`,
+		",", `\,`,
+		"\r\n", `\n`,
+		"\n", `\n`,
+	).Replace(s)
+}
+
+// writeLine folds lines longer than 75 octets without splitting UTF-8
+// sequences, as required by RFC 5545 section 3.1.
+func writeLine(b *bytes.Buffer, line string) {
+	const limit = 75
+
+	width := 0
+	for _, r := range line {
+		size := len(string(r))
+		if width+size > limit {
+			b.WriteString("\r\n ")
+			width = 1
+		}
+		b.WriteRune(r)
+		width += size
+	}
+	b.WriteString("\r\n")
+}
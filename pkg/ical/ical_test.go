@@ -0,0 +1,51 @@
+package ical
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncode(t *testing.T) {
+	start := time.Date(2025, 9, 1, 10, 0, 0, 0, time.FixedZone("MSK", 3*60*60))
+	cal := Calendar{
+		Name: "Office hours",
+		Events: []Event{{
+			UID:      "appointment-1@student_go",
+			Start:    start,
+			End:      start.Add(15 * time.Minute),
+			Summary:  "Office hours, room 101",
+			Location: "Room 101; 1st floor",
+		}},
+	}
+
+	out := string(cal.Encode(time.Date(2025, 8, 1, 0, 0, 0, 0, time.UTC)))
+
+	assert.True(t, strings.HasPrefix(out, "BEGIN:VCALENDAR\r\nVERSION:2.0\r\n"))
+	assert.Contains(t, out, "DTSTART:20250901T070000Z\r\n")
+	assert.Contains(t, out, "DTEND:20250901T071500Z\r\n")
+	assert.Contains(t, out, "DTSTAMP:20250801T000000Z\r\n")
+	assert.Contains(t, out, "SUMMARY:Office hours\\, room 101\r\n")
+	assert.Contains(t, out, "LOCATION:Room 101\\; 1st floor\r\n")
+	assert.True(t, strings.HasSuffix(out, "END:VEVENT\r\nEND:VCALENDAR\r\n"))
+}
+
+func TestEncode_AllDay(t *testing.T) {
+	day := time.Date(2025, 12, 31, 0, 0, 0, 0, time.UTC)
+	cal := Calendar{Events: []Event{{UID: "1", Start: day, End: day.AddDate(0, 0, 1), AllDay: true, Summary: "Holiday"}}}
+
+	out := string(cal.Encode(day))
+
+	assert.Contains(t, out, "DTSTART;VALUE=DATE:20251231\r\n")
+	assert.Contains(t, out, "DTEND;VALUE=DATE:20260101\r\n")
+}
+
+func TestWriteLine_Folds(t *testing.T) {
+	cal := Calendar{Events: []Event{{UID: "1", Summary: strings.Repeat("Ж", 60)}}}
+
+	for _, line := range strings.Split(string(cal.Encode(time.Now())), "\r\n") {
+		assert.LessOrEqual(t, len(line), 75)
+	}
+}
@@ -0,0 +1,142 @@
+package pagination
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var (
+	CursorVar = "cursor"
+	LimitVar  = "limit"
+	// TotalVar asks for total_count in cursor mode, which costs a COUNT(*).
+	TotalVar = "include_total"
+)
+
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// Cursor is a position in a list ordered by ID. Clients only ever see it
+// encoded, so its fields can change without breaking them.
+type Cursor struct {
+	ID     uint `json:"id"`
+	Before bool `json:"before,omitempty"`
+}
+
+func (c Cursor) Encode() string {
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func DecodeCursor(value string) (*Cursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	var cursor Cursor
+	if err := json.Unmarshal(data, &cursor); err != nil || cursor.ID == 0 {
+		return nil, ErrInvalidCursor
+	}
+
+	return &cursor, nil
+}
+
+// Keyset selects one page of a list ordered by ID. Unlike an offset it stays
+// cheap on large tables and does not skip or repeat rows when rows are
+// inserted concurrently. A nil Cursor selects the first page.
+type Keyset struct {
+	Cursor *Cursor
+	Limit  int
+}
+
+// IsCursorRequest reports whether the client asked for cursor pagination
+// instead of page/per_page.
+func IsCursorRequest(req *http.Request) bool {
+	query := req.URL.Query()
+	return query.Has(CursorVar) || query.Has(LimitVar)
+}
+
+func NewKeysetFromRequest(req *http.Request) (Keyset, error) {
+	limit := parseInt(req.URL.Query().Get(LimitVar), DefaultPageSize)
+	if limit <= 0 {
+		limit = DefaultPageSize
+	}
+	if limit > MaxPageSize {
+		limit = MaxPageSize
+	}
+
+	keyset := Keyset{Limit: limit}
+	if value := req.URL.Query().Get(CursorVar); value != "" {
+		cursor, err := DecodeCursor(value)
+		if err != nil {
+			return Keyset{}, err
+		}
+		keyset.Cursor = cursor
+	}
+
+	return keyset, nil
+}
+
+// Apply restricts db to the page around the cursor. It fetches one row more
+// than the limit so Slice can tell whether another page follows.
+func (k Keyset) Apply(db *gorm.DB) *gorm.DB {
+	id := clause.Column{Table: clause.CurrentTable, Name: "id"}
+	backward := k.Cursor != nil && k.Cursor.Before
+
+	if k.Cursor != nil {
+		op := ">"
+		if backward {
+			op = "<"
+		}
+		db = db.Where(clause.Expr{SQL: "? " + op + " ?", Vars: []interface{}{id, k.Cursor.ID}})
+	}
+
+	return db.
+		Order(clause.OrderByColumn{Column: id, Desc: backward}).
+		Limit(k.Limit + 1)
+}
+
+// CursorPage is the response for a list fetched with a Keyset.
+type CursorPage struct {
+	Limit      int         `json:"limit"`
+	NextCursor string      `json:"next_cursor,omitempty"`
+	PrevCursor string      `json:"prev_cursor,omitempty"`
+	TotalCount *int        `json:"total_count,omitempty"`
+	Items      interface{} `json:"items"`
+}
+
+// Slice trims rows fetched with k.Apply to the requested page, puts them in
+// ascending ID order and returns the page with its cursors.
+func Slice[T any](k Keyset, rows []T, id func(T) uint) ([]T, *CursorPage) {
+	backward := k.Cursor != nil && k.Cursor.Before
+	more := len(rows) > k.Limit
+	if more {
+		rows = rows[:k.Limit]
+	}
+	if backward {
+		for i, j := 0, len(rows)-1; i < j; i, j = i+1, j-1 {
+			rows[i], rows[j] = rows[j], rows[i]
+		}
+	}
+
+	page := &CursorPage{Limit: k.Limit}
+	if len(rows) == 0 {
+		return rows, page
+	}
+
+	// Going forward there is a previous page whenever we started from a
+	// cursor; going backward there is always a next page.
+	hasNext := more || backward
+	hasPrev := (backward && more) || (!backward && k.Cursor != nil)
+	if hasNext {
+		page.NextCursor = Cursor{ID: id(rows[len(rows)-1])}.Encode()
+	}
+	if hasPrev {
+		page.PrevCursor = Cursor{ID: id(rows[0]), Before: true}.Encode()
+	}
+
+	return rows, page
+}
@@ -0,0 +1,168 @@
+package pagination
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+type row struct {
+	ID uint
+}
+
+func (row) TableName() string {
+	return "rows"
+}
+
+func rowID(r row) uint {
+	return r.ID
+}
+
+func rows(ids ...uint) []row {
+	result := make([]row, 0, len(ids))
+	for _, id := range ids {
+		result = append(result, row{ID: id})
+	}
+	return result
+}
+
+func TestCursorRoundTrip(t *testing.T) {
+	encoded := Cursor{ID: 42, Before: true}.Encode()
+
+	cursor, err := DecodeCursor(encoded)
+
+	require.NoError(t, err)
+	assert.Equal(t, &Cursor{ID: 42, Before: true}, cursor)
+}
+
+func TestDecodeCursor_Invalid(t *testing.T) {
+	for _, value := range []string{"%%%", "bm90IGpzb24", Cursor{}.Encode()} {
+		_, err := DecodeCursor(value)
+		assert.ErrorIs(t, err, ErrInvalidCursor, value)
+	}
+}
+
+func TestIsCursorRequest(t *testing.T) {
+	tests := []struct {
+		url  string
+		want bool
+	}{
+		{"http://example.com?limit=10", true},
+		{"http://example.com?cursor=abc", true},
+		{"http://example.com?cursor=", true},
+		{"http://example.com?page=2&per_page=10", false},
+	}
+
+	for _, tt := range tests {
+		req, _ := http.NewRequest("GET", tt.url, nil)
+		assert.Equal(t, tt.want, IsCursorRequest(req), tt.url)
+	}
+}
+
+func TestNewKeysetFromRequest(t *testing.T) {
+	req, _ := http.NewRequest("GET", "http://example.com?limit=5000&cursor="+Cursor{ID: 7}.Encode(), nil)
+
+	keyset, err := NewKeysetFromRequest(req)
+
+	require.NoError(t, err)
+	assert.Equal(t, MaxPageSize, keyset.Limit)
+	assert.Equal(t, &Cursor{ID: 7}, keyset.Cursor)
+}
+
+func TestNewKeysetFromRequest_InvalidCursor(t *testing.T) {
+	req, _ := http.NewRequest("GET", "http://example.com?cursor=garbage", nil)
+
+	_, err := NewKeysetFromRequest(req)
+
+	assert.ErrorIs(t, err, ErrInvalidCursor)
+}
+
+func TestKeysetApply(t *testing.T) {
+	tests := []struct {
+		name     string
+		keyset   Keyset
+		wantSQL  string
+		wantVars []interface{}
+	}{
+		{"first page", Keyset{Limit: 2},
+			`SELECT * FROM "rows" ORDER BY "rows"."id" LIMIT $1`, []interface{}{3}},
+		{"after", Keyset{Cursor: &Cursor{ID: 10}, Limit: 2},
+			`SELECT * FROM "rows" WHERE "rows"."id" > $1 ORDER BY "rows"."id" LIMIT $2`, []interface{}{uint(10), 3}},
+		{"before", Keyset{Cursor: &Cursor{ID: 10, Before: true}, Limit: 2},
+			`SELECT * FROM "rows" WHERE "rows"."id" < $1 ORDER BY "rows"."id" DESC LIMIT $2`, []interface{}{uint(10), 3}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var result []row
+			stmt := tt.keyset.Apply(dryRunDB(t)).Find(&result).Statement
+
+			assert.Equal(t, tt.wantSQL, stmt.SQL.String())
+			assert.Equal(t, tt.wantVars, stmt.Vars)
+		})
+	}
+}
+
+func TestSlice(t *testing.T) {
+	tests := []struct {
+		name     string
+		keyset   Keyset
+		rows     []row
+		wantRows []row
+		wantNext *Cursor
+		wantPrev *Cursor
+	}{
+		{"first page with more", Keyset{Limit: 2}, rows(1, 2, 3), rows(1, 2),
+			&Cursor{ID: 2}, nil},
+		{"first page only", Keyset{Limit: 2}, rows(1, 2), rows(1, 2),
+			nil, nil},
+		{"middle page", Keyset{Cursor: &Cursor{ID: 2}, Limit: 2}, rows(3, 4, 5), rows(3, 4),
+			&Cursor{ID: 4}, &Cursor{ID: 3, Before: true}},
+		{"last page", Keyset{Cursor: &Cursor{ID: 4}, Limit: 2}, rows(5), rows(5),
+			nil, &Cursor{ID: 5, Before: true}},
+		{"backward with more", Keyset{Cursor: &Cursor{ID: 5, Before: true}, Limit: 2}, rows(4, 3, 2), rows(3, 4),
+			&Cursor{ID: 4}, &Cursor{ID: 3, Before: true}},
+		{"backward to start", Keyset{Cursor: &Cursor{ID: 3, Before: true}, Limit: 2}, rows(2, 1), rows(1, 2),
+			&Cursor{ID: 2}, nil},
+		{"empty", Keyset{Cursor: &Cursor{ID: 9}, Limit: 2}, nil, rows(),
+			nil, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, page := Slice(tt.keyset, tt.rows, rowID)
+
+			assert.Equal(t, tt.wantRows, append([]row{}, result...))
+			assert.Equal(t, tt.keyset.Limit, page.Limit)
+			assert.Equal(t, encoded(tt.wantNext), page.NextCursor)
+			assert.Equal(t, encoded(tt.wantPrev), page.PrevCursor)
+		})
+	}
+}
+
+func encoded(cursor *Cursor) string {
+	if cursor == nil {
+		return ""
+	}
+	return cursor.Encode()
+}
+
+func dryRunDB(t *testing.T) *gorm.DB {
+	sqlDB, _, err := sqlmock.New()
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = sqlDB.Close() })
+
+	db, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}), &gorm.Config{
+		DryRun: true,
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	require.NoError(t, err)
+
+	return db
+}
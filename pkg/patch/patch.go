@@ -0,0 +1,141 @@
+// Package patch binds JSON Merge Patch (RFC 7396) request bodies: only the
+// members a client sends are changed, and null clears a member.
+package patch
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"mime"
+	"net/http"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/gin-gonic/gin/binding"
+	"github.com/go-playground/validator/v10"
+)
+
+const ContentType = "application/merge-patch+json"
+
+// ErrUnsupportedMediaType means the body is neither a merge patch nor plain
+// JSON, which is read as one.
+var ErrUnsupportedMediaType = errors.New("the body must be " + ContentType + " or application/json")
+
+// Fields is the set of members present in a patch, including the ones set
+// to null.
+type Fields map[string]bool
+
+// Has reports whether the patch sets the member with the given JSON name.
+func (f Fields) Has(name string) bool {
+	return f[name]
+}
+
+// Names returns the members present in the patch in sorted order.
+func (f Fields) Names() []string {
+	names := make([]string, 0, len(f))
+	for name := range f {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// Bind decodes the merge patch in the request body into obj, a pointer to
+// a struct of pointer fields, and runs the binding tags of the members the
+// patch sets only. A member set to null leaves its field nil, so a field
+// that cannot be cleared is tagged "required". Unknown members are
+// rejected rather than ignored.
+func Bind(req *http.Request, obj interface{}) (Fields, error) {
+	if header := req.Header.Get("Content-Type"); header != "" {
+		mediaType, _, err := mime.ParseMediaType(header)
+		if err != nil || (mediaType != ContentType && mediaType != binding.MIMEJSON) {
+			return nil, ErrUnsupportedMediaType
+		}
+	}
+
+	var members map[string]json.RawMessage
+	if err := json.NewDecoder(req.Body).Decode(&members); err != nil {
+		return nil, err
+	}
+	if members == nil {
+		return nil, errors.New("the patch must be a JSON object")
+	}
+
+	target := reflect.ValueOf(obj).Elem()
+	fields := make(Fields, len(members))
+	for name, raw := range members {
+		field, ok := fieldByName(target, name)
+		if !ok {
+			return nil, fmt.Errorf("unknown field %q", name)
+		}
+
+		value := target.FieldByIndex(field.Index)
+		if !bytes.Equal(raw, []byte("null")) {
+			if err := json.Unmarshal(raw, value.Addr().Interface()); err != nil {
+				return nil, fmt.Errorf("field %q: %w", name, err)
+			}
+		}
+		if err := validate(name, value.Interface(), field.Tag.Get("binding")); err != nil {
+			return nil, err
+		}
+		fields[name] = true
+	}
+
+	return fields, nil
+}
+
+// Value returns what p points to, or the zero value for a member that the
+// patch set to null.
+func Value[T any](p *T) T {
+	var value T
+	if p != nil {
+		value = *p
+	}
+
+	return value
+}
+
+func fieldByName(target reflect.Value, name string) (reflect.StructField, bool) {
+	for i := 0; i < target.NumField(); i++ {
+		field := target.Type().Field(i)
+		tag, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if tag == name && tag != "-" {
+			return field, true
+		}
+	}
+
+	return reflect.StructField{}, false
+}
+
+// validate runs tag against one member and words a failure the way gin's
+// binding does, with the JSON name in place of the struct path.
+func validate(name string, value interface{}, tag string) error {
+	if tag == "" || tag == "-" {
+		return nil
+	}
+
+	engine, ok := binding.Validator.Engine().(*validator.Validate)
+	if !ok {
+		return nil
+	}
+
+	err := engine.Var(value, tag)
+	var validationErrors validator.ValidationErrors
+	if !errors.As(err, &validationErrors) {
+		return err
+	}
+
+	messages := make([]string, 0, len(validationErrors))
+	for _, fieldErr := range validationErrors {
+		key, field := name+fieldErr.Namespace(), fieldErr.Field()
+		if field == "" {
+			field = name
+		}
+		messages = append(messages, fmt.Sprintf("Key: '%s' Error:Field validation for '%s' failed on the '%s' tag", key, field, fieldErr.Tag()))
+	}
+
+	return errors.New(strings.Join(messages, "\n"))
+}
@@ -0,0 +1,94 @@
+package patch
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type item struct {
+	Kind string `json:"kind" binding:"required,oneof=home term"`
+}
+
+type target struct {
+	Name   *string `json:"name" binding:"required,min=1"`
+	Email  *string `json:"email" binding:"omitempty,email"`
+	Parent *uint   `json:"parentId"`
+	Items  *[]item `json:"items" binding:"omitempty,max=2,dive"`
+}
+
+func patchRequest(contentType, body string) *http.Request {
+	req := httptest.NewRequest(http.MethodPatch, "/", strings.NewReader(body))
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	return req
+}
+
+func TestBind(t *testing.T) {
+	var dst target
+	fields, err := Bind(patchRequest(ContentType, `{"email":"a@example.com","parentId":null}`), &dst)
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"email", "parentId"}, fields.Names())
+	assert.False(t, fields.Has("name"))
+	assert.Nil(t, dst.Name)
+	assert.Equal(t, "a@example.com", Value(dst.Email))
+	assert.Nil(t, dst.Parent)
+}
+
+func TestBind_ContentType(t *testing.T) {
+	tests := []struct {
+		contentType string
+		err         error
+	}{
+		{contentType: ""},
+		{contentType: "application/json"},
+		{contentType: "application/json; charset=utf-8"},
+		{contentType: ContentType},
+		{contentType: "application/json-patch+json", err: ErrUnsupportedMediaType},
+		{contentType: "text/plain", err: ErrUnsupportedMediaType},
+	}
+
+	for _, tt := range tests {
+		var dst target
+		_, err := Bind(patchRequest(tt.contentType, `{"name":"John"}`), &dst)
+
+		assert.ErrorIs(t, err, tt.err, tt.contentType)
+	}
+}
+
+func TestBind_Invalid(t *testing.T) {
+	tests := []struct {
+		body    string
+		message string
+	}{
+		{body: `{"name":null}`, message: "Key: 'name' Error:Field validation for 'name' failed on the 'required' tag"},
+		{body: `{"name":""}`, message: "Key: 'name' Error:Field validation for 'name' failed on the 'min' tag"},
+		{body: `{"email":"nope"}`, message: "Key: 'email' Error:Field validation for 'email' failed on the 'email' tag"},
+		{body: `{"items":[{"kind":"work"}]}`, message: "Key: 'items[0].Kind' Error:Field validation for 'Kind' failed on the 'oneof' tag"},
+		{body: `{"id":1}`, message: `unknown field "id"`},
+		{body: `{"parentId":"one"}`, message: `field "parentId"`},
+		{body: `null`, message: "the patch must be a JSON object"},
+		{body: `[]`, message: "cannot unmarshal array"},
+	}
+
+	for _, tt := range tests {
+		var dst target
+		_, err := Bind(patchRequest(ContentType, tt.body), &dst)
+
+		require.Error(t, err, tt.body)
+		assert.Contains(t, err.Error(), tt.message, tt.body)
+	}
+}
+
+func TestValue(t *testing.T) {
+	name := "John"
+
+	assert.Equal(t, "John", Value(&name))
+	assert.Equal(t, "", Value[string](nil))
+}
@@ -0,0 +1,318 @@
+// Package query provides support for filtering, sorting and shaping list
+// requests.
+//
+// Filters use the form filter[field][op]=value (the operator defaults to eq)
+// and sorting uses sort=-name,id, where a leading "-" sorts descending. Only
+// the fields listed in a resource's Schema are accepted, and their values are
+// always bound as query parameters.
+//
+// include=courses,courses.teacher selects the relations to load and
+// fields=id,name the attributes to return; see Relations and Attributes.
+//
+// include_deleted=true adds soft-deleted rows. Parse accepts it from anyone;
+// handlers decide who may use it.
+package query
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var (
+	FilterVar  = "filter"
+	SortVar    = "sort"
+	IncludeVar = "include"
+	FieldsVar  = "fields"
+	// IncludeDeletedVar lists soft-deleted rows as well.
+	IncludeDeletedVar = "include_deleted"
+)
+
+// ErrInvalid is wrapped by every error Parse returns.
+var ErrInvalid = errors.New("invalid query")
+
+type Type int
+
+const (
+	String Type = iota
+	Int
+	Bool
+)
+
+type Op string
+
+const (
+	Eq       Op = "eq"
+	Ne       Op = "ne"
+	Contains Op = "contains"
+	Lt       Op = "lt"
+	Lte      Op = "lte"
+	Gt       Op = "gt"
+	Gte      Op = "gte"
+	// Null matches rows where the column is NULL (value true) or not NULL
+	// (value false). It is only accepted for Nullable fields.
+	Null Op = "null"
+)
+
+var opsByType = map[Type][]Op{
+	String: {Eq, Ne, Contains},
+	Int:    {Eq, Ne, Lt, Lte, Gt, Gte},
+	Bool:   {Eq, Ne},
+}
+
+var comparisons = map[Op]string{
+	Eq:  "=",
+	Ne:  "<>",
+	Lt:  "<",
+	Lte: "<=",
+	Gt:  ">",
+	Gte: ">=",
+}
+
+// Field describes one column a resource can be filtered and sorted by.
+type Field struct {
+	Column   string
+	Type     Type
+	Nullable bool
+}
+
+// Schema maps the field names clients use to the columns behind them.
+type Schema map[string]Field
+
+type Filter struct {
+	Column string
+	Op     Op
+	Value  interface{}
+}
+
+type Sort struct {
+	Column string
+	Desc   bool
+}
+
+// Spec is a parsed list request. The zero value matches every row in the
+// default order, loads no relations and returns every field.
+type Spec struct {
+	Filters []Filter
+	Sorts   []Sort
+	// Include holds the requested include paths and Fields the requested
+	// attributes, nil meaning all of them.
+	Include []string
+	Fields  []string
+	// IncludeDeleted disables the soft-delete scope.
+	IncludeDeleted bool
+}
+
+func NewFromRequest(req *http.Request, schema Schema, relations Relations, attributes Attributes) (Spec, error) {
+	return Parse(req.URL.Query(), schema, relations, attributes)
+}
+
+func Parse(values url.Values, schema Schema, relations Relations, attributes Attributes) (Spec, error) {
+	var spec Spec
+
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		if strings.HasPrefix(key, FilterVar+"[") {
+			keys = append(keys, key)
+		}
+	}
+	// Map iteration order is random; keep the generated SQL stable.
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		name, op, err := parseFilterKey(key)
+		if err != nil {
+			return Spec{}, err
+		}
+		field, ok := schema[name]
+		if !ok {
+			return Spec{}, fmt.Errorf("%w: cannot filter by %q", ErrInvalid, name)
+		}
+		for _, raw := range values[key] {
+			filter, err := newFilter(name, field, op, raw)
+			if err != nil {
+				return Spec{}, err
+			}
+			spec.Filters = append(spec.Filters, filter)
+		}
+	}
+
+	if param := values.Get(SortVar); param != "" {
+		for _, name := range strings.Split(param, ",") {
+			desc := strings.HasPrefix(name, "-")
+			name = strings.TrimPrefix(name, "-")
+			field, ok := schema[name]
+			if !ok {
+				return Spec{}, fmt.Errorf("%w: cannot sort by %q", ErrInvalid, name)
+			}
+			spec.Sorts = append(spec.Sorts, Sort{Column: field.Column, Desc: desc})
+		}
+	}
+
+	if param := values.Get(IncludeVar); param != "" {
+		for _, path := range strings.Split(param, ",") {
+			if _, ok := relations[path]; !ok {
+				return Spec{}, fmt.Errorf("%w: cannot include %q", ErrInvalid, path)
+			}
+			spec.Include = append(spec.Include, path)
+		}
+	}
+
+	if param := values.Get(FieldsVar); param != "" {
+		for _, field := range strings.Split(param, ",") {
+			if !attributes[field] {
+				return Spec{}, fmt.Errorf("%w: unknown field %q", ErrInvalid, field)
+			}
+			spec.Fields = append(spec.Fields, field)
+		}
+	}
+
+	if param := values.Get(IncludeDeletedVar); param != "" {
+		includeDeleted, err := strconv.ParseBool(param)
+		if err != nil {
+			return Spec{}, fmt.Errorf("%w: %s must be true or false", ErrInvalid, IncludeDeletedVar)
+		}
+		spec.IncludeDeleted = includeDeleted
+	}
+
+	return spec, nil
+}
+
+// parseFilterKey splits filter[name] and filter[name][op].
+func parseFilterKey(key string) (string, Op, error) {
+	rest := strings.TrimPrefix(key, FilterVar)
+	var parts []string
+	for rest != "" {
+		if rest[0] != '[' {
+			return "", "", fmt.Errorf("%w: malformed filter %q", ErrInvalid, key)
+		}
+		end := strings.IndexByte(rest, ']')
+		if end < 0 {
+			return "", "", fmt.Errorf("%w: malformed filter %q", ErrInvalid, key)
+		}
+		parts = append(parts, rest[1:end])
+		rest = rest[end+1:]
+	}
+
+	switch len(parts) {
+	case 1:
+		return parts[0], Eq, nil
+	case 2:
+		return parts[0], Op(parts[1]), nil
+	default:
+		return "", "", fmt.Errorf("%w: malformed filter %q", ErrInvalid, key)
+	}
+}
+
+func newFilter(name string, field Field, op Op, raw string) (Filter, error) {
+	if op == Null {
+		if !field.Nullable {
+			return Filter{}, fmt.Errorf("%w: %q cannot be null", ErrInvalid, name)
+		}
+		isNull, err := strconv.ParseBool(raw)
+		if err != nil {
+			return Filter{}, fmt.Errorf("%w: %q expects true or false", ErrInvalid, name)
+		}
+		return Filter{Column: field.Column, Op: op, Value: isNull}, nil
+	}
+
+	if !allowed(field.Type, op) {
+		return Filter{}, fmt.Errorf("%w: operator %q is not supported for %q", ErrInvalid, op, name)
+	}
+
+	var value interface{}
+	switch field.Type {
+	case Int:
+		parsed, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil {
+			return Filter{}, fmt.Errorf("%w: %q expects an integer", ErrInvalid, name)
+		}
+		value = parsed
+	case Bool:
+		parsed, err := strconv.ParseBool(raw)
+		if err != nil {
+			return Filter{}, fmt.Errorf("%w: %q expects true or false", ErrInvalid, name)
+		}
+		value = parsed
+	default:
+		value = raw
+	}
+
+	return Filter{Column: field.Column, Op: op, Value: value}, nil
+}
+
+func allowed(t Type, op Op) bool {
+	for _, candidate := range opsByType[t] {
+		if candidate == op {
+			return true
+		}
+	}
+	return false
+}
+
+// Where adds the filters to db. Use it for both the page query and Count so
+// that total_count matches the filtered set.
+func (s Spec) Where(db *gorm.DB) *gorm.DB {
+	if s.IncludeDeleted {
+		db = db.Unscoped()
+	}
+
+	for _, filter := range s.Filters {
+		column := clause.Column{Table: clause.CurrentTable, Name: filter.Column}
+		switch filter.Op {
+		case Contains:
+			pattern := "%" + escapeLike(filter.Value.(string)) + "%"
+			db = db.Where(clause.Expr{SQL: "? ILIKE ?", Vars: []interface{}{column, pattern}})
+		case Null:
+			if filter.Value.(bool) {
+				db = db.Where(clause.Expr{SQL: "? IS NULL", Vars: []interface{}{column}})
+			} else {
+				db = db.Where(clause.Expr{SQL: "? IS NOT NULL", Vars: []interface{}{column}})
+			}
+		default:
+			db = db.Where(clause.Expr{SQL: "? " + comparisons[filter.Op] + " ?", Vars: []interface{}{column, filter.Value}})
+		}
+	}
+
+	return db
+}
+
+// Order adds the requested sort to db. Unless the sort includes id, id is
+// appended in the direction of the last key, so that rows with equal keys
+// keep their place between pages; without a sort the rows are in id order.
+func (s Spec) Order(db *gorm.DB) *gorm.DB {
+	byId, desc := false, false
+	for _, order := range s.Sorts {
+		db = db.Order(clause.OrderByColumn{
+			Column: clause.Column{Table: clause.CurrentTable, Name: order.Column},
+			Desc:   order.Desc,
+		})
+		byId = byId || order.Column == "id"
+		desc = order.Desc
+	}
+
+	if !byId {
+		db = db.Order(clause.OrderByColumn{
+			Column: clause.Column{Table: clause.CurrentTable, Name: "id"},
+			Desc:   desc,
+		})
+	}
+
+	return db
+}
+
+// Apply adds both the filters and the sort to db.
+func (s Spec) Apply(db *gorm.DB) *gorm.DB {
+	return s.Order(s.Where(db))
+}
+
+func escapeLike(value string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(value)
+}
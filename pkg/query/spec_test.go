@@ -0,0 +1,171 @@
+package query
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+var testSchema = Schema{
+	"id":         {Column: "id", Type: Int},
+	"name":       {Column: "name", Type: String},
+	"active":     {Column: "is_active", Type: Bool},
+	"teacher_id": {Column: "teacher_id", Type: Int, Nullable: true},
+}
+
+type row struct {
+	ID   uint
+	Name string
+}
+
+func (row) TableName() string {
+	return "rows"
+}
+
+func TestParse(t *testing.T) {
+	values, _ := url.ParseQuery("filter[name][contains]=ann&filter[id][gte]=3&filter[teacher_id][null]=true&sort=-name,id&page=2")
+
+	spec, err := Parse(values, testSchema, nil, nil)
+
+	require.NoError(t, err)
+	assert.Equal(t, []Filter{
+		{Column: "id", Op: Gte, Value: int64(3)},
+		{Column: "name", Op: Contains, Value: "ann"},
+		{Column: "teacher_id", Op: Null, Value: true},
+	}, spec.Filters)
+	assert.Equal(t, []Sort{{Column: "name", Desc: true}, {Column: "id"}}, spec.Sorts)
+}
+
+func TestParse_DefaultsToEq(t *testing.T) {
+	values, _ := url.ParseQuery("filter[active]=true")
+
+	spec, err := Parse(values, testSchema, nil, nil)
+
+	require.NoError(t, err)
+	assert.Equal(t, []Filter{{Column: "is_active", Op: Eq, Value: true}}, spec.Filters)
+}
+
+func TestParse_Invalid(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+	}{
+		{"unknown filter field", "filter[password]=x"},
+		{"unknown sort field", "sort=password"},
+		{"unsupported operator", "filter[name][gt]=a"},
+		{"unknown operator", "filter[id][like]=1"},
+		{"not an integer", "filter[id]=abc"},
+		{"not a bool", "filter[active]=maybe"},
+		{"null on required field", "filter[name][null]=true"},
+		{"malformed key", "filter[name]x=a"},
+		{"too many parts", "filter[name][eq][x]=a"},
+		{"include_deleted not a bool", "include_deleted=maybe"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			values, _ := url.ParseQuery(tt.query)
+			_, err := Parse(values, testSchema, nil, nil)
+			assert.True(t, errors.Is(err, ErrInvalid), "got %v", err)
+		})
+	}
+}
+
+func TestNewFromRequest(t *testing.T) {
+	req, _ := http.NewRequest("GET", "http://example.com?sort=-id", nil)
+
+	spec, err := NewFromRequest(req, testSchema, nil, nil)
+
+	require.NoError(t, err)
+	assert.Equal(t, []Sort{{Column: "id", Desc: true}}, spec.Sorts)
+	assert.Empty(t, spec.Filters)
+}
+
+func TestApply(t *testing.T) {
+	db := dryRunDB(t)
+	spec := Spec{
+		Filters: []Filter{
+			{Column: "name", Op: Contains, Value: "50%_off"},
+			{Column: "id", Op: Ne, Value: int64(3)},
+			{Column: "teacher_id", Op: Null, Value: false},
+		},
+		Sorts: []Sort{{Column: "name", Desc: true}, {Column: "id"}},
+	}
+
+	var rows []row
+	stmt := spec.Apply(db).Find(&rows).Statement
+
+	assert.Equal(t,
+		`SELECT * FROM "rows" WHERE "rows"."name" ILIKE $1 AND "rows"."id" <> $2 AND "rows"."teacher_id" IS NOT NULL ORDER BY "rows"."name" DESC,"rows"."id"`,
+		stmt.SQL.String())
+	assert.Equal(t, []interface{}{`%50\%\_off%`, int64(3)}, stmt.Vars)
+}
+
+func TestOrder_Tiebreaker(t *testing.T) {
+	tests := []struct {
+		sorts []Sort
+		order string
+	}{
+		{sorts: nil, order: `ORDER BY "rows"."id"`},
+		{sorts: []Sort{{Column: "name"}}, order: `ORDER BY "rows"."name","rows"."id"`},
+		{sorts: []Sort{{Column: "teacher_id"}, {Column: "name", Desc: true}}, order: `ORDER BY "rows"."teacher_id","rows"."name" DESC,"rows"."id" DESC`},
+		{sorts: []Sort{{Column: "id", Desc: true}, {Column: "name"}}, order: `ORDER BY "rows"."id" DESC,"rows"."name"`},
+	}
+
+	for _, tt := range tests {
+		var rows []row
+		stmt := Spec{Sorts: tt.sorts}.Order(dryRunDB(t)).Find(&rows).Statement
+
+		assert.Equal(t, `SELECT * FROM "rows" `+tt.order, stmt.SQL.String())
+	}
+}
+
+func TestWhere_ZeroSpec(t *testing.T) {
+	db := dryRunDB(t)
+
+	var count int64
+	stmt := Spec{}.Where(db.Model(&row{})).Count(&count).Statement
+
+	assert.Equal(t, `SELECT count(*) FROM "rows"`, stmt.SQL.String())
+}
+
+func TestWhere_IncludeDeleted(t *testing.T) {
+	values, _ := url.ParseQuery("include_deleted=true")
+	spec, err := Parse(values, testSchema, nil, nil)
+	require.NoError(t, err)
+	require.True(t, spec.IncludeDeleted)
+
+	var rows []deletableRow
+	db := dryRunDB(t)
+	assert.Equal(t, `SELECT * FROM "deletable_rows" WHERE "deletable_rows"."deleted_at" IS NULL`,
+		Spec{}.Where(db).Find(&rows).Statement.SQL.String())
+	assert.Equal(t, `SELECT * FROM "deletable_rows"`,
+		spec.Where(db).Find(&rows).Statement.SQL.String())
+}
+
+type deletableRow struct {
+	ID        uint
+	DeletedAt gorm.DeletedAt
+}
+
+func dryRunDB(t *testing.T) *gorm.DB {
+	sqlDB, _, err := sqlmock.New()
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = sqlDB.Close() })
+
+	db, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}), &gorm.Config{
+		DryRun: true,
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	require.NoError(t, err)
+
+	return db
+}
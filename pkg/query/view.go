@@ -0,0 +1,143 @@
+package query
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+// Relations maps the include paths clients use to GORM association paths,
+// e.g. "courses.teacher" to "Courses.Teacher". Each segment of an include
+// path must be the JSON key the relation is rendered under.
+type Relations map[string]string
+
+// Attributes is the set of JSON keys list items are rendered with, which
+// fields can select from.
+type Attributes map[string]bool
+
+// AttributesOf returns the attributes of item, a response DTO or a pointer
+// to one.
+func AttributesOf(item interface{}) Attributes {
+	return jsonKeys(reflect.TypeOf(item))
+}
+
+// Preload loads the included relations, and only those.
+func (s Spec) Preload(db *gorm.DB, relations Relations) *gorm.DB {
+	for _, path := range s.Include {
+		db = db.Preload(relations[path])
+	}
+
+	return db
+}
+
+// Render converts items, a slice of response DTOs, into the JSON objects the
+// client asked for: relations that were not included are dropped at every
+// level, and top-level attributes are limited to Fields. Unknown fields are
+// reported as ErrInvalid.
+func (s Spec) Render(items interface{}, relations Relations) (interface{}, error) {
+	value := reflect.ValueOf(items)
+	if value.Kind() != reflect.Slice || value.IsNil() {
+		return items, nil
+	}
+
+	var fields map[string]bool
+	if s.Fields != nil {
+		known := jsonKeys(value.Type().Elem())
+		fields = make(map[string]bool, len(s.Fields))
+		for _, field := range s.Fields {
+			if !known[field] {
+				return nil, fmt.Errorf("%w: unknown field %q", ErrInvalid, field)
+			}
+			fields[field] = true
+		}
+	}
+
+	included := make(map[string]bool)
+	for _, path := range s.Include {
+		// courses.teacher implies courses.
+		for i := range path {
+			if path[i] == '.' {
+				included[path[:i]] = true
+			}
+		}
+		included[path] = true
+	}
+
+	data, err := json.Marshal(items)
+	if err != nil {
+		return nil, err
+	}
+	var objects []map[string]interface{}
+	if err := json.Unmarshal(data, &objects); err != nil {
+		return nil, err
+	}
+
+	for _, object := range objects {
+		trim(object, "", relations, included)
+		for key := range object {
+			if fields != nil && !fields[key] && !isRelation(key, relations) {
+				delete(object, key)
+			}
+		}
+	}
+
+	return objects, nil
+}
+
+func trim(object map[string]interface{}, prefix string, relations Relations, included map[string]bool) {
+	for key, value := range object {
+		path := prefix + key
+		if _, ok := relations[path]; !ok {
+			continue
+		}
+		if !included[path] {
+			delete(object, key)
+			continue
+		}
+
+		switch nested := value.(type) {
+		case map[string]interface{}:
+			trim(nested, path+".", relations, included)
+		case []interface{}:
+			for _, element := range nested {
+				if child, ok := element.(map[string]interface{}); ok {
+					trim(child, path+".", relations, included)
+				}
+			}
+		}
+	}
+}
+
+func isRelation(key string, relations Relations) bool {
+	_, ok := relations[key]
+	return ok
+}
+
+// jsonKeys returns the JSON keys of the struct t, or of the struct it points
+// to.
+func jsonKeys(t reflect.Type) map[string]bool {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	keys := make(map[string]bool)
+	if t.Kind() != reflect.Struct {
+		return keys
+	}
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name := strings.Split(field.Tag.Get("json"), ",")[0]
+		if name == "-" || !field.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		keys[name] = true
+	}
+
+	return keys
+}
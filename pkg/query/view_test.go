@@ -0,0 +1,123 @@
+package query
+
+import (
+	"errors"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type teacherDTO struct {
+	ID   uint   `json:"id"`
+	Name string `json:"name"`
+}
+
+type courseDTO struct {
+	ID      uint        `json:"id"`
+	Title   string      `json:"title"`
+	Teacher *teacherDTO `json:"teacher"`
+}
+
+type studentDTO struct {
+	ID      uint        `json:"id"`
+	Name    string      `json:"name"`
+	Email   string      `json:"email"`
+	Courses []courseDTO `json:"courses"`
+}
+
+var testRelations = Relations{
+	"courses":         "Courses",
+	"courses.teacher": "Courses.Teacher",
+}
+
+func testStudents() []*studentDTO {
+	return []*studentDTO{{
+		ID:    1,
+		Name:  "Ann",
+		Email: "ann@example.com",
+		Courses: []courseDTO{
+			{ID: 10, Title: "Biology", Teacher: &teacherDTO{ID: 100, Name: "Dr. Darwin"}},
+		},
+	}}
+}
+
+func TestParse_IncludeAndFields(t *testing.T) {
+	values, _ := url.ParseQuery("include=courses,courses.teacher&fields=id,name")
+
+	spec, err := Parse(values, testSchema, testRelations, AttributesOf(studentDTO{}))
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"courses", "courses.teacher"}, spec.Include)
+	assert.Equal(t, []string{"id", "name"}, spec.Fields)
+}
+
+func TestParse_UnknownField(t *testing.T) {
+	values, _ := url.ParseQuery("fields=id,age")
+
+	_, err := Parse(values, testSchema, testRelations, AttributesOf(&studentDTO{}))
+
+	assert.True(t, errors.Is(err, ErrInvalid))
+	assert.Contains(t, err.Error(), `unknown field "age"`)
+}
+
+func TestParse_UnknownInclude(t *testing.T) {
+	values, _ := url.ParseQuery("include=courses.students")
+
+	_, err := Parse(values, testSchema, testRelations, AttributesOf(studentDTO{}))
+
+	assert.True(t, errors.Is(err, ErrInvalid))
+}
+
+func TestPreload(t *testing.T) {
+	db := dryRunDB(t)
+	spec := Spec{Include: []string{"courses.teacher"}}
+
+	preloads := spec.Preload(db, testRelations).Statement.Preloads
+
+	assert.Len(t, preloads, 1)
+	assert.Contains(t, preloads, "Courses.Teacher")
+}
+
+func TestRender_DropsRelationsNotIncluded(t *testing.T) {
+	items, err := Spec{}.Render(testStudents(), testRelations)
+
+	require.NoError(t, err)
+	assert.Equal(t, []map[string]interface{}{
+		{"id": float64(1), "name": "Ann", "email": "ann@example.com"},
+	}, items)
+}
+
+func TestRender_NestedInclude(t *testing.T) {
+	items, err := Spec{Include: []string{"courses"}, Fields: []string{"name"}}.Render(testStudents(), testRelations)
+
+	require.NoError(t, err)
+	assert.Equal(t, []map[string]interface{}{{
+		"name":    "Ann",
+		"courses": []interface{}{map[string]interface{}{"id": float64(10), "title": "Biology"}},
+	}}, items)
+}
+
+func TestRender_IncludeImpliesParent(t *testing.T) {
+	items, err := Spec{Include: []string{"courses.teacher"}}.Render(testStudents(), testRelations)
+
+	require.NoError(t, err)
+	course := items.([]map[string]interface{})[0]["courses"].([]interface{})[0].(map[string]interface{})
+	assert.Equal(t, "Dr. Darwin", course["teacher"].(map[string]interface{})["name"])
+}
+
+func TestRender_UnknownField(t *testing.T) {
+	_, err := Spec{Fields: []string{"password"}}.Render(testStudents(), testRelations)
+
+	assert.True(t, errors.Is(err, ErrInvalid))
+}
+
+func TestRender_Nil(t *testing.T) {
+	var students []*studentDTO
+
+	items, err := Spec{Fields: []string{"password"}}.Render(students, testRelations)
+
+	require.NoError(t, err)
+	assert.Nil(t, items)
+}
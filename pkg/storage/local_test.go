@@ -0,0 +1,53 @@
+package storage
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocal_RoundTrip(t *testing.T) {
+	s := NewLocal(t.TempDir())
+	ctx := context.Background()
+
+	require.NoError(t, s.Put(ctx, "2025/01/abc", strings.NewReader("hello world"), 11, "text/plain"))
+
+	r, err := s.Open(ctx, "2025/01/abc", 6, 3)
+	require.NoError(t, err)
+	data, _ := io.ReadAll(r)
+	r.Close()
+	assert.Equal(t, "wor", string(data))
+
+	r, err = s.Open(ctx, "2025/01/abc", 6, -1)
+	require.NoError(t, err)
+	data, _ = io.ReadAll(r)
+	r.Close()
+	assert.Equal(t, "world", string(data))
+
+	require.NoError(t, s.Delete(ctx, "2025/01/abc"))
+	_, err = s.Open(ctx, "2025/01/abc", 0, -1)
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestLocal_SizeMismatch(t *testing.T) {
+	s := NewLocal(t.TempDir())
+
+	err := s.Put(context.Background(), "a", strings.NewReader("short"), 10, "")
+
+	assert.Error(t, err)
+	_, err = s.Open(context.Background(), "a", 0, -1)
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestLocal_RejectsEscapingKeys(t *testing.T) {
+	s := NewLocal(t.TempDir())
+
+	for _, key := range []string{"../x", "/etc/passwd", "a/../../x", ""} {
+		err := s.Put(context.Background(), key, strings.NewReader(""), 0, "")
+		assert.Error(t, err, key)
+	}
+}
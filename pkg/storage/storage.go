@@ -0,0 +1,21 @@
+// Package storage stores binary objects in a local directory or an
+// S3-compatible bucket.
+package storage
+
+import (
+	"context"
+	"errors"
+	"io"
+)
+
+var ErrNotFound = errors.New("object not found")
+
+// Storage keeps objects addressed by slash-separated keys.
+type Storage interface {
+	// Put stores exactly size bytes read from r under key.
+	Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) error
+	// Open returns length bytes of the object starting at offset. A
+	// negative length reads to the end of the object.
+	Open(ctx context.Context, key string, offset, length int64) (io.ReadCloser, error)
+	Delete(ctx context.Context, key string) error
+}